@@ -2,8 +2,11 @@ package admin
 
 import (
 	"context"
+	"io"
+	"time"
 
 	proto "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Data provides access to Powergate data admin APIs.
@@ -20,3 +23,84 @@ func (w *Data) GCStaged(ctx context.Context) (*proto.GCStagedResponse, error) {
 func (w *Data) PinnedCids(ctx context.Context) (*proto.PinnedCidsResponse, error) {
 	return w.client.PinnedCids(ctx, &proto.PinnedCidsRequest{})
 }
+
+// ReconcileHotStorageConfig configures a reconciliation of hot-storage pins
+// with the pins of the IPFS node.
+type ReconcileHotStorageConfig struct {
+	// DryRun only reports differences without fixing them.
+	DryRun bool
+	// RepinTimeout bounds re-pinning each missing cid from the IPFS network.
+	// Zero skips re-pinning from the network.
+	RepinTimeout time.Duration
+	// UnpinUnknown unpins IPFS node pins unknown to Powergate.
+	UnpinUnknown bool
+}
+
+// ReconcileHotStorage reconciles hot-storage pins with the pins of the IPFS node,
+// re-pinning or unfreezing missing data.
+func (w *Data) ReconcileHotStorage(ctx context.Context, config ReconcileHotStorageConfig) (*proto.ReconcileHotStorageResponse, error) {
+	req := &proto.ReconcileHotStorageRequest{
+		DryRun:       config.DryRun,
+		RepinTimeout: int64(config.RepinTimeout.Seconds()),
+		UnpinUnknown: config.UnpinUnknown,
+	}
+	return w.client.ReconcileHotStorage(ctx, req)
+}
+
+// ExportLogsConfig configures which job logs are exported.
+type ExportLogsConfig struct {
+	// UserID filters logs of the specified user. If empty, logs of all users are exported.
+	UserID string
+	// From filters logs created at or after this time. Zero means unbounded.
+	From time.Time
+	// To filters logs created at or before this time. Zero means unbounded.
+	To time.Time
+}
+
+// ExportLogs writes job logs as newline-delimited JSON to dst.
+func (w *Data) ExportLogs(ctx context.Context, dst io.Writer, config ExportLogsConfig) error {
+	req := &proto.ExportLogsRequest{UserId: config.UserID}
+	if !config.From.IsZero() {
+		req.From = timestamppb.New(config.From)
+	}
+	if !config.To.IsZero() {
+		req.To = timestamppb.New(config.To)
+	}
+	stream, err := w.client.ExportLogs(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := dst.Write(res.Data); err != nil {
+			return err
+		}
+	}
+}
+
+// Backup writes a backup archive of the complete Powergate datastore to dst.
+// The backup is taken while Powergate keeps running.
+func (w *Data) Backup(ctx context.Context, dst io.Writer) error {
+	stream, err := w.client.Backup(ctx, &proto.BackupRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := dst.Write(res.Data); err != nil {
+			return err
+		}
+	}
+}
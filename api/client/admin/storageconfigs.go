@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"context"
+
+	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
+)
+
+// StorageConfigs provides access to Powergate storage config admin APIs.
+type StorageConfigs struct {
+	client adminPb.AdminServiceClient
+}
+
+// BulkUpdateConfig describes a bulk transformation of storage configs.
+type BulkUpdateConfig struct {
+	// UserIDs selects only storage configs of these users.
+	UserIDs []string
+	// Cids selects only storage configs of these cids.
+	Cids []string
+	// Where are conditions that selected storage configs must satisfy, e.g: cold.filecoin.maxPrice=0.
+	Where []string
+	// Set are field assignments applied to selected storage configs, e.g: cold.filecoin.maxPrice=1000.
+	Set []string
+	// Repush indicates that updated storage configs should be pushed as new Jobs.
+	Repush bool
+	// DryRun reports which storage configs would be updated without applying changes.
+	DryRun bool
+}
+
+// BulkUpdate applies a declarative transformation to all selected storage configs.
+// Failures are reported in the returned updates, without a Cid for users whose
+// storage configs couldn't be read. Unknown users fail the request before any
+// storage config is updated.
+func (s *StorageConfigs) BulkUpdate(ctx context.Context, config BulkUpdateConfig) (*adminPb.BulkUpdateStorageConfigsResponse, error) {
+	req := &adminPb.BulkUpdateStorageConfigsRequest{
+		UserIds: config.UserIDs,
+		Cids:    config.Cids,
+		Where:   config.Where,
+		Set:     config.Set,
+		Repush:  config.Repush,
+		DryRun:  config.DryRun,
+	}
+	return s.client.BulkUpdateStorageConfigs(ctx, req)
+}
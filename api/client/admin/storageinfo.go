@@ -21,3 +21,25 @@ func (s *StorageInfo) Get(ctx context.Context, userID, cid string) (*adminPb.Sto
 func (s *StorageInfo) List(ctx context.Context, userIDs, cids []string) (*adminPb.ListStorageInfoResponse, error) {
 	return s.client.ListStorageInfo(ctx, &adminPb.ListStorageInfoRequest{UserIds: userIDs, Cids: cids})
 }
+
+// ExpirationForecast returns the forecast of stored cids having deals expiring within the
+// provided amount of epochs, filtered by user ids if provided.
+func (s *StorageInfo) ExpirationForecast(ctx context.Context, userIDs []string, withinEpochs uint64) (*adminPb.ExpirationForecastResponse, error) {
+	return s.client.ExpirationForecast(ctx, &adminPb.ExpirationForecastRequest{UserIds: userIDs, WithinEpochs: withinEpochs})
+}
+
+// AuditReports returns the last audit reports of stored cids, filtered by user ids and cids if provided.
+func (s *StorageInfo) AuditReports(ctx context.Context, userIDs, cids []string, onlyUnhealthy bool) (*adminPb.AuditReportsResponse, error) {
+	return s.client.AuditReports(ctx, &adminPb.AuditReportsRequest{UserIds: userIDs, Cids: cids, OnlyUnhealthy: onlyUnhealthy})
+}
+
+// AuditSummary returns a health summary of the last audit reports of stored cids,
+// filtered by user ids if provided.
+func (s *StorageInfo) AuditSummary(ctx context.Context, userIDs []string) (*adminPb.AuditSummaryResponse, error) {
+	return s.client.AuditSummary(ctx, &adminPb.AuditSummaryRequest{UserIds: userIDs})
+}
+
+// Usage returns the effective usage of users, filtered by user ids if provided.
+func (s *StorageInfo) Usage(ctx context.Context, userIDs []string) (*adminPb.UsageResponse, error) {
+	return s.client.Usage(ctx, &adminPb.UsageRequest{UserIds: userIDs})
+}
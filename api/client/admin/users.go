@@ -25,3 +25,9 @@ func (p *Users) RegenerateAuth(ctx context.Context, token string) (*adminPb.Rege
 func (p *Users) List(ctx context.Context) (*adminPb.UsersResponse, error) {
 	return p.client.Users(ctx, &adminPb.UsersRequest{})
 }
+
+// SetMinerSelectorParams sets the composite miner selector configuration override
+// of a user. Empty params remove the override.
+func (p *Users) SetMinerSelectorParams(ctx context.Context, userID, params string) (*adminPb.SetUserMinerSelectorParamsResponse, error) {
+	return p.client.SetUserMinerSelectorParams(ctx, &adminPb.SetUserMinerSelectorParamsRequest{UserId: userID, Params: params})
+}
@@ -20,6 +20,7 @@ type Client struct {
 	Deals         *Deals
 	StorageInfo   *StorageInfo
 	StorageJobs   *StorageJobs
+	Names         *Names
 	Admin         *admin.Admin
 	conn          *grpc.ClientConn
 	client        userPb.UserServiceClient
@@ -100,6 +101,7 @@ func NewClient(host string, optsOverrides ...grpc.DialOption) (*Client, error) {
 		Deals:         &Deals{client: client},
 		StorageInfo:   &StorageInfo{client: client},
 		StorageJobs:   &StorageJobs{client: client},
+		Names:         &Names{client: client},
 		Admin:         admin.NewAdmin(adminPb.NewAdminServiceClient(conn)),
 		conn:          conn,
 		client:        client,
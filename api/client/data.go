@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"net/http"
@@ -14,6 +15,7 @@ import (
 	ipfspath "github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/multiformats/go-multiaddr"
 	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
+	"github.com/textileio/powergate/v2/importopts"
 	"github.com/textileio/powergate/v2/util"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -43,6 +45,95 @@ func WithHistory(enabled bool) WatchLogsOption {
 	}
 }
 
+// WithMinLevel filters only log messages with a level equal
+// or higher than level.
+func WithMinLevel(level userPb.LogLevel) WatchLogsOption {
+	return func(r *userPb.WatchLogsRequest) {
+		r.MinLevel = level
+	}
+}
+
+// WithEventTypes filters only log messages with any of
+// the provided event types.
+func WithEventTypes(types ...userPb.LogEventType) WatchLogsOption {
+	return func(r *userPb.WatchLogsRequest) {
+		r.EventTypes = types
+	}
+}
+
+// StageOption configures how data is staged.
+type StageOption func(*stageConfig)
+
+type stageConfig struct {
+	importOptions *importopts.Options
+}
+
+// WithImportOptions configures the UnixFS import options of staged
+// data, which determine its Cid.
+func WithImportOptions(opts importopts.Options) StageOption {
+	return func(c *stageConfig) {
+		c.importOptions = &opts
+	}
+}
+
+// GetCarOption configures the CAR file of a DAG.
+type GetCarOption func(r *userPb.GetCarRequest)
+
+// WithCarVersion configures the CAR format version, 1 or 2.
+// The default is 1.
+func WithCarVersion(version int) GetCarOption {
+	return func(r *userPb.GetCarRequest) {
+		r.Version = int32(version)
+	}
+}
+
+// WithSelector restricts the blocks of the DAG included in the CAR
+// file to the ones traversed by a dag-json encoded IPLD selector.
+func WithSelector(selector string) GetCarOption {
+	return func(r *userPb.GetCarRequest) {
+		r.Selector = selector
+	}
+}
+
+// VerifyDataOption configures a VerifyDataRequest.
+type VerifyDataOption func(r *userPb.VerifyDataRequest)
+
+// WithVerifyHot enables verifying the data in Hot Storage.
+func WithVerifyHot() VerifyDataOption {
+	return func(r *userPb.VerifyDataRequest) {
+		r.Hot = true
+	}
+}
+
+// WithVerifyCold enables verifying active deals retrieving data from their
+// miners. If full is false, the top depth levels of the DAG are retrieved
+// from every active deal, otherwise the whole DAG is retrieved.
+func WithVerifyCold(full bool, depth int) VerifyDataOption {
+	return func(r *userPb.VerifyDataRequest) {
+		r.Cold = true
+		r.Mode = userPb.VerifyMode_VERIFY_MODE_SAMPLED
+		if full {
+			r.Mode = userPb.VerifyMode_VERIFY_MODE_FULL
+		}
+		r.Depth = int64(depth)
+	}
+}
+
+// WithVerifyMaxPrice configures the maximum amount of attoFil to pay
+// for each retrieval.
+func WithVerifyMaxPrice(maxPrice uint64) VerifyDataOption {
+	return func(r *userPb.VerifyDataRequest) {
+		r.MaxPrice = maxPrice
+	}
+}
+
+// WithVerifyRepair enables repairing data which failed verification.
+func WithVerifyRepair() VerifyDataOption {
+	return func(r *userPb.VerifyDataRequest) {
+		r.Repair = true
+	}
+}
+
 // WatchLogsEvent represents an event for watching cid logs.
 type WatchLogsEvent struct {
 	Res *userPb.WatchLogsResponse
@@ -50,7 +141,8 @@ type WatchLogsEvent struct {
 }
 
 // Stage allows to temporarily stage data in hot storage in preparation for pushing a cid storage config.
-func (d *Data) Stage(ctx context.Context, data io.Reader) (*userPb.StageResponse, error) {
+func (d *Data) Stage(ctx context.Context, data io.Reader, opts ...StageOption) (*userPb.StageResponse, error) {
+	importOptions := toRPCImportOptions(opts)
 	stream, err := d.client.Stage(ctx)
 	if err != nil {
 		return nil, err
@@ -62,7 +154,9 @@ func (d *Data) Stage(ctx context.Context, data io.Reader) (*userPb.StageResponse
 		if err != nil && err != io.EOF {
 			return nil, err
 		}
-		sendErr := stream.Send(&userPb.StageRequest{Chunk: buffer[:bytesRead]})
+		// Import options are only sent in the first request.
+		sendErr := stream.Send(&userPb.StageRequest{Chunk: buffer[:bytesRead], ImportOptions: importOptions})
+		importOptions = nil
 		if sendErr != nil {
 			if sendErr == io.EOF {
 				var noOp interface{}
@@ -77,8 +171,112 @@ func (d *Data) Stage(ctx context.Context, data io.Reader) (*userPb.StageResponse
 	return stream.CloseAndRecv()
 }
 
+// StageCar imports a CAR file in hot storage, stage-pinning its roots in preparation
+// for pushing cid storage configs. The DAG of the CAR file is kept as is.
+func (d *Data) StageCar(ctx context.Context, data io.Reader) (*userPb.StageCarResponse, error) {
+	stream, err := d.client.StageCar(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer := make([]byte, 1024*32) // 32KB
+	for {
+		bytesRead, err := data.Read(buffer)
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if sendErr := stream.Send(&userPb.StageCarRequest{Chunk: buffer[:bytesRead]}); sendErr != nil {
+			if sendErr == io.EOF {
+				var noOp interface{}
+				return nil, stream.RecvMsg(noOp)
+			}
+			return nil, sendErr
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+// CreateUpload creates a resumable upload, which stages data in hot storage sent in
+// numbered chunks that may span multiple connections.
+func (d *Data) CreateUpload(ctx context.Context) (*userPb.CreateUploadResponse, error) {
+	return d.client.CreateUpload(ctx, &userPb.CreateUploadRequest{})
+}
+
+// UploadChunk sends the chunk with the provided index of a resumable upload.
+func (d *Data) UploadChunk(ctx context.Context, uploadID string, index uint64, data []byte) (*userPb.UploadChunkResponse, error) {
+	sum := sha256.Sum256(data)
+	return d.client.UploadChunk(ctx, &userPb.UploadChunkRequest{
+		UploadId: uploadID,
+		Index:    index,
+		Data:     data,
+		Checksum: sum[:],
+	})
+}
+
+// UploadStatus returns the received chunks of a resumable upload.
+func (d *Data) UploadStatus(ctx context.Context, uploadID string) (*userPb.UploadStatusResponse, error) {
+	return d.client.UploadStatus(ctx, &userPb.UploadStatusRequest{UploadId: uploadID})
+}
+
+// FinalizeUpload stages the data of a resumable upload in hot storage. The size is the
+// total size of the uploaded data.
+func (d *Data) FinalizeUpload(ctx context.Context, uploadID string, size int64, opts ...StageOption) (*userPb.FinalizeUploadResponse, error) {
+	return d.client.FinalizeUpload(ctx, &userPb.FinalizeUploadRequest{
+		UploadId:      uploadID,
+		Size:          size,
+		ImportOptions: toRPCImportOptions(opts),
+	})
+}
+
+// ResumeUpload sends the data of r which wasn't received yet by a resumable upload,
+// and finalizes it. If the upload is interrupted, calling ResumeUpload again with the
+// same upload continues from the last received chunk.
+func (d *Data) ResumeUpload(ctx context.Context, uploadID string, r io.ReadSeeker, opts ...StageOption) (*userPb.FinalizeUploadResponse, error) {
+	res, err := d.UploadStatus(ctx, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	size := res.Upload.ReceivedSize
+	index := res.Upload.ReceivedChunks
+	if _, err := r.Seek(size, io.SeekStart); err != nil {
+		return nil, fmt.Errorf("seeking to received offset: %s", err)
+	}
+
+	buffer := make([]byte, 1024*1024) // 1MB
+	for {
+		n, err := io.ReadFull(r, buffer)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return nil, err
+		}
+		if n > 0 {
+			if _, err := d.UploadChunk(ctx, uploadID, index, buffer[:n]); err != nil {
+				return nil, err
+			}
+			index++
+			size += int64(n)
+		}
+		if err != nil {
+			break
+		}
+	}
+	return d.FinalizeUpload(ctx, uploadID, size, opts...)
+}
+
 // StageFolder allows to temporarily stage a folder in hot storage in preparation for pushing a cid storage config.
-func (d *Data) StageFolder(ctx context.Context, ipfsRevProxyAddr string, folderPath string) (string, error) {
+// Without import options, the folder is imported with CIDv1.
+func (d *Data) StageFolder(ctx context.Context, ipfsRevProxyAddr string, folderPath string, opts ...StageOption) (string, error) {
+	config := stageConfig{importOptions: &importopts.Options{CidVersion: 1}}
+	for _, o := range opts {
+		o(&config)
+	}
+	addOpts, err := config.importOptions.UnixfsAddOptions()
+	if err != nil {
+		return "", fmt.Errorf("parsing import options: %s", err)
+	}
+
 	ffsToken := ctx.Value(AuthKey).(string)
 
 	ipfs, err := newDecoratedIPFSAPI(ipfsRevProxyAddr, ffsToken)
@@ -95,11 +293,8 @@ func (d *Data) StageFolder(ctx context.Context, ipfsRevProxyAddr string, folderP
 		return "", err
 	}
 	defer func() { _ = ff.Close() }()
-	opts := []options.UnixfsAddOption{
-		options.Unixfs.CidVersion(1),
-		options.Unixfs.Pin(true),
-	}
-	pth, err := ipfs.Unixfs().Add(context.Background(), files.ToDir(ff), opts...)
+	addOpts = append(addOpts, options.Unixfs.Pin(true))
+	pth, err := ipfs.Unixfs().Add(context.Background(), files.ToDir(ff), addOpts...)
 	if err != nil {
 		return "", err
 	}
@@ -118,6 +313,16 @@ func (d *Data) ReplaceData(ctx context.Context, cid1, cid2 string) (*userPb.Repl
 	return d.client.ReplaceData(ctx, &userPb.ReplaceDataRequest{Cid1: cid1, Cid2: cid2})
 }
 
+// VerifyData schedules a Job which verifies the integrity of the stored data
+// of a cid. The results are saved in its storage info.
+func (d *Data) VerifyData(ctx context.Context, cid string, opts ...VerifyDataOption) (*userPb.VerifyDataResponse, error) {
+	req := &userPb.VerifyDataRequest{Cid: cid}
+	for _, opt := range opts {
+		opt(req)
+	}
+	return d.client.VerifyData(ctx, req)
+}
+
 // Get returns an io.Reader for reading a stored Cid from hot storage.
 func (d *Data) Get(ctx context.Context, cid string) (io.Reader, error) {
 	stream, err := d.client.Get(ctx, &userPb.GetRequest{
@@ -148,6 +353,39 @@ func (d *Data) Get(ctx context.Context, cid string) (io.Reader, error) {
 	return reader, nil
 }
 
+// GetCar returns an io.Reader for reading a CAR file of the DAG of a stored Cid
+// from hot storage.
+func (d *Data) GetCar(ctx context.Context, cid string, opts ...GetCarOption) (io.Reader, error) {
+	req := &userPb.GetCarRequest{Cid: cid}
+	for _, opt := range opts {
+		opt(req)
+	}
+	stream, err := d.client.GetCar(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	reader, writer := io.Pipe()
+	go func() {
+		for {
+			res, err := stream.Recv()
+			if err == io.EOF {
+				_ = writer.Close()
+				break
+			} else if err != nil {
+				_ = writer.CloseWithError(err)
+				break
+			}
+			_, err = writer.Write(res.GetChunk())
+			if err != nil {
+				_ = writer.CloseWithError(err)
+				break
+			}
+		}
+	}()
+
+	return reader, nil
+}
+
 // GetFolder retrieves to outputDir a Cid which corresponds to a folder.
 func (d *Data) GetFolder(ctx context.Context, ipfsRevProxyAddr, cid, outputDir string) error {
 	token := ctx.Value(AuthKey).(string)
@@ -254,3 +492,21 @@ func (fhd ffsHeaderDecorator) RoundTrip(req *http.Request) (*http.Response, erro
 
 	return http.DefaultTransport.RoundTrip(req)
 }
+
+func toRPCImportOptions(opts []StageOption) *userPb.ImportOptions {
+	var config stageConfig
+	for _, o := range opts {
+		o(&config)
+	}
+	if config.importOptions == nil {
+		return nil
+	}
+	return &userPb.ImportOptions{
+		Chunker:      config.importOptions.Chunker,
+		CidVersion:   int32(config.importOptions.CidVersion),
+		RawLeaves:    config.importOptions.RawLeaves,
+		HasRawLeaves: config.importOptions.RawLeavesSet,
+		Layout:       config.importOptions.Layout,
+		HashFunction: config.importOptions.HashFunction,
+	}
+}
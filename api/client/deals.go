@@ -61,6 +61,55 @@ func WithAscending(ascending bool) DealRecordsOption {
 	}
 }
 
+// WithMiners limits the results to deals made with the provided miners.
+// Ignored for RetrievalDealRecords.
+func WithMiners(miners ...string) DealRecordsOption {
+	return func(c *userPb.DealRecordsConfig) {
+		c.Miners = miners
+	}
+}
+
+// WithDealStates limits the results to deals in any of the provided storage deal states.
+// Ignored for RetrievalDealRecords.
+func WithDealStates(states ...uint64) DealRecordsOption {
+	return func(c *userPb.DealRecordsConfig) {
+		c.DealStates = states
+	}
+}
+
+// WithExpirationRange limits the results to deals expiring between the min and max epochs, inclusive.
+// A zero value leaves that side of the range unbounded. Ignored for RetrievalDealRecords.
+func WithExpirationRange(min, max uint64) DealRecordsOption {
+	return func(c *userPb.DealRecordsConfig) {
+		c.MinExpiration = min
+		c.MaxExpiration = max
+	}
+}
+
+// WithSortBy specifies the field used to sort the results. Default is sorting by timestamp.
+// Ignored for RetrievalDealRecords.
+func WithSortBy(sortBy userPb.DealRecordsSortBy) DealRecordsOption {
+	return func(c *userPb.DealRecordsConfig) {
+		c.SortBy = sortBy
+	}
+}
+
+// WithLimit limits the number of returned results. If the limit is reached, the response
+// includes a cursor to fetch the next page. Ignored for RetrievalDealRecords.
+func WithLimit(limit int32) DealRecordsOption {
+	return func(c *userPb.DealRecordsConfig) {
+		c.Limit = limit
+	}
+}
+
+// WithCursor continues a previous listing using the cursor returned in its response.
+// Ignored for RetrievalDealRecords.
+func WithCursor(cursor string) DealRecordsOption {
+	return func(c *userPb.DealRecordsConfig) {
+		c.Cursor = cursor
+	}
+}
+
 // StorageDealRecords returns a list of storage deals for the user according to the provided options.
 func (d *Deals) StorageDealRecords(ctx context.Context, opts ...DealRecordsOption) (*userPb.StorageDealRecordsResponse, error) {
 	conf := &userPb.DealRecordsConfig{}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+
+	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
+)
+
+// Names provides access to Powergate named references APIs.
+type Names struct {
+	client userPb.UserServiceClient
+}
+
+// SetNameOption configures a SetNameRequest.
+type SetNameOption func(r *userPb.SetNameRequest)
+
+// WithNameRetention configures the maximum number of previous versions
+// kept stored in the history of the named reference. Zero means no limit.
+func WithNameRetention(retention int) SetNameOption {
+	return func(r *userPb.SetNameRequest) {
+		r.Retention = int64(retention)
+		r.HasRetention = true
+	}
+}
+
+// WithNamePublish configures if the named reference is published to IPNS.
+func WithNamePublish(publish bool) SetNameOption {
+	return func(r *userPb.SetNameRequest) {
+		r.Publish = publish
+		r.HasPublish = true
+	}
+}
+
+// Set creates or updates a named reference to point to a cid. Updating a
+// named reference replaces its current cid with the new one, carrying over
+// its storage config. The returned job id is empty if no job was scheduled.
+func (n *Names) Set(ctx context.Context, name, cid string, opts ...SetNameOption) (*userPb.SetNameResponse, error) {
+	req := &userPb.SetNameRequest{Name: name, Cid: cid}
+	for _, opt := range opts {
+		opt(req)
+	}
+	return n.client.SetName(ctx, req)
+}
+
+// Get returns a named reference.
+func (n *Names) Get(ctx context.Context, name string) (*userPb.GetNameResponse, error) {
+	return n.client.GetName(ctx, &userPb.GetNameRequest{Name: name})
+}
+
+// List returns the named references with names starting with prefix.
+// An empty prefix returns all named references.
+func (n *Names) List(ctx context.Context, prefix string) (*userPb.ListNamesResponse, error) {
+	return n.client.ListNames(ctx, &userPb.ListNamesRequest{Prefix: prefix})
+}
+
+// Remove removes a named reference. The referenced cid stays stored.
+func (n *Names) Remove(ctx context.Context, name string) (*userPb.RemoveNameResponse, error) {
+	return n.client.RemoveName(ctx, &userPb.RemoveNameRequest{Name: name})
+}
@@ -21,3 +21,20 @@ func (s *StorageInfo) Get(ctx context.Context, cid string) (*userPb.StorageInfoR
 func (s *StorageInfo) List(ctx context.Context, cids ...string) (*userPb.ListStorageInfoResponse, error) {
 	return s.client.ListStorageInfo(ctx, &userPb.ListStorageInfoRequest{Cids: cids})
 }
+
+// ExpirationForecast returns the forecast of stored cids having deals expiring within the
+// provided amount of epochs, including whether renewal is enabled and healthy for them.
+func (s *StorageInfo) ExpirationForecast(ctx context.Context, withinEpochs uint64) (*userPb.ExpirationForecastResponse, error) {
+	return s.client.ExpirationForecast(ctx, &userPb.ExpirationForecastRequest{WithinEpochs: withinEpochs})
+}
+
+// AuditReports returns the last audit reports of stored cids, filtered by cids if provided.
+func (s *StorageInfo) AuditReports(ctx context.Context, cids ...string) (*userPb.AuditReportsResponse, error) {
+	return s.client.AuditReports(ctx, &userPb.AuditReportsRequest{Cids: cids})
+}
+
+// Usage returns the effective usage of the user, which attributes data stored by
+// multiple users in equal parts to each of them.
+func (s *StorageInfo) Usage(ctx context.Context) (*userPb.UsageResponse, error) {
+	return s.client.Usage(ctx, &userPb.UsageRequest{})
+}
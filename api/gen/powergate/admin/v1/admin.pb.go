@@ -636,6 +636,99 @@ func (x *UsersResponse) GetUsers() []*User {
 	return nil
 }
 
+type SetUserMinerSelectorParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Params string `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *SetUserMinerSelectorParamsRequest) Reset() {
+	*x = SetUserMinerSelectorParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserMinerSelectorParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserMinerSelectorParamsRequest) ProtoMessage() {}
+
+func (x *SetUserMinerSelectorParamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserMinerSelectorParamsRequest.ProtoReflect.Descriptor instead.
+func (*SetUserMinerSelectorParamsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetUserMinerSelectorParamsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserMinerSelectorParamsRequest) GetParams() string {
+	if x != nil {
+		return x.Params
+	}
+	return ""
+}
+
+type SetUserMinerSelectorParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetUserMinerSelectorParamsResponse) Reset() {
+	*x = SetUserMinerSelectorParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserMinerSelectorParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserMinerSelectorParamsResponse) ProtoMessage() {}
+
+func (x *SetUserMinerSelectorParamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserMinerSelectorParamsResponse.ProtoReflect.Descriptor instead.
+func (*SetUserMinerSelectorParamsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{14}
+}
+
 type StorageInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -648,7 +741,7 @@ type StorageInfoRequest struct {
 func (x *StorageInfoRequest) Reset() {
 	*x = StorageInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[13]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -661,7 +754,7 @@ func (x *StorageInfoRequest) String() string {
 func (*StorageInfoRequest) ProtoMessage() {}
 
 func (x *StorageInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[13]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -674,7 +767,7 @@ func (x *StorageInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StorageInfoRequest.ProtoReflect.Descriptor instead.
 func (*StorageInfoRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{13}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *StorageInfoRequest) GetUserId() string {
@@ -702,7 +795,7 @@ type StorageInfoResponse struct {
 func (x *StorageInfoResponse) Reset() {
 	*x = StorageInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[14]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -715,7 +808,7 @@ func (x *StorageInfoResponse) String() string {
 func (*StorageInfoResponse) ProtoMessage() {}
 
 func (x *StorageInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[14]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +821,7 @@ func (x *StorageInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StorageInfoResponse.ProtoReflect.Descriptor instead.
 func (*StorageInfoResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{14}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *StorageInfoResponse) GetStorageInfo() *v1.StorageInfo {
@@ -750,7 +843,7 @@ type ListStorageInfoRequest struct {
 func (x *ListStorageInfoRequest) Reset() {
 	*x = ListStorageInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[15]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -763,7 +856,7 @@ func (x *ListStorageInfoRequest) String() string {
 func (*ListStorageInfoRequest) ProtoMessage() {}
 
 func (x *ListStorageInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[15]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,7 +869,7 @@ func (x *ListStorageInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStorageInfoRequest.ProtoReflect.Descriptor instead.
 func (*ListStorageInfoRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{15}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListStorageInfoRequest) GetUserIds() []string {
@@ -804,7 +897,7 @@ type ListStorageInfoResponse struct {
 func (x *ListStorageInfoResponse) Reset() {
 	*x = ListStorageInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[16]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -817,7 +910,7 @@ func (x *ListStorageInfoResponse) String() string {
 func (*ListStorageInfoResponse) ProtoMessage() {}
 
 func (x *ListStorageInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[16]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,7 +923,7 @@ func (x *ListStorageInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListStorageInfoResponse.ProtoReflect.Descriptor instead.
 func (*ListStorageInfoResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{16}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListStorageInfoResponse) GetStorageInfo() []*v1.StorageInfo {
@@ -840,36 +933,32 @@ func (x *ListStorageInfoResponse) GetStorageInfo() []*v1.StorageInfo {
 	return nil
 }
 
-type ListStorageJobsRequest struct {
+type ExpirationForecastRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserIdFilter  string                 `protobuf:"bytes,1,opt,name=user_id_filter,json=userIdFilter,proto3" json:"user_id_filter,omitempty"`
-	CidFilter     string                 `protobuf:"bytes,2,opt,name=cid_filter,json=cidFilter,proto3" json:"cid_filter,omitempty"`
-	Limit         uint64                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Ascending     bool                   `protobuf:"varint,4,opt,name=ascending,proto3" json:"ascending,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	Selector      v1.StorageJobsSelector `protobuf:"varint,6,opt,name=selector,proto3,enum=powergate.user.v1.StorageJobsSelector" json:"selector,omitempty"`
+	UserIds      []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	WithinEpochs uint64   `protobuf:"varint,2,opt,name=within_epochs,json=withinEpochs,proto3" json:"within_epochs,omitempty"`
 }
 
-func (x *ListStorageJobsRequest) Reset() {
-	*x = ListStorageJobsRequest{}
+func (x *ExpirationForecastRequest) Reset() {
+	*x = ExpirationForecastRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[17]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListStorageJobsRequest) String() string {
+func (x *ExpirationForecastRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStorageJobsRequest) ProtoMessage() {}
+func (*ExpirationForecastRequest) ProtoMessage() {}
 
-func (x *ListStorageJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[17]
+func (x *ExpirationForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -880,80 +969,107 @@ func (x *ListStorageJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStorageJobsRequest.ProtoReflect.Descriptor instead.
-func (*ListStorageJobsRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ExpirationForecastRequest.ProtoReflect.Descriptor instead.
+func (*ExpirationForecastRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListStorageJobsRequest) GetUserIdFilter() string {
+func (x *ExpirationForecastRequest) GetUserIds() []string {
 	if x != nil {
-		return x.UserIdFilter
+		return x.UserIds
 	}
-	return ""
+	return nil
 }
 
-func (x *ListStorageJobsRequest) GetCidFilter() string {
+func (x *ExpirationForecastRequest) GetWithinEpochs() uint64 {
 	if x != nil {
-		return x.CidFilter
+		return x.WithinEpochs
 	}
-	return ""
+	return 0
 }
 
-func (x *ListStorageJobsRequest) GetLimit() uint64 {
-	if x != nil {
-		return x.Limit
+type ExpirationForecastResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    uint64                   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Forecasts []*v1.ExpirationForecast `protobuf:"bytes,2,rep,name=forecasts,proto3" json:"forecasts,omitempty"`
+}
+
+func (x *ExpirationForecastResponse) Reset() {
+	*x = ExpirationForecastResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ListStorageJobsRequest) GetAscending() bool {
-	if x != nil {
-		return x.Ascending
+func (x *ExpirationForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpirationForecastResponse) ProtoMessage() {}
+
+func (x *ExpirationForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *ListStorageJobsRequest) GetNextPageToken() string {
+// Deprecated: Use ExpirationForecastResponse.ProtoReflect.Descriptor instead.
+func (*ExpirationForecastResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExpirationForecastResponse) GetHeight() uint64 {
 	if x != nil {
-		return x.NextPageToken
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *ListStorageJobsRequest) GetSelector() v1.StorageJobsSelector {
+func (x *ExpirationForecastResponse) GetForecasts() []*v1.ExpirationForecast {
 	if x != nil {
-		return x.Selector
+		return x.Forecasts
 	}
-	return v1.StorageJobsSelector_STORAGE_JOBS_SELECTOR_UNSPECIFIED
+	return nil
 }
 
-type ListStorageJobsResponse struct {
+type AuditReportsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StorageJobs   []*v1.StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
-	More          bool             `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
-	NextPageToken string           `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	UserIds       []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Cids          []string `protobuf:"bytes,2,rep,name=cids,proto3" json:"cids,omitempty"`
+	OnlyUnhealthy bool     `protobuf:"varint,3,opt,name=only_unhealthy,json=onlyUnhealthy,proto3" json:"only_unhealthy,omitempty"`
 }
 
-func (x *ListStorageJobsResponse) Reset() {
-	*x = ListStorageJobsResponse{}
+func (x *AuditReportsRequest) Reset() {
+	*x = AuditReportsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[18]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListStorageJobsResponse) String() string {
+func (x *AuditReportsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListStorageJobsResponse) ProtoMessage() {}
+func (*AuditReportsRequest) ProtoMessage() {}
 
-func (x *ListStorageJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[18]
+func (x *AuditReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -964,58 +1080,1119 @@ func (x *ListStorageJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListStorageJobsResponse.ProtoReflect.Descriptor instead.
-func (*ListStorageJobsResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use AuditReportsRequest.ProtoReflect.Descriptor instead.
+func (*AuditReportsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListStorageJobsResponse) GetStorageJobs() []*v1.StorageJob {
+func (x *AuditReportsRequest) GetUserIds() []string {
 	if x != nil {
-		return x.StorageJobs
+		return x.UserIds
 	}
 	return nil
 }
 
-func (x *ListStorageJobsResponse) GetMore() bool {
+func (x *AuditReportsRequest) GetCids() []string {
 	if x != nil {
-		return x.More
+		return x.Cids
+	}
+	return nil
+}
+
+func (x *AuditReportsRequest) GetOnlyUnhealthy() bool {
+	if x != nil {
+		return x.OnlyUnhealthy
 	}
 	return false
 }
 
-func (x *ListStorageJobsResponse) GetNextPageToken() string {
+type AuditReportsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reports []*v1.AuditReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+}
+
+func (x *AuditReportsResponse) Reset() {
+	*x = AuditReportsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditReportsResponse) ProtoMessage() {}
+
+func (x *AuditReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditReportsResponse.ProtoReflect.Descriptor instead.
+func (*AuditReportsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AuditReportsResponse) GetReports() []*v1.AuditReport {
 	if x != nil {
-		return x.NextPageToken
+		return x.Reports
 	}
-	return ""
+	return nil
+}
+
+type AuditSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIds []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+}
+
+func (x *AuditSummaryRequest) Reset() {
+	*x = AuditSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditSummaryRequest) ProtoMessage() {}
+
+func (x *AuditSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditSummaryRequest.ProtoReflect.Descriptor instead.
+func (*AuditSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AuditSummaryRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type AuditSummaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastAudit          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_audit,json=lastAudit,proto3" json:"last_audit,omitempty"`
+	AuditedCids        int64                  `protobuf:"varint,2,opt,name=audited_cids,json=auditedCids,proto3" json:"audited_cids,omitempty"`
+	HealthyCids        int64                  `protobuf:"varint,3,opt,name=healthy_cids,json=healthyCids,proto3" json:"healthy_cids,omitempty"`
+	AuditedDeals       int64                  `protobuf:"varint,4,opt,name=audited_deals,json=auditedDeals,proto3" json:"audited_deals,omitempty"`
+	ActiveDeals        int64                  `protobuf:"varint,5,opt,name=active_deals,json=activeDeals,proto3" json:"active_deals,omitempty"`
+	DealsNotActive     int64                  `protobuf:"varint,6,opt,name=deals_not_active,json=dealsNotActive,proto3" json:"deals_not_active,omitempty"`
+	PieceCidMismatches int64                  `protobuf:"varint,7,opt,name=piece_cid_mismatches,json=pieceCidMismatches,proto3" json:"piece_cid_mismatches,omitempty"`
+	MinerMismatches    int64                  `protobuf:"varint,8,opt,name=miner_mismatches,json=minerMismatches,proto3" json:"miner_mismatches,omitempty"`
+	SectorsNotSealed   int64                  `protobuf:"varint,9,opt,name=sectors_not_sealed,json=sectorsNotSealed,proto3" json:"sectors_not_sealed,omitempty"`
+}
+
+func (x *AuditSummaryResponse) Reset() {
+	*x = AuditSummaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditSummaryResponse) ProtoMessage() {}
+
+func (x *AuditSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditSummaryResponse.ProtoReflect.Descriptor instead.
+func (*AuditSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AuditSummaryResponse) GetLastAudit() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAudit
+	}
+	return nil
+}
+
+func (x *AuditSummaryResponse) GetAuditedCids() int64 {
+	if x != nil {
+		return x.AuditedCids
+	}
+	return 0
+}
+
+func (x *AuditSummaryResponse) GetHealthyCids() int64 {
+	if x != nil {
+		return x.HealthyCids
+	}
+	return 0
+}
+
+func (x *AuditSummaryResponse) GetAuditedDeals() int64 {
+	if x != nil {
+		return x.AuditedDeals
+	}
+	return 0
+}
+
+func (x *AuditSummaryResponse) GetActiveDeals() int64 {
+	if x != nil {
+		return x.ActiveDeals
+	}
+	return 0
+}
+
+func (x *AuditSummaryResponse) GetDealsNotActive() int64 {
+	if x != nil {
+		return x.DealsNotActive
+	}
+	return 0
+}
+
+func (x *AuditSummaryResponse) GetPieceCidMismatches() int64 {
+	if x != nil {
+		return x.PieceCidMismatches
+	}
+	return 0
+}
+
+func (x *AuditSummaryResponse) GetMinerMismatches() int64 {
+	if x != nil {
+		return x.MinerMismatches
+	}
+	return 0
+}
+
+func (x *AuditSummaryResponse) GetSectorsNotSealed() int64 {
+	if x != nil {
+		return x.SectorsNotSealed
+	}
+	return 0
+}
+
+type UsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIds []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+}
+
+func (x *UsageRequest) Reset() {
+	*x = UsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageRequest) ProtoMessage() {}
+
+func (x *UsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageRequest.ProtoReflect.Descriptor instead.
+func (*UsageRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UsageRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type UsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Usages []*v1.Usage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`
+}
+
+func (x *UsageResponse) Reset() {
+	*x = UsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageResponse) ProtoMessage() {}
+
+func (x *UsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageResponse.ProtoReflect.Descriptor instead.
+func (*UsageResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UsageResponse) GetUsages() []*v1.Usage {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
+type ListStorageJobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIdFilter  string                 `protobuf:"bytes,1,opt,name=user_id_filter,json=userIdFilter,proto3" json:"user_id_filter,omitempty"`
+	CidFilter     string                 `protobuf:"bytes,2,opt,name=cid_filter,json=cidFilter,proto3" json:"cid_filter,omitempty"`
+	Limit         uint64                 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Ascending     bool                   `protobuf:"varint,4,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Selector      v1.StorageJobsSelector `protobuf:"varint,6,opt,name=selector,proto3,enum=powergate.user.v1.StorageJobsSelector" json:"selector,omitempty"`
+}
+
+func (x *ListStorageJobsRequest) Reset() {
+	*x = ListStorageJobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStorageJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStorageJobsRequest) ProtoMessage() {}
+
+func (x *ListStorageJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStorageJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListStorageJobsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListStorageJobsRequest) GetUserIdFilter() string {
+	if x != nil {
+		return x.UserIdFilter
+	}
+	return ""
+}
+
+func (x *ListStorageJobsRequest) GetCidFilter() string {
+	if x != nil {
+		return x.CidFilter
+	}
+	return ""
+}
+
+func (x *ListStorageJobsRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListStorageJobsRequest) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+func (x *ListStorageJobsRequest) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListStorageJobsRequest) GetSelector() v1.StorageJobsSelector {
+	if x != nil {
+		return x.Selector
+	}
+	return v1.StorageJobsSelector_STORAGE_JOBS_SELECTOR_UNSPECIFIED
+}
+
+type ListStorageJobsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StorageJobs   []*v1.StorageJob `protobuf:"bytes,1,rep,name=storage_jobs,json=storageJobs,proto3" json:"storage_jobs,omitempty"`
+	More          bool             `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	NextPageToken string           `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListStorageJobsResponse) Reset() {
+	*x = ListStorageJobsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStorageJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStorageJobsResponse) ProtoMessage() {}
+
+func (x *ListStorageJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStorageJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListStorageJobsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListStorageJobsResponse) GetStorageJobs() []*v1.StorageJob {
+	if x != nil {
+		return x.StorageJobs
+	}
+	return nil
+}
+
+func (x *ListStorageJobsResponse) GetMore() bool {
+	if x != nil {
+		return x.More
+	}
+	return false
+}
+
+func (x *ListStorageJobsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type StorageJobsSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cid    string `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+}
+
+func (x *StorageJobsSummaryRequest) Reset() {
+	*x = StorageJobsSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageJobsSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageJobsSummaryRequest) ProtoMessage() {}
+
+func (x *StorageJobsSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageJobsSummaryRequest.ProtoReflect.Descriptor instead.
+func (*StorageJobsSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StorageJobsSummaryRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StorageJobsSummaryRequest) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+type StorageJobsSummaryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QueuedStorageJobs    []string `protobuf:"bytes,1,rep,name=queued_storage_jobs,json=queuedStorageJobs,proto3" json:"queued_storage_jobs,omitempty"`
+	ExecutingStorageJobs []string `protobuf:"bytes,2,rep,name=executing_storage_jobs,json=executingStorageJobs,proto3" json:"executing_storage_jobs,omitempty"`
+	FinalStorageJobs     []string `protobuf:"bytes,3,rep,name=final_storage_jobs,json=finalStorageJobs,proto3" json:"final_storage_jobs,omitempty"`
+}
+
+func (x *StorageJobsSummaryResponse) Reset() {
+	*x = StorageJobsSummaryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageJobsSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageJobsSummaryResponse) ProtoMessage() {}
+
+func (x *StorageJobsSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageJobsSummaryResponse.ProtoReflect.Descriptor instead.
+func (*StorageJobsSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StorageJobsSummaryResponse) GetQueuedStorageJobs() []string {
+	if x != nil {
+		return x.QueuedStorageJobs
+	}
+	return nil
+}
+
+func (x *StorageJobsSummaryResponse) GetExecutingStorageJobs() []string {
+	if x != nil {
+		return x.ExecutingStorageJobs
+	}
+	return nil
+}
+
+func (x *StorageJobsSummaryResponse) GetFinalStorageJobs() []string {
+	if x != nil {
+		return x.FinalStorageJobs
+	}
+	return nil
+}
+
+type BulkUpdateStorageConfigsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIds []string `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Cids    []string `protobuf:"bytes,2,rep,name=cids,proto3" json:"cids,omitempty"`
+	Where   []string `protobuf:"bytes,3,rep,name=where,proto3" json:"where,omitempty"`
+	Set     []string `protobuf:"bytes,4,rep,name=set,proto3" json:"set,omitempty"`
+	Repush  bool     `protobuf:"varint,5,opt,name=repush,proto3" json:"repush,omitempty"`
+	DryRun  bool     `protobuf:"varint,6,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *BulkUpdateStorageConfigsRequest) Reset() {
+	*x = BulkUpdateStorageConfigsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkUpdateStorageConfigsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateStorageConfigsRequest) ProtoMessage() {}
+
+func (x *BulkUpdateStorageConfigsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateStorageConfigsRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateStorageConfigsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BulkUpdateStorageConfigsRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *BulkUpdateStorageConfigsRequest) GetCids() []string {
+	if x != nil {
+		return x.Cids
+	}
+	return nil
+}
+
+func (x *BulkUpdateStorageConfigsRequest) GetWhere() []string {
+	if x != nil {
+		return x.Where
+	}
+	return nil
+}
+
+func (x *BulkUpdateStorageConfigsRequest) GetSet() []string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *BulkUpdateStorageConfigsRequest) GetRepush() bool {
+	if x != nil {
+		return x.Repush
+	}
+	return false
+}
+
+func (x *BulkUpdateStorageConfigsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type BulkUpdateStorageConfigsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updates []*StorageConfigUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+}
+
+func (x *BulkUpdateStorageConfigsResponse) Reset() {
+	*x = BulkUpdateStorageConfigsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkUpdateStorageConfigsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateStorageConfigsResponse) ProtoMessage() {}
+
+func (x *BulkUpdateStorageConfigsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateStorageConfigsResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateStorageConfigsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BulkUpdateStorageConfigsResponse) GetUpdates() []*StorageConfigUpdate {
+	if x != nil {
+		return x.Updates
+	}
+	return nil
+}
+
+type StorageConfigUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cid    string `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	JobId  string `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Error  string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *StorageConfigUpdate) Reset() {
+	*x = StorageConfigUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageConfigUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageConfigUpdate) ProtoMessage() {}
+
+func (x *StorageConfigUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorageConfigUpdate.ProtoReflect.Descriptor instead.
+func (*StorageConfigUpdate) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StorageConfigUpdate) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StorageConfigUpdate) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *StorageConfigUpdate) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *StorageConfigUpdate) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GCStagedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GCStagedRequest) Reset() {
+	*x = GCStagedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GCStagedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GCStagedRequest) ProtoMessage() {}
+
+func (x *GCStagedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GCStagedRequest.ProtoReflect.Descriptor instead.
+func (*GCStagedRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{34}
+}
+
+type GCStagedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnpinnedCids []string `protobuf:"bytes,1,rep,name=unpinned_cids,json=unpinnedCids,proto3" json:"unpinned_cids,omitempty"`
+}
+
+func (x *GCStagedResponse) Reset() {
+	*x = GCStagedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GCStagedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GCStagedResponse) ProtoMessage() {}
+
+func (x *GCStagedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GCStagedResponse.ProtoReflect.Descriptor instead.
+func (*GCStagedResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GCStagedResponse) GetUnpinnedCids() []string {
+	if x != nil {
+		return x.UnpinnedCids
+	}
+	return nil
+}
+
+type PinnedCidsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PinnedCidsRequest) Reset() {
+	*x = PinnedCidsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinnedCidsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinnedCidsRequest) ProtoMessage() {}
+
+func (x *PinnedCidsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinnedCidsRequest.ProtoReflect.Descriptor instead.
+func (*PinnedCidsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{36}
+}
+
+type PinnedCidsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cids []*HSPinnedCid `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
+}
+
+func (x *PinnedCidsResponse) Reset() {
+	*x = PinnedCidsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinnedCidsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinnedCidsResponse) ProtoMessage() {}
+
+func (x *PinnedCidsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinnedCidsResponse.ProtoReflect.Descriptor instead.
+func (*PinnedCidsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PinnedCidsResponse) GetCids() []*HSPinnedCid {
+	if x != nil {
+		return x.Cids
+	}
+	return nil
+}
+
+type HSPinnedCid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid   string             `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Users []*HSPinnedCidUser `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *HSPinnedCid) Reset() {
+	*x = HSPinnedCid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HSPinnedCid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HSPinnedCid) ProtoMessage() {}
+
+func (x *HSPinnedCid) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HSPinnedCid.ProtoReflect.Descriptor instead.
+func (*HSPinnedCid) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *HSPinnedCid) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *HSPinnedCid) GetUsers() []*HSPinnedCidUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type HSPinnedCidUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Staged    bool   `protobuf:"varint,2,opt,name=staged,proto3" json:"staged,omitempty"`
+	CreatedAt int64  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *HSPinnedCidUser) Reset() {
+	*x = HSPinnedCidUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HSPinnedCidUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HSPinnedCidUser) ProtoMessage() {}
+
+func (x *HSPinnedCidUser) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HSPinnedCidUser.ProtoReflect.Descriptor instead.
+func (*HSPinnedCidUser) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *HSPinnedCidUser) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *HSPinnedCidUser) GetStaged() bool {
+	if x != nil {
+		return x.Staged
+	}
+	return false
+}
+
+func (x *HSPinnedCidUser) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
 }
 
-type StorageJobsSummaryRequest struct {
+type ReconcileHotStorageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Cid    string `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	DryRun       bool  `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	RepinTimeout int64 `protobuf:"varint,2,opt,name=repin_timeout,json=repinTimeout,proto3" json:"repin_timeout,omitempty"`
+	UnpinUnknown bool  `protobuf:"varint,3,opt,name=unpin_unknown,json=unpinUnknown,proto3" json:"unpin_unknown,omitempty"`
 }
 
-func (x *StorageJobsSummaryRequest) Reset() {
-	*x = StorageJobsSummaryRequest{}
+func (x *ReconcileHotStorageRequest) Reset() {
+	*x = ReconcileHotStorageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[19]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageJobsSummaryRequest) String() string {
+func (x *ReconcileHotStorageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageJobsSummaryRequest) ProtoMessage() {}
+func (*ReconcileHotStorageRequest) ProtoMessage() {}
 
-func (x *StorageJobsSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[19]
+func (x *ReconcileHotStorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1026,52 +2203,62 @@ func (x *StorageJobsSummaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageJobsSummaryRequest.ProtoReflect.Descriptor instead.
-func (*StorageJobsSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ReconcileHotStorageRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileHotStorageRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *StorageJobsSummaryRequest) GetUserId() string {
+func (x *ReconcileHotStorageRequest) GetDryRun() bool {
 	if x != nil {
-		return x.UserId
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-func (x *StorageJobsSummaryRequest) GetCid() string {
+func (x *ReconcileHotStorageRequest) GetRepinTimeout() int64 {
 	if x != nil {
-		return x.Cid
+		return x.RepinTimeout
 	}
-	return ""
+	return 0
 }
 
-type StorageJobsSummaryResponse struct {
+func (x *ReconcileHotStorageRequest) GetUnpinUnknown() bool {
+	if x != nil {
+		return x.UnpinUnknown
+	}
+	return false
+}
+
+type ReconcileHotStorageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	QueuedStorageJobs    []string `protobuf:"bytes,1,rep,name=queued_storage_jobs,json=queuedStorageJobs,proto3" json:"queued_storage_jobs,omitempty"`
-	ExecutingStorageJobs []string `protobuf:"bytes,2,rep,name=executing_storage_jobs,json=executingStorageJobs,proto3" json:"executing_storage_jobs,omitempty"`
-	FinalStorageJobs     []string `protobuf:"bytes,3,rep,name=final_storage_jobs,json=finalStorageJobs,proto3" json:"final_storage_jobs,omitempty"`
+	MissingCids  []string       `protobuf:"bytes,1,rep,name=missing_cids,json=missingCids,proto3" json:"missing_cids,omitempty"`
+	RepinnedCids []string       `protobuf:"bytes,2,rep,name=repinned_cids,json=repinnedCids,proto3" json:"repinned_cids,omitempty"`
+	LostCids     []*HSPinnedCid `protobuf:"bytes,3,rep,name=lost_cids,json=lostCids,proto3" json:"lost_cids,omitempty"`
+	UnknownCids  []string       `protobuf:"bytes,4,rep,name=unknown_cids,json=unknownCids,proto3" json:"unknown_cids,omitempty"`
+	UnpinnedCids []string       `protobuf:"bytes,5,rep,name=unpinned_cids,json=unpinnedCids,proto3" json:"unpinned_cids,omitempty"`
+	Unfreezes    []*HSUnfreeze  `protobuf:"bytes,6,rep,name=unfreezes,proto3" json:"unfreezes,omitempty"`
 }
 
-func (x *StorageJobsSummaryResponse) Reset() {
-	*x = StorageJobsSummaryResponse{}
+func (x *ReconcileHotStorageResponse) Reset() {
+	*x = ReconcileHotStorageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[20]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StorageJobsSummaryResponse) String() string {
+func (x *ReconcileHotStorageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StorageJobsSummaryResponse) ProtoMessage() {}
+func (*ReconcileHotStorageResponse) ProtoMessage() {}
 
-func (x *StorageJobsSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[20]
+func (x *ReconcileHotStorageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1082,95 +2269,80 @@ func (x *StorageJobsSummaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StorageJobsSummaryResponse.ProtoReflect.Descriptor instead.
-func (*StorageJobsSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ReconcileHotStorageResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileHotStorageResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *StorageJobsSummaryResponse) GetQueuedStorageJobs() []string {
+func (x *ReconcileHotStorageResponse) GetMissingCids() []string {
 	if x != nil {
-		return x.QueuedStorageJobs
+		return x.MissingCids
 	}
 	return nil
 }
 
-func (x *StorageJobsSummaryResponse) GetExecutingStorageJobs() []string {
+func (x *ReconcileHotStorageResponse) GetRepinnedCids() []string {
 	if x != nil {
-		return x.ExecutingStorageJobs
+		return x.RepinnedCids
 	}
 	return nil
 }
 
-func (x *StorageJobsSummaryResponse) GetFinalStorageJobs() []string {
+func (x *ReconcileHotStorageResponse) GetLostCids() []*HSPinnedCid {
 	if x != nil {
-		return x.FinalStorageJobs
+		return x.LostCids
 	}
 	return nil
 }
 
-type GCStagedRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *GCStagedRequest) Reset() {
-	*x = GCStagedRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReconcileHotStorageResponse) GetUnknownCids() []string {
+	if x != nil {
+		return x.UnknownCids
 	}
+	return nil
 }
 
-func (x *GCStagedRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GCStagedRequest) ProtoMessage() {}
-
-func (x *GCStagedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ReconcileHotStorageResponse) GetUnpinnedCids() []string {
+	if x != nil {
+		return x.UnpinnedCids
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GCStagedRequest.ProtoReflect.Descriptor instead.
-func (*GCStagedRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{21}
+func (x *ReconcileHotStorageResponse) GetUnfreezes() []*HSUnfreeze {
+	if x != nil {
+		return x.Unfreezes
+	}
+	return nil
 }
 
-type GCStagedResponse struct {
+type HSUnfreeze struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UnpinnedCids []string `protobuf:"bytes,1,rep,name=unpinned_cids,json=unpinnedCids,proto3" json:"unpinned_cids,omitempty"`
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cid    string `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	JobId  string `protobuf:"bytes,3,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 }
 
-func (x *GCStagedResponse) Reset() {
-	*x = GCStagedResponse{}
+func (x *HSUnfreeze) Reset() {
+	*x = HSUnfreeze{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[22]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GCStagedResponse) String() string {
+func (x *HSUnfreeze) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCStagedResponse) ProtoMessage() {}
+func (*HSUnfreeze) ProtoMessage() {}
 
-func (x *GCStagedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[22]
+func (x *HSUnfreeze) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,41 +2353,59 @@ func (x *GCStagedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCStagedResponse.ProtoReflect.Descriptor instead.
-func (*GCStagedResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use HSUnfreeze.ProtoReflect.Descriptor instead.
+func (*HSUnfreeze) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GCStagedResponse) GetUnpinnedCids() []string {
+func (x *HSUnfreeze) GetUserId() string {
 	if x != nil {
-		return x.UnpinnedCids
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type PinnedCidsRequest struct {
+func (x *HSUnfreeze) GetCid() string {
+	if x != nil {
+		return x.Cid
+	}
+	return ""
+}
+
+func (x *HSUnfreeze) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type ExportLogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
 }
 
-func (x *PinnedCidsRequest) Reset() {
-	*x = PinnedCidsRequest{}
+func (x *ExportLogsRequest) Reset() {
+	*x = ExportLogsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[23]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PinnedCidsRequest) String() string {
+func (x *ExportLogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PinnedCidsRequest) ProtoMessage() {}
+func (*ExportLogsRequest) ProtoMessage() {}
 
-func (x *PinnedCidsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[23]
+func (x *ExportLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1226,36 +2416,57 @@ func (x *PinnedCidsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PinnedCidsRequest.ProtoReflect.Descriptor instead.
-func (*PinnedCidsRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ExportLogsRequest.ProtoReflect.Descriptor instead.
+func (*ExportLogsRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{43}
 }
 
-type PinnedCidsResponse struct {
+func (x *ExportLogsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExportLogsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ExportLogsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type ExportLogsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cids []*HSPinnedCid `protobuf:"bytes,1,rep,name=cids,proto3" json:"cids,omitempty"`
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *PinnedCidsResponse) Reset() {
-	*x = PinnedCidsResponse{}
+func (x *ExportLogsResponse) Reset() {
+	*x = ExportLogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[24]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PinnedCidsResponse) String() string {
+func (x *ExportLogsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PinnedCidsResponse) ProtoMessage() {}
+func (*ExportLogsResponse) ProtoMessage() {}
 
-func (x *PinnedCidsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[24]
+func (x *ExportLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1266,44 +2477,41 @@ func (x *PinnedCidsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PinnedCidsResponse.ProtoReflect.Descriptor instead.
-func (*PinnedCidsResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ExportLogsResponse.ProtoReflect.Descriptor instead.
+func (*ExportLogsResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *PinnedCidsResponse) GetCids() []*HSPinnedCid {
+func (x *ExportLogsResponse) GetData() []byte {
 	if x != nil {
-		return x.Cids
+		return x.Data
 	}
 	return nil
 }
 
-type HSPinnedCid struct {
+type BackupRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Cid   string             `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Users []*HSPinnedCidUser `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *HSPinnedCid) Reset() {
-	*x = HSPinnedCid{}
+func (x *BackupRequest) Reset() {
+	*x = BackupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[25]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HSPinnedCid) String() string {
+func (x *BackupRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HSPinnedCid) ProtoMessage() {}
+func (*BackupRequest) ProtoMessage() {}
 
-func (x *HSPinnedCid) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[25]
+func (x *BackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1314,52 +2522,36 @@ func (x *HSPinnedCid) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HSPinnedCid.ProtoReflect.Descriptor instead.
-func (*HSPinnedCid) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *HSPinnedCid) GetCid() string {
-	if x != nil {
-		return x.Cid
-	}
-	return ""
-}
-
-func (x *HSPinnedCid) GetUsers() []*HSPinnedCidUser {
-	if x != nil {
-		return x.Users
-	}
-	return nil
+// Deprecated: Use BackupRequest.ProtoReflect.Descriptor instead.
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{45}
 }
 
-type HSPinnedCidUser struct {
+type BackupResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Staged    bool   `protobuf:"varint,2,opt,name=staged,proto3" json:"staged,omitempty"`
-	CreatedAt int64  `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *HSPinnedCidUser) Reset() {
-	*x = HSPinnedCidUser{}
+func (x *BackupResponse) Reset() {
+	*x = BackupResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[26]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HSPinnedCidUser) String() string {
+func (x *BackupResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HSPinnedCidUser) ProtoMessage() {}
+func (*BackupResponse) ProtoMessage() {}
 
-func (x *HSPinnedCidUser) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[26]
+func (x *BackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1370,30 +2562,16 @@ func (x *HSPinnedCidUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HSPinnedCidUser.ProtoReflect.Descriptor instead.
-func (*HSPinnedCidUser) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *HSPinnedCidUser) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *HSPinnedCidUser) GetStaged() bool {
-	if x != nil {
-		return x.Staged
-	}
-	return false
+// Deprecated: Use BackupResponse.ProtoReflect.Descriptor instead.
+func (*BackupResponse) Descriptor() ([]byte, []int) {
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *HSPinnedCidUser) GetCreatedAt() int64 {
+func (x *BackupResponse) GetData() []byte {
 	if x != nil {
-		return x.CreatedAt
+		return x.Data
 	}
-	return 0
+	return nil
 }
 
 type GetUpdatedStorageDealRecordsSinceRequest struct {
@@ -1408,7 +2586,7 @@ type GetUpdatedStorageDealRecordsSinceRequest struct {
 func (x *GetUpdatedStorageDealRecordsSinceRequest) Reset() {
 	*x = GetUpdatedStorageDealRecordsSinceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[27]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1421,7 +2599,7 @@ func (x *GetUpdatedStorageDealRecordsSinceRequest) String() string {
 func (*GetUpdatedStorageDealRecordsSinceRequest) ProtoMessage() {}
 
 func (x *GetUpdatedStorageDealRecordsSinceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[27]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1434,7 +2612,7 @@ func (x *GetUpdatedStorageDealRecordsSinceRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use GetUpdatedStorageDealRecordsSinceRequest.ProtoReflect.Descriptor instead.
 func (*GetUpdatedStorageDealRecordsSinceRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{27}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *GetUpdatedStorageDealRecordsSinceRequest) GetSince() *timestamppb.Timestamp {
@@ -1462,7 +2640,7 @@ type GetUpdatedStorageDealRecordsSinceResponse struct {
 func (x *GetUpdatedStorageDealRecordsSinceResponse) Reset() {
 	*x = GetUpdatedStorageDealRecordsSinceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[28]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1475,7 +2653,7 @@ func (x *GetUpdatedStorageDealRecordsSinceResponse) String() string {
 func (*GetUpdatedStorageDealRecordsSinceResponse) ProtoMessage() {}
 
 func (x *GetUpdatedStorageDealRecordsSinceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[28]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1488,7 +2666,7 @@ func (x *GetUpdatedStorageDealRecordsSinceResponse) ProtoReflect() protoreflect.
 
 // Deprecated: Use GetUpdatedStorageDealRecordsSinceResponse.ProtoReflect.Descriptor instead.
 func (*GetUpdatedStorageDealRecordsSinceResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{28}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetUpdatedStorageDealRecordsSinceResponse) GetRecords() []*v1.StorageDealRecord {
@@ -1510,7 +2688,7 @@ type GetUpdatedRetrievalRecordsSinceRequest struct {
 func (x *GetUpdatedRetrievalRecordsSinceRequest) Reset() {
 	*x = GetUpdatedRetrievalRecordsSinceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[29]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1523,7 +2701,7 @@ func (x *GetUpdatedRetrievalRecordsSinceRequest) String() string {
 func (*GetUpdatedRetrievalRecordsSinceRequest) ProtoMessage() {}
 
 func (x *GetUpdatedRetrievalRecordsSinceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[29]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1536,7 +2714,7 @@ func (x *GetUpdatedRetrievalRecordsSinceRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use GetUpdatedRetrievalRecordsSinceRequest.ProtoReflect.Descriptor instead.
 func (*GetUpdatedRetrievalRecordsSinceRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{29}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetUpdatedRetrievalRecordsSinceRequest) GetSince() *timestamppb.Timestamp {
@@ -1564,7 +2742,7 @@ type GetUpdatedRetrievalRecordsSinceResponse struct {
 func (x *GetUpdatedRetrievalRecordsSinceResponse) Reset() {
 	*x = GetUpdatedRetrievalRecordsSinceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[30]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1577,7 +2755,7 @@ func (x *GetUpdatedRetrievalRecordsSinceResponse) String() string {
 func (*GetUpdatedRetrievalRecordsSinceResponse) ProtoMessage() {}
 
 func (x *GetUpdatedRetrievalRecordsSinceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[30]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1590,7 +2768,7 @@ func (x *GetUpdatedRetrievalRecordsSinceResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use GetUpdatedRetrievalRecordsSinceResponse.ProtoReflect.Descriptor instead.
 func (*GetUpdatedRetrievalRecordsSinceResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{30}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetUpdatedRetrievalRecordsSinceResponse) GetRecords() []*v1.RetrievalDealRecord {
@@ -1611,7 +2789,7 @@ type GetMinersRequest struct {
 func (x *GetMinersRequest) Reset() {
 	*x = GetMinersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[31]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1624,7 +2802,7 @@ func (x *GetMinersRequest) String() string {
 func (*GetMinersRequest) ProtoMessage() {}
 
 func (x *GetMinersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[31]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1637,7 +2815,7 @@ func (x *GetMinersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMinersRequest.ProtoReflect.Descriptor instead.
 func (*GetMinersRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{31}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *GetMinersRequest) GetWithPower() bool {
@@ -1658,7 +2836,7 @@ type GetMinersResponse struct {
 func (x *GetMinersResponse) Reset() {
 	*x = GetMinersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[32]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1671,7 +2849,7 @@ func (x *GetMinersResponse) String() string {
 func (*GetMinersResponse) ProtoMessage() {}
 
 func (x *GetMinersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[32]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1684,7 +2862,7 @@ func (x *GetMinersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMinersResponse.ProtoReflect.Descriptor instead.
 func (*GetMinersResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{32}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *GetMinersResponse) GetMiners() []*FilecoinMiner {
@@ -1705,7 +2883,7 @@ type FilecoinMiner struct {
 func (x *FilecoinMiner) Reset() {
 	*x = FilecoinMiner{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[33]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1718,7 +2896,7 @@ func (x *FilecoinMiner) String() string {
 func (*FilecoinMiner) ProtoMessage() {}
 
 func (x *FilecoinMiner) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[33]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1731,7 +2909,7 @@ func (x *FilecoinMiner) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilecoinMiner.ProtoReflect.Descriptor instead.
 func (*FilecoinMiner) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{33}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *FilecoinMiner) GetAddress() string {
@@ -1752,7 +2930,7 @@ type GetMinerInfoRequest struct {
 func (x *GetMinerInfoRequest) Reset() {
 	*x = GetMinerInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[34]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1765,7 +2943,7 @@ func (x *GetMinerInfoRequest) String() string {
 func (*GetMinerInfoRequest) ProtoMessage() {}
 
 func (x *GetMinerInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[34]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1778,7 +2956,7 @@ func (x *GetMinerInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMinerInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetMinerInfoRequest) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{34}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *GetMinerInfoRequest) GetMiners() []string {
@@ -1799,7 +2977,7 @@ type GetMinerInfoResponse struct {
 func (x *GetMinerInfoResponse) Reset() {
 	*x = GetMinerInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[35]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1812,7 +2990,7 @@ func (x *GetMinerInfoResponse) String() string {
 func (*GetMinerInfoResponse) ProtoMessage() {}
 
 func (x *GetMinerInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[35]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1825,7 +3003,7 @@ func (x *GetMinerInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetMinerInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetMinerInfoResponse) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{35}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GetMinerInfoResponse) GetMinersInfo() []*MinerInfo {
@@ -1856,7 +3034,7 @@ type MinerInfo struct {
 func (x *MinerInfo) Reset() {
 	*x = MinerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_powergate_admin_v1_admin_proto_msgTypes[36]
+		mi := &file_powergate_admin_v1_admin_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1869,7 +3047,7 @@ func (x *MinerInfo) String() string {
 func (*MinerInfo) ProtoMessage() {}
 
 func (x *MinerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_powergate_admin_v1_admin_proto_msgTypes[36]
+	mi := &file_powergate_admin_v1_admin_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1882,7 +3060,7 @@ func (x *MinerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MinerInfo.ProtoReflect.Descriptor instead.
 func (*MinerInfo) Descriptor() ([]byte, []int) {
-	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{36}
+	return file_powergate_admin_v1_admin_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *MinerInfo) GetAddress() string {
@@ -2010,211 +3188,393 @@ var file_powergate_admin_v1_admin_proto_rawDesc = []byte{
 	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61,
 	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x22, 0x3f, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x63, 0x69, 0x64, 0x22, 0x58, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x47,
-	0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x65, 0x72, 0x73, 0x22, 0x54, 0x0a, 0x21, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x69,
+	0x6e, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x24, 0x0a, 0x22, 0x53, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3f, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64,
+	0x22, 0x58, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x47, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x69, 0x64, 0x73, 0x22, 0x5c, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x5b, 0x0a, 0x19, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x69, 0x74,
+	0x68, 0x69, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0x79,
+	0x0a, 0x1a, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x43, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
+	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x09,
+	0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x22, 0x6b, 0x0a, 0x13, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6f, 0x6e, 0x6c, 0x79, 0x55, 0x6e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x22, 0x50, 0x0a, 0x14, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38,
+	0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x30, 0x0a, 0x13, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x94, 0x03, 0x0a, 0x14, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x75, 0x64, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x65, 0x64, 0x43, 0x69, 0x64,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x5f, 0x63, 0x69, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79,
+	0x43, 0x69, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x64, 0x69, 0x74, 0x65, 0x64, 0x5f,
+	0x64, 0x65, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x65, 0x64, 0x44, 0x65, 0x61, 0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x64, 0x65, 0x61, 0x6c, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x65, 0x61, 0x6c, 0x73, 0x4e, 0x6f, 0x74,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f,
+	0x63, 0x69, 0x64, 0x5f, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x70, 0x69, 0x65, 0x63, 0x65, 0x43, 0x69, 0x64, 0x4d, 0x69,
+	0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x5f, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x73, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x4e, 0x6f, 0x74, 0x53, 0x65, 0x61, 0x6c, 0x65,
+	0x64, 0x22, 0x29, 0x0a, 0x0c, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x41, 0x0a, 0x0d,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x06, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22,
+	0xfd, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x69, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x69, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x73, 0x63, 0x65, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x42, 0x0a, 0x08, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22,
+	0x97, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62,
+	0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x6f, 0x72,
+	0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x46, 0x0a, 0x19, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69,
+	0x64, 0x22, 0xb0, 0x01, 0x0a, 0x1a, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62,
+	0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x13, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73,
+	0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x14, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x4a, 0x6f, 0x62, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x1f, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
 	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72,
 	0x49, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0x5c, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x41, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e,
-	0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
-	0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xfd, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x24, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x69, 0x64, 0x5f, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x69, 0x64, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61,
-	0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x61, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
-	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x42, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a,
-	0x6f, 0x62, 0x73, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x73, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x97, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x40, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
-	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a,
-	0x6f, 0x62, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x04, 0x6d, 0x6f, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x46, 0x0a, 0x19, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x77, 0x68, 0x65, 0x72, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x75, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x72, 0x65, 0x70, 0x75, 0x73, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x22, 0x65, 0x0a, 0x20, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x07,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x6d, 0x0a, 0x13, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x43, 0x53, 0x74, 0x61, 0x67,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x10, 0x47, 0x43, 0x53,
+	0x74, 0x61, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69,
+	0x64, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x12, 0x50, 0x69, 0x6e, 0x6e, 0x65,
+	0x64, 0x43, 0x69, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x53, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x52, 0x04, 0x63, 0x69,
+	0x64, 0x73, 0x22, 0x5a, 0x0a, 0x0b, 0x48, 0x53, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x63, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x53, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64,
+	0x43, 0x69, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x61,
+	0x0a, 0x0f, 0x48, 0x53, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x67,
+	0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x7f, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x48, 0x6f,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x72, 0x65, 0x70, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x5f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x22, 0xa9, 0x02, 0x0a, 0x1b, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65,
+	0x48, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x43, 0x69, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x69, 0x6e, 0x6e, 0x65,
+	0x64, 0x5f, 0x63, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x6c, 0x6f,
+	0x73, 0x74, 0x5f, 0x63, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x53, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x52, 0x08,
+	0x6c, 0x6f, 0x73, 0x74, 0x43, 0x69, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x5f, 0x63, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x43, 0x69, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x75,
+	0x6e, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x73,
+	0x12, 0x3c, 0x0a, 0x09, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x53, 0x55, 0x6e, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x65, 0x52, 0x09, 0x75, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x73, 0x22, 0x4e,
+	0x0a, 0x0a, 0x48, 0x53, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x12, 0x17, 0x0a, 0x07,
 	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
 	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0xb0, 0x01, 0x0a, 0x1a, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64,
-	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x11, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f, 0x62, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x14, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6e,
-	0x67, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x2c, 0x0a, 0x12,
-	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6a, 0x6f,
-	0x62, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x43,
-	0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a,
-	0x10, 0x47, 0x43, 0x53, 0x74, 0x61, 0x67, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x69,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x6e,
-	0x65, 0x64, 0x43, 0x69, 0x64, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64,
-	0x43, 0x69, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x12, 0x50,
-	0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x53, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64,
-	0x52, 0x04, 0x63, 0x69, 0x64, 0x73, 0x22, 0x5a, 0x0a, 0x0b, 0x48, 0x53, 0x50, 0x69, 0x6e, 0x6e,
-	0x65, 0x64, 0x43, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
-	0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x53, 0x50, 0x69,
-	0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x22, 0x61, 0x0a, 0x0f, 0x48, 0x53, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x67, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x72, 0x0a, 0x28, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69,
-	0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6b, 0x0a, 0x29, 0x47, 0x65, 0x74,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65,
+	0x28, 0x09, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x88,
+	0x01, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x28, 0x0a, 0x12, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x0f, 0x0a, 0x0d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x24, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x72, 0x0a, 0x28, 0x47, 0x65,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44,
+	0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6b,
+	0x0a, 0x29, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x26, 0x47,
+	0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
 	0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67,
-	0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x70, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x63,
-	0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e,
-	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6b, 0x0a, 0x27, 0x47, 0x65, 0x74, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76,
-	0x61, 0x6c, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x31, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x74,
-	0x68, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77,
-	0x69, 0x74, 0x68, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x4e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d,
-	0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a,
-	0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x65, 0x72,
-	0x52, 0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x29, 0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x65,
-	0x63, 0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x2d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69,
-	0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x65,
-	0x72, 0x73, 0x22, 0x56, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x6d, 0x69,
-	0x6e, 0x65, 0x72, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a,
-	0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x91, 0x03, 0x0a, 0x09, 0x4d,
-	0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x73, 0x6b,
-	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x73,
-	0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x73, 0x6b, 0x5f, 0x76, 0x65,
-	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x10, 0x61, 0x73, 0x6b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x69, 0x65, 0x63,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x69,
-	0x6e, 0x50, 0x69, 0x65, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61,
-	0x78, 0x5f, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x69, 0x65, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x7a,
-	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x73, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
-	0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x4c, 0x69, 0x76, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x46, 0x61, 0x75, 0x6c,
-	0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xab,
-	0x0d, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x5d, 0x0a, 0x0a, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x2e,
-	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a,
-	0x0a, 0x09, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x6f,
-	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x07, 0x53, 0x65,
-	0x6e, 0x64, 0x46, 0x69, 0x6c, 0x12, 0x22, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
-	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46,
-	0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x6b, 0x0a,
+	0x27, 0x47, 0x65, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6c, 0x44, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x22, 0x31, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x74, 0x68, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x22, 0x4e, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e,
+	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x29, 0x0a,
+	0x0d, 0x46, 0x69, 0x6c, 0x65, 0x63, 0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x2d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d,
+	0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x56, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x69,
+	0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3e, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x91, 0x03, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61,
+	0x73, 0x6b, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x73, 0x6b, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x69, 0x6e,
+	0x5f, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x50, 0x69, 0x65, 0x63, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x69, 0x65, 0x63, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x69, 0x65, 0x63,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x4c, 0x69, 0x76, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x5f, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x32, 0x86, 0x15, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x0a, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x65, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x54, 0x0a, 0x07, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x12, 0x22, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
 	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
-	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x69, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74,
-	0x68, 0x12, 0x29, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x12, 0x29, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64,
 	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70,
-	0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x05, 0x55, 0x73,
-	0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
-	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x0b, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65,
-	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x0f,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x6f,
+	0x65, 0x41, 0x75, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4e, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x8d, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x65, 0x72,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x35,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x65, 0x72,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x60, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26,
+	0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6c, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2a, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x75, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72,
+	0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x2d, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61,
+	0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0c, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x27, 0x2e, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4e, 0x0a, 0x05, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x2e, 0x70, 0x6f, 0x77, 0x65,
+	0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x6f,
 	0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x87, 0x01, 0x0a, 0x18, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x33, 0x2e,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x34, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x0f, 0x4c, 0x69,
 	0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x2a, 0x2e,
 	0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
@@ -2261,7 +3621,26 @@ var file_powergate_admin_v1_admin_proto_rawDesc = []byte{
 	0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64,
 	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x43, 0x69, 0x64,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x09, 0x47,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x13, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x48, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x12, 0x2e, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x48, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x48, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c,
+	0x6f, 0x67, 0x73, 0x12, 0x25, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c,
+	0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x53, 0x0a, 0x06, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x12, 0x21, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5a, 0x0a, 0x09, 0x47,
 	0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x6f, 0x77, 0x65, 0x72,
 	0x67, 0x61, 0x74, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
 	0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
@@ -2293,7 +3672,7 @@ func file_powergate_admin_v1_admin_proto_rawDescGZIP() []byte {
 	return file_powergate_admin_v1_admin_proto_rawDescData
 }
 
-var file_powergate_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_powergate_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
 var file_powergate_admin_v1_admin_proto_goTypes = []interface{}{
 	(*NewAddressRequest)(nil),                         // 0: powergate.admin.v1.NewAddressRequest
 	(*NewAddressResponse)(nil),                        // 1: powergate.admin.v1.NewAddressResponse
@@ -2308,89 +3687,139 @@ var file_powergate_admin_v1_admin_proto_goTypes = []interface{}{
 	(*RegenerateAuthResponse)(nil),                    // 10: powergate.admin.v1.RegenerateAuthResponse
 	(*UsersRequest)(nil),                              // 11: powergate.admin.v1.UsersRequest
 	(*UsersResponse)(nil),                             // 12: powergate.admin.v1.UsersResponse
-	(*StorageInfoRequest)(nil),                        // 13: powergate.admin.v1.StorageInfoRequest
-	(*StorageInfoResponse)(nil),                       // 14: powergate.admin.v1.StorageInfoResponse
-	(*ListStorageInfoRequest)(nil),                    // 15: powergate.admin.v1.ListStorageInfoRequest
-	(*ListStorageInfoResponse)(nil),                   // 16: powergate.admin.v1.ListStorageInfoResponse
-	(*ListStorageJobsRequest)(nil),                    // 17: powergate.admin.v1.ListStorageJobsRequest
-	(*ListStorageJobsResponse)(nil),                   // 18: powergate.admin.v1.ListStorageJobsResponse
-	(*StorageJobsSummaryRequest)(nil),                 // 19: powergate.admin.v1.StorageJobsSummaryRequest
-	(*StorageJobsSummaryResponse)(nil),                // 20: powergate.admin.v1.StorageJobsSummaryResponse
-	(*GCStagedRequest)(nil),                           // 21: powergate.admin.v1.GCStagedRequest
-	(*GCStagedResponse)(nil),                          // 22: powergate.admin.v1.GCStagedResponse
-	(*PinnedCidsRequest)(nil),                         // 23: powergate.admin.v1.PinnedCidsRequest
-	(*PinnedCidsResponse)(nil),                        // 24: powergate.admin.v1.PinnedCidsResponse
-	(*HSPinnedCid)(nil),                               // 25: powergate.admin.v1.HSPinnedCid
-	(*HSPinnedCidUser)(nil),                           // 26: powergate.admin.v1.HSPinnedCidUser
-	(*GetUpdatedStorageDealRecordsSinceRequest)(nil),  // 27: powergate.admin.v1.GetUpdatedStorageDealRecordsSinceRequest
-	(*GetUpdatedStorageDealRecordsSinceResponse)(nil), // 28: powergate.admin.v1.GetUpdatedStorageDealRecordsSinceResponse
-	(*GetUpdatedRetrievalRecordsSinceRequest)(nil),    // 29: powergate.admin.v1.GetUpdatedRetrievalRecordsSinceRequest
-	(*GetUpdatedRetrievalRecordsSinceResponse)(nil),   // 30: powergate.admin.v1.GetUpdatedRetrievalRecordsSinceResponse
-	(*GetMinersRequest)(nil),                          // 31: powergate.admin.v1.GetMinersRequest
-	(*GetMinersResponse)(nil),                         // 32: powergate.admin.v1.GetMinersResponse
-	(*FilecoinMiner)(nil),                             // 33: powergate.admin.v1.FilecoinMiner
-	(*GetMinerInfoRequest)(nil),                       // 34: powergate.admin.v1.GetMinerInfoRequest
-	(*GetMinerInfoResponse)(nil),                      // 35: powergate.admin.v1.GetMinerInfoResponse
-	(*MinerInfo)(nil),                                 // 36: powergate.admin.v1.MinerInfo
-	(*v1.StorageInfo)(nil),                            // 37: powergate.user.v1.StorageInfo
-	(v1.StorageJobsSelector)(0),                       // 38: powergate.user.v1.StorageJobsSelector
-	(*v1.StorageJob)(nil),                             // 39: powergate.user.v1.StorageJob
-	(*timestamppb.Timestamp)(nil),                     // 40: google.protobuf.Timestamp
-	(*v1.StorageDealRecord)(nil),                      // 41: powergate.user.v1.StorageDealRecord
-	(*v1.RetrievalDealRecord)(nil),                    // 42: powergate.user.v1.RetrievalDealRecord
+	(*SetUserMinerSelectorParamsRequest)(nil),         // 13: powergate.admin.v1.SetUserMinerSelectorParamsRequest
+	(*SetUserMinerSelectorParamsResponse)(nil),        // 14: powergate.admin.v1.SetUserMinerSelectorParamsResponse
+	(*StorageInfoRequest)(nil),                        // 15: powergate.admin.v1.StorageInfoRequest
+	(*StorageInfoResponse)(nil),                       // 16: powergate.admin.v1.StorageInfoResponse
+	(*ListStorageInfoRequest)(nil),                    // 17: powergate.admin.v1.ListStorageInfoRequest
+	(*ListStorageInfoResponse)(nil),                   // 18: powergate.admin.v1.ListStorageInfoResponse
+	(*ExpirationForecastRequest)(nil),                 // 19: powergate.admin.v1.ExpirationForecastRequest
+	(*ExpirationForecastResponse)(nil),                // 20: powergate.admin.v1.ExpirationForecastResponse
+	(*AuditReportsRequest)(nil),                       // 21: powergate.admin.v1.AuditReportsRequest
+	(*AuditReportsResponse)(nil),                      // 22: powergate.admin.v1.AuditReportsResponse
+	(*AuditSummaryRequest)(nil),                       // 23: powergate.admin.v1.AuditSummaryRequest
+	(*AuditSummaryResponse)(nil),                      // 24: powergate.admin.v1.AuditSummaryResponse
+	(*UsageRequest)(nil),                              // 25: powergate.admin.v1.UsageRequest
+	(*UsageResponse)(nil),                             // 26: powergate.admin.v1.UsageResponse
+	(*ListStorageJobsRequest)(nil),                    // 27: powergate.admin.v1.ListStorageJobsRequest
+	(*ListStorageJobsResponse)(nil),                   // 28: powergate.admin.v1.ListStorageJobsResponse
+	(*StorageJobsSummaryRequest)(nil),                 // 29: powergate.admin.v1.StorageJobsSummaryRequest
+	(*StorageJobsSummaryResponse)(nil),                // 30: powergate.admin.v1.StorageJobsSummaryResponse
+	(*BulkUpdateStorageConfigsRequest)(nil),           // 31: powergate.admin.v1.BulkUpdateStorageConfigsRequest
+	(*BulkUpdateStorageConfigsResponse)(nil),          // 32: powergate.admin.v1.BulkUpdateStorageConfigsResponse
+	(*StorageConfigUpdate)(nil),                       // 33: powergate.admin.v1.StorageConfigUpdate
+	(*GCStagedRequest)(nil),                           // 34: powergate.admin.v1.GCStagedRequest
+	(*GCStagedResponse)(nil),                          // 35: powergate.admin.v1.GCStagedResponse
+	(*PinnedCidsRequest)(nil),                         // 36: powergate.admin.v1.PinnedCidsRequest
+	(*PinnedCidsResponse)(nil),                        // 37: powergate.admin.v1.PinnedCidsResponse
+	(*HSPinnedCid)(nil),                               // 38: powergate.admin.v1.HSPinnedCid
+	(*HSPinnedCidUser)(nil),                           // 39: powergate.admin.v1.HSPinnedCidUser
+	(*ReconcileHotStorageRequest)(nil),                // 40: powergate.admin.v1.ReconcileHotStorageRequest
+	(*ReconcileHotStorageResponse)(nil),               // 41: powergate.admin.v1.ReconcileHotStorageResponse
+	(*HSUnfreeze)(nil),                                // 42: powergate.admin.v1.HSUnfreeze
+	(*ExportLogsRequest)(nil),                         // 43: powergate.admin.v1.ExportLogsRequest
+	(*ExportLogsResponse)(nil),                        // 44: powergate.admin.v1.ExportLogsResponse
+	(*BackupRequest)(nil),                             // 45: powergate.admin.v1.BackupRequest
+	(*BackupResponse)(nil),                            // 46: powergate.admin.v1.BackupResponse
+	(*GetUpdatedStorageDealRecordsSinceRequest)(nil),  // 47: powergate.admin.v1.GetUpdatedStorageDealRecordsSinceRequest
+	(*GetUpdatedStorageDealRecordsSinceResponse)(nil), // 48: powergate.admin.v1.GetUpdatedStorageDealRecordsSinceResponse
+	(*GetUpdatedRetrievalRecordsSinceRequest)(nil),    // 49: powergate.admin.v1.GetUpdatedRetrievalRecordsSinceRequest
+	(*GetUpdatedRetrievalRecordsSinceResponse)(nil),   // 50: powergate.admin.v1.GetUpdatedRetrievalRecordsSinceResponse
+	(*GetMinersRequest)(nil),                          // 51: powergate.admin.v1.GetMinersRequest
+	(*GetMinersResponse)(nil),                         // 52: powergate.admin.v1.GetMinersResponse
+	(*FilecoinMiner)(nil),                             // 53: powergate.admin.v1.FilecoinMiner
+	(*GetMinerInfoRequest)(nil),                       // 54: powergate.admin.v1.GetMinerInfoRequest
+	(*GetMinerInfoResponse)(nil),                      // 55: powergate.admin.v1.GetMinerInfoResponse
+	(*MinerInfo)(nil),                                 // 56: powergate.admin.v1.MinerInfo
+	(*v1.StorageInfo)(nil),                            // 57: powergate.user.v1.StorageInfo
+	(*v1.ExpirationForecast)(nil),                     // 58: powergate.user.v1.ExpirationForecast
+	(*v1.AuditReport)(nil),                            // 59: powergate.user.v1.AuditReport
+	(*timestamppb.Timestamp)(nil),                     // 60: google.protobuf.Timestamp
+	(*v1.Usage)(nil),                                  // 61: powergate.user.v1.Usage
+	(v1.StorageJobsSelector)(0),                       // 62: powergate.user.v1.StorageJobsSelector
+	(*v1.StorageJob)(nil),                             // 63: powergate.user.v1.StorageJob
+	(*v1.StorageDealRecord)(nil),                      // 64: powergate.user.v1.StorageDealRecord
+	(*v1.RetrievalDealRecord)(nil),                    // 65: powergate.user.v1.RetrievalDealRecord
 }
 var file_powergate_admin_v1_admin_proto_depIdxs = []int32{
 	6,  // 0: powergate.admin.v1.CreateUserResponse.user:type_name -> powergate.admin.v1.User
 	6,  // 1: powergate.admin.v1.UsersResponse.users:type_name -> powergate.admin.v1.User
-	37, // 2: powergate.admin.v1.StorageInfoResponse.storage_info:type_name -> powergate.user.v1.StorageInfo
-	37, // 3: powergate.admin.v1.ListStorageInfoResponse.storage_info:type_name -> powergate.user.v1.StorageInfo
-	38, // 4: powergate.admin.v1.ListStorageJobsRequest.selector:type_name -> powergate.user.v1.StorageJobsSelector
-	39, // 5: powergate.admin.v1.ListStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
-	25, // 6: powergate.admin.v1.PinnedCidsResponse.cids:type_name -> powergate.admin.v1.HSPinnedCid
-	26, // 7: powergate.admin.v1.HSPinnedCid.users:type_name -> powergate.admin.v1.HSPinnedCidUser
-	40, // 8: powergate.admin.v1.GetUpdatedStorageDealRecordsSinceRequest.since:type_name -> google.protobuf.Timestamp
-	41, // 9: powergate.admin.v1.GetUpdatedStorageDealRecordsSinceResponse.records:type_name -> powergate.user.v1.StorageDealRecord
-	40, // 10: powergate.admin.v1.GetUpdatedRetrievalRecordsSinceRequest.since:type_name -> google.protobuf.Timestamp
-	42, // 11: powergate.admin.v1.GetUpdatedRetrievalRecordsSinceResponse.records:type_name -> powergate.user.v1.RetrievalDealRecord
-	33, // 12: powergate.admin.v1.GetMinersResponse.miners:type_name -> powergate.admin.v1.FilecoinMiner
-	36, // 13: powergate.admin.v1.GetMinerInfoResponse.miners_info:type_name -> powergate.admin.v1.MinerInfo
-	0,  // 14: powergate.admin.v1.AdminService.NewAddress:input_type -> powergate.admin.v1.NewAddressRequest
-	2,  // 15: powergate.admin.v1.AdminService.Addresses:input_type -> powergate.admin.v1.AddressesRequest
-	4,  // 16: powergate.admin.v1.AdminService.SendFil:input_type -> powergate.admin.v1.SendFilRequest
-	7,  // 17: powergate.admin.v1.AdminService.CreateUser:input_type -> powergate.admin.v1.CreateUserRequest
-	9,  // 18: powergate.admin.v1.AdminService.RegenerateAuth:input_type -> powergate.admin.v1.RegenerateAuthRequest
-	11, // 19: powergate.admin.v1.AdminService.Users:input_type -> powergate.admin.v1.UsersRequest
-	13, // 20: powergate.admin.v1.AdminService.StorageInfo:input_type -> powergate.admin.v1.StorageInfoRequest
-	15, // 21: powergate.admin.v1.AdminService.ListStorageInfo:input_type -> powergate.admin.v1.ListStorageInfoRequest
-	17, // 22: powergate.admin.v1.AdminService.ListStorageJobs:input_type -> powergate.admin.v1.ListStorageJobsRequest
-	19, // 23: powergate.admin.v1.AdminService.StorageJobsSummary:input_type -> powergate.admin.v1.StorageJobsSummaryRequest
-	27, // 24: powergate.admin.v1.AdminService.GetUpdatedStorageDealRecordsSince:input_type -> powergate.admin.v1.GetUpdatedStorageDealRecordsSinceRequest
-	29, // 25: powergate.admin.v1.AdminService.GetUpdatedRetrievalRecordsSince:input_type -> powergate.admin.v1.GetUpdatedRetrievalRecordsSinceRequest
-	21, // 26: powergate.admin.v1.AdminService.GCStaged:input_type -> powergate.admin.v1.GCStagedRequest
-	23, // 27: powergate.admin.v1.AdminService.PinnedCids:input_type -> powergate.admin.v1.PinnedCidsRequest
-	31, // 28: powergate.admin.v1.AdminService.GetMiners:input_type -> powergate.admin.v1.GetMinersRequest
-	34, // 29: powergate.admin.v1.AdminService.GetMinerInfo:input_type -> powergate.admin.v1.GetMinerInfoRequest
-	1,  // 30: powergate.admin.v1.AdminService.NewAddress:output_type -> powergate.admin.v1.NewAddressResponse
-	3,  // 31: powergate.admin.v1.AdminService.Addresses:output_type -> powergate.admin.v1.AddressesResponse
-	5,  // 32: powergate.admin.v1.AdminService.SendFil:output_type -> powergate.admin.v1.SendFilResponse
-	8,  // 33: powergate.admin.v1.AdminService.CreateUser:output_type -> powergate.admin.v1.CreateUserResponse
-	10, // 34: powergate.admin.v1.AdminService.RegenerateAuth:output_type -> powergate.admin.v1.RegenerateAuthResponse
-	12, // 35: powergate.admin.v1.AdminService.Users:output_type -> powergate.admin.v1.UsersResponse
-	14, // 36: powergate.admin.v1.AdminService.StorageInfo:output_type -> powergate.admin.v1.StorageInfoResponse
-	16, // 37: powergate.admin.v1.AdminService.ListStorageInfo:output_type -> powergate.admin.v1.ListStorageInfoResponse
-	18, // 38: powergate.admin.v1.AdminService.ListStorageJobs:output_type -> powergate.admin.v1.ListStorageJobsResponse
-	20, // 39: powergate.admin.v1.AdminService.StorageJobsSummary:output_type -> powergate.admin.v1.StorageJobsSummaryResponse
-	28, // 40: powergate.admin.v1.AdminService.GetUpdatedStorageDealRecordsSince:output_type -> powergate.admin.v1.GetUpdatedStorageDealRecordsSinceResponse
-	30, // 41: powergate.admin.v1.AdminService.GetUpdatedRetrievalRecordsSince:output_type -> powergate.admin.v1.GetUpdatedRetrievalRecordsSinceResponse
-	22, // 42: powergate.admin.v1.AdminService.GCStaged:output_type -> powergate.admin.v1.GCStagedResponse
-	24, // 43: powergate.admin.v1.AdminService.PinnedCids:output_type -> powergate.admin.v1.PinnedCidsResponse
-	32, // 44: powergate.admin.v1.AdminService.GetMiners:output_type -> powergate.admin.v1.GetMinersResponse
-	35, // 45: powergate.admin.v1.AdminService.GetMinerInfo:output_type -> powergate.admin.v1.GetMinerInfoResponse
-	30, // [30:46] is the sub-list for method output_type
-	14, // [14:30] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	57, // 2: powergate.admin.v1.StorageInfoResponse.storage_info:type_name -> powergate.user.v1.StorageInfo
+	57, // 3: powergate.admin.v1.ListStorageInfoResponse.storage_info:type_name -> powergate.user.v1.StorageInfo
+	58, // 4: powergate.admin.v1.ExpirationForecastResponse.forecasts:type_name -> powergate.user.v1.ExpirationForecast
+	59, // 5: powergate.admin.v1.AuditReportsResponse.reports:type_name -> powergate.user.v1.AuditReport
+	60, // 6: powergate.admin.v1.AuditSummaryResponse.last_audit:type_name -> google.protobuf.Timestamp
+	61, // 7: powergate.admin.v1.UsageResponse.usages:type_name -> powergate.user.v1.Usage
+	62, // 8: powergate.admin.v1.ListStorageJobsRequest.selector:type_name -> powergate.user.v1.StorageJobsSelector
+	63, // 9: powergate.admin.v1.ListStorageJobsResponse.storage_jobs:type_name -> powergate.user.v1.StorageJob
+	33, // 10: powergate.admin.v1.BulkUpdateStorageConfigsResponse.updates:type_name -> powergate.admin.v1.StorageConfigUpdate
+	38, // 11: powergate.admin.v1.PinnedCidsResponse.cids:type_name -> powergate.admin.v1.HSPinnedCid
+	39, // 12: powergate.admin.v1.HSPinnedCid.users:type_name -> powergate.admin.v1.HSPinnedCidUser
+	38, // 13: powergate.admin.v1.ReconcileHotStorageResponse.lost_cids:type_name -> powergate.admin.v1.HSPinnedCid
+	42, // 14: powergate.admin.v1.ReconcileHotStorageResponse.unfreezes:type_name -> powergate.admin.v1.HSUnfreeze
+	60, // 15: powergate.admin.v1.ExportLogsRequest.from:type_name -> google.protobuf.Timestamp
+	60, // 16: powergate.admin.v1.ExportLogsRequest.to:type_name -> google.protobuf.Timestamp
+	60, // 17: powergate.admin.v1.GetUpdatedStorageDealRecordsSinceRequest.since:type_name -> google.protobuf.Timestamp
+	64, // 18: powergate.admin.v1.GetUpdatedStorageDealRecordsSinceResponse.records:type_name -> powergate.user.v1.StorageDealRecord
+	60, // 19: powergate.admin.v1.GetUpdatedRetrievalRecordsSinceRequest.since:type_name -> google.protobuf.Timestamp
+	65, // 20: powergate.admin.v1.GetUpdatedRetrievalRecordsSinceResponse.records:type_name -> powergate.user.v1.RetrievalDealRecord
+	53, // 21: powergate.admin.v1.GetMinersResponse.miners:type_name -> powergate.admin.v1.FilecoinMiner
+	56, // 22: powergate.admin.v1.GetMinerInfoResponse.miners_info:type_name -> powergate.admin.v1.MinerInfo
+	0,  // 23: powergate.admin.v1.AdminService.NewAddress:input_type -> powergate.admin.v1.NewAddressRequest
+	2,  // 24: powergate.admin.v1.AdminService.Addresses:input_type -> powergate.admin.v1.AddressesRequest
+	4,  // 25: powergate.admin.v1.AdminService.SendFil:input_type -> powergate.admin.v1.SendFilRequest
+	7,  // 26: powergate.admin.v1.AdminService.CreateUser:input_type -> powergate.admin.v1.CreateUserRequest
+	9,  // 27: powergate.admin.v1.AdminService.RegenerateAuth:input_type -> powergate.admin.v1.RegenerateAuthRequest
+	11, // 28: powergate.admin.v1.AdminService.Users:input_type -> powergate.admin.v1.UsersRequest
+	13, // 29: powergate.admin.v1.AdminService.SetUserMinerSelectorParams:input_type -> powergate.admin.v1.SetUserMinerSelectorParamsRequest
+	15, // 30: powergate.admin.v1.AdminService.StorageInfo:input_type -> powergate.admin.v1.StorageInfoRequest
+	17, // 31: powergate.admin.v1.AdminService.ListStorageInfo:input_type -> powergate.admin.v1.ListStorageInfoRequest
+	19, // 32: powergate.admin.v1.AdminService.ExpirationForecast:input_type -> powergate.admin.v1.ExpirationForecastRequest
+	21, // 33: powergate.admin.v1.AdminService.AuditReports:input_type -> powergate.admin.v1.AuditReportsRequest
+	23, // 34: powergate.admin.v1.AdminService.AuditSummary:input_type -> powergate.admin.v1.AuditSummaryRequest
+	25, // 35: powergate.admin.v1.AdminService.Usage:input_type -> powergate.admin.v1.UsageRequest
+	31, // 36: powergate.admin.v1.AdminService.BulkUpdateStorageConfigs:input_type -> powergate.admin.v1.BulkUpdateStorageConfigsRequest
+	27, // 37: powergate.admin.v1.AdminService.ListStorageJobs:input_type -> powergate.admin.v1.ListStorageJobsRequest
+	29, // 38: powergate.admin.v1.AdminService.StorageJobsSummary:input_type -> powergate.admin.v1.StorageJobsSummaryRequest
+	47, // 39: powergate.admin.v1.AdminService.GetUpdatedStorageDealRecordsSince:input_type -> powergate.admin.v1.GetUpdatedStorageDealRecordsSinceRequest
+	49, // 40: powergate.admin.v1.AdminService.GetUpdatedRetrievalRecordsSince:input_type -> powergate.admin.v1.GetUpdatedRetrievalRecordsSinceRequest
+	34, // 41: powergate.admin.v1.AdminService.GCStaged:input_type -> powergate.admin.v1.GCStagedRequest
+	36, // 42: powergate.admin.v1.AdminService.PinnedCids:input_type -> powergate.admin.v1.PinnedCidsRequest
+	40, // 43: powergate.admin.v1.AdminService.ReconcileHotStorage:input_type -> powergate.admin.v1.ReconcileHotStorageRequest
+	43, // 44: powergate.admin.v1.AdminService.ExportLogs:input_type -> powergate.admin.v1.ExportLogsRequest
+	45, // 45: powergate.admin.v1.AdminService.Backup:input_type -> powergate.admin.v1.BackupRequest
+	51, // 46: powergate.admin.v1.AdminService.GetMiners:input_type -> powergate.admin.v1.GetMinersRequest
+	54, // 47: powergate.admin.v1.AdminService.GetMinerInfo:input_type -> powergate.admin.v1.GetMinerInfoRequest
+	1,  // 48: powergate.admin.v1.AdminService.NewAddress:output_type -> powergate.admin.v1.NewAddressResponse
+	3,  // 49: powergate.admin.v1.AdminService.Addresses:output_type -> powergate.admin.v1.AddressesResponse
+	5,  // 50: powergate.admin.v1.AdminService.SendFil:output_type -> powergate.admin.v1.SendFilResponse
+	8,  // 51: powergate.admin.v1.AdminService.CreateUser:output_type -> powergate.admin.v1.CreateUserResponse
+	10, // 52: powergate.admin.v1.AdminService.RegenerateAuth:output_type -> powergate.admin.v1.RegenerateAuthResponse
+	12, // 53: powergate.admin.v1.AdminService.Users:output_type -> powergate.admin.v1.UsersResponse
+	14, // 54: powergate.admin.v1.AdminService.SetUserMinerSelectorParams:output_type -> powergate.admin.v1.SetUserMinerSelectorParamsResponse
+	16, // 55: powergate.admin.v1.AdminService.StorageInfo:output_type -> powergate.admin.v1.StorageInfoResponse
+	18, // 56: powergate.admin.v1.AdminService.ListStorageInfo:output_type -> powergate.admin.v1.ListStorageInfoResponse
+	20, // 57: powergate.admin.v1.AdminService.ExpirationForecast:output_type -> powergate.admin.v1.ExpirationForecastResponse
+	22, // 58: powergate.admin.v1.AdminService.AuditReports:output_type -> powergate.admin.v1.AuditReportsResponse
+	24, // 59: powergate.admin.v1.AdminService.AuditSummary:output_type -> powergate.admin.v1.AuditSummaryResponse
+	26, // 60: powergate.admin.v1.AdminService.Usage:output_type -> powergate.admin.v1.UsageResponse
+	32, // 61: powergate.admin.v1.AdminService.BulkUpdateStorageConfigs:output_type -> powergate.admin.v1.BulkUpdateStorageConfigsResponse
+	28, // 62: powergate.admin.v1.AdminService.ListStorageJobs:output_type -> powergate.admin.v1.ListStorageJobsResponse
+	30, // 63: powergate.admin.v1.AdminService.StorageJobsSummary:output_type -> powergate.admin.v1.StorageJobsSummaryResponse
+	48, // 64: powergate.admin.v1.AdminService.GetUpdatedStorageDealRecordsSince:output_type -> powergate.admin.v1.GetUpdatedStorageDealRecordsSinceResponse
+	50, // 65: powergate.admin.v1.AdminService.GetUpdatedRetrievalRecordsSince:output_type -> powergate.admin.v1.GetUpdatedRetrievalRecordsSinceResponse
+	35, // 66: powergate.admin.v1.AdminService.GCStaged:output_type -> powergate.admin.v1.GCStagedResponse
+	37, // 67: powergate.admin.v1.AdminService.PinnedCids:output_type -> powergate.admin.v1.PinnedCidsResponse
+	41, // 68: powergate.admin.v1.AdminService.ReconcileHotStorage:output_type -> powergate.admin.v1.ReconcileHotStorageResponse
+	44, // 69: powergate.admin.v1.AdminService.ExportLogs:output_type -> powergate.admin.v1.ExportLogsResponse
+	46, // 70: powergate.admin.v1.AdminService.Backup:output_type -> powergate.admin.v1.BackupResponse
+	52, // 71: powergate.admin.v1.AdminService.GetMiners:output_type -> powergate.admin.v1.GetMinersResponse
+	55, // 72: powergate.admin.v1.AdminService.GetMinerInfo:output_type -> powergate.admin.v1.GetMinerInfoResponse
+	48, // [48:73] is the sub-list for method output_type
+	23, // [23:48] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_powergate_admin_v1_admin_proto_init() }
@@ -2556,7 +3985,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageInfoRequest); i {
+			switch v := v.(*SetUserMinerSelectorParamsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2568,7 +3997,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageInfoResponse); i {
+			switch v := v.(*SetUserMinerSelectorParamsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2580,7 +4009,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStorageInfoRequest); i {
+			switch v := v.(*StorageInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2592,7 +4021,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStorageInfoResponse); i {
+			switch v := v.(*StorageInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2604,7 +4033,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStorageJobsRequest); i {
+			switch v := v.(*ListStorageInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2616,7 +4045,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListStorageJobsResponse); i {
+			switch v := v.(*ListStorageInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2628,7 +4057,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageJobsSummaryRequest); i {
+			switch v := v.(*ExpirationForecastRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2640,7 +4069,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StorageJobsSummaryResponse); i {
+			switch v := v.(*ExpirationForecastResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2652,7 +4081,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCStagedRequest); i {
+			switch v := v.(*AuditReportsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2664,7 +4093,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GCStagedResponse); i {
+			switch v := v.(*AuditReportsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2676,7 +4105,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PinnedCidsRequest); i {
+			switch v := v.(*AuditSummaryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2688,7 +4117,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PinnedCidsResponse); i {
+			switch v := v.(*AuditSummaryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2700,7 +4129,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HSPinnedCid); i {
+			switch v := v.(*UsageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2712,7 +4141,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HSPinnedCidUser); i {
+			switch v := v.(*UsageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2724,7 +4153,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUpdatedStorageDealRecordsSinceRequest); i {
+			switch v := v.(*ListStorageJobsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2736,7 +4165,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUpdatedStorageDealRecordsSinceResponse); i {
+			switch v := v.(*ListStorageJobsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2748,7 +4177,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUpdatedRetrievalRecordsSinceRequest); i {
+			switch v := v.(*StorageJobsSummaryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2760,7 +4189,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUpdatedRetrievalRecordsSinceResponse); i {
+			switch v := v.(*StorageJobsSummaryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2772,7 +4201,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMinersRequest); i {
+			switch v := v.(*BulkUpdateStorageConfigsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2784,7 +4213,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMinersResponse); i {
+			switch v := v.(*BulkUpdateStorageConfigsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2796,7 +4225,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilecoinMiner); i {
+			switch v := v.(*StorageConfigUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2808,7 +4237,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMinerInfoRequest); i {
+			switch v := v.(*GCStagedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2820,7 +4249,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMinerInfoResponse); i {
+			switch v := v.(*GCStagedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2832,6 +4261,246 @@ func file_powergate_admin_v1_admin_proto_init() {
 			}
 		}
 		file_powergate_admin_v1_admin_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinnedCidsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinnedCidsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HSPinnedCid); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HSPinnedCidUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileHotStorageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReconcileHotStorageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HSUnfreeze); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUpdatedStorageDealRecordsSinceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUpdatedStorageDealRecordsSinceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUpdatedRetrievalRecordsSinceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUpdatedRetrievalRecordsSinceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMinersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMinersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilecoinMiner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMinerInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMinerInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_powergate_admin_v1_admin_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MinerInfo); i {
 			case 0:
 				return &v.state
@@ -2850,7 +4519,7 @@ func file_powergate_admin_v1_admin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_powergate_admin_v1_admin_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   37,
+			NumMessages:   57,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,9 +25,16 @@ type AdminServiceClient interface {
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	RegenerateAuth(ctx context.Context, in *RegenerateAuthRequest, opts ...grpc.CallOption) (*RegenerateAuthResponse, error)
 	Users(ctx context.Context, in *UsersRequest, opts ...grpc.CallOption) (*UsersResponse, error)
+	SetUserMinerSelectorParams(ctx context.Context, in *SetUserMinerSelectorParamsRequest, opts ...grpc.CallOption) (*SetUserMinerSelectorParamsResponse, error)
 	// Storage Info
 	StorageInfo(ctx context.Context, in *StorageInfoRequest, opts ...grpc.CallOption) (*StorageInfoResponse, error)
 	ListStorageInfo(ctx context.Context, in *ListStorageInfoRequest, opts ...grpc.CallOption) (*ListStorageInfoResponse, error)
+	ExpirationForecast(ctx context.Context, in *ExpirationForecastRequest, opts ...grpc.CallOption) (*ExpirationForecastResponse, error)
+	AuditReports(ctx context.Context, in *AuditReportsRequest, opts ...grpc.CallOption) (*AuditReportsResponse, error)
+	AuditSummary(ctx context.Context, in *AuditSummaryRequest, opts ...grpc.CallOption) (*AuditSummaryResponse, error)
+	Usage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*UsageResponse, error)
+	// Storage Configs
+	BulkUpdateStorageConfigs(ctx context.Context, in *BulkUpdateStorageConfigsRequest, opts ...grpc.CallOption) (*BulkUpdateStorageConfigsResponse, error)
 	// Storage Jobs
 	ListStorageJobs(ctx context.Context, in *ListStorageJobsRequest, opts ...grpc.CallOption) (*ListStorageJobsResponse, error)
 	StorageJobsSummary(ctx context.Context, in *StorageJobsSummaryRequest, opts ...grpc.CallOption) (*StorageJobsSummaryResponse, error)
@@ -36,6 +43,9 @@ type AdminServiceClient interface {
 	GetUpdatedRetrievalRecordsSince(ctx context.Context, in *GetUpdatedRetrievalRecordsSinceRequest, opts ...grpc.CallOption) (*GetUpdatedRetrievalRecordsSinceResponse, error)
 	GCStaged(ctx context.Context, in *GCStagedRequest, opts ...grpc.CallOption) (*GCStagedResponse, error)
 	PinnedCids(ctx context.Context, in *PinnedCidsRequest, opts ...grpc.CallOption) (*PinnedCidsResponse, error)
+	ReconcileHotStorage(ctx context.Context, in *ReconcileHotStorageRequest, opts ...grpc.CallOption) (*ReconcileHotStorageResponse, error)
+	ExportLogs(ctx context.Context, in *ExportLogsRequest, opts ...grpc.CallOption) (AdminService_ExportLogsClient, error)
+	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (AdminService_BackupClient, error)
 	// Indices
 	GetMiners(ctx context.Context, in *GetMinersRequest, opts ...grpc.CallOption) (*GetMinersResponse, error)
 	GetMinerInfo(ctx context.Context, in *GetMinerInfoRequest, opts ...grpc.CallOption) (*GetMinerInfoResponse, error)
@@ -103,6 +113,15 @@ func (c *adminServiceClient) Users(ctx context.Context, in *UsersRequest, opts .
 	return out, nil
 }
 
+func (c *adminServiceClient) SetUserMinerSelectorParams(ctx context.Context, in *SetUserMinerSelectorParamsRequest, opts ...grpc.CallOption) (*SetUserMinerSelectorParamsResponse, error) {
+	out := new(SetUserMinerSelectorParamsResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/SetUserMinerSelectorParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) StorageInfo(ctx context.Context, in *StorageInfoRequest, opts ...grpc.CallOption) (*StorageInfoResponse, error) {
 	out := new(StorageInfoResponse)
 	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/StorageInfo", in, out, opts...)
@@ -121,6 +140,51 @@ func (c *adminServiceClient) ListStorageInfo(ctx context.Context, in *ListStorag
 	return out, nil
 }
 
+func (c *adminServiceClient) ExpirationForecast(ctx context.Context, in *ExpirationForecastRequest, opts ...grpc.CallOption) (*ExpirationForecastResponse, error) {
+	out := new(ExpirationForecastResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/ExpirationForecast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AuditReports(ctx context.Context, in *AuditReportsRequest, opts ...grpc.CallOption) (*AuditReportsResponse, error) {
+	out := new(AuditReportsResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/AuditReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AuditSummary(ctx context.Context, in *AuditSummaryRequest, opts ...grpc.CallOption) (*AuditSummaryResponse, error) {
+	out := new(AuditSummaryResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/AuditSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) Usage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*UsageResponse, error) {
+	out := new(UsageResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/Usage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BulkUpdateStorageConfigs(ctx context.Context, in *BulkUpdateStorageConfigsRequest, opts ...grpc.CallOption) (*BulkUpdateStorageConfigsResponse, error) {
+	out := new(BulkUpdateStorageConfigsResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/BulkUpdateStorageConfigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListStorageJobs(ctx context.Context, in *ListStorageJobsRequest, opts ...grpc.CallOption) (*ListStorageJobsResponse, error) {
 	out := new(ListStorageJobsResponse)
 	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/ListStorageJobs", in, out, opts...)
@@ -175,6 +239,79 @@ func (c *adminServiceClient) PinnedCids(ctx context.Context, in *PinnedCidsReque
 	return out, nil
 }
 
+func (c *adminServiceClient) ReconcileHotStorage(ctx context.Context, in *ReconcileHotStorageRequest, opts ...grpc.CallOption) (*ReconcileHotStorageResponse, error) {
+	out := new(ReconcileHotStorageResponse)
+	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/ReconcileHotStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExportLogs(ctx context.Context, in *ExportLogsRequest, opts ...grpc.CallOption) (AdminService_ExportLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminService_serviceDesc.Streams[0], "/powergate.admin.v1.AdminService/ExportLogs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceExportLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminService_ExportLogsClient interface {
+	Recv() (*ExportLogsResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceExportLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceExportLogsClient) Recv() (*ExportLogsResponse, error) {
+	m := new(ExportLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *adminServiceClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (AdminService_BackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AdminService_serviceDesc.Streams[1], "/powergate.admin.v1.AdminService/Backup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminService_BackupClient interface {
+	Recv() (*BackupResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceBackupClient) Recv() (*BackupResponse, error) {
+	m := new(BackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *adminServiceClient) GetMiners(ctx context.Context, in *GetMinersRequest, opts ...grpc.CallOption) (*GetMinersResponse, error) {
 	out := new(GetMinersResponse)
 	err := c.cc.Invoke(ctx, "/powergate.admin.v1.AdminService/GetMiners", in, out, opts...)
@@ -205,9 +342,16 @@ type AdminServiceServer interface {
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	RegenerateAuth(context.Context, *RegenerateAuthRequest) (*RegenerateAuthResponse, error)
 	Users(context.Context, *UsersRequest) (*UsersResponse, error)
+	SetUserMinerSelectorParams(context.Context, *SetUserMinerSelectorParamsRequest) (*SetUserMinerSelectorParamsResponse, error)
 	// Storage Info
 	StorageInfo(context.Context, *StorageInfoRequest) (*StorageInfoResponse, error)
 	ListStorageInfo(context.Context, *ListStorageInfoRequest) (*ListStorageInfoResponse, error)
+	ExpirationForecast(context.Context, *ExpirationForecastRequest) (*ExpirationForecastResponse, error)
+	AuditReports(context.Context, *AuditReportsRequest) (*AuditReportsResponse, error)
+	AuditSummary(context.Context, *AuditSummaryRequest) (*AuditSummaryResponse, error)
+	Usage(context.Context, *UsageRequest) (*UsageResponse, error)
+	// Storage Configs
+	BulkUpdateStorageConfigs(context.Context, *BulkUpdateStorageConfigsRequest) (*BulkUpdateStorageConfigsResponse, error)
 	// Storage Jobs
 	ListStorageJobs(context.Context, *ListStorageJobsRequest) (*ListStorageJobsResponse, error)
 	StorageJobsSummary(context.Context, *StorageJobsSummaryRequest) (*StorageJobsSummaryResponse, error)
@@ -216,6 +360,9 @@ type AdminServiceServer interface {
 	GetUpdatedRetrievalRecordsSince(context.Context, *GetUpdatedRetrievalRecordsSinceRequest) (*GetUpdatedRetrievalRecordsSinceResponse, error)
 	GCStaged(context.Context, *GCStagedRequest) (*GCStagedResponse, error)
 	PinnedCids(context.Context, *PinnedCidsRequest) (*PinnedCidsResponse, error)
+	ReconcileHotStorage(context.Context, *ReconcileHotStorageRequest) (*ReconcileHotStorageResponse, error)
+	ExportLogs(*ExportLogsRequest, AdminService_ExportLogsServer) error
+	Backup(*BackupRequest, AdminService_BackupServer) error
 	// Indices
 	GetMiners(context.Context, *GetMinersRequest) (*GetMinersResponse, error)
 	GetMinerInfo(context.Context, *GetMinerInfoRequest) (*GetMinerInfoResponse, error)
@@ -244,12 +391,30 @@ func (UnimplementedAdminServiceServer) RegenerateAuth(context.Context, *Regenera
 func (UnimplementedAdminServiceServer) Users(context.Context, *UsersRequest) (*UsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Users not implemented")
 }
+func (UnimplementedAdminServiceServer) SetUserMinerSelectorParams(context.Context, *SetUserMinerSelectorParamsRequest) (*SetUserMinerSelectorParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserMinerSelectorParams not implemented")
+}
 func (UnimplementedAdminServiceServer) StorageInfo(context.Context, *StorageInfoRequest) (*StorageInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageInfo not implemented")
 }
 func (UnimplementedAdminServiceServer) ListStorageInfo(context.Context, *ListStorageInfoRequest) (*ListStorageInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStorageInfo not implemented")
 }
+func (UnimplementedAdminServiceServer) ExpirationForecast(context.Context, *ExpirationForecastRequest) (*ExpirationForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpirationForecast not implemented")
+}
+func (UnimplementedAdminServiceServer) AuditReports(context.Context, *AuditReportsRequest) (*AuditReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditReports not implemented")
+}
+func (UnimplementedAdminServiceServer) AuditSummary(context.Context, *AuditSummaryRequest) (*AuditSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditSummary not implemented")
+}
+func (UnimplementedAdminServiceServer) Usage(context.Context, *UsageRequest) (*UsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Usage not implemented")
+}
+func (UnimplementedAdminServiceServer) BulkUpdateStorageConfigs(context.Context, *BulkUpdateStorageConfigsRequest) (*BulkUpdateStorageConfigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateStorageConfigs not implemented")
+}
 func (UnimplementedAdminServiceServer) ListStorageJobs(context.Context, *ListStorageJobsRequest) (*ListStorageJobsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStorageJobs not implemented")
 }
@@ -268,6 +433,15 @@ func (UnimplementedAdminServiceServer) GCStaged(context.Context, *GCStagedReques
 func (UnimplementedAdminServiceServer) PinnedCids(context.Context, *PinnedCidsRequest) (*PinnedCidsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PinnedCids not implemented")
 }
+func (UnimplementedAdminServiceServer) ReconcileHotStorage(context.Context, *ReconcileHotStorageRequest) (*ReconcileHotStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileHotStorage not implemented")
+}
+func (UnimplementedAdminServiceServer) ExportLogs(*ExportLogsRequest, AdminService_ExportLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportLogs not implemented")
+}
+func (UnimplementedAdminServiceServer) Backup(*BackupRequest, AdminService_BackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
 func (UnimplementedAdminServiceServer) GetMiners(context.Context, *GetMinersRequest) (*GetMinersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMiners not implemented")
 }
@@ -395,6 +569,24 @@ func _AdminService_Users_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_SetUserMinerSelectorParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserMinerSelectorParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetUserMinerSelectorParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/SetUserMinerSelectorParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetUserMinerSelectorParams(ctx, req.(*SetUserMinerSelectorParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_StorageInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StorageInfoRequest)
 	if err := dec(in); err != nil {
@@ -431,6 +623,96 @@ func _AdminService_ListStorageInfo_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ExpirationForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpirationForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExpirationForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/ExpirationForecast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExpirationForecast(ctx, req.(*ExpirationForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AuditReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AuditReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/AuditReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AuditReports(ctx, req.(*AuditReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AuditSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AuditSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/AuditSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AuditSummary(ctx, req.(*AuditSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_Usage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Usage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/Usage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Usage(ctx, req.(*UsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BulkUpdateStorageConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateStorageConfigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BulkUpdateStorageConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/BulkUpdateStorageConfigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BulkUpdateStorageConfigs(ctx, req.(*BulkUpdateStorageConfigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListStorageJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListStorageJobsRequest)
 	if err := dec(in); err != nil {
@@ -539,6 +821,66 @@ func _AdminService_PinnedCids_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ReconcileHotStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileHotStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReconcileHotStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.admin.v1.AdminService/ReconcileHotStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReconcileHotStorage(ctx, req.(*ReconcileHotStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExportLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).ExportLogs(m, &adminServiceExportLogsServer{stream})
+}
+
+type AdminService_ExportLogsServer interface {
+	Send(*ExportLogsResponse) error
+	grpc.ServerStream
+}
+
+type adminServiceExportLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceExportLogsServer) Send(m *ExportLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AdminService_Backup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).Backup(m, &adminServiceBackupServer{stream})
+}
+
+type AdminService_BackupServer interface {
+	Send(*BackupResponse) error
+	grpc.ServerStream
+}
+
+type adminServiceBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceBackupServer) Send(m *BackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _AdminService_GetMiners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMinersRequest)
 	if err := dec(in); err != nil {
@@ -603,6 +945,10 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Users",
 			Handler:    _AdminService_Users_Handler,
 		},
+		{
+			MethodName: "SetUserMinerSelectorParams",
+			Handler:    _AdminService_SetUserMinerSelectorParams_Handler,
+		},
 		{
 			MethodName: "StorageInfo",
 			Handler:    _AdminService_StorageInfo_Handler,
@@ -611,6 +957,26 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListStorageInfo",
 			Handler:    _AdminService_ListStorageInfo_Handler,
 		},
+		{
+			MethodName: "ExpirationForecast",
+			Handler:    _AdminService_ExpirationForecast_Handler,
+		},
+		{
+			MethodName: "AuditReports",
+			Handler:    _AdminService_AuditReports_Handler,
+		},
+		{
+			MethodName: "AuditSummary",
+			Handler:    _AdminService_AuditSummary_Handler,
+		},
+		{
+			MethodName: "Usage",
+			Handler:    _AdminService_Usage_Handler,
+		},
+		{
+			MethodName: "BulkUpdateStorageConfigs",
+			Handler:    _AdminService_BulkUpdateStorageConfigs_Handler,
+		},
 		{
 			MethodName: "ListStorageJobs",
 			Handler:    _AdminService_ListStorageJobs_Handler,
@@ -635,6 +1001,10 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PinnedCids",
 			Handler:    _AdminService_PinnedCids_Handler,
 		},
+		{
+			MethodName: "ReconcileHotStorage",
+			Handler:    _AdminService_ReconcileHotStorage_Handler,
+		},
 		{
 			MethodName: "GetMiners",
 			Handler:    _AdminService_GetMiners_Handler,
@@ -644,6 +1014,17 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _AdminService_GetMinerInfo_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportLogs",
+			Handler:       _AdminService_ExportLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Backup",
+			Handler:       _AdminService_Backup_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "powergate/admin/v1/admin.proto",
 }
@@ -28,11 +28,23 @@ type UserServiceClient interface {
 	// Data
 	Stage(ctx context.Context, opts ...grpc.CallOption) (UserService_StageClient, error)
 	StageCid(ctx context.Context, in *StageCidRequest, opts ...grpc.CallOption) (*StageCidResponse, error)
+	StageCar(ctx context.Context, opts ...grpc.CallOption) (UserService_StageCarClient, error)
+	CreateUpload(ctx context.Context, in *CreateUploadRequest, opts ...grpc.CallOption) (*CreateUploadResponse, error)
+	UploadChunk(ctx context.Context, in *UploadChunkRequest, opts ...grpc.CallOption) (*UploadChunkResponse, error)
+	UploadStatus(ctx context.Context, in *UploadStatusRequest, opts ...grpc.CallOption) (*UploadStatusResponse, error)
+	FinalizeUpload(ctx context.Context, in *FinalizeUploadRequest, opts ...grpc.CallOption) (*FinalizeUploadResponse, error)
 	ReplaceData(ctx context.Context, in *ReplaceDataRequest, opts ...grpc.CallOption) (*ReplaceDataResponse, error)
+	VerifyData(ctx context.Context, in *VerifyDataRequest, opts ...grpc.CallOption) (*VerifyDataResponse, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (UserService_GetClient, error)
+	GetCar(ctx context.Context, in *GetCarRequest, opts ...grpc.CallOption) (UserService_GetCarClient, error)
 	WatchLogs(ctx context.Context, in *WatchLogsRequest, opts ...grpc.CallOption) (UserService_WatchLogsClient, error)
 	CidSummary(ctx context.Context, in *CidSummaryRequest, opts ...grpc.CallOption) (*CidSummaryResponse, error)
 	CidInfo(ctx context.Context, in *CidInfoRequest, opts ...grpc.CallOption) (*CidInfoResponse, error)
+	// Names
+	SetName(ctx context.Context, in *SetNameRequest, opts ...grpc.CallOption) (*SetNameResponse, error)
+	GetName(ctx context.Context, in *GetNameRequest, opts ...grpc.CallOption) (*GetNameResponse, error)
+	ListNames(ctx context.Context, in *ListNamesRequest, opts ...grpc.CallOption) (*ListNamesResponse, error)
+	RemoveName(ctx context.Context, in *RemoveNameRequest, opts ...grpc.CallOption) (*RemoveNameResponse, error)
 	// Wallet
 	Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
 	NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error)
@@ -43,6 +55,9 @@ type UserServiceClient interface {
 	// Storage Info
 	StorageInfo(ctx context.Context, in *StorageInfoRequest, opts ...grpc.CallOption) (*StorageInfoResponse, error)
 	ListStorageInfo(ctx context.Context, in *ListStorageInfoRequest, opts ...grpc.CallOption) (*ListStorageInfoResponse, error)
+	ExpirationForecast(ctx context.Context, in *ExpirationForecastRequest, opts ...grpc.CallOption) (*ExpirationForecastResponse, error)
+	AuditReports(ctx context.Context, in *AuditReportsRequest, opts ...grpc.CallOption) (*AuditReportsResponse, error)
+	Usage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*UsageResponse, error)
 	// Storage Jobs
 	StorageJob(ctx context.Context, in *StorageJobRequest, opts ...grpc.CallOption) (*StorageJobResponse, error)
 	StorageConfigForJob(ctx context.Context, in *StorageConfigForJobRequest, opts ...grpc.CallOption) (*StorageConfigForJobResponse, error)
@@ -160,6 +175,76 @@ func (c *userServiceClient) StageCid(ctx context.Context, in *StageCidRequest, o
 	return out, nil
 }
 
+func (c *userServiceClient) StageCar(ctx context.Context, opts ...grpc.CallOption) (UserService_StageCarClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[1], "/powergate.user.v1.UserService/StageCar", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceStageCarClient{stream}
+	return x, nil
+}
+
+type UserService_StageCarClient interface {
+	Send(*StageCarRequest) error
+	CloseAndRecv() (*StageCarResponse, error)
+	grpc.ClientStream
+}
+
+type userServiceStageCarClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceStageCarClient) Send(m *StageCarRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *userServiceStageCarClient) CloseAndRecv() (*StageCarResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(StageCarResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *userServiceClient) CreateUpload(ctx context.Context, in *CreateUploadRequest, opts ...grpc.CallOption) (*CreateUploadResponse, error) {
+	out := new(CreateUploadResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/CreateUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UploadChunk(ctx context.Context, in *UploadChunkRequest, opts ...grpc.CallOption) (*UploadChunkResponse, error) {
+	out := new(UploadChunkResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/UploadChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UploadStatus(ctx context.Context, in *UploadStatusRequest, opts ...grpc.CallOption) (*UploadStatusResponse, error) {
+	out := new(UploadStatusResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/UploadStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) FinalizeUpload(ctx context.Context, in *FinalizeUploadRequest, opts ...grpc.CallOption) (*FinalizeUploadResponse, error) {
+	out := new(FinalizeUploadResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/FinalizeUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ReplaceData(ctx context.Context, in *ReplaceDataRequest, opts ...grpc.CallOption) (*ReplaceDataResponse, error) {
 	out := new(ReplaceDataResponse)
 	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/ReplaceData", in, out, opts...)
@@ -169,8 +254,17 @@ func (c *userServiceClient) ReplaceData(ctx context.Context, in *ReplaceDataRequ
 	return out, nil
 }
 
+func (c *userServiceClient) VerifyData(ctx context.Context, in *VerifyDataRequest, opts ...grpc.CallOption) (*VerifyDataResponse, error) {
+	out := new(VerifyDataResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/VerifyData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (UserService_GetClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[1], "/powergate.user.v1.UserService/Get", opts...)
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[2], "/powergate.user.v1.UserService/Get", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -201,8 +295,40 @@ func (x *userServiceGetClient) Recv() (*GetResponse, error) {
 	return m, nil
 }
 
+func (c *userServiceClient) GetCar(ctx context.Context, in *GetCarRequest, opts ...grpc.CallOption) (UserService_GetCarClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[3], "/powergate.user.v1.UserService/GetCar", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &userServiceGetCarClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type UserService_GetCarClient interface {
+	Recv() (*GetCarResponse, error)
+	grpc.ClientStream
+}
+
+type userServiceGetCarClient struct {
+	grpc.ClientStream
+}
+
+func (x *userServiceGetCarClient) Recv() (*GetCarResponse, error) {
+	m := new(GetCarResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *userServiceClient) WatchLogs(ctx context.Context, in *WatchLogsRequest, opts ...grpc.CallOption) (UserService_WatchLogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[2], "/powergate.user.v1.UserService/WatchLogs", opts...)
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[4], "/powergate.user.v1.UserService/WatchLogs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -251,6 +377,42 @@ func (c *userServiceClient) CidInfo(ctx context.Context, in *CidInfoRequest, opt
 	return out, nil
 }
 
+func (c *userServiceClient) SetName(ctx context.Context, in *SetNameRequest, opts ...grpc.CallOption) (*SetNameResponse, error) {
+	out := new(SetNameResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/SetName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetName(ctx context.Context, in *GetNameRequest, opts ...grpc.CallOption) (*GetNameResponse, error) {
+	out := new(GetNameResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/GetName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListNames(ctx context.Context, in *ListNamesRequest, opts ...grpc.CallOption) (*ListNamesResponse, error) {
+	out := new(ListNamesResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/ListNames", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveName(ctx context.Context, in *RemoveNameRequest, opts ...grpc.CallOption) (*RemoveNameResponse, error) {
+	out := new(RemoveNameResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/RemoveName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error) {
 	out := new(BalanceResponse)
 	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/Balance", in, out, opts...)
@@ -323,6 +485,33 @@ func (c *userServiceClient) ListStorageInfo(ctx context.Context, in *ListStorage
 	return out, nil
 }
 
+func (c *userServiceClient) ExpirationForecast(ctx context.Context, in *ExpirationForecastRequest, opts ...grpc.CallOption) (*ExpirationForecastResponse, error) {
+	out := new(ExpirationForecastResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/ExpirationForecast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AuditReports(ctx context.Context, in *AuditReportsRequest, opts ...grpc.CallOption) (*AuditReportsResponse, error) {
+	out := new(AuditReportsResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/AuditReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Usage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*UsageResponse, error) {
+	out := new(UsageResponse)
+	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/Usage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) StorageJob(ctx context.Context, in *StorageJobRequest, opts ...grpc.CallOption) (*StorageJobResponse, error) {
 	out := new(StorageJobResponse)
 	err := c.cc.Invoke(ctx, "/powergate.user.v1.UserService/StorageJob", in, out, opts...)
@@ -360,7 +549,7 @@ func (c *userServiceClient) StorageJobsSummary(ctx context.Context, in *StorageJ
 }
 
 func (c *userServiceClient) WatchStorageJobs(ctx context.Context, in *WatchStorageJobsRequest, opts ...grpc.CallOption) (UserService_WatchStorageJobsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[3], "/powergate.user.v1.UserService/WatchStorageJobs", opts...)
+	stream, err := c.cc.NewStream(ctx, &_UserService_serviceDesc.Streams[5], "/powergate.user.v1.UserService/WatchStorageJobs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -433,11 +622,23 @@ type UserServiceServer interface {
 	// Data
 	Stage(UserService_StageServer) error
 	StageCid(context.Context, *StageCidRequest) (*StageCidResponse, error)
+	StageCar(UserService_StageCarServer) error
+	CreateUpload(context.Context, *CreateUploadRequest) (*CreateUploadResponse, error)
+	UploadChunk(context.Context, *UploadChunkRequest) (*UploadChunkResponse, error)
+	UploadStatus(context.Context, *UploadStatusRequest) (*UploadStatusResponse, error)
+	FinalizeUpload(context.Context, *FinalizeUploadRequest) (*FinalizeUploadResponse, error)
 	ReplaceData(context.Context, *ReplaceDataRequest) (*ReplaceDataResponse, error)
+	VerifyData(context.Context, *VerifyDataRequest) (*VerifyDataResponse, error)
 	Get(*GetRequest, UserService_GetServer) error
+	GetCar(*GetCarRequest, UserService_GetCarServer) error
 	WatchLogs(*WatchLogsRequest, UserService_WatchLogsServer) error
 	CidSummary(context.Context, *CidSummaryRequest) (*CidSummaryResponse, error)
 	CidInfo(context.Context, *CidInfoRequest) (*CidInfoResponse, error)
+	// Names
+	SetName(context.Context, *SetNameRequest) (*SetNameResponse, error)
+	GetName(context.Context, *GetNameRequest) (*GetNameResponse, error)
+	ListNames(context.Context, *ListNamesRequest) (*ListNamesResponse, error)
+	RemoveName(context.Context, *RemoveNameRequest) (*RemoveNameResponse, error)
 	// Wallet
 	Balance(context.Context, *BalanceRequest) (*BalanceResponse, error)
 	NewAddress(context.Context, *NewAddressRequest) (*NewAddressResponse, error)
@@ -448,6 +649,9 @@ type UserServiceServer interface {
 	// Storage Info
 	StorageInfo(context.Context, *StorageInfoRequest) (*StorageInfoResponse, error)
 	ListStorageInfo(context.Context, *ListStorageInfoRequest) (*ListStorageInfoResponse, error)
+	ExpirationForecast(context.Context, *ExpirationForecastRequest) (*ExpirationForecastResponse, error)
+	AuditReports(context.Context, *AuditReportsRequest) (*AuditReportsResponse, error)
+	Usage(context.Context, *UsageRequest) (*UsageResponse, error)
 	// Storage Jobs
 	StorageJob(context.Context, *StorageJobRequest) (*StorageJobResponse, error)
 	StorageConfigForJob(context.Context, *StorageConfigForJobRequest) (*StorageConfigForJobResponse, error)
@@ -489,12 +693,33 @@ func (UnimplementedUserServiceServer) Stage(UserService_StageServer) error {
 func (UnimplementedUserServiceServer) StageCid(context.Context, *StageCidRequest) (*StageCidResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StageCid not implemented")
 }
+func (UnimplementedUserServiceServer) StageCar(UserService_StageCarServer) error {
+	return status.Errorf(codes.Unimplemented, "method StageCar not implemented")
+}
+func (UnimplementedUserServiceServer) CreateUpload(context.Context, *CreateUploadRequest) (*CreateUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUpload not implemented")
+}
+func (UnimplementedUserServiceServer) UploadChunk(context.Context, *UploadChunkRequest) (*UploadChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadChunk not implemented")
+}
+func (UnimplementedUserServiceServer) UploadStatus(context.Context, *UploadStatusRequest) (*UploadStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadStatus not implemented")
+}
+func (UnimplementedUserServiceServer) FinalizeUpload(context.Context, *FinalizeUploadRequest) (*FinalizeUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeUpload not implemented")
+}
 func (UnimplementedUserServiceServer) ReplaceData(context.Context, *ReplaceDataRequest) (*ReplaceDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReplaceData not implemented")
 }
+func (UnimplementedUserServiceServer) VerifyData(context.Context, *VerifyDataRequest) (*VerifyDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyData not implemented")
+}
 func (UnimplementedUserServiceServer) Get(*GetRequest, UserService_GetServer) error {
 	return status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedUserServiceServer) GetCar(*GetCarRequest, UserService_GetCarServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetCar not implemented")
+}
 func (UnimplementedUserServiceServer) WatchLogs(*WatchLogsRequest, UserService_WatchLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchLogs not implemented")
 }
@@ -504,6 +729,18 @@ func (UnimplementedUserServiceServer) CidSummary(context.Context, *CidSummaryReq
 func (UnimplementedUserServiceServer) CidInfo(context.Context, *CidInfoRequest) (*CidInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CidInfo not implemented")
 }
+func (UnimplementedUserServiceServer) SetName(context.Context, *SetNameRequest) (*SetNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetName not implemented")
+}
+func (UnimplementedUserServiceServer) GetName(context.Context, *GetNameRequest) (*GetNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetName not implemented")
+}
+func (UnimplementedUserServiceServer) ListNames(context.Context, *ListNamesRequest) (*ListNamesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNames not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveName(context.Context, *RemoveNameRequest) (*RemoveNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveName not implemented")
+}
 func (UnimplementedUserServiceServer) Balance(context.Context, *BalanceRequest) (*BalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
 }
@@ -528,6 +765,15 @@ func (UnimplementedUserServiceServer) StorageInfo(context.Context, *StorageInfoR
 func (UnimplementedUserServiceServer) ListStorageInfo(context.Context, *ListStorageInfoRequest) (*ListStorageInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListStorageInfo not implemented")
 }
+func (UnimplementedUserServiceServer) ExpirationForecast(context.Context, *ExpirationForecastRequest) (*ExpirationForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpirationForecast not implemented")
+}
+func (UnimplementedUserServiceServer) AuditReports(context.Context, *AuditReportsRequest) (*AuditReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditReports not implemented")
+}
+func (UnimplementedUserServiceServer) Usage(context.Context, *UsageRequest) (*UsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Usage not implemented")
+}
 func (UnimplementedUserServiceServer) StorageJob(context.Context, *StorageJobRequest) (*StorageJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageJob not implemented")
 }
@@ -717,6 +963,104 @@ func _UserService_StageCid_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_StageCar_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(UserServiceServer).StageCar(&userServiceStageCarServer{stream})
+}
+
+type UserService_StageCarServer interface {
+	SendAndClose(*StageCarResponse) error
+	Recv() (*StageCarRequest, error)
+	grpc.ServerStream
+}
+
+type userServiceStageCarServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceStageCarServer) SendAndClose(m *StageCarResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *userServiceStageCarServer) Recv() (*StageCarRequest, error) {
+	m := new(StageCarRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _UserService_CreateUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/CreateUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUpload(ctx, req.(*CreateUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UploadChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UploadChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/UploadChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UploadChunk(ctx, req.(*UploadChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UploadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UploadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/UploadStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UploadStatus(ctx, req.(*UploadStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_FinalizeUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).FinalizeUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/FinalizeUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).FinalizeUpload(ctx, req.(*FinalizeUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ReplaceData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReplaceDataRequest)
 	if err := dec(in); err != nil {
@@ -735,6 +1079,24 @@ func _UserService_ReplaceData_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_VerifyData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/VerifyData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyData(ctx, req.(*VerifyDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_Get_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -756,6 +1118,27 @@ func (x *userServiceGetServer) Send(m *GetResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _UserService_GetCar_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCarRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UserServiceServer).GetCar(m, &userServiceGetCarServer{stream})
+}
+
+type UserService_GetCarServer interface {
+	Send(*GetCarResponse) error
+	grpc.ServerStream
+}
+
+type userServiceGetCarServer struct {
+	grpc.ServerStream
+}
+
+func (x *userServiceGetCarServer) Send(m *GetCarResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _UserService_WatchLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(WatchLogsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -813,6 +1196,78 @@ func _UserService_CidInfo_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_SetName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/SetName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetName(ctx, req.(*SetNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/GetName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetName(ctx, req.(*GetNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/ListNames",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListNames(ctx, req.(*ListNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/RemoveName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveName(ctx, req.(*RemoveNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BalanceRequest)
 	if err := dec(in); err != nil {
@@ -957,6 +1412,60 @@ func _UserService_ListStorageInfo_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ExpirationForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpirationForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExpirationForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/ExpirationForecast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExpirationForecast(ctx, req.(*ExpirationForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AuditReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AuditReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/AuditReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AuditReports(ctx, req.(*AuditReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Usage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Usage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/powergate.user.v1.UserService/Usage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Usage(ctx, req.(*UsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_StorageJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StorageJobRequest)
 	if err := dec(in); err != nil {
@@ -1136,10 +1645,30 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StageCid",
 			Handler:    _UserService_StageCid_Handler,
 		},
+		{
+			MethodName: "CreateUpload",
+			Handler:    _UserService_CreateUpload_Handler,
+		},
+		{
+			MethodName: "UploadChunk",
+			Handler:    _UserService_UploadChunk_Handler,
+		},
+		{
+			MethodName: "UploadStatus",
+			Handler:    _UserService_UploadStatus_Handler,
+		},
+		{
+			MethodName: "FinalizeUpload",
+			Handler:    _UserService_FinalizeUpload_Handler,
+		},
 		{
 			MethodName: "ReplaceData",
 			Handler:    _UserService_ReplaceData_Handler,
 		},
+		{
+			MethodName: "VerifyData",
+			Handler:    _UserService_VerifyData_Handler,
+		},
 		{
 			MethodName: "CidSummary",
 			Handler:    _UserService_CidSummary_Handler,
@@ -1148,6 +1677,22 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CidInfo",
 			Handler:    _UserService_CidInfo_Handler,
 		},
+		{
+			MethodName: "SetName",
+			Handler:    _UserService_SetName_Handler,
+		},
+		{
+			MethodName: "GetName",
+			Handler:    _UserService_GetName_Handler,
+		},
+		{
+			MethodName: "ListNames",
+			Handler:    _UserService_ListNames_Handler,
+		},
+		{
+			MethodName: "RemoveName",
+			Handler:    _UserService_RemoveName_Handler,
+		},
 		{
 			MethodName: "Balance",
 			Handler:    _UserService_Balance_Handler,
@@ -1180,6 +1725,18 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListStorageInfo",
 			Handler:    _UserService_ListStorageInfo_Handler,
 		},
+		{
+			MethodName: "ExpirationForecast",
+			Handler:    _UserService_ExpirationForecast_Handler,
+		},
+		{
+			MethodName: "AuditReports",
+			Handler:    _UserService_AuditReports_Handler,
+		},
+		{
+			MethodName: "Usage",
+			Handler:    _UserService_Usage_Handler,
+		},
 		{
 			MethodName: "StorageJob",
 			Handler:    _UserService_StorageJob_Handler,
@@ -1215,11 +1772,21 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _UserService_Stage_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "StageCar",
+			Handler:       _UserService_StageCar_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "Get",
 			Handler:       _UserService_Get_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetCar",
+			Handler:       _UserService_GetCar_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "WatchLogs",
 			Handler:       _UserService_WatchLogs_Handler,
@@ -0,0 +1,37 @@
+package admin
+
+import (
+	"bufio"
+
+	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
+	"github.com/textileio/powergate/v2/backup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Backup streams a consistent backup archive of the complete datastore
+// while the daemon keeps running. The archive can be restored with powbackup.
+func (a *Service) Backup(req *adminPb.BackupRequest, srv adminPb.AdminService_BackupServer) error {
+	w := bufio.NewWriterSize(&backupWriter{srv: srv}, exportChunkSize)
+	if _, err := backup.Export(srv.Context(), a.ds, w); err != nil {
+		return status.Errorf(codes.Internal, "exporting datastore: %s", err)
+	}
+	if err := w.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "sending backup: %s", err)
+	}
+	return nil
+}
+
+type backupWriter struct {
+	srv adminPb.AdminService_BackupServer
+}
+
+func (w *backupWriter) Write(p []byte) (int, error) {
+	// p is reused by the caller, so the sent data must be copied.
+	data := make([]byte, len(p))
+	copy(data, p)
+	if err := w.srv.Send(&adminPb.BackupResponse{Data: data}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -2,8 +2,11 @@ package admin
 
 import (
 	"context"
+	"time"
 
+	"github.com/ipfs/go-cid"
 	adminProto "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
+	"github.com/textileio/powergate/v2/ffs"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -37,21 +40,67 @@ func (a *Service) PinnedCids(ctx context.Context, req *adminProto.PinnedCidsRequ
 	}
 
 	for i, pc := range pcids {
-		hspc := &adminProto.HSPinnedCid{
-			Cid:   pc.Cid.String(),
-			Users: make([]*adminProto.HSPinnedCidUser, len(pc.APIIDs)),
+		res.Cids[i] = toRPCHSPinnedCid(pc)
+	}
+
+	return res, nil
+}
+
+// ReconcileHotStorage reconciles the Hot-Storage pinset with the pins of the IPFS node.
+func (a *Service) ReconcileHotStorage(ctx context.Context, req *adminProto.ReconcileHotStorageRequest) (*adminProto.ReconcileHotStorageResponse, error) {
+	if req.RepinTimeout < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "repin timeout can't be negative")
+	}
+	opts := ffs.HotReconcileOptions{
+		DryRun:       req.DryRun,
+		RepinTimeout: time.Duration(req.RepinTimeout) * time.Second,
+		UnpinUnknown: req.UnpinUnknown,
+	}
+	r, err := a.s.ReconcileHotStorage(ctx, opts)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "reconciling hot-storage: %v", err)
+	}
+
+	res := &adminProto.ReconcileHotStorageResponse{
+		MissingCids:  cidsToStrings(r.Missing),
+		RepinnedCids: cidsToStrings(r.Repinned),
+		LostCids:     make([]*adminProto.HSPinnedCid, len(r.Lost)),
+		UnknownCids:  cidsToStrings(r.Unknown),
+		UnpinnedCids: cidsToStrings(r.Unpinned),
+		Unfreezes:    make([]*adminProto.HSUnfreeze, len(r.Unfreezes)),
+	}
+	for i, pc := range r.Lost {
+		res.LostCids[i] = toRPCHSPinnedCid(pc)
+	}
+	for i, u := range r.Unfreezes {
+		res.Unfreezes[i] = &adminProto.HSUnfreeze{
+			UserId: u.APIID.String(),
+			Cid:    u.Cid.String(),
+			JobId:  u.JobID.String(),
 		}
+	}
+	return res, nil
+}
 
-		for j, up := range pc.APIIDs {
-			upr := &adminProto.HSPinnedCidUser{
-				UserId:    up.ID.String(),
-				Staged:    up.Staged,
-				CreatedAt: up.CreatedAt,
-			}
-			hspc.Users[j] = upr
+func toRPCHSPinnedCid(pc ffs.PinnedCid) *adminProto.HSPinnedCid {
+	hspc := &adminProto.HSPinnedCid{
+		Cid:   pc.Cid.String(),
+		Users: make([]*adminProto.HSPinnedCidUser, len(pc.APIIDs)),
+	}
+	for j, up := range pc.APIIDs {
+		hspc.Users[j] = &adminProto.HSPinnedCidUser{
+			UserId:    up.ID.String(),
+			Staged:    up.Staged,
+			CreatedAt: up.CreatedAt,
 		}
-		res.Cids[i] = hspc
 	}
+	return hspc
+}
 
-	return res, nil
+func cidsToStrings(cids []cid.Cid) []string {
+	res := make([]string, len(cids))
+	for i := range cids {
+		res[i] = cids[i].String()
+	}
+	return res
 }
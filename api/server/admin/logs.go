@@ -0,0 +1,86 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportChunkSize is the approximate size of each streamed chunk of NDJSON.
+const exportChunkSize = 64 << 10
+
+// exportedLogEntry is the NDJSON representation of an exported job log entry.
+type exportedLogEntry struct {
+	UserID      string            `json:"user_id"`
+	Cid         string            `json:"cid,omitempty"`
+	RetrievalID string            `json:"retrieval_id,omitempty"`
+	JobID       string            `json:"job_id,omitempty"`
+	Time        time.Time         `json:"time"`
+	Level       string            `json:"level"`
+	EventType   string            `json:"event_type"`
+	Message     string            `json:"message"`
+	Fields      map[string]string `json:"fields,omitempty"`
+}
+
+// ExportLogs streams job log entries of a user, or all users, in a time range as
+// newline-delimited JSON.
+func (a *Service) ExportLogs(req *adminPb.ExportLogsRequest, srv adminPb.AdminService_ExportLogsServer) error {
+	var from, to time.Time
+	if req.From != nil {
+		from = req.From.AsTime()
+	}
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return status.Errorf(codes.InvalidArgument, "to can't be before from")
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	flush := func() error {
+		if buf.Len() == 0 {
+			return nil
+		}
+		// The buffer is reused, so the sent data must be copied.
+		data := make([]byte, buf.Len())
+		copy(data, buf.Bytes())
+		buf.Reset()
+		return srv.Send(&adminPb.ExportLogsResponse{Data: data})
+	}
+	err := a.l.Export(srv.Context(), ffs.APIID(req.UserId), from, to, func(le ffs.LogEntry) error {
+		e := exportedLogEntry{
+			UserID:      le.APIID.String(),
+			RetrievalID: le.RetrievalID.String(),
+			JobID:       le.Jid.String(),
+			Time:        le.Timestamp.UTC(),
+			Level:       ffs.LogLevelStr[le.Level],
+			EventType:   ffs.LogEventTypeStr[le.Type],
+			Message:     le.Msg,
+			Fields:      le.Fields,
+		}
+		if le.Cid.Defined() {
+			e.Cid = util.CidToString(le.Cid)
+		}
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+		if buf.Len() >= exportChunkSize {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "exporting logs: %s", err)
+	}
+	if err := flush(); err != nil {
+		return status.Errorf(codes.Internal, "sending logs: %s", err)
+	}
+	return nil
+}
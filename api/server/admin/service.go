@@ -1,8 +1,10 @@
 package admin
 
 import (
+	"github.com/ipfs/go-datastore"
 	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
 	dealsModule "github.com/textileio/powergate/v2/deals/module"
+	"github.com/textileio/powergate/v2/ffs/joblogger"
 	"github.com/textileio/powergate/v2/ffs/manager"
 	"github.com/textileio/powergate/v2/ffs/scheduler"
 	askIndex "github.com/textileio/powergate/v2/index/ask/runner"
@@ -13,8 +15,10 @@ import (
 // Service implements the Admin API.
 type Service struct {
 	adminPb.UnimplementedAdminServiceServer
+	ds datastore.TxnDatastore
 	m  *manager.Manager
 	s  *scheduler.Scheduler
+	l  *joblogger.Logger
 	wm wallet.Module
 	dm *dealsModule.Module
 	mi *minerIndex.Index
@@ -22,10 +26,12 @@ type Service struct {
 }
 
 // New creates a new AdminService.
-func New(m *manager.Manager, s *scheduler.Scheduler, wm wallet.Module, dm *dealsModule.Module, mi *minerIndex.Index, ai *askIndex.Runner) *Service {
+func New(ds datastore.TxnDatastore, m *manager.Manager, s *scheduler.Scheduler, l *joblogger.Logger, wm wallet.Module, dm *dealsModule.Module, mi *minerIndex.Index, ai *askIndex.Runner) *Service {
 	return &Service{
+		ds: ds,
 		m:  m,
 		s:  s,
+		l:  l,
 		wm: wm,
 		dm: dm,
 		mi: mi,
@@ -0,0 +1,117 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/ipfs/go-cid"
+	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/api"
+	"github.com/textileio/powergate/v2/ffs/cfgtransform"
+	"github.com/textileio/powergate/v2/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BulkUpdateStorageConfigs applies a declarative transformation to all selected storage configs.
+// Updated storage configs are used by repair and renewal evaluations, and can optionally be
+// re-pushed as new Jobs. Failing updates are reported per storage config, or per user
+// if its storage configs can't be read.
+func (a *Service) BulkUpdateStorageConfigs(ctx context.Context, req *adminPb.BulkUpdateStorageConfigsRequest) (*adminPb.BulkUpdateStorageConfigsResponse, error) {
+	t, err := cfgtransform.Compile(cfgtransform.Spec{
+		UserIDs: req.UserIds,
+		Cids:    req.Cids,
+		Where:   req.Where,
+		Set:     req.Set,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "parsing transformation: %v", err)
+	}
+
+	// Users are validated before updating any storage config, so an unknown
+	// user doesn't leave the transformation partially applied.
+	entries, err := a.m.List()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "listing users: %v", err)
+	}
+	users := make(map[ffs.APIID]struct{}, len(entries))
+	for _, e := range entries {
+		users[e.APIID] = struct{}{}
+	}
+	iids := make([]ffs.APIID, len(req.UserIds))
+	for i, id := range req.UserIds {
+		iids[i] = ffs.APIID(id)
+		if _, ok := users[iids[i]]; !ok {
+			return nil, status.Errorf(codes.NotFound, "user %s not found", id)
+		}
+	}
+	if len(iids) == 0 {
+		for _, e := range entries {
+			iids = append(iids, e.APIID)
+		}
+	}
+
+	var updates []*adminPb.StorageConfigUpdate
+	for _, iid := range iids {
+		// Storage configs are read without loading instances, which
+		// are only loaded for users with updated storage configs.
+		cfgs, err := a.m.StorageConfigs(iid)
+		if err != nil {
+			updates = append(updates, &adminPb.StorageConfigUpdate{
+				UserId: iid.String(),
+				Error:  fmt.Sprintf("getting storage configs: %s", err),
+			})
+			continue
+		}
+		var i *api.API
+		cids := make([]cid.Cid, 0, len(cfgs))
+		for c := range cfgs {
+			if t.Selects(iid, c) {
+				cids = append(cids, c)
+			}
+		}
+		sort.Slice(cids, func(a, b int) bool {
+			return cids[a].String() < cids[b].String()
+		})
+		for _, c := range cids {
+			cfg := cfgs[c]
+			modified, err := t.Apply(&cfg)
+			if !modified && err == nil {
+				continue
+			}
+			update := &adminPb.StorageConfigUpdate{
+				UserId: iid.String(),
+				Cid:    util.CidToString(c),
+			}
+			updates = append(updates, update)
+			if err != nil {
+				update.Error = err.Error()
+				continue
+			}
+			if req.DryRun {
+				continue
+			}
+			if i == nil {
+				if i, err = a.m.GetByAPIID(iid); err != nil {
+					update.Error = fmt.Sprintf("getting user: %s", err)
+					continue
+				}
+			}
+			if req.Repush {
+				jid, err := i.PushStorageConfig(c, api.WithStorageConfig(cfg), api.WithOverride(true))
+				if err != nil {
+					update.Error = err.Error()
+					continue
+				}
+				update.JobId = jid.String()
+				continue
+			}
+			if err := i.UpdateStorageConfig(c, cfg); err != nil {
+				update.Error = err.Error()
+			}
+		}
+	}
+	return &adminPb.BulkUpdateStorageConfigsResponse{Updates: updates}, nil
+}
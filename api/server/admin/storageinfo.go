@@ -12,6 +12,7 @@ import (
 	"github.com/textileio/powergate/v2/util"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // StorageInfo returns the information about a stored Cid. If no information is available,
@@ -57,3 +58,94 @@ func (a *Service) ListStorageInfo(ctx context.Context, req *adminPb.ListStorageI
 	}
 	return &adminPb.ListStorageInfoResponse{StorageInfo: res}, nil
 }
+
+// ExpirationForecast returns the stored cids having deals expiring within the requested epochs,
+// filtered by user ids if provided.
+func (a *Service) ExpirationForecast(ctx context.Context, req *adminPb.ExpirationForecastRequest) (*adminPb.ExpirationForecastResponse, error) {
+	if req.WithinEpochs == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "within epochs must be greater than zero")
+	}
+	iids := make([]ffs.APIID, len(req.UserIds))
+	for i, id := range req.UserIds {
+		iids[i] = ffs.APIID(id)
+	}
+	forecasts, height, err := a.s.ForecastExpirations(ctx, iids, req.WithinEpochs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "forecasting expirations: %v", err)
+	}
+	return &adminPb.ExpirationForecastResponse{Height: height, Forecasts: su.ToRPCExpirationForecasts(forecasts)}, nil
+}
+
+// AuditReports returns the last audit reports of stored cids, filtered by user ids and cids if provided.
+func (a *Service) AuditReports(ctx context.Context, req *adminPb.AuditReportsRequest) (*adminPb.AuditReportsResponse, error) {
+	iids := make([]ffs.APIID, len(req.UserIds))
+	for i, id := range req.UserIds {
+		iids[i] = ffs.APIID(id)
+	}
+	cids := make([]cid.Cid, len(req.Cids))
+	for i, s := range req.Cids {
+		c, err := util.CidFromString(s)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "parsing cid: %v", err)
+		}
+		cids[i] = c
+	}
+	reports, err := a.s.AuditReports(iids, cids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting audit reports: %v", err)
+	}
+	if req.OnlyUnhealthy {
+		unhealthy := reports[:0]
+		for _, r := range reports {
+			if !r.Healthy() {
+				unhealthy = append(unhealthy, r)
+			}
+		}
+		reports = unhealthy
+	}
+	return &adminPb.AuditReportsResponse{Reports: su.ToRPCAuditReports(reports)}, nil
+}
+
+// AuditSummary returns a health summary of the last audit reports of stored cids,
+// filtered by user ids if provided.
+func (a *Service) AuditSummary(ctx context.Context, req *adminPb.AuditSummaryRequest) (*adminPb.AuditSummaryResponse, error) {
+	iids := make([]ffs.APIID, len(req.UserIds))
+	for i, id := range req.UserIds {
+		iids[i] = ffs.APIID(id)
+	}
+	sum, err := a.s.AuditSummary(iids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting audit summary: %v", err)
+	}
+	res := &adminPb.AuditSummaryResponse{
+		AuditedCids:        int64(sum.AuditedCids),
+		HealthyCids:        int64(sum.HealthyCids),
+		AuditedDeals:       int64(sum.AuditedDeals),
+		ActiveDeals:        int64(sum.ActiveDeals),
+		DealsNotActive:     int64(sum.Discrepancies[ffs.AuditDealNotActive]),
+		PieceCidMismatches: int64(sum.Discrepancies[ffs.AuditPieceCidMismatch]),
+		MinerMismatches:    int64(sum.Discrepancies[ffs.AuditMinerMismatch]),
+		SectorsNotSealed:   int64(sum.Discrepancies[ffs.AuditSectorNotSealed]),
+	}
+	if !sum.LastAudit.IsZero() {
+		res.LastAudit = timestamppb.New(sum.LastAudit)
+	}
+	return res, nil
+}
+
+// Usage returns the effective usage of users, filtered by user ids if provided.
+func (a *Service) Usage(ctx context.Context, req *adminPb.UsageRequest) (*adminPb.UsageResponse, error) {
+	iids := make([]ffs.APIID, len(req.UserIds))
+	for i, id := range req.UserIds {
+		iids[i] = ffs.APIID(id)
+	}
+	usages, err := a.s.Usage(iids)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting usage: %v", err)
+	}
+	res := make([]*userPb.Usage, len(usages))
+	for i, u := range usages {
+		res[i] = su.ToRPCUsage(u)
+	}
+	return &adminPb.UsageResponse{Usages: res}, nil
+}
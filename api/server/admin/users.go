@@ -4,6 +4,9 @@ import (
 	"context"
 
 	adminPb "github.com/textileio/powergate/v2/api/gen/powergate/admin/v1"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/manager"
+	"github.com/textileio/powergate/v2/ffs/minerselector/composite"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -58,3 +61,21 @@ func (a *Service) Users(ctx context.Context, req *adminPb.UsersRequest) (*adminP
 		Users: ins,
 	}, nil
 }
+
+// SetUserMinerSelectorParams sets the composite miner selector configuration
+// override of a user. Empty params remove the override.
+func (a *Service) SetUserMinerSelectorParams(ctx context.Context, req *adminPb.SetUserMinerSelectorParamsRequest) (*adminPb.SetUserMinerSelectorParamsResponse, error) {
+	if req.Params != "" {
+		if _, err := composite.ParseConfig(req.Params); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "parsing miner selector params: %v", err)
+		}
+	}
+	err := a.m.SetMinerSelectorParams(ffs.APIID(req.UserId), req.Params)
+	if err == manager.ErrAPIIDNotFound {
+		return nil, status.Errorf(codes.NotFound, "getting user: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "setting miner selector params: %v", err)
+	}
+	return &adminPb.SetUserMinerSelectorParamsResponse{}, nil
+}
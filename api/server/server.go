@@ -11,7 +11,9 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/filecoin-project/go-address"
@@ -38,10 +40,15 @@ import (
 	"github.com/textileio/powergate/v2/ffs/coreipfs"
 	"github.com/textileio/powergate/v2/ffs/filcold"
 	"github.com/textileio/powergate/v2/ffs/joblogger"
+	"github.com/textileio/powergate/v2/ffs/keystore"
 	"github.com/textileio/powergate/v2/ffs/manager"
+	"github.com/textileio/powergate/v2/ffs/minerselector/composite"
+	"github.com/textileio/powergate/v2/ffs/minerselector/diversity"
+	"github.com/textileio/powergate/v2/ffs/minerselector/fixed"
 	"github.com/textileio/powergate/v2/ffs/minerselector/reptop"
 	"github.com/textileio/powergate/v2/ffs/minerselector/sr2"
 	"github.com/textileio/powergate/v2/ffs/scheduler"
+	"github.com/textileio/powergate/v2/ffs/uploads"
 	"github.com/textileio/powergate/v2/filchain"
 	"github.com/textileio/powergate/v2/gateway"
 	ask "github.com/textileio/powergate/v2/index/ask/runner"
@@ -50,6 +57,7 @@ import (
 	"github.com/textileio/powergate/v2/iplocation/maxmind"
 	"github.com/textileio/powergate/v2/lotus"
 	"github.com/textileio/powergate/v2/migration"
+	"github.com/textileio/powergate/v2/pgds"
 	"github.com/textileio/powergate/v2/reputation"
 	txndstr "github.com/textileio/powergate/v2/txndstransform"
 	"github.com/textileio/powergate/v2/util"
@@ -77,6 +85,7 @@ var (
 		3: migration.V3StorageJobsIndexMigration,
 		4: migration.V4RecordsMigration,
 		5: migration.V5DeleteOldMinerIndex,
+		6: migration.V6StorageDealRecordsIndexes,
 	}
 )
 
@@ -94,8 +103,9 @@ type Server struct {
 
 	ffsManager *manager.Manager
 	sched      *scheduler.Scheduler
-	hs         ffs.HotStorage
+	hs         *coreipfs.CoreIpfs
 	l          *joblogger.Logger
+	uploads    *uploads.Store
 
 	grpcServer *grpc.Server
 
@@ -112,6 +122,9 @@ type Config struct {
 	Devnet          bool
 	IpfsAPIAddr     ma.Multiaddr
 
+	IpfsExtraAPIAddrs        []ma.Multiaddr
+	IpfsHealthCheckFrequency time.Duration
+
 	LotusAddress           ma.Multiaddr
 	LotusAuthToken         string
 	LotusMasterAddr        string
@@ -129,6 +142,8 @@ type Config struct {
 	MongoURI string
 	MongoDB  string
 
+	PostgresURI string
+
 	FFSAdminToken                string
 	FFSUseMasterAddr             bool
 	FFSDealFinalityTimeout       time.Duration
@@ -137,6 +152,23 @@ type Config struct {
 	FFSMaxParallelDealPreparing  int
 	FFSGCAutomaticGCInterval     time.Duration
 	FFSGCStageGracePeriod        time.Duration
+	FFSJobLogsMaxAge             time.Duration
+	FFSJobLogsMaxPerCid          int
+	FFSJobLogsCompactionInterval time.Duration
+	FFSExpiryAlertWindow         uint64
+	FFSExpiryAlertFrequency      time.Duration
+	FFSAuditFrequency            time.Duration
+	FFSHotCacheCapacity          int64
+	FFSKeyStorePath              string
+	FFSHotCacheFrequency         time.Duration
+	FFSHotReconcileFrequency     time.Duration
+	FFSHotReconcileRepinTimeout  time.Duration
+	FFSHotReconcileUnpinUnknown  bool
+	FFSDedupAttachDeals          bool
+	FFSMetricsFrequency          time.Duration
+	FFSMetricsMaxUsers           int
+	FFSMetricsExpiringWindow     uint64
+	FFSUploadsTTL                time.Duration
 	SchedMaxParallel             int
 	MinerSelector                string
 	MinerSelectorParams          string
@@ -169,6 +201,10 @@ func NewServer(conf Config) (*Server, error) {
 	if err != nil {
 		return nil, fmt.Errorf("creating lotus client builder: %s", err)
 	}
+	retrievalClientBuilder, err := lotus.NewRetrievalBuilder(conf.LotusAddress, conf.LotusAuthToken, conf.LotusConnectionRetries)
+	if err != nil {
+		return nil, fmt.Errorf("creating lotus retrieval client builder: %s", err)
+	}
 	lsm, err := lotus.NewSyncMonitor(clientBuilder)
 	if err != nil {
 		return nil, fmt.Errorf("creating lotus sync monitor: %s", err)
@@ -254,7 +290,7 @@ func NewServer(conf Config) (*Server, error) {
 	}
 
 	log.Info("Starting deals module...")
-	dm, err := dealsModule.New(txndstr.Wrap(ds, "deals"), clientBuilder, conf.DealWatchPollDuration, conf.FFSDealFinalityTimeout, deals.WithImportPath(filepath.Join(conf.RepoPath, "imports")))
+	dm, err := dealsModule.New(txndstr.Wrap(ds, "deals"), clientBuilder, conf.DealWatchPollDuration, conf.FFSDealFinalityTimeout, deals.WithImportPath(filepath.Join(conf.RepoPath, "imports")), deals.WithRetrievalClientBuilder(retrievalClientBuilder))
 	if err != nil {
 		return nil, fmt.Errorf("creating deal module: %s", err)
 	}
@@ -273,36 +309,86 @@ func NewServer(conf Config) (*Server, error) {
 
 	chain := filchain.New(clientBuilder)
 
-	ms, err := getMinerSelector(conf, rm, ai, clientBuilder)
+	// The FFS manager is created after the miner selector, so user
+	// overrides are resolved lazily.
+	var managerRef atomic.Value
+	overrides := func(iid ffs.APIID) (string, error) {
+		m, ok := managerRef.Load().(*manager.Manager)
+		if !ok {
+			return "", nil
+		}
+		return m.MinerSelectorParams(iid)
+	}
+	ms, err := getMinerSelector(conf, rm, ai, mi, clientBuilder, overrides)
 	if err != nil {
 		return nil, fmt.Errorf("creating miner selector: %s", err)
 	}
 
-	l := joblogger.New(txndstr.Wrap(ds, "ffs/joblogger_v2"))
+	l := joblogger.New(
+		txndstr.Wrap(ds, "ffs/joblogger_v2"),
+		joblogger.WithMaxAge(conf.FFSJobLogsMaxAge),
+		joblogger.WithMaxEntriesPerCid(conf.FFSJobLogsMaxPerCid),
+		joblogger.WithCompactionInterval(conf.FFSJobLogsCompactionInterval),
+	)
 	if conf.Devnet {
 		conf.FFSMinimumPieceSize = 0
 	}
 	cs := filcold.New(ms, dm, wm, ipfs, chain, l, lsm, conf.FFSMinimumPieceSize, conf.FFSMaxParallelDealPreparing, conf.FFSRetrievalNextEventTimeout)
-	hs, err := coreipfs.New(txndstr.Wrap(ds, "ffs/coreipfs"), ipfs, l)
+	var extraNodes []coreipfs.Node
+	for _, addr := range conf.IpfsExtraAPIAddrs {
+		api, err := httpapi.NewApi(addr)
+		if err != nil {
+			return nil, fmt.Errorf("creating ipfs client for %s: %s", addr, err)
+		}
+		extraNodes = append(extraNodes, coreipfs.Node{Name: addr.String(), API: api})
+	}
+	hs, err := coreipfs.New(txndstr.Wrap(ds, "ffs/coreipfs"), ipfs, l, coreipfs.WithNodes(extraNodes...), coreipfs.WithHealthCheckFrequency(conf.IpfsHealthCheckFrequency))
 	if err != nil {
 		return nil, fmt.Errorf("creating coreipfs: %s", err)
 	}
 
+	up, err := uploads.New(txndstr.Wrap(ds, "ffs/uploads"), filepath.Join(conf.RepoPath, "uploads"), hs, uploads.WithTTL(conf.FFSUploadsTTL))
+	if err != nil {
+		return nil, fmt.Errorf("creating uploads store: %s", err)
+	}
+
+	ksPath := conf.FFSKeyStorePath
+	if ksPath == "" {
+		ksPath = filepath.Join(conf.RepoPath, "keystore")
+	}
+	ks, err := keystore.New(ksPath)
+	if err != nil {
+		return nil, fmt.Errorf("creating keystore: %s", err)
+	}
+
 	log.Info("Starting FFS scheduler...")
 	var sr2rf func() (int, error)
 	if ms, ok := ms.(*sr2.MinerSelector); ok {
 		sr2rf = ms.GetReplicationFactor
 	}
-	gcConfig := scheduler.GCConfig{StageGracePeriod: conf.FFSGCStageGracePeriod, AutoGCInterval: conf.FFSGCAutomaticGCInterval}
-	sched, err := scheduler.New(txndstr.Wrap(ds, "ffs/scheduler"), l, hs, cs, conf.SchedMaxParallel, conf.FFSDealFinalityTimeout, sr2rf, gcConfig)
+	schedConf := scheduler.Config{
+		MaxParallel:         conf.SchedMaxParallel,
+		DealFinalityTimeout: conf.FFSDealFinalityTimeout,
+		SR2RepFactor:        sr2rf,
+		KeyStore:            ks,
+		GC:                  scheduler.GCConfig{StageGracePeriod: conf.FFSGCStageGracePeriod, AutoGCInterval: conf.FFSGCAutomaticGCInterval},
+		ExpiryAlert:         scheduler.ExpiryAlertConfig{Window: conf.FFSExpiryAlertWindow, Frequency: conf.FFSExpiryAlertFrequency},
+		Audit:               scheduler.AuditConfig{Frequency: conf.FFSAuditFrequency},
+		HotCache:            scheduler.HotCacheConfig{Capacity: conf.FFSHotCacheCapacity, Frequency: conf.FFSHotCacheFrequency},
+		HotReconcile:        scheduler.HotReconcileConfig{Frequency: conf.FFSHotReconcileFrequency, RepinTimeout: conf.FFSHotReconcileRepinTimeout, UnpinUnknown: conf.FFSHotReconcileUnpinUnknown},
+		Dedup:               scheduler.DedupConfig{AttachDeals: conf.FFSDedupAttachDeals},
+	}
+	sched, err := scheduler.New(txndstr.Wrap(ds, "ffs/scheduler"), l, hs, cs, schedConf)
 	if err != nil {
 		return nil, fmt.Errorf("creating scheduler: %s", err)
 	}
 
-	ffsManager, err := manager.New(txndstr.Wrap(ds, "ffs/manager"), wm, dm, sched, conf.FFSUseMasterAddr, conf.Devnet)
+	metricsConfig := manager.MetricsConfig{RefreshInterval: conf.FFSMetricsFrequency, MaxUserLabels: conf.FFSMetricsMaxUsers, ExpiringWindow: conf.FFSMetricsExpiringWindow}
+	ffsManager, err := manager.New(txndstr.Wrap(ds, "ffs/manager"), wm, dm, sched, conf.FFSUseMasterAddr, conf.Devnet, metricsConfig)
 	if err != nil {
 		return nil, fmt.Errorf("creating ffs instance: %s", err)
 	}
+	managerRef.Store(ffsManager)
 
 	log.Info("Starting gRPC, gateway and index HTTP servers...")
 
@@ -321,7 +407,7 @@ func NewServer(conf Config) (*Server, error) {
 	}
 	webProxy := createProxyServer(wrappedGRPCServer, httpFFSAuthInterceptor, conf.GrpcWebProxyAddress)
 
-	gateway := gateway.NewGateway(conf.GatewayHostAddr, ai, mi, si, rm)
+	gateway := gateway.NewGateway(conf.GatewayHostAddr, ai, mi, si, rm, ffsManager, wm)
 	gateway.Start(conf.GatewayBasePath)
 
 	s := &Server{
@@ -340,6 +426,7 @@ func NewServer(conf Config) (*Server, error) {
 		sched:      sched,
 		hs:         hs,
 		l:          l,
+		uploads:    up,
 
 		grpcServer: grpcServer,
 		webProxy:   webProxy,
@@ -432,8 +519,8 @@ func wrapGRPCServer(grpcServer *grpc.Server) *grpcweb.WrappedGrpcServer {
 }
 
 func startGRPCServices(server *grpc.Server, webProxy *http.Server, s *Server, hostNetwork string, hostAddress ma.Multiaddr) error {
-	userService := user.New(s.ffsManager, s.wm, s.hs)
-	adminService := admin.New(s.ffsManager, s.sched, s.wm, s.dm, s.mi, s.ai)
+	userService := user.New(s.ffsManager, s.wm, s.hs, s.uploads)
+	adminService := admin.New(s.ds, s.ffsManager, s.sched, s.l, s.wm, s.dm, s.mi, s.ai)
 
 	hostAddr, err := util.TCPAddrFromMultiAddr(hostAddress)
 	if err != nil {
@@ -539,6 +626,12 @@ func (s *Server) Close() {
 	if err := s.sched.Close(); err != nil {
 		log.Errorf("closing ffs scheduler: %s", err)
 	}
+	if err := s.uploads.Close(); err != nil {
+		log.Errorf("closing uploads store: %s", err)
+	}
+	if err := s.hs.Close(); err != nil {
+		log.Errorf("closing hot storage: %s", err)
+	}
 	if err := s.l.Close(); err != nil {
 		log.Errorf("closing joblogger: %s", err)
 	}
@@ -576,7 +669,23 @@ func createDatastore(conf Config, longTimeout bool) (datastore.TxnDatastore, err
 	var ds datastore.TxnDatastore
 	var err error
 
-	if conf.MongoURI != "" {
+	if conf.MongoURI != "" && conf.PostgresURI != "" {
+		return nil, fmt.Errorf("mongo and postgres datastores can't be used at the same time")
+	}
+
+	if conf.PostgresURI != "" {
+		log.Info("Opening Postgres database...")
+		pgCtx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+		var opts []pgds.Option
+		if longTimeout {
+			opts = []pgds.Option{pgds.WithOpTimeout(time.Hour), pgds.WithTxnTimeout(time.Hour)}
+		}
+		ds, err = pgds.New(pgCtx, conf.PostgresURI, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("opening postgres datastore: %s", err)
+		}
+	} else if conf.MongoURI != "" {
 		log.Info("Opening Mongo database...")
 		mongoCtx, cancel := context.WithTimeout(context.Background(), time.Second*10)
 		defer cancel()
@@ -607,21 +716,61 @@ func createDatastore(conf Config, longTimeout bool) (datastore.TxnDatastore, err
 	return measure.New("powergate.datastore", ds), nil
 }
 
-func getMinerSelector(conf Config, rm *reputation.Module, ai *ask.Runner, cb lotus.ClientBuilder) (ffs.MinerSelector, error) {
+func getMinerSelector(conf Config, rm *reputation.Module, ai *ask.Runner, mi *minerIndex.Index, cb lotus.ClientBuilder, overrides composite.Overrides) (ffs.MinerSelector, error) {
 	if conf.Devnet {
-		return reptop.New(cb, rm, ai), nil
+		return diversity.New(reptop.New(cb, rm, ai), mi, cb), nil
 	}
 	var ms ffs.MinerSelector
 	var err error
 
 	switch conf.MinerSelector {
 	case "reputation":
-		ms = reptop.New(cb, rm, ai)
+		ms = diversity.New(reptop.New(cb, rm, ai), mi, cb)
+	case "reputation-cost":
+		minScore, err := parseMinScore(conf.MinerSelectorParams)
+		if err != nil {
+			return nil, fmt.Errorf("parsing reputation-cost miner selector params: %s", err)
+		}
+		ms = diversity.New(reptop.New(cb, rm, ai, reptop.WithCostOptimization(minScore)), mi, cb)
 	case "sr2":
 		ms, err = sr2.New(conf.MinerSelectorParams, cb)
 		if err != nil {
 			return nil, fmt.Errorf("creating sr2 miner selector: %s", err)
 		}
+	case "composite":
+		build := func(typ string, params json.RawMessage) (ffs.MinerSelector, error) {
+			switch typ {
+			case "reputation":
+				return reptop.New(cb, rm, ai), nil
+			case "reputation-cost":
+				var minScore int
+				if len(params) > 0 {
+					if err := json.Unmarshal(params, &minScore); err != nil {
+						return nil, fmt.Errorf("parsing minimum reputation score: %s", err)
+					}
+				}
+				return reptop.New(cb, rm, ai, reptop.WithCostOptimization(minScore)), nil
+			case "sr2":
+				var url string
+				if err := json.Unmarshal(params, &url); err != nil {
+					return nil, fmt.Errorf("parsing sr2 url: %s", err)
+				}
+				return sr2.New(url, cb)
+			case "fixed":
+				var miners []fixed.Miner
+				if err := json.Unmarshal(params, &miners); err != nil {
+					return nil, fmt.Errorf("parsing fixed miners: %s", err)
+				}
+				return fixed.New(miners), nil
+			default:
+				return nil, fmt.Errorf("unknown miner selector type: %s", typ)
+			}
+		}
+		cms, err := composite.New(conf.MinerSelectorParams, build, overrides)
+		if err != nil {
+			return nil, fmt.Errorf("creating composite miner selector: %s", err)
+		}
+		ms = diversity.New(cms, mi, cb)
 	default:
 		return nil, fmt.Errorf("unknown miner selector: %s", conf.MinerSelector)
 	}
@@ -629,6 +778,19 @@ func getMinerSelector(conf Config, rm *reputation.Module, ai *ask.Runner, cb lot
 	return ms, nil
 }
 
+// parseMinScore parses the minimum reputation score of the reputation-cost
+// miner selector. An empty value means no minimum score.
+func parseMinScore(params string) (int, error) {
+	if params == "" {
+		return 0, nil
+	}
+	minScore, err := strconv.Atoi(params)
+	if err != nil {
+		return 0, fmt.Errorf("parsing minimum score: %s", err)
+	}
+	return minScore, nil
+}
+
 func evaluateMasterAddr(conf Config, c *api.FullNodeStruct) (address.Address, error) {
 	var res address.Address
 	if conf.Devnet {
@@ -700,3 +862,58 @@ func runMigrations(conf Config) error {
 
 	return nil
 }
+
+// LatestMigrationVersion returns the datastore version after
+// running all supported migrations.
+func LatestMigrationVersion() int {
+	var max int
+	for v := range Migrations {
+		if v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+// DryRunMigrations runs the migrations needed to upgrade or downgrade the datastore
+// to the provided version in a discarded transaction, and reports the changes.
+func DryRunMigrations(conf Config, version int) ([]migration.DryRunReport, error) {
+	ds, err := createDatastore(conf, true)
+	if err != nil {
+		return nil, fmt.Errorf("creating migration datastore: %s", err)
+	}
+	defer func() {
+		if err := ds.Close(); err != nil {
+			log.Errorf("closing migration datastore: %s", err)
+		}
+	}()
+
+	m := migration.New(ds, Migrations)
+	reports, err := m.DryRun(version)
+	if err != nil {
+		return nil, fmt.Errorf("dry-running migrations: %s", err)
+	}
+	return reports, nil
+}
+
+// RevertMigrations reverts the datastore to the provided version, so it
+// can be used by an older Powergate version.
+func RevertMigrations(conf Config, version int) error {
+	ds, err := createDatastore(conf, true)
+	if err != nil {
+		return fmt.Errorf("creating migration datastore: %s", err)
+	}
+	defer func() {
+		if err := ds.Close(); err != nil {
+			log.Errorf("closing migration datastore: %s", err)
+		}
+	}()
+
+	m := migration.New(ds, Migrations)
+	if err := m.MigrateTo(version); err != nil {
+		return fmt.Errorf("reverting migrations: %s", err)
+	}
+	log.Infof("Datastore reverted to version %d", version)
+
+	return nil
+}
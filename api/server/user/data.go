@@ -1,6 +1,7 @@
 package user
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	"io"
@@ -23,6 +24,16 @@ func (s *Service) Stage(srv userPb.UserService_StageServer) error {
 		return err
 	}
 
+	// The first request carries the import options besides data.
+	first, err := srv.Recv()
+	if err != nil && err != io.EOF {
+		return fmt.Errorf("receiving first chunk: %s", err)
+	}
+	opts, err := fromRPCImportOptions(first.GetImportOptions()).UnixfsAddOptions()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "parsing import options: %v", err)
+	}
+
 	reader, writer := io.Pipe()
 	defer func() {
 		if err := reader.Close(); err != nil {
@@ -30,9 +41,9 @@ func (s *Service) Stage(srv userPb.UserService_StageServer) error {
 		}
 	}()
 
-	go receiveFile(srv, writer)
+	go receiveFile(srv, first, writer)
 
-	c, err := s.hot.Stage(srv.Context(), fapi.ID(), reader)
+	c, err := s.hot.Stage(srv.Context(), fapi.ID(), reader, opts...)
 	if err != nil {
 		return fmt.Errorf("adding data to hot storage: %s", err)
 	}
@@ -61,6 +72,35 @@ func (s *Service) StageCid(ctx context.Context, req *userPb.StageCidRequest) (*u
 	return &userPb.StageCidResponse{}, nil
 }
 
+// StageCar allows to import a stream of a CAR file in Hot-Storage, stage-pinning its roots
+// in preparation for pushing storage configurations.
+func (s *Service) StageCar(srv userPb.UserService_StageCarServer) error {
+	fapi, err := s.getInstanceByToken(srv.Context())
+	if err != nil {
+		return err
+	}
+
+	reader, writer := io.Pipe()
+	defer func() {
+		if err := reader.Close(); err != nil {
+			log.Errorf("closing reader: %s", err)
+		}
+	}()
+
+	go receiveCar(srv, writer)
+
+	roots, err := s.hot.StageCar(srv.Context(), fapi.ID(), reader)
+	if err != nil {
+		return fmt.Errorf("importing car in hot storage: %s", err)
+	}
+
+	res := &userPb.StageCarResponse{Roots: make([]string, len(roots))}
+	for i, c := range roots {
+		res.Roots[i] = util.CidToString(c)
+	}
+	return srv.SendAndClose(res)
+}
+
 // ReplaceData calls ffs.Replace.
 func (s *Service) ReplaceData(ctx context.Context, req *userPb.ReplaceDataRequest) (*userPb.ReplaceDataResponse, error) {
 	i, err := s.getInstanceByToken(ctx)
@@ -85,6 +125,40 @@ func (s *Service) ReplaceData(ctx context.Context, req *userPb.ReplaceDataReques
 	return &userPb.ReplaceDataResponse{JobId: jid.String()}, nil
 }
 
+// VerifyData schedules a Job which verifies the integrity of stored data.
+func (s *Service) VerifyData(ctx context.Context, req *userPb.VerifyDataRequest) (*userPb.VerifyDataResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := util.CidFromString(req.Cid)
+	if err != nil {
+		return nil, err
+	}
+	opts := ffs.VerifyOptions{
+		Hot:      req.Hot,
+		Cold:     req.Cold,
+		Mode:     ffs.VerifySampled,
+		Depth:    int(req.Depth),
+		MaxPrice: req.MaxPrice,
+		Repair:   req.Repair,
+	}
+	if req.Mode == userPb.VerifyMode_VERIFY_MODE_FULL {
+		opts.Mode = ffs.VerifyFull
+	}
+	if opts.Depth == 0 {
+		opts.Depth = 1
+	}
+
+	jid, err := i.Verify(c, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userPb.VerifyDataResponse{JobId: jid.String()}, nil
+}
+
 // Get gets the data for a stored Cid.
 func (s *Service) Get(req *userPb.GetRequest, srv userPb.UserService_GetServer) error {
 	i, err := s.getInstanceByToken(srv.Context())
@@ -115,6 +189,26 @@ func (s *Service) Get(req *userPb.GetRequest, srv userPb.UserService_GetServer)
 	}
 }
 
+// GetCar streams a CAR file of the DAG of a stored Cid, optionally restricted
+// by an IPLD selector.
+func (s *Service) GetCar(req *userPb.GetCarRequest, srv userPb.UserService_GetCarServer) error {
+	i, err := s.getInstanceByToken(srv.Context())
+	if err != nil {
+		return err
+	}
+	c, err := util.CidFromString(req.Cid)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(&carWriter{srv: srv}, 1024*32)
+	opts := ffs.CarOptions{Version: int(req.Version), Selector: req.Selector}
+	if err := i.GetCar(srv.Context(), c, w, opts); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
 // WatchLogs returns a stream of human-readable messages related to executions of a Cid.
 // The listener is automatically unsubscribed when the client closes the stream.
 func (s *Service) WatchLogs(req *userPb.WatchLogsRequest, srv userPb.UserService_WatchLogsServer) error {
@@ -128,6 +222,21 @@ func (s *Service) WatchLogs(req *userPb.WatchLogsRequest, srv userPb.UserService
 		opts = append(opts, api.WithJidFilter(ffs.JobID(req.JobId)))
 	}
 
+	minLevel, err := fromRPCLogLevel(req.MinLevel)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "parsing min level: %s", err)
+	}
+	if minLevel != ffs.LogLevelInfo {
+		opts = append(opts, api.WithMinLevel(minLevel))
+	}
+	if len(req.EventTypes) > 0 {
+		types, err := fromRPCLogEventTypes(req.EventTypes)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "parsing event types: %s", err)
+		}
+		opts = append(opts, api.WithEventTypes(types...))
+	}
+
 	c, err := util.CidFromString(req.Cid)
 	if err != nil {
 		return err
@@ -139,12 +248,7 @@ func (s *Service) WatchLogs(req *userPb.WatchLogsRequest, srv userPb.UserService
 	}()
 	for l := range ch {
 		reply := &userPb.WatchLogsResponse{
-			LogEntry: &userPb.LogEntry{
-				Cid:     util.CidToString(c),
-				JobId:   l.Jid.String(),
-				Time:    l.Timestamp.Unix(),
-				Message: l.Msg,
-			},
+			LogEntry: toRPCLogEntry(l),
 		}
 		if err := srv.Send(reply); err != nil {
 			return err
@@ -346,7 +450,44 @@ func (s *Service) CidInfo(ctx context.Context, req *userPb.CidInfoRequest) (*use
 	return &userPb.CidInfoResponse{CidInfo: cidInfo}, nil
 }
 
-func receiveFile(srv userPb.UserService_StageServer, writer *io.PipeWriter) {
+func receiveCar(srv userPb.UserService_StageCarServer, writer *io.PipeWriter) {
+	for {
+		req, err := srv.Recv()
+		if err == io.EOF {
+			_ = writer.Close()
+			return
+		} else if err != nil {
+			_ = writer.CloseWithError(err)
+			return
+		}
+		if _, err := writer.Write(req.GetChunk()); err != nil {
+			_ = writer.CloseWithError(err)
+			return
+		}
+	}
+}
+
+// carWriter sends the data of a CAR file in a GetCar stream.
+type carWriter struct {
+	srv userPb.UserService_GetCarServer
+}
+
+func (cw *carWriter) Write(p []byte) (int, error) {
+	if err := cw.srv.Send(&userPb.GetCarResponse{Chunk: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func receiveFile(srv userPb.UserService_StageServer, first *userPb.StageRequest, writer *io.PipeWriter) {
+	if first == nil {
+		_ = writer.Close()
+		return
+	}
+	if _, err := writer.Write(first.GetChunk()); err != nil {
+		_ = writer.CloseWithError(err)
+		return
+	}
 	for {
 		req, err := srv.Recv()
 		if err == io.EOF {
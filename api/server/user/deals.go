@@ -5,6 +5,7 @@ import (
 
 	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
 	"github.com/textileio/powergate/v2/api/server/util"
+	"github.com/textileio/powergate/v2/deals"
 )
 
 // StorageDealRecords calls ffs.ListStorageDealRecords.
@@ -17,7 +18,13 @@ func (s *Service) StorageDealRecords(ctx context.Context, req *userPb.StorageDea
 	if err != nil {
 		return nil, err
 	}
-	return &userPb.StorageDealRecordsResponse{Records: util.ToRPCStorageDealRecords(records)}, nil
+	res := &userPb.StorageDealRecordsResponse{Records: util.ToRPCStorageDealRecords(records)}
+	// A full page means there might be more records, so return a
+	// cursor to continue from the last one.
+	if limit := req.GetConfig().GetLimit(); limit > 0 && len(records) == int(limit) {
+		res.NextCursor = deals.StorageDealRecordsCursor(records[len(records)-1], sortBy(req.Config.SortBy))
+	}
+	return res, nil
 }
 
 // RetrievalDealRecords calls ffs.ListRetrievalDealRecords.
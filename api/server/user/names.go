@@ -0,0 +1,97 @@
+package user
+
+import (
+	"context"
+
+	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
+	"github.com/textileio/powergate/v2/ffs/api"
+	"github.com/textileio/powergate/v2/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetName creates or updates a named reference to a stored cid.
+func (s *Service) SetName(ctx context.Context, req *userPb.SetNameRequest) (*userPb.SetNameResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "getting instance: %v", err)
+	}
+	c, err := util.CidFromString(req.Cid)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "parsing cid: %v", err)
+	}
+	var opts []api.SetNameOption
+	if req.HasRetention {
+		opts = append(opts, api.WithNameRetention(int(req.Retention)))
+	}
+	if req.HasPublish {
+		opts = append(opts, api.WithNamePublish(req.Publish))
+	}
+	ref, jid, err := i.SetName(ctx, req.Name, c, opts...)
+	switch err {
+	case nil:
+	case api.ErrInvalidName:
+		return nil, status.Errorf(codes.InvalidArgument, "setting name: %v", err)
+	case api.ErrUnstoredCid:
+		return nil, status.Errorf(codes.FailedPrecondition, "setting name: %v", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "setting name: %v", err)
+	}
+	return &userPb.SetNameResponse{Ref: toRPCNamedRef(ref), JobId: jid.String()}, nil
+}
+
+// GetName returns a named reference. If it doesn't exist, it returns an error
+// with codes.NotFound.
+func (s *Service) GetName(ctx context.Context, req *userPb.GetNameRequest) (*userPb.GetNameResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "getting instance: %v", err)
+	}
+	ref, err := i.GetName(req.Name)
+	switch err {
+	case nil:
+	case api.ErrInvalidName:
+		return nil, status.Errorf(codes.InvalidArgument, "getting name: %v", err)
+	case api.ErrNotFound:
+		return nil, status.Errorf(codes.NotFound, "getting name: %v", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "getting name: %v", err)
+	}
+	return &userPb.GetNameResponse{Ref: toRPCNamedRef(ref)}, nil
+}
+
+// ListNames returns the named references with names starting with a prefix.
+func (s *Service) ListNames(ctx context.Context, req *userPb.ListNamesRequest) (*userPb.ListNamesResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "getting instance: %v", err)
+	}
+	refs, err := i.ListNames(req.Prefix)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "listing names: %v", err)
+	}
+	res := make([]*userPb.NamedRef, len(refs))
+	for i, ref := range refs {
+		res[i] = toRPCNamedRef(ref)
+	}
+	return &userPb.ListNamesResponse{Refs: res}, nil
+}
+
+// RemoveName removes a named reference.
+func (s *Service) RemoveName(ctx context.Context, req *userPb.RemoveNameRequest) (*userPb.RemoveNameResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "getting instance: %v", err)
+	}
+	err = i.RemoveName(req.Name)
+	switch err {
+	case nil:
+	case api.ErrInvalidName:
+		return nil, status.Errorf(codes.InvalidArgument, "removing name: %v", err)
+	case api.ErrNotFound:
+		return nil, status.Errorf(codes.NotFound, "removing name: %v", err)
+	default:
+		return nil, status.Errorf(codes.Internal, "removing name: %v", err)
+	}
+	return &userPb.RemoveNameResponse{}, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/ffs/api"
 	"github.com/textileio/powergate/v2/ffs/manager"
+	"github.com/textileio/powergate/v2/ffs/uploads"
 	"github.com/textileio/powergate/v2/wallet"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -25,14 +26,17 @@ type Service struct {
 	m   *manager.Manager
 	w   wallet.Module
 	hot ffs.HotStorage
+
+	uploads *uploads.Store
 }
 
 // New creates a new powergate Service.
-func New(m *manager.Manager, w wallet.Module, hot ffs.HotStorage) *Service {
+func New(m *manager.Manager, w wallet.Module, hot ffs.HotStorage, uploads *uploads.Store) *Service {
 	return &Service{
-		m:   m,
-		w:   w,
-		hot: hot,
+		m:       m,
+		w:       w,
+		hot:     hot,
+		uploads: uploads,
 	}
 }
 
@@ -58,3 +58,54 @@ func (s *Service) ListStorageInfo(ctx context.Context, req *userPb.ListStorageIn
 	}
 	return &userPb.ListStorageInfoResponse{StorageInfo: res}, nil
 }
+
+// ExpirationForecast returns the stored cids having deals expiring within the requested epochs.
+func (s *Service) ExpirationForecast(ctx context.Context, req *userPb.ExpirationForecastRequest) (*userPb.ExpirationForecastResponse, error) {
+	if req.WithinEpochs == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "within epochs must be greater than zero")
+	}
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "getting instance: %v", err)
+	}
+	forecasts, height, err := i.ExpirationForecasts(ctx, req.WithinEpochs)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "forecasting expirations: %v", err)
+	}
+	return &userPb.ExpirationForecastResponse{Height: height, Forecasts: su.ToRPCExpirationForecasts(forecasts)}, nil
+}
+
+// AuditReports returns the last audit reports of stored cids, filtered by cids if provided.
+func (s *Service) AuditReports(ctx context.Context, req *userPb.AuditReportsRequest) (*userPb.AuditReportsResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "getting instance: %v", err)
+	}
+	cids := make([]cid.Cid, len(req.Cids))
+	for i, s := range req.Cids {
+		c, err := util.CidFromString(s)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "parsing cid: %v", err)
+		}
+		cids[i] = c
+	}
+	reports, err := i.AuditReports(cids...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting audit reports: %v", err)
+	}
+	return &userPb.AuditReportsResponse{Reports: su.ToRPCAuditReports(reports)}, nil
+}
+
+// Usage returns the effective usage of the user, which attributes data stored
+// by multiple users in equal parts to each of them.
+func (s *Service) Usage(ctx context.Context, req *userPb.UsageRequest) (*userPb.UsageResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "getting instance: %v", err)
+	}
+	u, err := i.Usage()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "getting usage: %v", err)
+	}
+	return &userPb.UsageResponse{Usage: su.ToRPCUsage(u)}, nil
+}
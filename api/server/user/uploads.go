@@ -0,0 +1,93 @@
+package user
+
+import (
+	"context"
+
+	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
+	"github.com/textileio/powergate/v2/ffs/uploads"
+	"github.com/textileio/powergate/v2/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateUpload creates a resumable upload, which receives data in numbered
+// chunks that are staged in Hot-Storage when the upload is finalized.
+func (s *Service) CreateUpload(ctx context.Context, req *userPb.CreateUploadRequest) (*userPb.CreateUploadResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.uploads.Create(i.ID())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "creating upload: %v", err)
+	}
+	return &userPb.CreateUploadResponse{Upload: toRPCUpload(u)}, nil
+}
+
+// UploadChunk receives the next chunk of a resumable upload.
+func (s *Service) UploadChunk(ctx context.Context, req *userPb.UploadChunkRequest) (*userPb.UploadChunkResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.uploads.WriteChunk(i.ID(), req.UploadId, req.Index, req.Data, req.Checksum)
+	if err != nil {
+		return nil, status.Errorf(uploadErrorCode(err), "writing chunk: %v", err)
+	}
+	return &userPb.UploadChunkResponse{Upload: toRPCUpload(u)}, nil
+}
+
+// UploadStatus returns the received chunks of a resumable upload, to
+// know where an interrupted upload should be resumed from.
+func (s *Service) UploadStatus(ctx context.Context, req *userPb.UploadStatusRequest) (*userPb.UploadStatusResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.uploads.Get(i.ID(), req.UploadId)
+	if err != nil {
+		return nil, status.Errorf(uploadErrorCode(err), "getting upload: %v", err)
+	}
+	return &userPb.UploadStatusResponse{Upload: toRPCUpload(u)}, nil
+}
+
+// FinalizeUpload stage-pins the data of a resumable upload in Hot-Storage with the
+// provided import options, in preparation for pushing a storage configuration.
+func (s *Service) FinalizeUpload(ctx context.Context, req *userPb.FinalizeUploadRequest) (*userPb.FinalizeUploadResponse, error) {
+	i, err := s.getInstanceByToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := fromRPCImportOptions(req.ImportOptions).UnixfsAddOptions()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "parsing import options: %v", err)
+	}
+	c, err := s.uploads.Finalize(ctx, i.ID(), req.UploadId, req.Size, opts...)
+	if err != nil {
+		return nil, status.Errorf(uploadErrorCode(err), "finalizing upload: %v", err)
+	}
+	return &userPb.FinalizeUploadResponse{Cid: util.CidToString(c)}, nil
+}
+
+func uploadErrorCode(err error) codes.Code {
+	switch err {
+	case uploads.ErrNotFound:
+		return codes.NotFound
+	case uploads.ErrChecksumMismatch:
+		return codes.InvalidArgument
+	case uploads.ErrUnexpectedChunk, uploads.ErrSizeMismatch, uploads.ErrFinalizing:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+func toRPCUpload(u uploads.Upload) *userPb.Upload {
+	return &userPb.Upload{
+		Id:             u.ID,
+		ReceivedChunks: u.Chunks,
+		ReceivedSize:   u.Size,
+		ExpiresAt:      timestamppb.New(u.ExpiresAt),
+	}
+}
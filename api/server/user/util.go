@@ -1,9 +1,15 @@
 package user
 
 import (
+	"fmt"
+
 	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
 	"github.com/textileio/powergate/v2/deals"
 	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/api"
+	"github.com/textileio/powergate/v2/importopts"
+	"github.com/textileio/powergate/v2/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func toRPCStorageConfig(config ffs.StorageConfig) *userPb.StorageConfig {
@@ -22,6 +28,10 @@ func toRPCHotConfig(config ffs.HotConfig) *userPb.HotConfig {
 		Ipfs: &userPb.IpfsConfig{
 			AddTimeout: int64(config.Ipfs.AddTimeout),
 		},
+		Cache: &userPb.HotCacheConfig{
+			Enabled: config.Cache.Enabled,
+			Ttl:     config.Cache.TTL,
+		},
 	}
 }
 
@@ -43,6 +53,15 @@ func toRPCColdConfig(config ffs.ColdConfig) *userPb.ColdConfig {
 			FastRetrieval:   config.Filecoin.FastRetrieval,
 			DealStartOffset: config.Filecoin.DealStartOffset,
 			VerifiedDeal:    config.Filecoin.VerifiedDeal,
+			Diversity: &userPb.FilDiversity{
+				MaxPerCountry:     int64(config.Filecoin.Diversity.MaxPerCountry),
+				MaxPerContinent:   int64(config.Filecoin.Diversity.MaxPerContinent),
+				MinDistanceKm:     config.Filecoin.Diversity.MinDistanceKm,
+				DistinctOperators: config.Filecoin.Diversity.DistinctOperators,
+			},
+		},
+		Encryption: &userPb.ColdEncryptionConfig{
+			Enabled: config.Encryption.Enabled,
 		},
 	}
 }
@@ -59,6 +78,12 @@ func fromRPCHotConfig(config *userPb.HotConfig) ffs.HotConfig {
 			}
 			res.Ipfs = ipfs
 		}
+		if config.Cache != nil {
+			res.Cache = ffs.HotCacheConfig{
+				Enabled: config.Cache.Enabled,
+				TTL:     config.Cache.Ttl,
+			}
+		}
 	}
 	return res
 }
@@ -87,8 +112,22 @@ func fromRPCColdConfig(config *userPb.ColdConfig) ffs.ColdConfig {
 				}
 				filecoin.Renew = renew
 			}
+			if config.Filecoin.Diversity != nil {
+				diversity := ffs.FilDiversity{
+					MaxPerCountry:     int(config.Filecoin.Diversity.MaxPerCountry),
+					MaxPerContinent:   int(config.Filecoin.Diversity.MaxPerContinent),
+					MinDistanceKm:     config.Filecoin.Diversity.MinDistanceKm,
+					DistinctOperators: config.Filecoin.Diversity.DistinctOperators,
+				}
+				filecoin.Diversity = diversity
+			}
 			res.Filecoin = filecoin
 		}
+		if config.Encryption != nil {
+			res.Encryption = ffs.ColdEncryptionConfig{
+				Enabled: config.Encryption.Enabled,
+			}
+		}
 	}
 	return res
 }
@@ -103,7 +142,127 @@ func buildListDealRecordsOptions(conf *userPb.DealRecordsConfig) []deals.DealRec
 			deals.WithIncludePending(conf.IncludePending),
 			deals.WithIncludeFinal(conf.IncludeFinal),
 			deals.WithIncludeFailed(conf.IncludeFailed),
+			deals.WithMiners(conf.Miners...),
+			deals.WithDealStates(conf.DealStates...),
+			deals.WithExpirationRange(conf.MinExpiration, conf.MaxExpiration),
+			deals.WithSortBy(sortBy(conf.SortBy)),
+			deals.WithLimit(int(conf.Limit)),
+			deals.WithCursor(conf.Cursor),
 		}
 	}
 	return opts
 }
+
+func sortBy(s userPb.DealRecordsSortBy) deals.DealRecordsSortBy {
+	if s == userPb.DealRecordsSortBy_DEAL_RECORDS_SORT_BY_EXPIRATION {
+		return deals.SortByExpiration
+	}
+	return deals.SortByTime
+}
+
+var logLevels = map[ffs.LogLevel]userPb.LogLevel{
+	ffs.LogLevelInfo:  userPb.LogLevel_LOG_LEVEL_INFO,
+	ffs.LogLevelWarn:  userPb.LogLevel_LOG_LEVEL_WARN,
+	ffs.LogLevelError: userPb.LogLevel_LOG_LEVEL_ERROR,
+}
+
+var logEventTypes = map[ffs.LogEventType]userPb.LogEventType{
+	ffs.EventUnspecified:        userPb.LogEventType_LOG_EVENT_TYPE_UNSPECIFIED,
+	ffs.EventJobQueued:          userPb.LogEventType_LOG_EVENT_TYPE_JOB_QUEUED,
+	ffs.EventJobExecuting:       userPb.LogEventType_LOG_EVENT_TYPE_JOB_EXECUTING,
+	ffs.EventJobFailed:          userPb.LogEventType_LOG_EVENT_TYPE_JOB_FAILED,
+	ffs.EventHotStorage:         userPb.LogEventType_LOG_EVENT_TYPE_HOT_STORAGE,
+	ffs.EventColdStorage:        userPb.LogEventType_LOG_EVENT_TYPE_COLD_STORAGE,
+	ffs.EventDealProposed:       userPb.LogEventType_LOG_EVENT_TYPE_DEAL_PROPOSED,
+	ffs.EventDealProposalFailed: userPb.LogEventType_LOG_EVENT_TYPE_DEAL_PROPOSAL_FAILED,
+	ffs.EventDealAccepted:       userPb.LogEventType_LOG_EVENT_TYPE_DEAL_ACCEPTED,
+	ffs.EventTransferStarted:    userPb.LogEventType_LOG_EVENT_TYPE_TRANSFER_STARTED,
+	ffs.EventSealing:            userPb.LogEventType_LOG_EVENT_TYPE_SEALING,
+	ffs.EventDealStateChanged:   userPb.LogEventType_LOG_EVENT_TYPE_DEAL_STATE_CHANGED,
+	ffs.EventDealActive:         userPb.LogEventType_LOG_EVENT_TYPE_DEAL_ACTIVE,
+	ffs.EventDealFailed:         userPb.LogEventType_LOG_EVENT_TYPE_DEAL_FAILED,
+	ffs.EventRenewalScheduled:   userPb.LogEventType_LOG_EVENT_TYPE_RENEWAL_SCHEDULED,
+	ffs.EventRepairScheduled:    userPb.LogEventType_LOG_EVENT_TYPE_REPAIR_SCHEDULED,
+	ffs.EventUnfreezeStarted:    userPb.LogEventType_LOG_EVENT_TYPE_UNFREEZE_STARTED,
+	ffs.EventUnfreezeProgress:   userPb.LogEventType_LOG_EVENT_TYPE_UNFREEZE_PROGRESS,
+	ffs.EventUnfrozen:           userPb.LogEventType_LOG_EVENT_TYPE_UNFROZEN,
+	ffs.EventExpiryForecast:     userPb.LogEventType_LOG_EVENT_TYPE_EXPIRY_FORECAST,
+	ffs.EventHotEvicted:         userPb.LogEventType_LOG_EVENT_TYPE_HOT_EVICTED,
+	ffs.EventDealAttached:       userPb.LogEventType_LOG_EVENT_TYPE_DEAL_ATTACHED,
+	ffs.EventVerification:       userPb.LogEventType_LOG_EVENT_TYPE_VERIFICATION,
+	ffs.EventMinerExcluded:      userPb.LogEventType_LOG_EVENT_TYPE_MINER_EXCLUDED,
+}
+
+func toRPCLogEntry(l ffs.LogEntry) *userPb.LogEntry {
+	return &userPb.LogEntry{
+		Cid:       util.CidToString(l.Cid),
+		JobId:     l.Jid.String(),
+		Time:      l.Timestamp.Unix(),
+		Message:   l.Msg,
+		Level:     logLevels[l.Level],
+		EventType: logEventTypes[l.Type],
+		Fields:    l.Fields,
+	}
+}
+
+func fromRPCLogLevel(level userPb.LogLevel) (ffs.LogLevel, error) {
+	if level == userPb.LogLevel_LOG_LEVEL_UNSPECIFIED {
+		return ffs.LogLevelInfo, nil
+	}
+	for k, v := range logLevels {
+		if v == level {
+			return k, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown log level: %s", level)
+}
+
+func fromRPCLogEventTypes(types []userPb.LogEventType) ([]ffs.LogEventType, error) {
+	res := make([]ffs.LogEventType, len(types))
+Loop:
+	for i, t := range types {
+		for k, v := range logEventTypes {
+			if v == t {
+				res[i] = k
+				continue Loop
+			}
+		}
+		return nil, fmt.Errorf("unknown log event type: %s", t)
+	}
+	return res, nil
+}
+
+func fromRPCImportOptions(opts *userPb.ImportOptions) importopts.Options {
+	if opts == nil {
+		return importopts.Options{}
+	}
+	return importopts.Options{
+		Chunker:      opts.Chunker,
+		CidVersion:   int(opts.CidVersion),
+		RawLeaves:    opts.RawLeaves,
+		RawLeavesSet: opts.HasRawLeaves,
+		Layout:       opts.Layout,
+		HashFunction: opts.HashFunction,
+	}
+}
+
+func toRPCNamedRef(ref api.NamedRef) *userPb.NamedRef {
+	history := make([]*userPb.NamedRefVersion, len(ref.History))
+	for i, v := range ref.History {
+		history[i] = &userPb.NamedRefVersion{
+			Cid:       util.CidToString(v.Cid),
+			Version:   int64(v.Version),
+			UpdatedAt: timestamppb.New(v.UpdatedAt),
+		}
+	}
+	return &userPb.NamedRef{
+		Name:      ref.Name,
+		Cid:       util.CidToString(ref.Cid),
+		Version:   int64(ref.Version),
+		UpdatedAt: timestamppb.New(ref.UpdatedAt),
+		History:   history,
+		Retention: int64(ref.Retention),
+		Publish:   ref.Publish,
+		IpnsName:  ref.IpnsName,
+	}
+}
@@ -24,6 +24,7 @@ func ToRPCStorageInfo(info ffs.StorageInfo) *userPb.StorageInfo {
 			Ipfs: &userPb.IpfsHotInfo{
 				Created: info.Hot.Ipfs.Created.UnixNano(),
 			},
+			Evicted: info.Hot.Evicted,
 		},
 		Cold: &userPb.ColdInfo{
 			Enabled: info.Cold.Enabled,
@@ -31,25 +32,146 @@ func ToRPCStorageInfo(info ffs.StorageInfo) *userPb.StorageInfo {
 				DataCid:   util.CidToString(info.Cold.Filecoin.DataCid),
 				Size:      info.Cold.Filecoin.Size,
 				Proposals: make([]*userPb.FilStorage, len(info.Cold.Filecoin.Proposals)),
+				Encrypted: info.Cold.Filecoin.Encrypted,
 			},
 		},
 	}
 	for i, p := range info.Cold.Filecoin.Proposals {
-		var strPieceCid string
-		if p.PieceCid.Defined() {
-			strPieceCid = util.CidToString(p.PieceCid)
+		storageInfo.Cold.Filecoin.Proposals[i] = toRPCFilStorage(p)
+	}
+	if info.Verification.JobID != ffs.EmptyJobID {
+		storageInfo.Verification = toRPCVerifyInfo(info.Verification)
+	}
+	return storageInfo
+}
+
+func toRPCVerifyInfo(vi ffs.VerifyInfo) *userPb.VerifyInfo {
+	res := &userPb.VerifyInfo{
+		JobId:   vi.JobID.String(),
+		Time:    timestamppb.New(vi.Time),
+		Deals:   make([]*userPb.DealVerification, len(vi.Deals)),
+		Healthy: vi.Healthy(),
+	}
+	if vi.Hot != nil {
+		res.Hot = &userPb.HotVerification{
+			Blocks:  int64(vi.Hot.Blocks),
+			Size:    vi.Hot.Size,
+			Invalid: make([]string, len(vi.Hot.Invalid)),
+			Error:   vi.Hot.Error,
 		}
-		storageInfo.Cold.Filecoin.Proposals[i] = &userPb.FilStorage{
-			DealId:     int64(p.DealID),
-			PieceCid:   strPieceCid,
-			Renewed:    p.Renewed,
-			Duration:   p.Duration,
-			StartEpoch: p.StartEpoch,
-			Miner:      p.Miner,
-			EpochPrice: p.EpochPrice,
+		for i, c := range vi.Hot.Invalid {
+			res.Hot.Invalid[i] = util.CidToString(c)
 		}
 	}
-	return storageInfo
+	for i, d := range vi.Deals {
+		res.Deals[i] = &userPb.DealVerification{
+			DealId:     int64(d.DealID),
+			Miner:      d.Miner,
+			FundsSpent: d.FundsSpent,
+			Error:      d.Error,
+			Corrupt:    d.Corrupt,
+		}
+	}
+	return res
+}
+
+// ToRPCExpirationForecasts converts expiration forecasts to the proto version.
+func ToRPCExpirationForecasts(forecasts []ffs.ExpirationForecast) []*userPb.ExpirationForecast {
+	ret := make([]*userPb.ExpirationForecast, len(forecasts))
+	for i, f := range forecasts {
+		ret[i] = &userPb.ExpirationForecast{
+			UserId:              f.APIID.String(),
+			Cid:                 util.CidToString(f.Cid),
+			RepFactor:           int64(f.RepFactor),
+			RenewEnabled:        f.RenewEnabled,
+			RenewThreshold:      int64(f.RenewThreshold),
+			RenewHealthy:        f.RenewHealthy,
+			ActiveDeals:         int64(f.ActiveDeals),
+			ForecastActiveDeals: int64(f.ForecastActiveDeals),
+			AtRisk:              f.AtRisk(),
+			Expiring:            make([]*userPb.ExpiringDeal, len(f.Expiring)),
+		}
+		for j, d := range f.Expiring {
+			ret[i].Expiring[j] = &userPb.ExpiringDeal{
+				Deal:            toRPCFilStorage(d.FilStorage),
+				ExpirationEpoch: d.ExpirationEpoch,
+			}
+		}
+	}
+	return ret
+}
+
+// ToRPCUsage converts a Usage to the proto version.
+func ToRPCUsage(u ffs.Usage) *userPb.Usage {
+	return &userPb.Usage{
+		UserId:            u.APIID.String(),
+		Cids:              int64(u.Cids),
+		SharedCids:        int64(u.SharedCids),
+		HotSize:           u.HotSize,
+		EffectiveHotSize:  u.EffectiveHotSize,
+		Deals:             int64(u.Deals),
+		SharedDeals:       int64(u.SharedDeals),
+		ColdCost:          u.ColdCost.String(),
+		EffectiveColdCost: u.EffectiveColdCost.String(),
+	}
+}
+
+// ToRPCAuditReports converts AuditReports to the proto version.
+func ToRPCAuditReports(reports []ffs.AuditReport) []*userPb.AuditReport {
+	ret := make([]*userPb.AuditReport, len(reports))
+	for i, r := range reports {
+		ret[i] = &userPb.AuditReport{
+			UserId:        r.APIID.String(),
+			Cid:           util.CidToString(r.Cid),
+			Time:          timestamppb.New(r.Time),
+			Height:        r.Height,
+			AuditedDeals:  int64(r.AuditedDeals),
+			ActiveDeals:   int64(r.ActiveDeals),
+			Healthy:       r.Healthy(),
+			Discrepancies: make([]*userPb.AuditDiscrepancy, len(r.Discrepancies)),
+		}
+		for j, d := range r.Discrepancies {
+			ret[i].Discrepancies[j] = &userPb.AuditDiscrepancy{
+				Type:    toRPCAuditDiscrepancyType(d.Type),
+				DealId:  int64(d.DealID),
+				Miner:   d.Miner,
+				Message: d.Message,
+			}
+		}
+	}
+	return ret
+}
+
+func toRPCAuditDiscrepancyType(t ffs.AuditDiscrepancyType) userPb.AuditDiscrepancyType {
+	switch t {
+	case ffs.AuditDealNotActive:
+		return userPb.AuditDiscrepancyType_AUDIT_DISCREPANCY_TYPE_DEAL_NOT_ACTIVE
+	case ffs.AuditPieceCidMismatch:
+		return userPb.AuditDiscrepancyType_AUDIT_DISCREPANCY_TYPE_PIECE_CID_MISMATCH
+	case ffs.AuditMinerMismatch:
+		return userPb.AuditDiscrepancyType_AUDIT_DISCREPANCY_TYPE_MINER_MISMATCH
+	case ffs.AuditSectorNotSealed:
+		return userPb.AuditDiscrepancyType_AUDIT_DISCREPANCY_TYPE_SECTOR_NOT_SEALED
+	default:
+		return userPb.AuditDiscrepancyType_AUDIT_DISCREPANCY_TYPE_UNSPECIFIED
+	}
+}
+
+func toRPCFilStorage(p ffs.FilStorage) *userPb.FilStorage {
+	var strPieceCid string
+	if p.PieceCid.Defined() {
+		strPieceCid = util.CidToString(p.PieceCid)
+	}
+	return &userPb.FilStorage{
+		DealId:        int64(p.DealID),
+		PieceCid:      strPieceCid,
+		Renewed:       p.Renewed,
+		Duration:      p.Duration,
+		StartEpoch:    p.StartEpoch,
+		Miner:         p.Miner,
+		EpochPrice:    p.EpochPrice,
+		FastRetrieval: p.FastRetrieval,
+	}
 }
 
 // ToProtoStorageJobs converts a slice of ffs.StorageJobs to proto Jobs.
@@ -0,0 +1,402 @@
+// Package backup exports and restores the complete Powergate datastore
+// to and from a versioned archive, independently of the datastore backend.
+//
+// An archive is a gzip compressed stream containing a magic string, a JSON
+// header, the datastore entries as length-prefixed key/value records, an
+// empty record marking the end of entries, and a JSON trailer with the number
+// of entries and a checksum of all records.
+package backup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logger "github.com/ipfs/go-log/v2"
+)
+
+const (
+	// FormatVersion is the current archive format version.
+	FormatVersion = 1
+
+	magic = "powergate-backup\n"
+
+	// maxRecordSize is a sanity limit for record lengths read from archives.
+	maxRecordSize = 1 << 30
+
+	restoreBatchSize = 1000
+)
+
+var (
+	log = logger.Logger("backup")
+
+	keyDatastoreVersion = datastore.NewKey("version")
+
+	// ErrNotEmpty is returned when restoring into a non-empty datastore
+	// without allowing overwrites.
+	ErrNotEmpty = errors.New("target datastore isn't empty")
+)
+
+// Header describes the content of an archive.
+type Header struct {
+	FormatVersion int
+	// DatastoreVersion is the migration version of the exported datastore.
+	DatastoreVersion int
+	CreatedAt        time.Time
+}
+
+// Summary describes an exported, restored or verified archive.
+type Summary struct {
+	Header
+	Entries  int
+	Checksum string
+}
+
+type trailer struct {
+	Entries  int
+	Checksum string
+}
+
+// Export writes a consistent snapshot of ds to w. The snapshot is read in a
+// read-only transaction, so ds can be in use while the export runs.
+func Export(ctx context.Context, ds datastore.TxnDatastore, w io.Writer) (Summary, error) {
+	txn, err := ds.NewTransaction(true)
+	if err != nil {
+		return Summary{}, fmt.Errorf("creating read-only transaction: %s", err)
+	}
+	defer txn.Discard()
+
+	dsVersion, err := getDatastoreVersion(txn)
+	if err != nil {
+		return Summary{}, err
+	}
+	h := Header{
+		FormatVersion:    FormatVersion,
+		DatastoreVersion: dsVersion,
+		CreatedAt:        time.Now().UTC(),
+	}
+
+	gz := gzip.NewWriter(w)
+	bw := bufio.NewWriter(gz)
+	if _, err := bw.WriteString(magic); err != nil {
+		return Summary{}, fmt.Errorf("writing magic: %s", err)
+	}
+	if err := writeJSON(bw, h); err != nil {
+		return Summary{}, fmt.Errorf("writing header: %s", err)
+	}
+
+	res, err := txn.Query(query.Query{})
+	if err != nil {
+		return Summary{}, fmt.Errorf("querying datastore: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+
+	sum := sha256.New()
+	var entries int
+	for r := range res.Next() {
+		if r.Error != nil {
+			return Summary{}, fmt.Errorf("iterating datastore: %s", r.Error)
+		}
+		if ctx.Err() != nil {
+			return Summary{}, fmt.Errorf("export canceled: %s", ctx.Err())
+		}
+		if err := writeRecord(bw, sum, []byte(r.Key), r.Value); err != nil {
+			return Summary{}, fmt.Errorf("writing record: %s", err)
+		}
+		entries++
+	}
+	// An empty key marks the end of records.
+	if err := writeRecord(bw, nil, nil, nil); err != nil {
+		return Summary{}, fmt.Errorf("writing end of records: %s", err)
+	}
+	t := trailer{Entries: entries, Checksum: hex.EncodeToString(sum.Sum(nil))}
+	if err := writeJSON(bw, t); err != nil {
+		return Summary{}, fmt.Errorf("writing trailer: %s", err)
+	}
+	if err := bw.Flush(); err != nil {
+		return Summary{}, fmt.Errorf("flushing archive: %s", err)
+	}
+	if err := gz.Close(); err != nil {
+		return Summary{}, fmt.Errorf("closing archive: %s", err)
+	}
+
+	return Summary{Header: h, Entries: t.Entries, Checksum: t.Checksum}, nil
+}
+
+// RestoreOption configures a restore.
+type RestoreOption func(*restoreConfig)
+
+type restoreConfig struct {
+	overwrite           bool
+	maxDatastoreVersion int
+}
+
+// WithOverwrite allows restoring into a non-empty datastore. Existing
+// keys included in the archive are overwritten, and others are kept.
+func WithOverwrite(enabled bool) RestoreOption {
+	return func(c *restoreConfig) {
+		c.overwrite = enabled
+	}
+}
+
+// WithMaxDatastoreVersion fails the restore if the archive datastore
+// version is newer than version, which is usually the latest migration
+// known by the running Powergate version.
+func WithMaxDatastoreVersion(version int) RestoreOption {
+	return func(c *restoreConfig) {
+		c.maxDatastoreVersion = version
+	}
+}
+
+// Restore writes all the entries of the archive read from r into ds. Entries are
+// written in batched transactions, so if the archive turns out to be corrupted
+// ds could be partially restored.
+func Restore(ctx context.Context, r io.Reader, ds datastore.TxnDatastore, opts ...RestoreOption) (Summary, error) {
+	var cfg restoreConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+
+	if !cfg.overwrite {
+		empty, err := isEmpty(ds)
+		if err != nil {
+			return Summary{}, err
+		}
+		if !empty {
+			return Summary{}, ErrNotEmpty
+		}
+	}
+
+	var txn datastore.Txn
+	var batched int
+	commit := func() error {
+		if txn == nil {
+			return nil
+		}
+		if err := txn.Commit(); err != nil {
+			return fmt.Errorf("committing transaction: %s", err)
+		}
+		txn = nil
+		batched = 0
+		return nil
+	}
+	defer func() {
+		if txn != nil {
+			txn.Discard()
+		}
+	}()
+	checkHeader := func(h Header) error {
+		if cfg.maxDatastoreVersion > 0 && h.DatastoreVersion > cfg.maxDatastoreVersion {
+			return fmt.Errorf("archive datastore version %d is newer than supported version %d", h.DatastoreVersion, cfg.maxDatastoreVersion)
+		}
+		return nil
+	}
+	put := func(key, value []byte) error {
+		if ctx.Err() != nil {
+			return fmt.Errorf("restore canceled: %s", ctx.Err())
+		}
+		if txn == nil {
+			var err error
+			if txn, err = ds.NewTransaction(false); err != nil {
+				return fmt.Errorf("creating transaction: %s", err)
+			}
+		}
+		if err := txn.Put(datastore.NewKey(string(key)), value); err != nil {
+			return fmt.Errorf("putting %s: %s", key, err)
+		}
+		batched++
+		if batched >= restoreBatchSize {
+			return commit()
+		}
+		return nil
+	}
+	s, err := read(r, checkHeader, put)
+	if err != nil {
+		return Summary{}, err
+	}
+	if err := commit(); err != nil {
+		return Summary{}, err
+	}
+	return s, nil
+}
+
+// Verify reads a complete archive from r, and checks it isn't corrupted.
+func Verify(r io.Reader) (Summary, error) {
+	return read(r, nil, nil)
+}
+
+// read reads an archive from r, calling checkHeader with the archive header
+// and put with every record, if not nil.
+func read(r io.Reader, checkHeader func(Header) error, put func(key, value []byte) error) (Summary, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return Summary{}, fmt.Errorf("opening archive: %s", err)
+	}
+	defer func() { _ = gz.Close() }()
+	br := bufio.NewReader(gz)
+
+	m := make([]byte, len(magic))
+	if _, err := io.ReadFull(br, m); err != nil || string(m) != magic {
+		return Summary{}, fmt.Errorf("not a powergate backup archive")
+	}
+	var h Header
+	if err := readJSON(br, &h); err != nil {
+		return Summary{}, fmt.Errorf("reading header: %s", err)
+	}
+	if h.FormatVersion != FormatVersion {
+		return Summary{}, fmt.Errorf("unsupported archive format version %d", h.FormatVersion)
+	}
+	if checkHeader != nil {
+		if err := checkHeader(h); err != nil {
+			return Summary{}, err
+		}
+	}
+
+	sum := sha256.New()
+	var entries int
+	for {
+		key, value, err := readRecord(br, sum)
+		if err != nil {
+			return Summary{}, fmt.Errorf("reading record %d: %s", entries, err)
+		}
+		if len(key) == 0 {
+			break
+		}
+		if put != nil {
+			if err := put(key, value); err != nil {
+				return Summary{}, err
+			}
+		}
+		entries++
+	}
+
+	var t trailer
+	if err := readJSON(br, &t); err != nil {
+		return Summary{}, fmt.Errorf("reading trailer: %s", err)
+	}
+	checksum := hex.EncodeToString(sum.Sum(nil))
+	if t.Entries != entries || t.Checksum != checksum {
+		return Summary{}, fmt.Errorf("archive is corrupted, expected %d entries with checksum %s, got %d with checksum %s", t.Entries, t.Checksum, entries, checksum)
+	}
+	return Summary{Header: h, Entries: entries, Checksum: checksum}, nil
+}
+
+func writeRecord(w *bufio.Writer, sum hash.Hash, key, value []byte) error {
+	buf := make([]byte, binary.MaxVarintLen64)
+	for _, b := range [][]byte{key, value} {
+		n := binary.PutUvarint(buf, uint64(len(b)))
+		if _, err := w.Write(buf[:n]); err != nil {
+			return err
+		}
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+		if sum != nil {
+			_, _ = sum.Write(buf[:n])
+			_, _ = sum.Write(b)
+		}
+	}
+	return nil
+}
+
+func readRecord(r *bufio.Reader, sum hash.Hash) ([]byte, []byte, error) {
+	var parts [2][]byte
+	buf := make([]byte, binary.MaxVarintLen64)
+	for i := range parts {
+		l, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading length: %s", err)
+		}
+		if l > maxRecordSize {
+			return nil, nil, fmt.Errorf("record length %d exceeds maximum", l)
+		}
+		parts[i] = make([]byte, l)
+		if _, err := io.ReadFull(r, parts[i]); err != nil {
+			return nil, nil, fmt.Errorf("reading data: %s", err)
+		}
+		if i == 0 && l == 0 {
+			// End of records marker, the value length is
+			// read but not included in the checksum.
+			if _, err := binary.ReadUvarint(r); err != nil {
+				return nil, nil, fmt.Errorf("reading end of records: %s", err)
+			}
+			return nil, nil, nil
+		}
+		n := binary.PutUvarint(buf, l)
+		_, _ = sum.Write(buf[:n])
+		_, _ = sum.Write(parts[i])
+	}
+	return parts[0], parts[1], nil
+}
+
+func writeJSON(w *bufio.Writer, v interface{}) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, uint64(len(b)))
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+func readJSON(r *bufio.Reader, v interface{}) error {
+	l, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	if l > maxRecordSize {
+		return fmt.Errorf("length %d exceeds maximum", l)
+	}
+	b := make([]byte, l)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func getDatastoreVersion(r datastore.Read) (int, error) {
+	buf, err := r.Get(keyDatastoreVersion)
+	if err == datastore.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("getting datastore version: %s", err)
+	}
+	var v struct{ Version int }
+	if err := json.Unmarshal(buf, &v); err != nil {
+		return 0, fmt.Errorf("unmarshaling datastore version: %s", err)
+	}
+	return v.Version, nil
+}
+
+func isEmpty(ds datastore.Datastore) (bool, error) {
+	res, err := ds.Query(query.Query{Limit: 1, KeysOnly: true})
+	if err != nil {
+		return false, fmt.Errorf("querying datastore: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+	all, err := res.Rest()
+	if err != nil {
+		return false, fmt.Errorf("getting query results: %s", err)
+	}
+	return len(all) == 0, nil
+}
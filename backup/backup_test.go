@@ -0,0 +1,100 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func TestExportRestore(t *testing.T) {
+	t.Parallel()
+	src := populatedDatastore(t, 2500)
+
+	var buf bytes.Buffer
+	s, err := Export(context.Background(), src, &buf)
+	require.NoError(t, err)
+	require.Equal(t, FormatVersion, s.FormatVersion)
+	require.Equal(t, 3, s.DatastoreVersion)
+	require.Equal(t, 2501, s.Entries)
+
+	vs, err := Verify(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, s.Checksum, vs.Checksum)
+
+	dst := tests.NewTxMapDatastore()
+	rs, err := Restore(context.Background(), bytes.NewReader(buf.Bytes()), dst)
+	require.NoError(t, err)
+	require.Equal(t, s.Entries, rs.Entries)
+	require.Equal(t, dump(t, src), dump(t, dst))
+}
+
+func TestRestoreNotEmpty(t *testing.T) {
+	t.Parallel()
+	src := populatedDatastore(t, 10)
+	var buf bytes.Buffer
+	_, err := Export(context.Background(), src, &buf)
+	require.NoError(t, err)
+
+	dst := tests.NewTxMapDatastore()
+	require.NoError(t, dst.Put(datastore.NewKey("/existing"), []byte("1")))
+	_, err = Restore(context.Background(), bytes.NewReader(buf.Bytes()), dst)
+	require.Equal(t, ErrNotEmpty, err)
+
+	_, err = Restore(context.Background(), bytes.NewReader(buf.Bytes()), dst, WithOverwrite(true))
+	require.NoError(t, err)
+	require.Len(t, dump(t, dst), 12)
+}
+
+func TestRestoreNewerVersion(t *testing.T) {
+	t.Parallel()
+	src := populatedDatastore(t, 10)
+	var buf bytes.Buffer
+	_, err := Export(context.Background(), src, &buf)
+	require.NoError(t, err)
+
+	dst := tests.NewTxMapDatastore()
+	_, err = Restore(context.Background(), bytes.NewReader(buf.Bytes()), dst, WithMaxDatastoreVersion(2))
+	require.Error(t, err)
+	require.Empty(t, dump(t, dst))
+}
+
+func TestVerifyCorrupted(t *testing.T) {
+	t.Parallel()
+	src := populatedDatastore(t, 10)
+	var buf bytes.Buffer
+	_, err := Export(context.Background(), src, &buf)
+	require.NoError(t, err)
+
+	_, err = Verify(bytes.NewReader(buf.Bytes()[:buf.Len()/2]))
+	require.Error(t, err)
+	_, err = Verify(bytes.NewReader([]byte("not an archive")))
+	require.Error(t, err)
+}
+
+func populatedDatastore(t *testing.T, n int) *tests.TxMapDatastore {
+	ds := tests.NewTxMapDatastore()
+	require.NoError(t, ds.Put(keyDatastoreVersion, []byte(`{"Version":3}`)))
+	for i := 0; i < n; i++ {
+		k := datastore.NewKey(fmt.Sprintf("/ffs/manager/%d", i))
+		require.NoError(t, ds.Put(k, []byte(fmt.Sprintf("value-%d", i))))
+	}
+	return ds
+}
+
+func dump(t *testing.T, ds datastore.Datastore) map[string]string {
+	res, err := ds.Query(query.Query{})
+	require.NoError(t, err)
+	all, err := res.Rest()
+	require.NoError(t, err)
+	m := make(map[string]string, len(all))
+	for _, e := range all {
+		m[e.Key] = string(e.Value)
+	}
+	return m
+}
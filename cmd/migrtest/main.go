@@ -40,6 +40,7 @@ func main() {
 		runRemote      = config.GetBool("run-remote")
 		verbose        = config.GetBool("verbose")
 		skipMigrations = config.GetBool("skip-migrations")
+		dryRun         = config.GetBool("dry-run")
 	)
 	defer log.Infof("Inspect folder %s to see migration assets.", runName)
 
@@ -72,6 +73,16 @@ func main() {
 	}
 
 	m := migration.New(copiedDS, server.Migrations)
+	if dryRun {
+		reports, err := m.DryRun(server.LatestMigrationVersion())
+		if err != nil {
+			log.Fatalf("dry-running migrations: %s", err)
+		}
+		for _, r := range reports {
+			log.Infof("Migration to version %d: %d added, %d modified, %d deleted keys", r.Version, r.Added, r.Modified, r.Deleted)
+		}
+		return
+	}
 	if err = m.Ensure(); err != nil {
 		log.Fatalf("running migrations: %s", err)
 	}
@@ -82,6 +93,7 @@ func wireFlagsAndEnvs(config *viper.Viper) error {
 	pflag.Bool("run-remote", false, "Copies the origin to the remote to test the migration remotely. If not set, runs locally.")
 	pflag.Bool("verbose", false, "Verbose output")
 	pflag.Bool("skip-migrations", false, "Skips running migrations, the destination datastore would remain a clean copy of the origin")
+	pflag.Bool("dry-run", false, "Runs migrations in a discarded transaction, and reports the changed keys by each migration")
 	pflag.Parse()
 
 	config.SetEnvPrefix("MIGRTEST")
@@ -3,6 +3,7 @@ package admin
 import (
 	"github.com/spf13/cobra"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/data"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storageconfigs"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storageinfo"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storagejobs"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/users"
@@ -16,6 +17,7 @@ func init() {
 		data.Cmd,
 		storagejobs.Cmd,
 		storageinfo.Cmd,
+		storageconfigs.Cmd,
 		users.Cmd,
 		wallet.Cmd,
 	)
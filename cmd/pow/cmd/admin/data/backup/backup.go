@@ -0,0 +1,41 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+)
+
+func init() {
+	Cmd.Flags().StringP("output", "o", "", "file to write the backup archive to")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Backup the Powergate datastore.",
+	Long:  "Backup the complete Powergate datastore while it keeps running. The archive can be restored with powbackup.",
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		path := viper.GetString("output")
+		if path == "" {
+			c.Fatal(errors.New("--output is mandatory"))
+		}
+		f, err := os.Create(path)
+		c.CheckErr(err)
+		defer func() { c.CheckErr(f.Close()) }()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		err = c.PowClient.Admin.Data.Backup(c.AdminAuthCtx(ctx), f)
+		c.CheckErr(err)
+	},
+}
@@ -2,12 +2,15 @@ package data
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/data/backup"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/data/exportlogs"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/data/gcstaged"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/data/pinnedcids"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/data/reconcile"
 )
 
 func init() {
-	Cmd.AddCommand(gcstaged.Cmd, pinnedcids.Cmd)
+	Cmd.AddCommand(backup.Cmd, exportlogs.Cmd, gcstaged.Cmd, pinnedcids.Cmd, reconcile.Cmd)
 }
 
 // Cmd is the command.
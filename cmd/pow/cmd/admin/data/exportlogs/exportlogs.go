@@ -0,0 +1,64 @@
+package exportlogs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/textileio/powergate/v2/api/client/admin"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+)
+
+func init() {
+	Cmd.Flags().String("user-id", "", "export only logs of this user id")
+	Cmd.Flags().String("from", "", "export only logs created at or after this RFC3339 time")
+	Cmd.Flags().String("to", "", "export only logs created at or before this RFC3339 time")
+	Cmd.Flags().StringP("output", "o", "", "file to write the logs to, stdout if empty")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "exportlogs",
+	Short: "Export job logs as newline-delimited JSON.",
+	Long:  "Export job logs of a user or all users in a time range as newline-delimited JSON.",
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		conf := admin.ExportLogsConfig{
+			UserID: viper.GetString("user-id"),
+			From:   parseTime("from"),
+			To:     parseTime("to"),
+		}
+
+		out := os.Stdout
+		if path := viper.GetString("output"); path != "" {
+			f, err := os.Create(path)
+			c.CheckErr(err)
+			defer func() { c.CheckErr(f.Close()) }()
+			out = f
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		err := c.PowClient.Admin.Data.ExportLogs(c.AdminAuthCtx(ctx), out, conf)
+		c.CheckErr(err)
+	},
+}
+
+func parseTime(flag string) time.Time {
+	s := viper.GetString(flag)
+	if s == "" {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		c.Fatal(fmt.Errorf("parsing --%s: %s", flag, err))
+	}
+	return t
+}
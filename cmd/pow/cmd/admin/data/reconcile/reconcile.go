@@ -0,0 +1,48 @@
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/textileio/powergate/v2/api/client/admin"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	Cmd.Flags().Bool("dry-run", false, "only report differences without fixing them.")
+	Cmd.Flags().Duration("repin-timeout", time.Minute, "timeout for re-pinning each missing cid from the IPFS network; zero skips re-pinning.")
+	Cmd.Flags().Bool("unpin-unknown", false, "unpin IPFS node pins unknown to Powergate.")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "reconcile",
+	Short: "Reconciles hot-storage pins with the IPFS node pins.",
+	Long:  `Reconciles hot-storage pins with the IPFS node pins. Missing cids are re-pinned from the IPFS network, or unfrozen from Filecoin if allowed by their storage config. IPFS node pins unknown to Powergate are reported, and unpinned if requested.`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		config := admin.ReconcileHotStorageConfig{
+			DryRun:       viper.GetBool("dry-run"),
+			RepinTimeout: viper.GetDuration("repin-timeout"),
+			UnpinUnknown: viper.GetBool("unpin-unknown"),
+		}
+		res, err := c.PowClient.Admin.Data.ReconcileHotStorage(c.AdminAuthCtx(ctx), config)
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
@@ -0,0 +1,64 @@
+package bulkupdate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/textileio/powergate/v2/api/client/admin"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	Cmd.Flags().StringSlice("user-ids", nil, "transform only storage configs of these user ids")
+	Cmd.Flags().StringSlice("cids", nil, "transform only storage configs of these cids")
+	Cmd.Flags().StringArray("where", nil, "condition that storage configs must satisfy, e.g: cold.filecoin.maxPrice=0 (can be repeated)")
+	Cmd.Flags().StringArray("set", nil, "field assignment applied to storage configs, e.g: cold.filecoin.maxPrice=1000 (can be repeated)")
+	Cmd.Flags().Bool("repush", false, "push updated storage configs as new jobs")
+	Cmd.Flags().Bool("dry-run", false, "only report which storage configs would be updated")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "bulk-update",
+	Short: "Applies a transformation to all selected storage configs.",
+	Long: `Applies a transformation to all selected storage configs.
+
+Storage configs are selected by user ids, cids, and conditions on their fields. Fields are
+referenced by their dot-separated path, e.g: hot.ipfs.addTimeout. Conditions support the
+=, !=, <, <=, > and >= operators, and values are JSON literals of the field type.`,
+	Example: `pow admin storage-configs bulk-update --where cold.filecoin.maxPrice=0 --set cold.filecoin.maxPrice=1000 --dry-run`,
+	Args:    cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		// StringArray flags aren't split by viper, so they're read from the flag set.
+		where, err := cmd.Flags().GetStringArray("where")
+		c.CheckErr(err)
+		set, err := cmd.Flags().GetStringArray("set")
+		c.CheckErr(err)
+
+		conf := admin.BulkUpdateConfig{
+			UserIDs: viper.GetStringSlice("user-ids"),
+			Cids:    viper.GetStringSlice("cids"),
+			Where:   where,
+			Set:     set,
+			Repush:  viper.GetBool("repush"),
+			DryRun:  viper.GetBool("dry-run"),
+		}
+		res, err := c.PowClient.Admin.StorageConfigs.BulkUpdate(c.AdminAuthCtx(ctx), conf)
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
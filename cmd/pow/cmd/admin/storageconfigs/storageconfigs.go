@@ -0,0 +1,17 @@
+package storageconfigs
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storageconfigs/bulkupdate"
+)
+
+func init() {
+	Cmd.AddCommand(bulkupdate.Cmd)
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "storage-configs",
+	Short: "Provides admin storage config commands",
+	Long:  `Provides admin storage config commands`,
+}
@@ -0,0 +1,45 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	Cmd.Flags().StringSlice("user-ids", nil, "filter results by provided user ids.")
+	Cmd.Flags().StringSlice("cids", nil, "filter results by provided cids.")
+	Cmd.Flags().Bool("only-unhealthy", false, "only return reports with discrepancies.")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Returns the last audit reports of stored cids, filtered by user ids and cids if provided.",
+	Long:  `Returns the last audit reports of stored cids, filtered by user ids and cids if provided.`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		userIDs := viper.GetStringSlice("user-ids")
+		cids := viper.GetStringSlice("cids")
+		onlyUnhealthy := viper.GetBool("only-unhealthy")
+
+		res, err := c.PowClient.Admin.StorageInfo.AuditReports(c.AdminAuthCtx(ctx), userIDs, cids, onlyUnhealthy)
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
@@ -0,0 +1,41 @@
+package auditsummary
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	Cmd.Flags().StringSlice("user-ids", nil, "filter results by provided user ids.")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "audit-summary",
+	Short: "Returns a health summary of the last audit reports of stored cids, filtered by user ids if provided.",
+	Long:  `Returns a health summary of the last audit reports of stored cids, filtered by user ids if provided.`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		userIDs := viper.GetStringSlice("user-ids")
+
+		res, err := c.PowClient.Admin.StorageInfo.AuditSummary(c.AdminAuthCtx(ctx), userIDs)
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
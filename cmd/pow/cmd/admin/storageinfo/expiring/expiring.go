@@ -0,0 +1,42 @@
+package expiring
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	Cmd.Flags().StringSlice("user-ids", nil, "filter results by provided user ids.")
+	Cmd.Flags().Uint64P("within", "w", 2880*30, "forecast deals expiring within this amount of epochs")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "expiring",
+	Short: "Returns stored cids with deals expiring soon, filtered by user ids if provided.",
+	Long:  `Returns stored cids with deals expiring within the provided amount of epochs, filtered by user ids if provided.`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		userIDs := viper.GetStringSlice("user-ids")
+
+		res, err := c.PowClient.Admin.StorageInfo.ExpirationForecast(c.AdminAuthCtx(ctx), userIDs, viper.GetUint64("within"))
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
@@ -2,12 +2,16 @@ package storageinfo
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storageinfo/audit"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storageinfo/auditsummary"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storageinfo/expiring"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storageinfo/get"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storageinfo/list"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/storageinfo/usage"
 )
 
 func init() {
-	Cmd.AddCommand(get.Cmd, list.Cmd)
+	Cmd.AddCommand(audit.Cmd, auditsummary.Cmd, expiring.Cmd, get.Cmd, list.Cmd, usage.Cmd)
 }
 
 // Cmd is the command.
@@ -0,0 +1,62 @@
+package minerselector
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+)
+
+var (
+	log = logging.Logger("minerselector")
+)
+
+func init() {
+	Cmd.Flags().Bool("clear", false, "Removes the user miner selector override")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "miner-selector [user-id] [optional file]",
+	Short: "Sets the composite miner selector override of a user from stdin or a file",
+	Long:  `Sets the composite miner selector override of a user from stdin or a file`,
+	Args:  cobra.RangeArgs(1, 2),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		var params string
+		if !viper.GetBool("clear") {
+			var reader io.Reader
+			if len(args) > 1 {
+				file, err := os.Open(args[1])
+				c.CheckErr(err)
+				defer func() {
+					if err := file.Close(); err != nil {
+						log.Errorf("closing params file: %s", err)
+					}
+				}()
+				reader = file
+			} else {
+				reader = cmd.InOrStdin()
+			}
+
+			buf := new(bytes.Buffer)
+			_, err := buf.ReadFrom(reader)
+			c.CheckErr(err)
+			params = buf.String()
+		}
+
+		_, err := c.PowClient.Admin.Users.SetMinerSelectorParams(c.AdminAuthCtx(ctx), args[0], params)
+		c.CheckErr(err)
+	},
+}
@@ -4,11 +4,12 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/users/create"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/users/list"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/users/minerselector"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/admin/users/regenerate"
 )
 
 func init() {
-	Cmd.AddCommand(create.Cmd, list.Cmd, regenerate.Cmd)
+	Cmd.AddCommand(create.Cmd, list.Cmd, regenerate.Cmd, minerselector.Cmd)
 }
 
 // Cmd is the command.
@@ -0,0 +1,36 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "audit [optional cid1,cid2,...]",
+	Short: "Get the last audit reports of stored cids against the Filecoin state",
+	Long:  `Get the last audit reports of stored cids against the Filecoin state, filtered by cids if provided`,
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		var cids []string
+		if len(args) > 0 {
+			cids = strings.Split(args[0], ",")
+		}
+
+		res, err := c.PowClient.StorageInfo.AuditReports(c.MustAuthCtx(ctx), cids...)
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
@@ -2,16 +2,20 @@ package data
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/data/audit"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/data/expiring"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/data/get"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/data/info"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/data/log"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/data/replace"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/data/stage"
 	"github.com/textileio/powergate/v2/cmd/pow/cmd/data/summary"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/data/usage"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/data/verify"
 )
 
 func init() {
-	Cmd.AddCommand(get.Cmd, info.Cmd, log.Cmd, replace.Cmd, stage.Cmd, summary.Cmd)
+	Cmd.AddCommand(audit.Cmd, expiring.Cmd, get.Cmd, info.Cmd, log.Cmd, replace.Cmd, stage.Cmd, summary.Cmd, usage.Cmd, verify.Cmd)
 }
 
 // Cmd is the command.
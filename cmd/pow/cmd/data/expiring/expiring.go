@@ -0,0 +1,39 @@
+package expiring
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	Cmd.Flags().Uint64P("within", "w", 2880*30, "forecast deals expiring within this amount of epochs")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "expiring",
+	Short: "List stored cids with deals expiring soon",
+	Long:  `List stored cids with deals expiring within the provided amount of epochs, and whether renewal is enabled and healthy for them`,
+	Args:  cobra.NoArgs,
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		res, err := c.PowClient.StorageInfo.ExpirationForecast(c.MustAuthCtx(ctx), viper.GetUint64("within"))
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
@@ -10,12 +10,16 @@ import (
 	"github.com/caarlos0/spin"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/textileio/powergate/v2/api/client"
 	c "github.com/textileio/powergate/v2/cmd/pow/common"
 )
 
 func init() {
 	Cmd.Flags().String("ipfsrevproxy", "localhost:6002", "Powergate IPFS reverse proxy DNS address. If port 443, is assumed is a HTTPS endpoint.")
 	Cmd.Flags().BoolP("folder", "f", false, "Indicates that the retrieved Cid is a folder")
+	Cmd.Flags().Bool("car", false, "Retrieves the DAG of the Cid as a CAR file, which also works for folders")
+	Cmd.Flags().Int("carversion", 1, "CAR format version of the retrieved CAR file, 1 or 2")
+	Cmd.Flags().String("selector", "", "dag-json encoded IPLD selector restricting the blocks of the retrieved CAR file")
 }
 
 // Cmd is the command.
@@ -35,12 +39,23 @@ var Cmd = &cobra.Command{
 		s := spin.New("%s Retrieving specified data...")
 		s.Start()
 
+		isCar := viper.GetBool("car")
 		isFolder := viper.GetBool("folder")
-		if isFolder {
+		if isFolder && !isCar {
 			err := c.PowClient.Data.GetFolder(c.MustAuthCtx(ctx), viper.GetString("ipfsrevproxy"), args[0], args[1])
 			c.CheckErr(err)
 		} else {
-			reader, err := c.PowClient.Data.Get(c.MustAuthCtx(ctx), args[0])
+			var reader io.Reader
+			var err error
+			if isCar {
+				opts := []client.GetCarOption{client.WithCarVersion(viper.GetInt("carversion"))}
+				if selector := viper.GetString("selector"); selector != "" {
+					opts = append(opts, client.WithSelector(selector))
+				}
+				reader, err = c.PowClient.Data.GetCar(c.MustAuthCtx(ctx), args[0], opts...)
+			} else {
+				reader, err = c.PowClient.Data.Get(c.MustAuthCtx(ctx), args[0])
+			}
 			c.CheckErr(err)
 
 			dir := path.Dir(args[1])
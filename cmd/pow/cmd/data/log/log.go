@@ -2,19 +2,27 @@ package log
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
+	"sort"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/textileio/powergate/v2/api/client"
+	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
 	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func init() {
 	Cmd.Flags().StringP("jid", "j", "", "Display information for only this job id")
+	Cmd.Flags().StringP("level", "l", "", "Display only entries with this level or higher (info, warn, error)")
+	Cmd.Flags().StringSliceP("events", "e", nil, "Display only entries of these event types, e.g: deal-proposed,deal-active")
+	Cmd.Flags().Bool("json", false, "Display entries as JSON, including event types and fields")
 }
 
 // Cmd is the command.
@@ -34,6 +42,25 @@ var Cmd = &cobra.Command{
 		if jid != "" {
 			opts = append(opts, client.WithJobIDFilter(jid))
 		}
+		if level := viper.GetString("level"); level != "" {
+			l, ok := userPb.LogLevel_value["LOG_LEVEL_"+enumName(level)]
+			if !ok {
+				c.Fatal(fmt.Errorf("unknown log level %s", level))
+			}
+			opts = append(opts, client.WithMinLevel(userPb.LogLevel(l)))
+		}
+		if events := viper.GetStringSlice("events"); len(events) > 0 {
+			types := make([]userPb.LogEventType, len(events))
+			for i, e := range events {
+				t, ok := userPb.LogEventType_value["LOG_EVENT_TYPE_"+enumName(e)]
+				if !ok {
+					c.Fatal(fmt.Errorf("unknown event type %s", e))
+				}
+				types[i] = userPb.LogEventType(t)
+			}
+			opts = append(opts, client.WithEventTypes(types...))
+		}
+		asJSON := viper.GetBool("json")
 
 		ch := make(chan client.WatchLogsEvent)
 		ctx, cancel := context.WithCancel(context.Background())
@@ -59,8 +86,44 @@ var Cmd = &cobra.Command{
 				c.Fatal(event.Err)
 				break
 			}
-			ts := time.Unix(event.Res.LogEntry.Time, 0)
-			c.Message("%v - %v", ts.Format("2006-01-02T15:04:05"), event.Res.LogEntry.Message)
+			if asJSON {
+				json, err := protojson.Marshal(event.Res.LogEntry)
+				c.CheckErr(err)
+				fmt.Println(string(json))
+				continue
+			}
+			c.Message("%v", formatEntry(event.Res.LogEntry))
 		}
 	},
 }
+
+// enumName converts a user provided name such as deal-proposed
+// to the proto enum name suffix DEAL_PROPOSED.
+func enumName(s string) string {
+	return strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(s), "-", "_"))
+}
+
+func formatEntry(le *userPb.LogEntry) string {
+	ts := time.Unix(le.Time, 0)
+	msg := fmt.Sprintf("%v - ", ts.Format("2006-01-02T15:04:05"))
+	switch le.Level {
+	case userPb.LogLevel_LOG_LEVEL_WARN:
+		msg += "[WARN] "
+	case userPb.LogLevel_LOG_LEVEL_ERROR:
+		msg += "[ERROR] "
+	}
+	msg += le.Message
+	if len(le.Fields) > 0 {
+		keys := make([]string, 0, len(le.Fields))
+		for k := range le.Fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		pairs := make([]string, len(keys))
+		for i, k := range keys {
+			pairs[i] = fmt.Sprintf("%s=%s", k, le.Fields[k])
+		}
+		msg += fmt.Sprintf(" (%s)", strings.Join(pairs, " "))
+	}
+	return msg
+}
@@ -0,0 +1,111 @@
+package stage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/textileio/powergate/v2/api/client"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// pendingUpload is a resumable upload of a file which wasn't finalized.
+type pendingUpload struct {
+	UploadID string
+	Size     int64
+	ModTime  int64
+}
+
+// stageResumable stages a file with a resumable upload. The upload id is
+// saved until the upload is finalized, so running the command again for
+// the same unmodified file resumes an interrupted upload.
+func stageResumable(ctx context.Context, path string, fi os.FileInfo, opts ...client.StageOption) {
+	absPath, err := filepath.Abs(path)
+	c.CheckErr(err)
+	pending, err := loadPendingUploads()
+	c.CheckErr(err)
+
+	var uploadID string
+	if p, ok := pending[absPath]; ok && p.Size == fi.Size() && p.ModTime == fi.ModTime().UnixNano() {
+		_, err := c.PowClient.Data.UploadStatus(c.MustAuthCtx(ctx), p.UploadID)
+		if status.Code(err) != codes.NotFound {
+			c.CheckErr(err)
+			uploadID = p.UploadID
+			c.Message("Resuming upload %s", uploadID)
+		}
+	}
+	if uploadID == "" {
+		res, err := c.PowClient.Data.CreateUpload(c.MustAuthCtx(ctx))
+		c.CheckErr(err)
+		uploadID = res.Upload.Id
+		pending[absPath] = pendingUpload{UploadID: uploadID, Size: fi.Size(), ModTime: fi.ModTime().UnixNano()}
+		c.CheckErr(savePendingUploads(pending))
+	}
+
+	f, err := os.Open(path)
+	c.CheckErr(err)
+	defer func() { c.CheckErr(f.Close()) }()
+	res, err := c.PowClient.Data.ResumeUpload(c.MustAuthCtx(ctx), uploadID, f, opts...)
+	if err != nil {
+		c.Fatal(fmt.Errorf("%s, run the command again to resume the upload", err))
+	}
+
+	delete(pending, absPath)
+	c.CheckErr(savePendingUploads(pending))
+
+	json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+	c.CheckErr(err)
+
+	fmt.Println(string(json))
+}
+
+func pendingUploadsPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("getting user config dir: %s", err)
+	}
+	return filepath.Join(dir, "pow", "uploads.json"), nil
+}
+
+func loadPendingUploads() (map[string]pendingUpload, error) {
+	path, err := pendingUploadsPath()
+	if err != nil {
+		return nil, err
+	}
+	pending := map[string]pendingUpload{}
+	buf, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return pending, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading pending uploads: %s", err)
+	}
+	if err := json.Unmarshal(buf, &pending); err != nil {
+		return nil, fmt.Errorf("unmarshaling pending uploads: %s", err)
+	}
+	return pending, nil
+}
+
+func savePendingUploads(pending map[string]pendingUpload) error {
+	path, err := pendingUploadsPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("creating pending uploads dir: %s", err)
+	}
+	buf, err := json.Marshal(pending)
+	if err != nil {
+		return fmt.Errorf("marshaling pending uploads: %s", err)
+	}
+	if err := ioutil.WriteFile(path, buf, 0600); err != nil {
+		return fmt.Errorf("writing pending uploads: %s", err)
+	}
+	return nil
+}
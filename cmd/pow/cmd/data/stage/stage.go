@@ -12,19 +12,23 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/textileio/powergate/v2/api/client"
 	c "github.com/textileio/powergate/v2/cmd/pow/common"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func init() {
 	Cmd.Flags().String("ipfsrevproxy", "127.0.0.1:6002", "Powergate IPFS reverse proxy multiaddr")
+	Cmd.Flags().Bool("resume", false, "Stage a file with a resumable upload, resuming a previous interrupted upload of the same file")
+	Cmd.Flags().Bool("car", false, "Stage a CARv1 or CARv2 file keeping its DAG, which stage-pins the CAR roots")
+	c.AddImportOptionsFlags(Cmd.Flags())
 }
 
 // Cmd is the command.
 var Cmd = &cobra.Command{
 	Use:   "stage [path|url]",
 	Short: "Temporarily stage data in Hot Storage in preparation for applying a cid storage config",
-	Long:  `Temporarily stage data in Hot Storage in preparation for applying a cid storage config`,
+	Long:  `Temporarily stage data in Hot Storage in preparation for applying a cid storage config. Files are imported with CIDv0 and folders with CIDv1, unless --cid-version is set.`,
 	Args:  cobra.ExactArgs(1),
 	PreRun: func(cmd *cobra.Command, args []string) {
 		err := viper.BindPFlags(cmd.Flags())
@@ -34,11 +38,30 @@ var Cmd = &cobra.Command{
 		ctx, cancel := context.WithTimeout(context.Background(), time.Hour*8)
 		defer cancel()
 
+		// Import options are only sent if set, so the defaults of
+		// each kind of data are used otherwise, e.g: CIDv1 for folders.
+		var stageOpts []client.StageOption
+		if c.ImportOptionsSet(cmd) {
+			importOptions, err := c.ImportOptions(cmd)
+			c.CheckErr(err)
+			stageOpts = append(stageOpts, client.WithImportOptions(importOptions))
+		}
+		if viper.GetBool("car") && viper.GetBool("resume") {
+			c.Fatal(errors.New("--resume isn't supported for CAR files"))
+		}
+
 		if strings.HasPrefix(strings.ToLower(args[0]), "http") {
+			if viper.GetBool("resume") {
+				c.Fatal(errors.New("--resume is only supported for files"))
+			}
 			res, err := http.DefaultClient.Get(args[0])
 			c.CheckErr(err)
 			defer func() { c.CheckErr(res.Body.Close()) }()
-			stageReader(ctx, res.Body)
+			if viper.GetBool("car") {
+				stageCarReader(ctx, res.Body)
+				return
+			}
+			stageReader(ctx, res.Body, stageOpts...)
 			return
 		}
 
@@ -49,21 +72,55 @@ var Cmd = &cobra.Command{
 		if err != nil {
 			c.Fatal(fmt.Errorf("getting file/folder information: %s", err))
 		}
+		if viper.GetBool("car") {
+			if fi.IsDir() {
+				c.Fatal(errors.New("--car is only supported for files"))
+			}
+			f, err := os.Open(args[0])
+			c.CheckErr(err)
+			defer func() { c.CheckErr(f.Close()) }()
+			stageCarReader(ctx, f)
+			return
+		}
+		if viper.GetBool("resume") {
+			if fi.IsDir() {
+				c.Fatal(errors.New("--resume is only supported for files"))
+			}
+			stageResumable(ctx, args[0], fi, stageOpts...)
+			return
+		}
 		if fi.IsDir() {
-			cid, err := c.PowClient.Data.StageFolder(c.MustAuthCtx(ctx), viper.GetString("ipfsrevproxy"), args[0])
+			if c.ImportOptionsSet(cmd) && !cmd.Flags().Changed("cid-version") {
+				// Folders are imported with CIDv1 by default.
+				importOptions, err := c.ImportOptions(cmd)
+				c.CheckErr(err)
+				importOptions.CidVersion = 1
+				stageOpts = []client.StageOption{client.WithImportOptions(importOptions)}
+			}
+			cid, err := c.PowClient.Data.StageFolder(c.MustAuthCtx(ctx), viper.GetString("ipfsrevproxy"), args[0], stageOpts...)
 			c.CheckErr(err)
 			c.Success("Staged folder with cid: %s", cid)
 		} else {
 			f, err := os.Open(args[0])
 			c.CheckErr(err)
 			defer func() { c.CheckErr(f.Close()) }()
-			stageReader(ctx, f)
+			stageReader(ctx, f, stageOpts...)
 		}
 	},
 }
 
-func stageReader(ctx context.Context, reader io.Reader) {
-	res, err := c.PowClient.Data.Stage(c.MustAuthCtx(ctx), reader)
+func stageReader(ctx context.Context, reader io.Reader, opts ...client.StageOption) {
+	res, err := c.PowClient.Data.Stage(c.MustAuthCtx(ctx), reader, opts...)
+	c.CheckErr(err)
+
+	json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+	c.CheckErr(err)
+
+	fmt.Println(string(json))
+}
+
+func stageCarReader(ctx context.Context, reader io.Reader) {
+	res, err := c.PowClient.Data.StageCar(c.MustAuthCtx(ctx), reader)
 	c.CheckErr(err)
 
 	json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
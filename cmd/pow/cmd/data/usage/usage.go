@@ -0,0 +1,30 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "usage",
+	Short: "Returns the effective storage usage of the user",
+	Long:  `Returns the effective storage usage of the user, where data stored by multiple users is attributed in equal parts to each of them`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), c.CmdTimeout)
+		defer cancel()
+
+		res, err := c.PowClient.StorageInfo.Usage(c.MustAuthCtx(ctx))
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+	},
+}
@@ -0,0 +1,65 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/textileio/powergate/v2/api/client"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	Cmd.Flags().Bool("hot", true, "Verify the data in hot storage")
+	Cmd.Flags().Bool("cold", false, "Verify active deals retrieving data from their miners")
+	Cmd.Flags().Bool("full", false, "Retrieve the whole DAG from active deals instead of its top levels")
+	Cmd.Flags().Int("depth", 1, "Number of DAG levels below the root to retrieve if not retrieving the whole DAG")
+	Cmd.Flags().Uint64("maxprice", 0, "Max attoFil to pay for each retrieval, zero is no limit")
+	Cmd.Flags().Bool("repair", false, "Repair data which failed verification")
+	Cmd.Flags().BoolP("watch", "w", false, "Watch the progress of the resulting job")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "verify [cid]",
+	Short: "Verifies the integrity of stored data",
+	Long:  `Schedules a job which verifies the integrity of stored data, walking its DAG in hot storage and retrieving it from the miners of active deals. The results are saved in its storage info`,
+	Args:  cobra.ExactArgs(1),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
+		defer cancel()
+
+		var opts []client.VerifyDataOption
+		if viper.GetBool("hot") {
+			opts = append(opts, client.WithVerifyHot())
+		}
+		if viper.GetBool("cold") {
+			opts = append(opts, client.WithVerifyCold(viper.GetBool("full"), viper.GetInt("depth")))
+		}
+		if viper.IsSet("maxprice") {
+			opts = append(opts, client.WithVerifyMaxPrice(viper.GetUint64("maxprice")))
+		}
+		if viper.GetBool("repair") {
+			opts = append(opts, client.WithVerifyRepair())
+		}
+
+		res, err := c.PowClient.Data.VerifyData(c.MustAuthCtx(ctx), args[0], opts...)
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+
+		if viper.GetBool("watch") {
+			c.WatchJobIds(res.JobId)
+		}
+	},
+}
@@ -3,10 +3,13 @@ package storage
 import (
 	"context"
 	"fmt"
+	"strconv"
 
+	"github.com/filecoin-project/go-fil-markets/storagemarket"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/textileio/powergate/v2/api/client"
+	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
 	c "github.com/textileio/powergate/v2/cmd/pow/common"
 	"google.golang.org/protobuf/encoding/protojson"
 )
@@ -18,6 +21,13 @@ func init() {
 	Cmd.Flags().BoolP("include-pending", "p", false, "include pending deals")
 	Cmd.Flags().BoolP("include-final", "f", false, "include final deals")
 	Cmd.Flags().BoolP("include-failed", "e", false, "include failed deals")
+	Cmd.Flags().StringSlice("miners", []string{}, "limit the records to deals made with the specified miners")
+	Cmd.Flags().StringSlice("states", []string{}, "limit the records to deals in the specified states, by name (e.g: StorageDealActive) or id")
+	Cmd.Flags().Uint64("min-expiration", 0, "limit the records to deals expiring at or after the specified epoch")
+	Cmd.Flags().Uint64("max-expiration", 0, "limit the records to deals expiring at or before the specified epoch")
+	Cmd.Flags().String("sort-by", "time", "sort records by time or expiration")
+	Cmd.Flags().Int32("limit", 0, "maximum number of records to return, the output includes a cursor to fetch the next page")
+	Cmd.Flags().String("cursor", "", "continue listing from the cursor returned by a previous call")
 }
 
 // Cmd is the command.
@@ -54,6 +64,32 @@ var Cmd = &cobra.Command{
 			opts = append(opts, client.WithIncludeFailed(viper.GetBool("include-failed")))
 		}
 
+		if viper.IsSet("miners") {
+			opts = append(opts, client.WithMiners(viper.GetStringSlice("miners")...))
+		}
+		if viper.IsSet("states") {
+			states, err := parseDealStates(viper.GetStringSlice("states"))
+			c.CheckErr(err)
+			opts = append(opts, client.WithDealStates(states...))
+		}
+		if viper.IsSet("min-expiration") || viper.IsSet("max-expiration") {
+			opts = append(opts, client.WithExpirationRange(viper.GetUint64("min-expiration"), viper.GetUint64("max-expiration")))
+		}
+		switch viper.GetString("sort-by") {
+		case "time":
+			opts = append(opts, client.WithSortBy(userPb.DealRecordsSortBy_DEAL_RECORDS_SORT_BY_TIME))
+		case "expiration":
+			opts = append(opts, client.WithSortBy(userPb.DealRecordsSortBy_DEAL_RECORDS_SORT_BY_EXPIRATION))
+		default:
+			c.Fatal(fmt.Errorf("invalid --sort-by value %s, must be time or expiration", viper.GetString("sort-by")))
+		}
+		if viper.IsSet("limit") {
+			opts = append(opts, client.WithLimit(viper.GetInt32("limit")))
+		}
+		if viper.IsSet("cursor") {
+			opts = append(opts, client.WithCursor(viper.GetString("cursor")))
+		}
+
 		res, err := c.PowClient.Deals.StorageDealRecords(c.MustAuthCtx(ctx), opts...)
 		c.CheckErr(err)
 
@@ -63,3 +99,23 @@ var Cmd = &cobra.Command{
 		fmt.Println(string(json))
 	},
 }
+
+func parseDealStates(states []string) ([]uint64, error) {
+	ids := make(map[string]uint64, len(storagemarket.DealStates))
+	for id, name := range storagemarket.DealStates {
+		ids[name] = id
+	}
+	ret := make([]uint64, len(states))
+	for i, s := range states {
+		if id, ok := ids[s]; ok {
+			ret[i] = id
+			continue
+		}
+		id, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unknown deal state %s", s)
+		}
+		ret[i] = id
+	}
+	return ret, nil
+}
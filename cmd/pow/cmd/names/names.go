@@ -0,0 +1,26 @@
+package names
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/names/get"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/names/list"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/names/remove"
+	"github.com/textileio/powergate/v2/cmd/pow/cmd/names/set"
+)
+
+func init() {
+	Cmd.AddCommand(
+		get.Cmd,
+		list.Cmd,
+		remove.Cmd,
+		set.Cmd,
+	)
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:     "names",
+	Aliases: []string{"name"},
+	Short:   "Provides commands to manage named references to stored data",
+	Long:    `Provides commands to manage named references to stored data`,
+}
@@ -0,0 +1,55 @@
+package set
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/textileio/powergate/v2/api/client"
+	c "github.com/textileio/powergate/v2/cmd/pow/common"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func init() {
+	Cmd.Flags().Int("retention", 0, "Max number of previous versions kept stored in the history, zero is no limit")
+	Cmd.Flags().Bool("publish", false, "Publish the named reference to IPNS")
+	Cmd.Flags().BoolP("watch", "w", false, "Watch the progress of the resulting job")
+}
+
+// Cmd is the command.
+var Cmd = &cobra.Command{
+	Use:   "set [name] [cid]",
+	Short: "Creates or updates a named reference to a cid",
+	Long:  `Creates or updates a named reference to a cid. A new named reference must point to a stored cid. Updating a named reference stores the new cid with the storage config of the current one, and saves the previous version in its history. Versions in the history are kept stored until they're trimmed by the retention`,
+	Args:  cobra.ExactArgs(2),
+	PreRun: func(cmd *cobra.Command, args []string) {
+		err := viper.BindPFlags(cmd.Flags())
+		c.CheckErr(err)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*60)
+		defer cancel()
+
+		var opts []client.SetNameOption
+		if cmd.Flags().Changed("retention") {
+			opts = append(opts, client.WithNameRetention(viper.GetInt("retention")))
+		}
+		if cmd.Flags().Changed("publish") {
+			opts = append(opts, client.WithNamePublish(viper.GetBool("publish")))
+		}
+
+		res, err := c.PowClient.Names.Set(c.MustAuthCtx(ctx), args[0], args[1], opts...)
+		c.CheckErr(err)
+
+		json, err := protojson.MarshalOptions{Multiline: true, Indent: "  ", EmitUnpopulated: true}.Marshal(res)
+		c.CheckErr(err)
+
+		fmt.Println(string(json))
+
+		if viper.GetBool("watch") && res.JobId != "" {
+			c.WatchJobIds(res.JobId)
+		}
+	},
+}
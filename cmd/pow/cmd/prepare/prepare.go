@@ -28,6 +28,7 @@ import (
 	httpapi "github.com/ipfs/go-ipfs-http-client"
 	ipld "github.com/ipfs/go-ipld-format"
 	dag "github.com/ipfs/go-merkledag"
+	"github.com/ipfs/interface-go-ipfs-core/options"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -42,6 +43,7 @@ func init() {
 	prepare.Flags().String("ipfs-api", "", "IPFS HTTP API multiaddress that stores the cid (only for Cid processing instead of file/folder path)")
 	prepare.Flags().Bool("json", false, "avoid pretty output and use json formatting")
 	prepare.Flags().Bool("aggregate", false, "aggregates a folder of files")
+	c.AddImportOptionsFlags(prepare.Flags())
 
 	commp.Flags().Bool("json", false, "avoid pretty output and use json formatting")
 	commp.Flags().Bool("skip-car-validation", false, "skips CAR validation when processing a path")
@@ -50,6 +52,7 @@ func init() {
 	genCar.Flags().String("ipfs-api", "", "IPFS HTTP API multiaddress that stores the cid (only for Cid processing instead of file/folder path)")
 	genCar.Flags().Bool("quiet", false, "avoid pretty output")
 	genCar.Flags().Bool("aggregate", false, "aggregates a folder of files")
+	c.AddImportOptionsFlags(genCar.Flags())
 }
 
 // Cmd is the command.
@@ -330,11 +333,20 @@ func prepareDAGService(cmd *cobra.Command, args []string, quiet bool) (cid.Cid,
 			return cid.Undef, nil, nil, nil, fmt.Errorf("getting tmpdir directory: %s", err)
 		}
 
+		importOptions, err := c.ImportOptions(cmd)
+		if err != nil {
+			return cid.Undef, nil, nil, nil, fmt.Errorf("getting import options: %s", err)
+		}
+		addOpts, err := importOptions.UnixfsAddOptions()
+		if err != nil {
+			return cid.Undef, nil, nil, nil, fmt.Errorf("parsing import options: %s", err)
+		}
+
 		dagService, cls, err := createTmpDAGService(tmpDir)
 		if err != nil {
 			return cid.Undef, nil, nil, nil, fmt.Errorf("creating temporary dag-service: %s", err)
 		}
-		dataCid, aggregatedFiles, err := dagify(context.Background(), dagService, path, quiet, aggregate)
+		dataCid, aggregatedFiles, err := dagify(context.Background(), dagService, path, quiet, aggregate, addOpts)
 		if err != nil {
 			return cid.Undef, nil, nil, nil, fmt.Errorf("creating dag for data: %s", err)
 		}
@@ -406,7 +418,7 @@ func printJSONResult(pieceSize uint64, payloadCid, pieceCID cid.Cid, aggrFiles [
 	fmt.Fprint(jsonOutput, string(out))
 }
 
-func dagify(ctx context.Context, dagService ipld.DAGService, path string, quiet bool, aggregate bool) (cid.Cid, []aggregatedFile, error) {
+func dagify(ctx context.Context, dagService ipld.DAGService, path string, quiet bool, aggregate bool, addOpts []options.UnixfsAddOption) (cid.Cid, []aggregatedFile, error) {
 	var progressChan chan int64
 	if !quiet {
 		f, err := os.Open(path)
@@ -460,7 +472,7 @@ func dagify(ctx context.Context, dagService ipld.DAGService, path string, quiet
 	var dataCid cid.Cid
 	var err error
 	if !aggregate {
-		dataCid, err = dataprep.Dagify(ctx, dagService, path, progressChan)
+		dataCid, err = dataprep.Dagify(ctx, dagService, path, progressChan, addOpts...)
 		if err != nil {
 			return cid.Undef, nil, fmt.Errorf("creating dag for data: %s", err)
 		}
@@ -473,7 +485,7 @@ func dagify(ctx context.Context, dagService ipld.DAGService, path string, quiet
 			if info.IsDir() {
 				return nil
 			}
-			dcid, err := dataprep.Dagify(ctx, dagService, p, progressChan)
+			dcid, err := dataprep.Dagify(ctx, dagService, p, progressChan, addOpts...)
 			if err != nil {
 				return err
 			}
@@ -3,11 +3,13 @@ package prepare
 import (
 	"bytes"
 	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strconv"
 	"testing"
 
+	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/require"
 )
 
@@ -37,7 +39,35 @@ func TestOfflinePreparation(t *testing.T) {
 	}
 }
 
-func run(t *testing.T, size int) (string, error) {
+// TestOfflinePreparationImportOptions checks that data is DAGified with the
+// same Cid that go-ipfs generates when adding it with the same options.
+func TestOfflinePreparationImportOptions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		flags      []string
+		payloadCid string
+	}{
+		{name: "cidv1", flags: []string{"--cid-version", "1"}, payloadCid: "bafkreiefov6z55mgro2ti4vgx2gydupdyomfi23jwedrigwtgyctyqglkq"},
+		{name: "raw-leaves", flags: []string{"--chunker", "size-1024", "--raw-leaves"}, payloadCid: "Qmeg4u2fnqrX4FRhp8Ww3V2KLiRpqs53ohNbukEMj8Abng"},
+		{name: "trickle", flags: []string{"--chunker", "rabin-512-1024-2048", "--layout", "trickle"}, payloadCid: "QmbHy4NCZGpvcufMsF4CmC9c7VSTrskaRd77EmQ6n9P6Vb"},
+		{name: "blake2b", flags: []string{"--hash", "blake2b-256", "--raw-leaves=false"}, payloadCid: "bafykbzacedillvcs7udjjqv6mhfk4btzmwjxo7qaibtyprztqjzyanxcj7kec"},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			out, err := run(t, 10000, test.flags...)
+			require.NoError(t, err)
+			var res struct {
+				PayloadCid string `json:"payload_cid"`
+			}
+			require.NoError(t, json.Unmarshal([]byte(out), &res))
+			require.Equal(t, test.payloadCid, res.PayloadCid)
+		})
+	}
+}
+
+func run(t *testing.T, size int, flags ...string) (string, error) {
 	f, err := os.CreateTemp("", "preparetest")
 	if err != nil {
 		return "", fmt.Errorf("creating temp file: %s", err)
@@ -58,7 +88,12 @@ func run(t *testing.T, size int) (string, error) {
 
 	stdbuf := bytes.NewBuffer(nil)
 	jsonOutput = stdbuf
-	Cmd.SetArgs([]string{"prepare", "--json", f.Name()})
+	Cmd.SetArgs(append(append([]string{"prepare", "--json"}, flags...), f.Name()))
+	// Flags keep their values between executions.
+	defer prepare.Flags().VisitAll(func(f *pflag.Flag) {
+		_ = f.Value.Set(f.DefValue)
+		f.Changed = false
+	})
 
 	if _, err := Cmd.ExecuteC(); err != nil {
 		return "", fmt.Errorf("executing command: %s", err)
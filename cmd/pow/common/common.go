@@ -16,9 +16,12 @@ import (
 	"github.com/apoorvam/goterminal"
 	"github.com/logrusorgru/aurora"
 	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	"github.com/textileio/powergate/v2/api/client"
 	userPb "github.com/textileio/powergate/v2/api/gen/powergate/user/v1"
+	"github.com/textileio/powergate/v2/importopts"
 )
 
 var (
@@ -76,6 +79,56 @@ func CheckErr(e error) {
 	}
 }
 
+// AddImportOptionsFlags adds the flags of UnixFS import options, which
+// determine the Cid of imported data.
+func AddImportOptionsFlags(flags *pflag.FlagSet) {
+	flags.String("chunker", "size-262144", "UnixFS chunking algorithm, size-<bytes> or rabin-<min>-<avg>-<max>")
+	flags.Int("cid-version", 0, "CID version, 0 or 1")
+	flags.Bool("raw-leaves", false, "use raw blocks for leaf nodes (default enabled only for CIDv1)")
+	flags.String("layout", importopts.LayoutBalanced, "DAG layout, balanced or trickle")
+	flags.String("hash", "sha2-256", "hash function")
+}
+
+// ImportOptionsSet returns true if any flag added by AddImportOptionsFlags
+// was set explicitly.
+func ImportOptionsSet(cmd *cobra.Command) bool {
+	for _, name := range []string{"chunker", "cid-version", "raw-leaves", "layout", "hash"} {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ImportOptions returns the UnixFS import options of the flags
+// added by AddImportOptionsFlags.
+func ImportOptions(cmd *cobra.Command) (importopts.Options, error) {
+	var (
+		opts importopts.Options
+		err  error
+	)
+	if opts.Chunker, err = cmd.Flags().GetString("chunker"); err != nil {
+		return importopts.Options{}, fmt.Errorf("getting chunker flag: %s", err)
+	}
+	if opts.CidVersion, err = cmd.Flags().GetInt("cid-version"); err != nil {
+		return importopts.Options{}, fmt.Errorf("getting cid-version flag: %s", err)
+	}
+	if opts.RawLeaves, err = cmd.Flags().GetBool("raw-leaves"); err != nil {
+		return importopts.Options{}, fmt.Errorf("getting raw-leaves flag: %s", err)
+	}
+	opts.RawLeavesSet = cmd.Flags().Changed("raw-leaves")
+	if opts.Layout, err = cmd.Flags().GetString("layout"); err != nil {
+		return importopts.Options{}, fmt.Errorf("getting layout flag: %s", err)
+	}
+	if opts.HashFunction, err = cmd.Flags().GetString("hash"); err != nil {
+		return importopts.Options{}, fmt.Errorf("getting hash flag: %s", err)
+	}
+	if _, err := opts.UnixfsAddOptions(); err != nil {
+		return importopts.Options{}, err
+	}
+	return opts, nil
+}
+
 // WatchJobIds watches jobs.
 func WatchJobIds(jobIds ...string) {
 	state := make(map[string]*client.WatchStorageJobsEvent, len(jobIds))
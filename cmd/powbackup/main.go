@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	badger "github.com/ipfs/go-ds-badger2"
+	logger "github.com/ipfs/go-log/v2"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	mongods "github.com/textileio/go-ds-mongo"
+	"github.com/textileio/powergate/v2/api/server"
+	"github.com/textileio/powergate/v2/backup"
+	"github.com/textileio/powergate/v2/buildinfo"
+	"github.com/textileio/powergate/v2/pgds"
+)
+
+var (
+	log    = logger.Logger("powbackup")
+	config = viper.New()
+)
+
+func main() {
+	logger.SetAllLoggers(logger.LevelInfo)
+	log.Infof("starting powbackup:\n%s", buildinfo.Summary())
+
+	if err := wireFlagsAndEnvs(); err != nil {
+		log.Fatalf("wiring flags/envs: %s", err)
+	}
+	if pflag.NArg() != 1 {
+		log.Fatalf("expected a single command: export, restore or verify")
+	}
+	file := config.GetString("file")
+	if file == "" {
+		log.Fatalf("archive file path is empty")
+	}
+
+	var (
+		s   backup.Summary
+		err error
+	)
+	switch cmd := pflag.Arg(0); cmd {
+	case "export":
+		s, err = runExport(file)
+	case "restore":
+		s, err = runRestore(file, config.GetBool("overwrite"))
+	case "verify":
+		s, err = runVerify(file)
+	default:
+		log.Fatalf("unknown command %s", cmd)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Infof("Archive format version %d, datastore version %d, created at %s", s.FormatVersion, s.DatastoreVersion, s.CreatedAt.Format(time.RFC3339))
+	log.Infof("%d entries with checksum %s", s.Entries, s.Checksum)
+}
+
+func wireFlagsAndEnvs() error {
+	pflag.String("mongouri", "", "MongoDB URI")
+	pflag.String("mongodb", "", "MongoDB database name")
+	pflag.String("mongocollection", "kvstore", "MongoDB collection name")
+	pflag.String("postgresuri", "", "PostgreSQL URI")
+	pflag.String("badgerrepo", "", "Badger Repo")
+	pflag.String("file", "", "Path of the backup archive")
+	pflag.Bool("overwrite", false, "Allow restoring into a non-empty datastore")
+	pflag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: powbackup [flags] export|restore|verify\n")
+		pflag.PrintDefaults()
+	}
+	config.SetEnvPrefix("POWBACKUP")
+	config.AutomaticEnv()
+	pflag.Parse()
+	if err := config.BindPFlags(pflag.CommandLine); err != nil {
+		return fmt.Errorf("binding flags: %s", err)
+	}
+	return nil
+}
+
+func runExport(file string) (backup.Summary, error) {
+	ds, err := openDatastore()
+	if err != nil {
+		return backup.Summary{}, err
+	}
+	defer closeDatastore(ds)
+
+	f, err := os.Create(file)
+	if err != nil {
+		return backup.Summary{}, fmt.Errorf("creating archive file: %s", err)
+	}
+	s, err := backup.Export(context.Background(), ds, f)
+	if err != nil {
+		_ = f.Close()
+		return backup.Summary{}, fmt.Errorf("exporting datastore: %s", err)
+	}
+	if err := f.Close(); err != nil {
+		return backup.Summary{}, fmt.Errorf("closing archive file: %s", err)
+	}
+	return s, nil
+}
+
+func runRestore(file string, overwrite bool) (backup.Summary, error) {
+	// Verify the archive before touching the target datastore, so
+	// corrupted archives don't leave partially restored datastores.
+	if _, err := runVerify(file); err != nil {
+		return backup.Summary{}, err
+	}
+
+	ds, err := openDatastore()
+	if err != nil {
+		return backup.Summary{}, err
+	}
+	defer closeDatastore(ds)
+
+	f, err := os.Open(file)
+	if err != nil {
+		return backup.Summary{}, fmt.Errorf("opening archive file: %s", err)
+	}
+	defer func() { _ = f.Close() }()
+	s, err := backup.Restore(context.Background(), f, ds, backup.WithOverwrite(overwrite), backup.WithMaxDatastoreVersion(server.LatestMigrationVersion()))
+	if err != nil {
+		return backup.Summary{}, fmt.Errorf("restoring datastore: %s", err)
+	}
+	return s, nil
+}
+
+func runVerify(file string) (backup.Summary, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return backup.Summary{}, fmt.Errorf("opening archive file: %s", err)
+	}
+	defer func() { _ = f.Close() }()
+	s, err := backup.Verify(f)
+	if err != nil {
+		return backup.Summary{}, fmt.Errorf("verifying archive: %s", err)
+	}
+	return s, nil
+}
+
+func openDatastore() (datastore.TxnDatastore, error) {
+	mongoURI := config.GetString("mongouri")
+	mongoDB := config.GetString("mongodb")
+	mongoCollection := config.GetString("mongocollection")
+	postgresURI := config.GetString("postgresuri")
+	badgerRepo := config.GetString("badgerrepo")
+
+	if postgresURI != "" {
+		log.Info("Opening Postgres database...")
+		pgCtx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+		opts := []pgds.Option{
+			pgds.WithOpTimeout(time.Hour),
+			pgds.WithTxnTimeout(time.Hour),
+		}
+		ds, err := pgds.New(pgCtx, postgresURI, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("opening postgres datastore: %s", err)
+		}
+		return ds, nil
+	}
+
+	if mongoURI != "" {
+		log.Info("Opening Mongo database...")
+		mongoCtx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+		if mongoDB == "" {
+			return nil, fmt.Errorf("mongo database name is empty")
+		}
+		opts := []mongods.Option{
+			mongods.WithCollName(mongoCollection),
+			mongods.WithOpTimeout(time.Hour),
+			mongods.WithTxnTimeout(time.Hour),
+		}
+		ds, err := mongods.New(mongoCtx, mongoURI, mongoDB, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("opening mongo datastore: %s", err)
+		}
+		return ds, nil
+	}
+
+	if badgerRepo == "" {
+		return nil, fmt.Errorf("either a mongo uri, postgres uri or badger repo is required")
+	}
+	log.Info("Opening badger database...")
+	if err := os.MkdirAll(badgerRepo, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("creating badger repo folder: %s", err)
+	}
+	opts := &badger.DefaultOptions
+	ds, err := badger.NewDatastore(badgerRepo, opts)
+	if err != nil {
+		return nil, fmt.Errorf("opening badger datastore: %s", err)
+	}
+	return ds, nil
+}
+
+func closeDatastore(ds datastore.TxnDatastore) {
+	if err := ds.Close(); err != nil {
+		log.Errorf("closing datastore: %s", err)
+	}
+}
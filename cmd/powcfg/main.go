@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"time"
 
 	"github.com/ipfs/go-datastore"
@@ -12,6 +14,8 @@ import (
 	"github.com/spf13/viper"
 	mongods "github.com/textileio/go-ds-mongo"
 	"github.com/textileio/powergate/v2/buildinfo"
+	"github.com/textileio/powergate/v2/ffs/cfgtransform"
+	"github.com/textileio/powergate/v2/pgds"
 )
 
 var (
@@ -30,14 +34,19 @@ func main() {
 	mongoURI := config.GetString("mongouri")
 	mongoDB := config.GetString("mongodb")
 	mongoCollection := config.GetString("mongocollection")
+	postgresURI := config.GetString("postgresuri")
 	badgerrepo := config.GetString("badgerrepo")
 	dryrun := config.GetBool("dryrun")
-	ds, err := createDatastore(mongoURI, mongoDB, mongoCollection, badgerrepo)
+	t, err := loadTransform()
+	if err != nil {
+		log.Fatalf("loading transformation: %s", err)
+	}
+	ds, err := createDatastore(mongoURI, mongoDB, mongoCollection, postgresURI, badgerrepo)
 	if err != nil {
 		log.Fatalf("opening datastore: %s", err)
 	}
 
-	count, err := applyTransform(ds, dryrun, bumpIpfsAddTimeout(480))
+	count, err := applyTransform(ds, dryrun, t)
 	if err != nil {
 		log.Fatalf("applying transformation: %s", err)
 	}
@@ -52,8 +61,14 @@ func wireFlagsAndEnvs() error {
 	pflag.String("mongouri", "", "MongoDB URI")
 	pflag.String("mongodb", "", "MongoDB database name")
 	pflag.String("mongocollection", "", "MongoDB collection name")
+	pflag.String("postgresuri", "", "PostgreSQL URI")
 	pflag.String("badgerrepo", "", "Badger Repo")
 	pflag.Bool("dryrun", false, "Avoid any write to the datastore")
+	pflag.String("spec", "", "Path of a JSON file with the transformation spec: {\"user_ids\": [], \"cids\": [], \"where\": [], \"set\": []}")
+	pflag.StringSlice("userids", nil, "Transform only storage configs of these user ids")
+	pflag.StringSlice("cids", nil, "Transform only storage configs of these cids")
+	pflag.StringArray("where", nil, "Condition that storage configs must satisfy to be transformed, e.g: cold.filecoin.maxPrice=0 (can be repeated)")
+	pflag.StringArray("set", nil, "Field assignment applied to transformed storage configs, e.g: hot.ipfs.addTimeout=480 (can be repeated)")
 	config.SetEnvPrefix("POWCFG")
 	config.AutomaticEnv()
 	pflag.Parse()
@@ -63,7 +78,45 @@ func wireFlagsAndEnvs() error {
 	return nil
 }
 
-func createDatastore(mongoURI, mongoDB, mongoCollection, badgerrepo string) (datastore.TxnDatastore, error) {
+// loadTransform compiles the transformation provided with --spec, or
+// built from --userids, --cids, --where and --set flags.
+func loadTransform() (*cfgtransform.Transform, error) {
+	var spec cfgtransform.Spec
+	if path := config.GetString("spec"); path != "" {
+		buf, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading spec file: %s", err)
+		}
+		if err := json.Unmarshal(buf, &spec); err != nil {
+			return nil, fmt.Errorf("parsing spec file: %s", err)
+		}
+	}
+	spec.UserIDs = append(spec.UserIDs, config.GetStringSlice("userids")...)
+	spec.Cids = append(spec.Cids, config.GetStringSlice("cids")...)
+	// StringArray flags aren't split by viper, so they're read from pflag.
+	where, _ := pflag.CommandLine.GetStringArray("where")
+	set, _ := pflag.CommandLine.GetStringArray("set")
+	spec.Where = append(spec.Where, where...)
+	spec.Set = append(spec.Set, set...)
+	return cfgtransform.Compile(spec)
+}
+
+func createDatastore(mongoURI, mongoDB, mongoCollection, postgresURI, badgerrepo string) (datastore.TxnDatastore, error) {
+	if postgresURI != "" {
+		log.Info("Opening Postgres database...")
+		pgCtx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+		opts := []pgds.Option{
+			pgds.WithOpTimeout(time.Hour),
+			pgds.WithTxnTimeout(time.Hour),
+		}
+		ds, err := pgds.New(pgCtx, postgresURI, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("opening postgres datastore: %s", err)
+		}
+		return ds, nil
+	}
+
 	if mongoURI != "" {
 		log.Info("Opening Mongo database...")
 		mongoCtx, cancel := context.WithTimeout(context.Background(), time.Second*10)
@@ -9,14 +9,20 @@ import (
 	"github.com/ipfs/go-datastore/query"
 	"github.com/textileio/powergate/v2/cmd/powcfg/ratelim"
 	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/cfgtransform"
+	"github.com/textileio/powergate/v2/ffs/scheduler"
+	txndstr "github.com/textileio/powergate/v2/txndstransform"
+	"github.com/textileio/powergate/v2/util"
 )
 
-// Transform is a function that applies a transformation to a StorageConfig.
-// The function should edit the received parameter directly, and return 'true'
-// if changes were made, or false otherwise.
-type Transform func(*ffs.StorageConfig) (bool, error)
+// applyTransform applies t to all selected storage configs, keeping the
+// scheduler repair and renewal tracking consistent with the new configs.
+func applyTransform(ds datastore.TxnDatastore, dryrun bool, t *cfgtransform.Transform) (int, error) {
+	tracker, err := scheduler.NewOfflineTracker(txndstr.Wrap(ds, "ffs/scheduler"))
+	if err != nil {
+		return 0, fmt.Errorf("creating scheduler tracker: %s", err)
+	}
 
-func applyTransform(ds datastore.TxnDatastore, dryrun bool, t Transform) (int, error) {
 	q := query.Query{Prefix: "/ffs/manager/api"}
 	res, err := ds.Query(q)
 	if err != nil {
@@ -43,12 +49,20 @@ func applyTransform(ds datastore.TxnDatastore, dryrun bool, t Transform) (int, e
 		// Key format: /ffs/manager/api/<api-id>/istore/storageconfig/<cid>
 		key := datastore.NewKey(r.Key)
 		parts := key.Namespaces()
-		if len(parts) < 6 {
+		if len(parts) != 7 {
 			continue
 		}
 		if parts[4] != "istore" || parts[5] != "cidstorageconfig" {
 			continue
 		}
+		iid := ffs.APIID(parts[3])
+		c, err := util.CidFromString(parts[6])
+		if err != nil {
+			return 0, fmt.Errorf("parsing cid from key %s: %s", key, err)
+		}
+		if !t.Selects(iid, c) {
+			continue
+		}
 
 		r := r
 		rl.Exec(func() error {
@@ -57,7 +71,7 @@ func applyTransform(ds datastore.TxnDatastore, dryrun bool, t Transform) (int, e
 				return fmt.Errorf("unmarshaling storage config: %s", err)
 			}
 
-			modified, err := t(&cfg)
+			modified, err := t.Apply(&cfg)
 			if err != nil {
 				return fmt.Errorf("applying transform: %s", err)
 			}
@@ -76,9 +90,22 @@ func applyTransform(ds datastore.TxnDatastore, dryrun bool, t Transform) (int, e
 			if err != nil {
 				return fmt.Errorf("marshaling storage config: %s", err)
 			}
-			if err := ds.Put(datastore.NewKey(r.Key), buf); err != nil {
+			// Save the config and its tracking state atomically, so
+			// a failure can't leave the scheduler with a stale config.
+			txn, err := ds.NewTransaction(false)
+			if err != nil {
+				return fmt.Errorf("creating transaction: %s", err)
+			}
+			defer txn.Discard()
+			if err := txn.Put(datastore.NewKey(r.Key), buf); err != nil {
 				return fmt.Errorf("put in datastore: %s", err)
 			}
+			if err := tracker.TrackTxn(txndstr.WrapTxn(txn, "ffs/scheduler"), iid, c, cfg); err != nil {
+				return fmt.Errorf("tracking storage config: %s", err)
+			}
+			if err := txn.Commit(); err != nil {
+				return fmt.Errorf("committing transaction: %s", err)
+			}
 
 			return nil
 		})
@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-datastore"
 	badger "github.com/ipfs/go-ds-badger2"
 	logger "github.com/ipfs/go-log/v2"
 	cp "github.com/otiai10/copy"
 	"github.com/stretchr/testify/require"
 	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/cfgtransform"
+	"github.com/textileio/powergate/v2/tests"
+	"github.com/textileio/powergate/v2/util"
 )
 
 func TestIpfsAddBump(t *testing.T) {
@@ -30,7 +34,12 @@ func TestIpfsAddBump(t *testing.T) {
 			require.NoError(t, err)
 			defer func() { require.NoError(t, ds.Close()) }()
 
-			modified, err := applyTransform(ds, dryRun, bumpIpfsAddTimeout(12345))
+			tr, err := cfgtransform.Compile(cfgtransform.Spec{
+				Where: []string{"hot.ipfs.addTimeout<12345"},
+				Set:   []string{"hot.ipfs.addTimeout=12345"},
+			})
+			require.NoError(t, err)
+			modified, err := applyTransform(ds, dryRun, tr)
 			require.NoError(t, err)
 
 			// Verify
@@ -51,3 +60,59 @@ func TestIpfsAddBump(t *testing.T) {
 		})
 	}
 }
+
+func TestTransformUpdatesTracking(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	iid1 := ffs.NewAPIID()
+	iid2 := ffs.NewAPIID()
+	c, _ := util.CidFromString("QmPewMLNYZS5ehyCRWnYpGMMvw5TJhLkHFkhYTNJBjnQZp")
+	cfg := ffs.StorageConfig{
+		Hot: ffs.HotConfig{Enabled: true, Ipfs: ffs.IpfsConfig{AddTimeout: 30}},
+		Cold: ffs.ColdConfig{
+			Enabled:  true,
+			Filecoin: ffs.FilConfig{RepFactor: 1, DealMinDuration: 518400, Addr: "f3addr"},
+		},
+	}
+	for _, iid := range []ffs.APIID{iid1, iid2} {
+		buf, err := json.Marshal(cfg)
+		require.NoError(t, err)
+		require.NoError(t, ds.Put(istoreKey(iid, c), buf))
+	}
+
+	tr, err := cfgtransform.Compile(cfgtransform.Spec{
+		UserIDs: []string{iid1.String()},
+		Where:   []string{"repairable=false"},
+		Set:     []string{"repairable=true"},
+	})
+	require.NoError(t, err)
+	modified, err := applyTransform(ds, false, tr)
+	require.NoError(t, err)
+	require.Equal(t, 1, modified)
+
+	var got ffs.StorageConfig
+	buf, err := ds.Get(istoreKey(iid1, c))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(buf, &got))
+	require.True(t, got.Repairable)
+	buf, err = ds.Get(istoreKey(iid2, c))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(buf, &got))
+	require.False(t, got.Repairable)
+
+	// The now repairable config is tracked by the scheduler.
+	buf, err = ds.Get(datastore.NewKey("/ffs/scheduler/tstore").ChildString(c.String()))
+	require.NoError(t, err)
+	var tracked []struct {
+		IID           ffs.APIID
+		StorageConfig ffs.StorageConfig
+	}
+	require.NoError(t, json.Unmarshal(buf, &tracked))
+	require.Len(t, tracked, 1)
+	require.Equal(t, iid1, tracked[0].IID)
+	require.True(t, tracked[0].StorageConfig.Repairable)
+}
+
+func istoreKey(iid ffs.APIID, c cid.Cid) datastore.Key {
+	return datastore.NewKey("/ffs/manager/api").ChildString(iid.String()).ChildString("istore/cidstorageconfig").ChildString(util.CidToString(c))
+}
@@ -65,12 +65,23 @@ func main() {
 	if confProtected.MongoURI != "" {
 		confProtected.MongoURI = "<hidden>"
 	}
+	if confProtected.PostgresURI != "" {
+		confProtected.PostgresURI = "<hidden>"
+	}
 	confJSON, err := json.MarshalIndent(confProtected, "", "  ")
 	if err != nil {
 		log.Fatalf("marshaling configuration: %s", err)
 	}
 	log.Infof("%s", confJSON)
 
+	// Run migration maintenance modes, if enabled.
+	if config.GetBool("migrationdryrun") || config.GetInt("migrationrevertto") >= 0 {
+		if err := runMigrationMode(conf); err != nil {
+			log.Fatalf("running migrations: %s", err)
+		}
+		return
+	}
+
 	// Start server.
 	log.Info("starting server...")
 	powd, err := server.NewServer(conf)
@@ -93,6 +104,35 @@ func main() {
 	log.Info("Closed")
 }
 
+// runMigrationMode reverts the datastore to --migrationrevertto version, or
+// reports the changes of doing so or upgrading it if --migrationdryrun is set.
+func runMigrationMode(conf server.Config) error {
+	version := config.GetInt("migrationrevertto")
+	if !config.GetBool("migrationdryrun") {
+		return server.RevertMigrations(conf, version)
+	}
+
+	if version < 0 {
+		version = server.LatestMigrationVersion()
+	}
+	reports, err := server.DryRunMigrations(conf, version)
+	if err != nil {
+		return err
+	}
+	if len(reports) == 0 {
+		log.Infof("Dry-run: no migrations needed")
+	}
+	for _, r := range reports {
+		action := "Upgrade"
+		if r.Down {
+			action = "Revert"
+		}
+		log.Infof("Dry-run: %s to version %d: %d added, %d modified, %d deleted keys", action, r.Version, r.Added, r.Modified, r.Deleted)
+	}
+	log.Warnf("Dry-run: No changes applied")
+	return nil
+}
+
 func configFromFlags() (server.Config, error) {
 	devnet := config.GetBool("devnet")
 
@@ -118,6 +158,15 @@ func configFromFlags() (server.Config, error) {
 
 	walletInitialFunds := *big.NewInt(config.GetInt64("walletinitialfund"))
 	ipfsAPIAddr := util.MustParseAddr(config.GetString("ipfsapiaddr"))
+	var ipfsExtraAPIAddrs []ma.Multiaddr
+	for _, addr := range config.GetStringSlice("ipfsextraapiaddrs") {
+		maddr, err := ma.NewMultiaddr(addr)
+		if err != nil {
+			return server.Config{}, fmt.Errorf("parsing ipfs extra api multiaddr: %s", err)
+		}
+		ipfsExtraAPIAddrs = append(ipfsExtraAPIAddrs, maddr)
+	}
+	ipfsHealthCheckFrequency := config.GetDuration("ipfshealthcheckfreq")
 	lotusMasterAddr := config.GetString("lotusmasteraddr")
 	lotusConnectionRetries := config.GetInt("lotusconnectionretries")
 	autocreateMasterAddr := config.GetBool("autocreatemasteraddr")
@@ -129,6 +178,7 @@ func configFromFlags() (server.Config, error) {
 	maxminddbfolder := config.GetString("maxminddbfolder")
 	mongoURI := config.GetString("mongouri")
 	mongoDB := config.GetString("mongodb")
+	postgresURI := config.GetString("postgresuri")
 	minerSelector := config.GetString("ffsminerselector")
 	minerSelectorParams := config.GetString("ffsminerselectorparams")
 	ffsAdminToken := config.GetString("ffsadmintoken")
@@ -139,6 +189,23 @@ func configFromFlags() (server.Config, error) {
 	ffsMaxParallelDealPreparing := config.GetInt("ffsmaxparalleldealpreparing")
 	ffsGCInterval := time.Minute * time.Duration(config.GetInt("ffsgcinterval"))
 	ffsGCStagedGracePeriod := time.Minute * time.Duration(config.GetInt("ffsgcstagedgraceperiod"))
+	ffsJobLogsMaxAge := config.GetDuration("ffsjoblogsmaxage")
+	ffsJobLogsMaxPerCid := config.GetInt("ffsjoblogsmaxpercid")
+	ffsJobLogsCompactionInterval := config.GetDuration("ffsjoblogscompactioninterval")
+	ffsExpiryAlertWindow := config.GetUint64("ffsexpiryalertwindow")
+	ffsExpiryAlertFrequency := config.GetDuration("ffsexpiryalertfreq")
+	ffsAuditFrequency := config.GetDuration("ffsauditfreq")
+	ffsHotCacheCapacity := config.GetInt64("ffshotcachecapacity")
+	ffsKeyStorePath := config.GetString("ffskeystorepath")
+	ffsHotCacheFrequency := config.GetDuration("ffshotcachefreq")
+	ffsHotReconcileFrequency := config.GetDuration("ffshotreconcilefreq")
+	ffsHotReconcileRepinTimeout := config.GetDuration("ffshotrepintimeout")
+	ffsHotReconcileUnpinUnknown := config.GetBool("ffshotreconcileunpinunknown")
+	ffsDedupAttachDeals := config.GetBool("ffsdedupattachdeals")
+	ffsMetricsFrequency := config.GetDuration("ffsmetricsfreq")
+	ffsMetricsMaxUsers := config.GetInt("ffsmetricsmaxusers")
+	ffsMetricsExpiringWindow := config.GetUint64("ffsmetricsexpiringwindow")
+	ffsUploadsTTL := config.GetDuration("ffsuploadsttl")
 	dealWatchPollDuration := time.Second * time.Duration(config.GetInt("dealwatchpollduration"))
 	askIndexQueryAskTimeout := time.Second * time.Duration(config.GetInt("askindexqueryasktimeout"))
 	askIndexRefreshInterval := time.Minute * time.Duration(config.GetInt("askindexrefreshinterval"))
@@ -157,6 +224,9 @@ func configFromFlags() (server.Config, error) {
 		RepoPath:           repoPath,
 		MaxMindDBFolder:    maxminddbfolder,
 
+		IpfsExtraAPIAddrs:        ipfsExtraAPIAddrs,
+		IpfsHealthCheckFrequency: ipfsHealthCheckFrequency,
+
 		LotusAddress:           lotusHost,
 		LotusAuthToken:         lotusToken,
 		LotusConnectionRetries: lotusConnectionRetries,
@@ -174,6 +244,8 @@ func configFromFlags() (server.Config, error) {
 		MongoURI: mongoURI,
 		MongoDB:  mongoDB,
 
+		PostgresURI: postgresURI,
+
 		FFSAdminToken:                ffsAdminToken,
 		FFSUseMasterAddr:             ffsUseMasterAddr,
 		FFSDealFinalityTimeout:       ffsDealWatchFinalityTimeout,
@@ -182,6 +254,23 @@ func configFromFlags() (server.Config, error) {
 		FFSMaxParallelDealPreparing:  ffsMaxParallelDealPreparing,
 		FFSGCAutomaticGCInterval:     ffsGCInterval,
 		FFSGCStageGracePeriod:        ffsGCStagedGracePeriod,
+		FFSJobLogsMaxAge:             ffsJobLogsMaxAge,
+		FFSJobLogsMaxPerCid:          ffsJobLogsMaxPerCid,
+		FFSJobLogsCompactionInterval: ffsJobLogsCompactionInterval,
+		FFSExpiryAlertWindow:         ffsExpiryAlertWindow,
+		FFSExpiryAlertFrequency:      ffsExpiryAlertFrequency,
+		FFSAuditFrequency:            ffsAuditFrequency,
+		FFSHotCacheCapacity:          ffsHotCacheCapacity,
+		FFSKeyStorePath:              ffsKeyStorePath,
+		FFSHotCacheFrequency:         ffsHotCacheFrequency,
+		FFSHotReconcileFrequency:     ffsHotReconcileFrequency,
+		FFSHotReconcileRepinTimeout:  ffsHotReconcileRepinTimeout,
+		FFSHotReconcileUnpinUnknown:  ffsHotReconcileUnpinUnknown,
+		FFSDedupAttachDeals:          ffsDedupAttachDeals,
+		FFSMetricsFrequency:          ffsMetricsFrequency,
+		FFSMetricsMaxUsers:           ffsMetricsMaxUsers,
+		FFSMetricsExpiringWindow:     ffsMetricsExpiringWindow,
+		FFSUploadsTTL:                ffsUploadsTTL,
 		AutocreateMasterAddr:         autocreateMasterAddr,
 		MinerSelector:                minerSelector,
 		MinerSelectorParams:          minerSelectorParams,
@@ -388,14 +477,17 @@ func setupFlags() error {
 	pflag.String("repopath", "~/.powergate", "Path of the repository where Powergate state will be saved.")
 	pflag.Bool("devnet", false, "Indicate that will be running on an ephemeral devnet. --repopath will be autocleaned on exit.")
 	pflag.String("ipfsapiaddr", "/ip4/127.0.0.1/tcp/5001", "IPFS API endpoint multiaddress. (Optional, only needed if FFS is used)")
+	pflag.StringSlice("ipfsextraapiaddrs", []string{}, "Extra IPFS API endpoint multiaddresses to shard Hot Storage data across.")
+	pflag.Duration("ipfshealthcheckfreq", time.Minute, "Frequency of IPFS nodes health checks, 0 disables them.")
 	pflag.String("maxminddbfolder", ".", "Path of the folder containing GeoLite2-City.mmdb.")
 
 	pflag.String("mongouri", "", "Mongo URI to connect to MongoDB database. (Optional: if empty, will use Badger).")
 	pflag.String("mongodb", "", "Mongo database name. (if --mongouri is used, is mandatory.")
+	pflag.String("postgresuri", "", "PostgreSQL URI to connect to a PostgreSQL database. (Optional: if empty, will use Badger or MongoDB).")
 
 	pflag.String("ffsadmintoken", "", "FFS admin token for authorized APIs. If empty, the APIs will be open to the public.")
 	pflag.Bool("ffsusemasteraddr", false, "Use the master address as the initial address for all new FFS instances instead of creating a new unique addess for each new FFS instance.")
-	pflag.String("ffsminerselector", "reputation", "Miner selector to be used by FFS: 'sr2', 'reputation'.")
+	pflag.String("ffsminerselector", "reputation", "Miner selector to be used by FFS: 'sr2', 'reputation', 'reputation-cost', 'composite'.")
 	pflag.String("ffsminerselectorparams", "", "Miner selector configuration parameter, depends on --ffsminerselector.")
 	pflag.String("ffsminimumpiecesize", "67108864", "Minimum piece size in bytes allowed to be stored in Filecoin.")
 	pflag.Duration("ffsretrievalnexteventtimeout", time.Hour, "Maximum amount of time to wait for the next retrieval event before erroring it.")
@@ -404,6 +496,23 @@ func setupFlags() error {
 	pflag.String("ffsmaxparalleldealpreparing", "2", "Max parallel deal preparing tasks.")
 	pflag.String("ffsgcinterval", "60", "Interval in minutes of Hot Storage GC for staged data; zero is never.")
 	pflag.String("ffsgcstagedgraceperiod", "60", "Duration in minutes where a staged Cid will be considered GCable if scheduled in a Job.")
+	pflag.Duration("ffsjoblogsmaxage", 0, "Maximum age of job log entries before being pruned; zero is never.")
+	pflag.Int("ffsjoblogsmaxpercid", 0, "Maximum number of job log entries kept per Cid, older entries are pruned; zero is unlimited.")
+	pflag.Duration("ffsjoblogscompactioninterval", time.Hour, "Interval of job logs compaction enforcing --ffsjoblogsmaxage and --ffsjoblogsmaxpercid.")
+	pflag.Uint64("ffsexpiryalertwindow", 0, "Amount of epochs to forecast deal expirations, logging an alert for Cids whose active deals would drop below their replication factor; zero is disabled.")
+	pflag.Duration("ffsexpiryalertfreq", time.Hour*24, "Frequency of deal expiration forecast evaluations for --ffsexpiryalertwindow.")
+	pflag.Duration("ffsauditfreq", time.Hour*24, "Frequency of audits reconciling recorded deals with on-chain state; zero is disabled.")
+	pflag.Int64("ffshotcachecapacity", 0, "Maximum bytes stored in Hot Storage before evicting least-recently accessed cached Cids; zero only evicts expired cached Cids.")
+	pflag.Duration("ffshotcachefreq", time.Minute*10, "Frequency of evictions of cached Cids from Hot Storage; zero is disabled.")
+	pflag.Duration("ffshotreconcilefreq", time.Hour*24, "Frequency of reconciliations of Hot Storage pins with the IPFS node pins; zero is disabled.")
+	pflag.Duration("ffshotrepintimeout", time.Minute, "Timeout for re-pinning each Cid missing in the IPFS node from the IPFS network during reconciliations.")
+	pflag.Bool("ffshotreconcileunpinunknown", false, "Unpin IPFS node pins unknown to Powergate during reconciliations.")
+	pflag.String("ffskeystorepath", "", "Folder of the keystore with the keys of Cold Storage encryption, which isn't included in datastore backups; if empty, the keystore folder of the repo path is used.")
+	pflag.Bool("ffsdedupattachdeals", false, "Attach active deals of other users storing the same data, instead of making new deals, if they satisfy the user storage config.")
+	pflag.Duration("ffsmetricsfreq", time.Minute*5, "Frequency of FFS state metrics refreshes; zero is disabled.")
+	pflag.Int("ffsmetricsmaxusers", 20, "Maximum number of users labeled individually in FFS state metrics, ranked by stored cids; remaining users are labeled as 'other'.")
+	pflag.Uint64("ffsmetricsexpiringwindow", 2880*7, "Amount of epochs in which deals are considered expiring soon in FFS state metrics.")
+	pflag.Duration("ffsuploadsttl", time.Hour*24, "Time since the last received chunk after which unfinished resumable uploads are deleted.")
 	pflag.String("dealwatchpollduration", "900", "Poll interval in seconds used by Deals Module watch to detect state changes.")
 
 	pflag.String("askindexqueryasktimeout", "15", "Timeout in seconds for a query ask.")
@@ -418,6 +527,9 @@ func setupFlags() error {
 	pflag.Bool("disableindices", false, "Disable all indices updates, useful to help Lotus syncing process.")
 	pflag.Bool("disablenoncompliantapis", false, "Disable APIs that may not easily comply with US law.")
 
+	pflag.Bool("migrationdryrun", false, "Report the datastore changes of pending migrations, or of --migrationrevertto, without applying them, and exit.")
+	pflag.Int("migrationrevertto", -1, "Revert the datastore to this migration version and exit; negative is disabled.")
+
 	pflag.Parse()
 
 	config.SetEnvPrefix("POWD")
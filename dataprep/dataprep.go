@@ -13,6 +13,7 @@ import (
 	"github.com/ipfs/go-ipfs/core/coreunix"
 	ipld "github.com/ipfs/go-ipld-format"
 	coreiface "github.com/ipfs/interface-go-ipfs-core"
+	"github.com/ipfs/interface-go-ipfs-core/options"
 )
 
 // CommP calculates the piece cid and size from an io.Reader.
@@ -35,14 +36,24 @@ func CommP(r io.Reader) (cid.Cid, uint64, error) {
 	return pieceCid, pieceSize, nil
 }
 
-// Dagify creates a UnixFS DAG from the provided file.
-func Dagify(ctx context.Context, dagService ipld.DAGService, path string, progressBytes chan<- int64) (cid.Cid, error) {
+// Dagify creates a UnixFS DAG from the provided file with the provided import options.
+// The DAG is built in the same way as go-ipfs does when adding data, so equal options
+// result in the same Cid.
+func Dagify(ctx context.Context, dagService ipld.DAGService, path string, progressBytes chan<- int64, opts ...options.UnixfsAddOption) (cid.Cid, error) {
+	settings, prefix, err := options.UnixfsAddOptions(opts...)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("parsing import options: %s", err)
+	}
 	fileAdder, err := coreunix.NewAdder(ctx, nil, nil, dagService)
 	if err != nil {
 		return cid.Undef, fmt.Errorf("creating unixfs adder: %s", err)
 	}
 	fileAdder.Pin = false
 	fileAdder.Progress = true
+	fileAdder.Chunker = settings.Chunker
+	fileAdder.RawLeaves = settings.RawLeaves
+	fileAdder.CidBuilder = prefix
+	fileAdder.Trickle = settings.Layout == options.TrickleLayout
 	events := make(chan interface{}, 10)
 	fileAdder.Out = events
 
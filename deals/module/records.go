@@ -31,62 +31,12 @@ func (m *Module) ListStorageDealRecords(opts ...deals.DealRecordsOption) ([]deal
 		return nil, fmt.Errorf("you must specify one or both options of IncludePending and IncludeFinal")
 	}
 
-	var final []deals.StorageDealRecord
-	if c.IncludeFinal {
-		recs, err := m.store.GetFinalStorageDeals()
-		if err != nil {
-			return nil, fmt.Errorf("getting final deals: %v", err)
-		}
-		final = recs
-	}
-
-	var pending []deals.StorageDealRecord
-	if c.IncludePending {
-		recs, err := m.store.GetPendingStorageDeals()
-		if err != nil {
-			return nil, fmt.Errorf("getting pending deals: %v", err)
-		}
-		pending = recs
-	}
-
-	combined := append(final, pending...)
-
-	var filtered []deals.StorageDealRecord
-
-	if len(c.FromAddrs) > 0 || len(c.DataCids) > 0 || !c.IncludeFailed {
-		fromAddrsFilter := make(map[string]struct{})
-		dataCidsFilter := make(map[string]struct{})
-		for _, addr := range c.FromAddrs {
-			fromAddrsFilter[addr] = struct{}{}
-		}
-		for _, cid := range c.DataCids {
-			dataCidsFilter[cid] = struct{}{}
-		}
-		for _, record := range combined {
-			_, inFromAddrsFilter := fromAddrsFilter[record.Addr]
-			_, inDataCidsFilter := dataCidsFilter[util.CidToString(record.RootCid)]
-			includeViaFromAddrs := len(c.FromAddrs) == 0 || inFromAddrsFilter
-			includeViaDataCids := len(c.DataCids) == 0 || inDataCidsFilter
-			includeViaIncludeFailed := !c.IncludeFailed || record.ErrMsg != ""
-			if includeViaFromAddrs && includeViaDataCids && includeViaIncludeFailed {
-				filtered = append(filtered, record)
-			}
-		}
-	} else {
-		filtered = combined
+	recs, err := m.store.QueryStorageDeals(c)
+	if err != nil {
+		return nil, fmt.Errorf("querying storage deals: %v", err)
 	}
 
-	sort.Slice(filtered, func(i, j int) bool {
-		l := filtered[j]
-		r := filtered[i]
-		if c.Ascending {
-			l = filtered[i]
-			r = filtered[j]
-		}
-		return l.Time < r.Time
-	})
-
-	return filtered, nil
+	return recs, nil
 }
 
 // ListRetrievalDealRecords returns a list of retrieval deals according to the provided options.
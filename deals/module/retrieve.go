@@ -14,26 +14,33 @@ import (
 	"github.com/filecoin-project/go-address"
 	"github.com/filecoin-project/go-fil-markets/retrievalmarket"
 	"github.com/filecoin-project/lotus/api"
+	"github.com/filecoin-project/lotus/chain/types"
 	marketevents "github.com/filecoin-project/lotus/markets/loggers"
 	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/v2/lotus"
 )
 
 var (
 	// ErrRetrievalNoAvailableProviders indicates that the data isn't available on any provided
 	// to be retrieved.
 	ErrRetrievalNoAvailableProviders = errors.New("no providers to retrieve the data")
+	// ErrRetrievalSelectorUnsupported indicates that retrievals restricted
+	// by a selector aren't configured.
+	ErrRetrievalSelectorUnsupported = errors.New("retrieval selectors aren't supported")
 )
 
 // Fetch fetches deal data to the underlying blockstore of the Filecoin client.
 // This API is meant for clients that use external implementations of blockstores with
-// their own API, e.g: IPFS.
-func (m *Module) Fetch(ctx context.Context, waddr string, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string) (string, <-chan marketevents.RetrievalEvent, error) {
+// their own API, e.g: IPFS. Offers from miners with a price greater than maxPrice
+// attoFil aren't considered, unless maxPrice is zero. If selector isn't empty,
+// only the blocks matched by the dag-json encoded selector are fetched.
+func (m *Module) Fetch(ctx context.Context, waddr string, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string, maxPrice uint64, selector string) (string, <-chan marketevents.RetrievalEvent, error) {
 	lapi, cls, err := m.clientBuilder(ctx)
 	if err != nil {
 		return "", nil, fmt.Errorf("creating lotus client: %s", err)
 	}
 
-	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, pieceCid, miners, nil)
+	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, pieceCid, miners, maxPrice, selector, nil)
 	if err != nil {
 		return "", nil, err
 	}
@@ -56,7 +63,7 @@ func (m *Module) Retrieve(ctx context.Context, waddr string, payloadCid cid.Cid,
 	if err != nil {
 		return "", nil, fmt.Errorf("creating lotus client: %s", err)
 	}
-	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, pieceCid, miners, &ref)
+	miner, events, err := m.retrieve(ctx, lapi, cls, waddr, payloadCid, pieceCid, miners, 0, "", &ref)
 	if err != nil {
 		return "", nil, fmt.Errorf("retrieving from lotus: %s", err)
 	}
@@ -73,14 +80,40 @@ func (m *Module) Retrieve(ctx context.Context, waddr string, payloadCid cid.Cid,
 	return miner, &autodeleteFile{File: f}, nil
 }
 
-func (m *Module) retrieve(ctx context.Context, lapi *api.FullNodeStruct, lapiCls func(), waddr string, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string, ref *api.FileRef) (string, <-chan marketevents.RetrievalEvent, error) {
+func (m *Module) retrieve(ctx context.Context, lapi *api.FullNodeStruct, lapiCls func(), waddr string, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string, maxPrice uint64, selector string, ref *api.FileRef) (string, <-chan marketevents.RetrievalEvent, error) {
 	addr, err := address.NewFromString(waddr)
 	if err != nil {
+		lapiCls()
 		return "", nil, fmt.Errorf("parsing wallet address: %s", err)
 	}
 
-	sortedOffers := getRetrievalOffers(ctx, lapi, payloadCid, pieceCid, miners)
+	retrieve := func(o api.QueryOffer) (<-chan marketevents.RetrievalEvent, error) {
+		return lapi.ClientRetrieveWithEvents(ctx, o.Order(addr), ref)
+	}
+	if selector != "" {
+		if m.cfg.RetrievalClientBuilder == nil {
+			lapiCls()
+			return "", nil, ErrRetrievalSelectorUnsupported
+		}
+		rc, rcCls, err := m.cfg.RetrievalClientBuilder(ctx)
+		if err != nil {
+			lapiCls()
+			return "", nil, fmt.Errorf("creating lotus retrieval client: %s", err)
+		}
+		cls := lapiCls
+		lapiCls = func() {
+			rcCls()
+			cls()
+		}
+		retrieve = func(o api.QueryOffer) (<-chan marketevents.RetrievalEvent, error) {
+			order := lotus.RetrievalOrder{RetrievalOrder: o.Order(addr), DataSelector: &selector}
+			return rc.ClientRetrieveWithEvents(ctx, order, ref)
+		}
+	}
+
+	sortedOffers := getRetrievalOffers(ctx, lapi, payloadCid, pieceCid, miners, maxPrice)
 	if len(sortedOffers) == 0 {
+		lapiCls()
 		return "", nil, ErrRetrievalNoAvailableProviders
 	}
 
@@ -90,7 +123,7 @@ func (m *Module) retrieve(ctx context.Context, lapi *api.FullNodeStruct, lapiCls
 	// find one accepting providing the data.
 	var o api.QueryOffer
 	for _, o = range sortedOffers {
-		events, err = lapi.ClientRetrieveWithEvents(ctx, o.Order(addr), ref)
+		events, err = retrieve(o)
 		if err != nil {
 			log.Infof("fetching/retrieving cid %s from %s: %s", payloadCid, o.Miner, err)
 			continue
@@ -167,7 +200,7 @@ func (m *Module) retrieve(ctx context.Context, lapi *api.FullNodeStruct, lapiCls
 	return o.MinerPeer.Address.String(), out, nil
 }
 
-func getRetrievalOffers(ctx context.Context, lapi *api.FullNodeStruct, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string) []api.QueryOffer {
+func getRetrievalOffers(ctx context.Context, lapi *api.FullNodeStruct, payloadCid cid.Cid, pieceCid *cid.Cid, miners []string, maxPrice uint64) []api.QueryOffer {
 	// Ask each miner about costs and information about retrieving this data.
 	var offers []api.QueryOffer
 	for _, mi := range miners {
@@ -180,6 +213,10 @@ func getRetrievalOffers(ctx context.Context, lapi *api.FullNodeStruct, payloadCi
 			log.Infof("asking miner %s query-offer failed: %s", a, err)
 			continue
 		}
+		if maxPrice > 0 && qo.MinPrice.GreaterThan(types.NewInt(maxPrice)) {
+			log.Infof("miner %s query-offer price %s exceeds max price %d", a, qo.MinPrice, maxPrice)
+			continue
+		}
 		offers = append(offers, qo)
 	}
 
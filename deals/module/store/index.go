@@ -0,0 +1,369 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/util"
+)
+
+var (
+	// Storage deal records secondary indexes. Every index entry key ends
+	// with the record proposal cid, and its value is the record key.
+	dsStorageIdx           = datastore.NewKey("storageidx")
+	dsStorageIdxMiner      = dsStorageIdx.ChildString("miner")
+	dsStorageIdxPayload    = dsStorageIdx.ChildString("payload")
+	dsStorageIdxState      = dsStorageIdx.ChildString("state")
+	dsStorageIdxAddr       = dsStorageIdx.ChildString("addr")
+	dsStorageIdxTime       = dsStorageIdx.ChildString("time")
+	dsStorageIdxExpiration = dsStorageIdx.ChildString("expiration")
+)
+
+// QueryStorageDeals returns the storage deal records matching c. Set filters
+// are resolved with secondary indexes, so only matching records are loaded.
+func (s *Store) QueryStorageDeals(c deals.DealRecordsConfig) ([]deals.StorageDealRecord, error) {
+	if c.Limit < 0 {
+		return nil, fmt.Errorf("limit is negative")
+	}
+	cur := &cursor{}
+	if c.Cursor != "" {
+		v, pc, err := deals.ParseStorageDealRecordsCursor(c.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("parsing cursor: %s", err)
+		}
+		cur = &cursor{value: v, proposalCid: pc, set: true}
+	}
+
+	candidates, err := s.queryCandidates(c)
+	if err != nil {
+		return nil, err
+	}
+	if candidates == nil {
+		return s.queryBySortIndex(c, cur)
+	}
+
+	var res []sortedRecord
+	for _, recordKey := range candidates {
+		r, ok, err := s.getStorageDeal(recordKey)
+		if err != nil {
+			return nil, err
+		}
+		if !ok || !matchesResidual(c, r) {
+			continue
+		}
+		v, ok := deals.StorageDealRecordSortValue(r, c.SortBy)
+		if !ok {
+			continue
+		}
+		sr := sortedRecord{value: v, proposalCid: util.CidToString(r.DealInfo.ProposalCid), record: r}
+		if cur.set && !cur.before(sr.value, sr.proposalCid, c.Ascending) {
+			continue
+		}
+		res = append(res, sr)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		l, r := res[j], res[i]
+		if c.Ascending {
+			l, r = res[i], res[j]
+		}
+		if l.value != r.value {
+			return l.value < r.value
+		}
+		return l.proposalCid < r.proposalCid
+	})
+	if c.Limit > 0 && len(res) > c.Limit {
+		res = res[:c.Limit]
+	}
+	ret := make([]deals.StorageDealRecord, len(res))
+	for i := range res {
+		ret[i] = res[i].record
+	}
+	return ret, nil
+}
+
+type sortedRecord struct {
+	value       uint64
+	proposalCid string
+	record      deals.StorageDealRecord
+}
+
+type cursor struct {
+	value       uint64
+	proposalCid string
+	set         bool
+}
+
+// before returns true if the cursor position is before the provided
+// one in the listing order.
+func (c *cursor) before(value uint64, proposalCid string, ascending bool) bool {
+	if value == c.value {
+		if ascending {
+			return proposalCid > c.proposalCid
+		}
+		return proposalCid < c.proposalCid
+	}
+	if ascending {
+		return value > c.value
+	}
+	return value < c.value
+}
+
+// queryCandidates returns the record keys, indexed by proposal cid, that
+// match all the indexed filters set in c. It returns nil if no indexed
+// filter is set.
+func (s *Store) queryCandidates(c deals.DealRecordsConfig) (map[string]string, error) {
+	var candidates map[string]string
+	intersect := func(m map[string]string) {
+		if candidates == nil {
+			candidates = m
+			return
+		}
+		for pc := range candidates {
+			if _, ok := m[pc]; !ok {
+				delete(candidates, pc)
+			}
+		}
+	}
+
+	filters := []struct {
+		base   datastore.Key
+		values []string
+	}{
+		{base: dsStorageIdxAddr, values: c.FromAddrs},
+		{base: dsStorageIdxPayload, values: c.DataCids},
+		{base: dsStorageIdxMiner, values: c.Miners},
+		{base: dsStorageIdxState, values: formatStates(c.DealStates)},
+	}
+	for _, f := range filters {
+		if len(f.values) == 0 {
+			continue
+		}
+		m := make(map[string]string)
+		for _, v := range f.values {
+			if err := s.scanIndex(f.base.ChildString(v), datastore.Key{}, func(_ uint64, pc, recordKey string) bool {
+				m[pc] = recordKey
+				return true
+			}); err != nil {
+				return nil, err
+			}
+		}
+		intersect(m)
+	}
+
+	if c.MinExpiration > 0 || c.MaxExpiration > 0 {
+		m := make(map[string]string)
+		from := dsStorageIdxExpiration.ChildString(padUint(c.MinExpiration))
+		if err := s.scanIndex(dsStorageIdxExpiration, from, func(exp uint64, pc, recordKey string) bool {
+			if exp < c.MinExpiration {
+				return true
+			}
+			if c.MaxExpiration > 0 && exp > c.MaxExpiration {
+				return false
+			}
+			m[pc] = recordKey
+			return true
+		}); err != nil {
+			return nil, err
+		}
+		intersect(m)
+	}
+
+	return candidates, nil
+}
+
+// queryBySortIndex lists records walking the index of the sorting field,
+// so only the returned page of records is loaded.
+func (s *Store) queryBySortIndex(c deals.DealRecordsConfig, cur *cursor) ([]deals.StorageDealRecord, error) {
+	base := dsStorageIdxTime
+	if c.SortBy == deals.SortByExpiration {
+		base = dsStorageIdxExpiration
+	}
+	q := query.Query{Prefix: base.String(), Orders: []query.Order{query.OrderByKeyDescending{}}}
+	if c.Ascending {
+		q.Orders = []query.Order{query.OrderByKey{}}
+	}
+	res, err := s.ds.Query(q)
+	if err != nil {
+		return nil, fmt.Errorf("executing query: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+
+	var ret []deals.StorageDealRecord
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", r.Error)
+		}
+		v, pc, err := parseIndexKey(r.Key)
+		if err != nil {
+			return nil, err
+		}
+		if cur.set && !cur.before(v, pc, c.Ascending) {
+			continue
+		}
+		dr, ok, err := s.getStorageDeal(string(r.Value))
+		if err != nil {
+			return nil, err
+		}
+		if !ok || !matchesResidual(c, dr) {
+			continue
+		}
+		ret = append(ret, dr)
+		if c.Limit > 0 && len(ret) == c.Limit {
+			break
+		}
+	}
+	return ret, nil
+}
+
+// scanIndex calls f with every entry under prefix in ascending key order,
+// until f returns false. If from isn't empty, entries with keys lower than
+// from are skipped by the datastore.
+func (s *Store) scanIndex(prefix, from datastore.Key, f func(value uint64, proposalCid, recordKey string) bool) error {
+	q := query.Query{Prefix: prefix.String(), Orders: []query.Order{query.OrderByKey{}}}
+	if from.String() != "/" {
+		q.Filters = []query.Filter{query.FilterKeyCompare{Op: query.GreaterThanOrEqual, Key: from.String()}}
+	}
+	res, err := s.ds.Query(q)
+	if err != nil {
+		return fmt.Errorf("executing query: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+	for r := range res.Next() {
+		if r.Error != nil {
+			return fmt.Errorf("iter next: %s", r.Error)
+		}
+		// Depending on the datastore, prefixes might also match sibling
+		// keys sharing a string prefix, such as f01 and f010.
+		if !strings.HasPrefix(r.Key, prefix.String()+"/") {
+			continue
+		}
+		v, pc, err := parseIndexKey(r.Key)
+		if err != nil {
+			return err
+		}
+		if !f(v, pc, string(r.Value)) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (s *Store) getStorageDeal(recordKey string) (deals.StorageDealRecord, bool, error) {
+	buf, err := s.ds.Get(datastore.RawKey(recordKey))
+	if err == datastore.ErrNotFound {
+		return deals.StorageDealRecord{}, false, nil
+	}
+	if err != nil {
+		return deals.StorageDealRecord{}, false, fmt.Errorf("getting storage deal record %s: %s", recordKey, err)
+	}
+	var dr deals.StorageDealRecord
+	if err := json.Unmarshal(buf, &dr); err != nil {
+		return deals.StorageDealRecord{}, false, fmt.Errorf("unmarshaling storage deal record: %s", err)
+	}
+	return dr, true, nil
+}
+
+// matchesResidual applies the filters that aren't resolved by indexes.
+func matchesResidual(c deals.DealRecordsConfig, r deals.StorageDealRecord) bool {
+	if r.Pending && !c.IncludePending || !r.Pending && !c.IncludeFinal {
+		return false
+	}
+	return !c.IncludeFailed || r.ErrMsg != ""
+}
+
+// updateStorageDealIndexes replaces the index entries of prev, if not nil,
+// with the ones of dr saved under recordKey.
+func updateStorageDealIndexes(w datastore.Write, prev *deals.StorageDealRecord, dr deals.StorageDealRecord, recordKey datastore.Key) error {
+	newKeys := storageDealIndexKeys(dr)
+	if prev != nil {
+		keep := make(map[datastore.Key]struct{}, len(newKeys))
+		for _, k := range newKeys {
+			keep[k] = struct{}{}
+		}
+		for _, k := range storageDealIndexKeys(*prev) {
+			if _, ok := keep[k]; ok {
+				continue
+			}
+			if err := w.Delete(k); err != nil {
+				return fmt.Errorf("deleting index entry %s: %s", k, err)
+			}
+		}
+	}
+	for _, k := range newKeys {
+		if err := w.Put(k, recordKey.Bytes()); err != nil {
+			return fmt.Errorf("putting index entry %s: %s", k, err)
+		}
+	}
+	return nil
+}
+
+func storageDealIndexKeys(dr deals.StorageDealRecord) []datastore.Key {
+	pc := util.CidToString(dr.DealInfo.ProposalCid)
+	keys := []datastore.Key{
+		dsStorageIdxState.ChildString(strconv.FormatUint(dr.DealInfo.StateID, 10)).ChildString(pc),
+	}
+	if dr.DealInfo.Miner != "" {
+		keys = append(keys, dsStorageIdxMiner.ChildString(dr.DealInfo.Miner).ChildString(pc))
+	}
+	if dr.RootCid.Defined() {
+		keys = append(keys, dsStorageIdxPayload.ChildString(util.CidToString(dr.RootCid)).ChildString(pc))
+	}
+	if dr.Addr != "" {
+		keys = append(keys, dsStorageIdxAddr.ChildString(dr.Addr).ChildString(pc))
+	}
+	if v, ok := deals.StorageDealRecordSortValue(dr, deals.SortByTime); ok {
+		keys = append(keys, dsStorageIdxTime.ChildString(padUint(v)).ChildString(pc))
+	}
+	if v, ok := deals.StorageDealRecordSortValue(dr, deals.SortByExpiration); ok {
+		keys = append(keys, dsStorageIdxExpiration.ChildString(padUint(v)).ChildString(pc))
+	}
+	return keys
+}
+
+// parseIndexKey returns the proposal cid of an index entry key, and the
+// numeric value of its parent namespace for sortable indexes.
+func parseIndexKey(key string) (uint64, string, error) {
+	parts := datastore.NewKey(key).Namespaces()
+	if len(parts) < 4 {
+		return 0, "", fmt.Errorf("malformed index key %s", key)
+	}
+	pc := parts[len(parts)-1]
+	switch "/" + strings.Join(parts[:2], "/") {
+	case dsStorageIdxTime.String(), dsStorageIdxExpiration.String():
+		v, err := strconv.ParseUint(parts[2], 10, 64)
+		if err != nil {
+			return 0, "", fmt.Errorf("parsing index key %s: %s", key, err)
+		}
+		return v, pc, nil
+	default:
+		return 0, pc, nil
+	}
+}
+
+func formatStates(states []uint64) []string {
+	ret := make([]string, len(states))
+	for i, s := range states {
+		ret[i] = strconv.FormatUint(s, 10)
+	}
+	return ret
+}
+
+// padUint formats v so that the lexicographic order of keys matches the
+// numeric order of values.
+func padUint(v uint64) string {
+	return fmt.Sprintf("%020d", v)
+}
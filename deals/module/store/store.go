@@ -70,6 +70,11 @@ func (s *Store) PutStorageDeal(dr deals.StorageDealRecord) error {
 		return fmt.Errorf("pending storage records can't have error messages")
 	}
 
+	prev, err := getPreviousStorageDeal(txn, dr.DealInfo.ProposalCid)
+	if err != nil {
+		return err
+	}
+
 	// If not pending, delete any saved record in the 'pending' keyspace.
 	// If not exists, `Delete()` is a noop.
 	if !dr.Pending {
@@ -108,6 +113,10 @@ func (s *Store) PutStorageDeal(dr deals.StorageDealRecord) error {
 		return fmt.Errorf("saving updated-at index: %s", err)
 	}
 
+	if err := updateStorageDealIndexes(txn, prev, dr, key); err != nil {
+		return fmt.Errorf("updating storage deal indexes: %s", err)
+	}
+
 	if err := txn.Commit(); err != nil {
 		return fmt.Errorf("committing transaction: %s", err)
 	}
@@ -115,6 +124,26 @@ func (s *Store) PutStorageDeal(dr deals.StorageDealRecord) error {
 	return nil
 }
 
+// getPreviousStorageDeal returns the currently saved record for a proposal
+// cid, or nil if it doesn't exist.
+func getPreviousStorageDeal(r datastore.Read, proposalCid cid.Cid) (*deals.StorageDealRecord, error) {
+	for _, key := range []datastore.Key{makeFinalDealKey(proposalCid), makePendingDealKey(proposalCid)} {
+		buf, err := r.Get(key)
+		if err == datastore.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("getting previous storage deal record: %s", err)
+		}
+		var dr deals.StorageDealRecord
+		if err := json.Unmarshal(buf, &dr); err != nil {
+			return nil, fmt.Errorf("unmarshaling previous storage deal record: %s", err)
+		}
+		return &dr, nil
+	}
+	return nil, nil
+}
+
 // GetPendingStorageDeals returns all the pending storage deal records.
 func (s *Store) GetPendingStorageDeals() ([]deals.StorageDealRecord, error) {
 	s.lock.Lock()
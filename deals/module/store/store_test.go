@@ -11,7 +11,7 @@ import (
 )
 
 func TestPutPendingDeal(t *testing.T) {
-	s := New(tests.NewTxMapDatastore())
+	s := New(tests.NewTxnDatastore(t))
 
 	c1, err := util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
 	require.NoError(t, err)
@@ -30,7 +30,7 @@ func TestPutPendingDeal(t *testing.T) {
 }
 
 func TestGetPendingDeals(t *testing.T) {
-	s := New(tests.NewTxMapDatastore())
+	s := New(tests.NewTxnDatastore(t))
 
 	now := time.Now().Unix()
 
@@ -58,7 +58,7 @@ func TestGetPendingDeals(t *testing.T) {
 }
 
 func TestErrorPendingDeal(t *testing.T) {
-	s := New(tests.NewTxMapDatastore())
+	s := New(tests.NewTxnDatastore(t))
 
 	c1, err := util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
 	require.NoError(t, err)
@@ -87,7 +87,7 @@ func TestErrorPendingDeal(t *testing.T) {
 }
 
 func TestPutDealRecord(t *testing.T) {
-	s := New(tests.NewTxMapDatastore())
+	s := New(tests.NewTxnDatastore(t))
 
 	c1, err := util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
 	require.NoError(t, err)
@@ -116,7 +116,7 @@ func TestPutDealRecord(t *testing.T) {
 }
 
 func TestGetDealRecords(t *testing.T) {
-	s := New(tests.NewTxMapDatastore())
+	s := New(tests.NewTxnDatastore(t))
 
 	now := time.Now().Unix()
 
@@ -144,7 +144,7 @@ func TestGetDealRecords(t *testing.T) {
 }
 
 func TestPutRetrievalRecords(t *testing.T) {
-	s := New(tests.NewTxMapDatastore())
+	s := New(tests.NewTxnDatastore(t))
 	now := time.Now().Unix()
 	c1, err := util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
 	require.NoError(t, err)
@@ -154,7 +154,7 @@ func TestPutRetrievalRecords(t *testing.T) {
 }
 
 func TestGetRetrievalDeals(t *testing.T) {
-	s := New(tests.NewTxMapDatastore())
+	s := New(tests.NewTxnDatastore(t))
 	now := time.Now().Unix()
 
 	c1, err := util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
@@ -181,7 +181,7 @@ func TestGetRetrievalDeals(t *testing.T) {
 
 func TestUpdatedAt(t *testing.T) {
 	t.Run("retrieval", func(t *testing.T) {
-		s := New(tests.NewTxMapDatastore())
+		s := New(tests.NewTxnDatastore(t))
 
 		c1, err := util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D")
 		require.NoError(t, err)
@@ -205,7 +205,7 @@ func TestUpdatedAt(t *testing.T) {
 	})
 
 	t.Run("storage-deal", func(t *testing.T) {
-		s := New(tests.NewTxMapDatastore())
+		s := New(tests.NewTxnDatastore(t))
 
 		c, err := util.CidFromString("QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2F")
 		require.NoError(t, err)
@@ -230,7 +230,7 @@ func TestUpdatedAt(t *testing.T) {
 }
 
 func TestStorageUpdatedSince(t *testing.T) {
-	s := New(tests.NewTxMapDatastore())
+	s := New(tests.NewTxnDatastore(t))
 
 	// Inception.
 	t0 := time.Now()
@@ -301,7 +301,7 @@ func TestStorageUpdatedSince(t *testing.T) {
 }
 
 func TestRetrievalUpdatedSince(t *testing.T) {
-	s := New(tests.NewTxMapDatastore())
+	s := New(tests.NewTxnDatastore(t))
 
 	// Inception.
 	t0 := time.Now()
@@ -366,3 +366,91 @@ func TestRetrievalUpdatedSince(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, udr, 1)
 }
+
+func TestQueryStorageDeals(t *testing.T) {
+	s := New(tests.NewTxnDatastore(t))
+
+	pcs := []string{
+		"QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2D",
+		"QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2E",
+		"QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2F",
+		"QmSnuWmxptJZdLJpKRarxBMS2Ju2oANVrgbr2xWbie9b2G",
+	}
+	for i, pc := range pcs {
+		c, err := util.CidFromString(pc)
+		require.NoError(t, err)
+		dr := deals.StorageDealRecord{
+			Addr: []string{"a", "b"}[i%2],
+			Time: int64(100 + i),
+			DealInfo: deals.StorageDealInfo{
+				ProposalCid: c,
+				Miner:       []string{"f01", "f010"}[i/2],
+				StateID:     7,
+				StartEpoch:  uint64(1000 - i*100),
+				Duration:    10,
+			},
+		}
+		require.NoError(t, s.PutStorageDeal(dr))
+	}
+
+	c := deals.DealRecordsConfig{IncludeFinal: true}
+	res, err := s.QueryStorageDeals(c)
+	require.NoError(t, err)
+	requireProposalCids(t, res, pcs[3], pcs[2], pcs[1], pcs[0])
+
+	c.Ascending = true
+	c.Miners = []string{"f01"}
+	res, err = s.QueryStorageDeals(c)
+	require.NoError(t, err)
+	requireProposalCids(t, res, pcs[0], pcs[1])
+
+	c.Miners = nil
+	c.FromAddrs = []string{"a"}
+	c.DealStates = []uint64{7}
+	res, err = s.QueryStorageDeals(c)
+	require.NoError(t, err)
+	requireProposalCids(t, res, pcs[0], pcs[2])
+
+	c.FromAddrs = nil
+	c.DealStates = nil
+	c.MinExpiration = 810
+	c.MaxExpiration = 910
+	c.SortBy = deals.SortByExpiration
+	res, err = s.QueryStorageDeals(c)
+	require.NoError(t, err)
+	requireProposalCids(t, res, pcs[2], pcs[1])
+
+	// Paginate with cursors, with and without indexed filters.
+	for _, miners := range [][]string{nil, {"f01", "f010"}} {
+		c := deals.DealRecordsConfig{IncludeFinal: true, Miners: miners, Limit: 3}
+		res, err := s.QueryStorageDeals(c)
+		require.NoError(t, err)
+		requireProposalCids(t, res, pcs[3], pcs[2], pcs[1])
+
+		c.Cursor = deals.StorageDealRecordsCursor(res[len(res)-1], c.SortBy)
+		res, err = s.QueryStorageDeals(c)
+		require.NoError(t, err)
+		requireProposalCids(t, res, pcs[0])
+	}
+
+	// Updating a record replaces its stale index entries.
+	pc, err := util.CidFromString(pcs[0])
+	require.NoError(t, err)
+	dr := deals.StorageDealRecord{Addr: "a", Time: 100, DealInfo: deals.StorageDealInfo{ProposalCid: pc, Miner: "f02", StateID: 7}}
+	require.NoError(t, s.PutStorageDeal(dr))
+	res, err = s.QueryStorageDeals(deals.DealRecordsConfig{IncludeFinal: true, Miners: []string{"f01"}})
+	require.NoError(t, err)
+	requireProposalCids(t, res, pcs[1])
+	res, err = s.QueryStorageDeals(deals.DealRecordsConfig{IncludeFinal: true, SortBy: deals.SortByExpiration})
+	require.NoError(t, err)
+	requireProposalCids(t, res, pcs[1], pcs[2], pcs[3])
+}
+
+func requireProposalCids(t *testing.T, res []deals.StorageDealRecord, pcs ...string) {
+	t.Helper()
+	got := make([]string, len(res))
+	for i, r := range res {
+		got[i] = util.CidToString(r.DealInfo.ProposalCid)
+	}
+	require.Equal(t, pcs, got)
+}
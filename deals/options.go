@@ -1,10 +1,21 @@
 package deals
 
-import "os"
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/textileio/powergate/v2/lotus"
+	"github.com/textileio/powergate/v2/util"
+)
 
 // Config contains configuration for storing deals.
 type Config struct {
-	ImportPath string
+	ImportPath             string
+	RetrievalClientBuilder lotus.RetrievalClientBuilder
 }
 
 // Option sets values on a Config.
@@ -22,6 +33,15 @@ func WithImportPath(path string) Option {
 	}
 }
 
+// WithRetrievalClientBuilder indicates the builder of Lotus clients used
+// for retrievals restricted by a selector.
+func WithRetrievalClientBuilder(rcb lotus.RetrievalClientBuilder) Option {
+	return func(c *Config) error {
+		c.RetrievalClientBuilder = rcb
+		return nil
+	}
+}
+
 // DealRecordsConfig specifies the options for DealsManager.List.
 type DealRecordsConfig struct {
 	FromAddrs      []string
@@ -30,8 +50,27 @@ type DealRecordsConfig struct {
 	IncludeFinal   bool
 	IncludeFailed  bool
 	Ascending      bool
+
+	Miners        []string
+	DealStates    []uint64
+	MinExpiration uint64
+	MaxExpiration uint64
+	SortBy        DealRecordsSortBy
+	Limit         int
+	Cursor        string
 }
 
+// DealRecordsSortBy indicates the field used to sort storage deal records.
+type DealRecordsSortBy int
+
+const (
+	// SortByTime sorts records by their timestamp.
+	SortByTime DealRecordsSortBy = iota
+	// SortByExpiration sorts records by their expiration epoch. Records
+	// that don't have a known start epoch yet are excluded.
+	SortByExpiration
+)
+
 // DealRecordsOption updates a ListDealRecordsConfig.
 type DealRecordsOption func(*DealRecordsConfig)
 
@@ -82,3 +121,94 @@ func WithAscending(ascending bool) DealRecordsOption {
 		c.Ascending = ascending
 	}
 }
+
+// WithMiners limits the results to deals made with the provided miners.
+// Ignored for ListRetrievalDealRecords.
+func WithMiners(miners ...string) DealRecordsOption {
+	return func(c *DealRecordsConfig) {
+		c.Miners = miners
+	}
+}
+
+// WithDealStates limits the results to deals in any of the provided
+// storage deal states. Ignored for ListRetrievalDealRecords.
+func WithDealStates(states ...uint64) DealRecordsOption {
+	return func(c *DealRecordsConfig) {
+		c.DealStates = states
+	}
+}
+
+// WithExpirationRange limits the results to deals expiring between the
+// min and max epochs, inclusive. A zero value leaves that side of the
+// range unbounded. Ignored for ListRetrievalDealRecords.
+func WithExpirationRange(min, max uint64) DealRecordsOption {
+	return func(c *DealRecordsConfig) {
+		c.MinExpiration = min
+		c.MaxExpiration = max
+	}
+}
+
+// WithSortBy specifies the field used to sort the results. Default is SortByTime.
+// Ignored for ListRetrievalDealRecords.
+func WithSortBy(sortBy DealRecordsSortBy) DealRecordsOption {
+	return func(c *DealRecordsConfig) {
+		c.SortBy = sortBy
+	}
+}
+
+// WithLimit limits the number of returned results. Zero means no limit.
+// Ignored for ListRetrievalDealRecords.
+func WithLimit(limit int) DealRecordsOption {
+	return func(c *DealRecordsConfig) {
+		c.Limit = limit
+	}
+}
+
+// WithCursor continues a previous listing after the record the cursor was
+// built from. See StorageDealRecordsCursor. Ignored for ListRetrievalDealRecords.
+func WithCursor(cursor string) DealRecordsOption {
+	return func(c *DealRecordsConfig) {
+		c.Cursor = cursor
+	}
+}
+
+// StorageDealRecordSortValue returns the value used to sort r by sortBy.
+// The second return value is false if r can't be sorted by sortBy.
+func StorageDealRecordSortValue(r StorageDealRecord, sortBy DealRecordsSortBy) (uint64, bool) {
+	if sortBy == SortByExpiration {
+		if r.DealInfo.StartEpoch == 0 {
+			return 0, false
+		}
+		return r.DealInfo.StartEpoch + r.DealInfo.Duration, true
+	}
+	if r.Time < 0 {
+		return 0, false
+	}
+	return uint64(r.Time), true
+}
+
+// StorageDealRecordsCursor returns an opaque cursor to continue a listing
+// sorted by sortBy right after r.
+func StorageDealRecordsCursor(r StorageDealRecord, sortBy DealRecordsSortBy) string {
+	v, _ := StorageDealRecordSortValue(r, sortBy)
+	raw := strconv.FormatUint(v, 10) + "/" + util.CidToString(r.DealInfo.ProposalCid)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseStorageDealRecordsCursor returns the sort value and proposal cid
+// of the record a cursor was built from.
+func ParseStorageDealRecordsCursor(cursor string) (uint64, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, "", fmt.Errorf("decoding cursor: %s", err)
+	}
+	parts := strings.SplitN(string(raw), "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return 0, "", errors.New("malformed cursor")
+	}
+	v, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("parsing cursor sort value: %s", err)
+	}
+	return v, parts[1], nil
+}
@@ -8,10 +8,10 @@ import (
 
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-datastore"
-	"github.com/ipfs/go-datastore/namespace"
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/ffs/scheduler"
+	txndstr "github.com/textileio/powergate/v2/txndstransform"
 )
 
 var (
@@ -27,6 +27,9 @@ var (
 	// ErrActiveInStorage returns when a Cid is trying to be removed but still defined as active
 	// on Hot or Cold storage.
 	ErrActiveInStorage = errors.New("can't remove Cid, disable from Hot and Cold storage")
+	// ErrNamedCid returns when a Cid is trying to be removed or replaced but it's
+	// referenced by a named reference.
+	ErrNamedCid = errors.New("can't remove Cid, it's referenced by a named reference")
 	// ErrHotStorageDisabled returned when trying to fetch a Cid when disabled on Hot Storage.
 	// To retrieve the data, is necessary to call unfreeze by enabling the Enabled flag in
 	// hot storage for that Cid.
@@ -42,6 +45,16 @@ type API struct {
 
 	sched *scheduler.Scheduler
 
+	// namesLock serializes updates of named references, and
+	// removals of Cids which check them. It's taken before lock,
+	// since named reference updates call other API methods.
+	namesLock sync.Mutex
+
+	// unfreezeLock guards unfreezeLocks, which serialize unfreezing
+	// evicted Cids, so concurrent reads wait on a single Job.
+	unfreezeLock  sync.Mutex
+	unfreezeLocks map[cid.Cid]*unfreezeCidLock
+
 	lock   sync.Mutex
 	closed bool
 	cfg    InstanceConfig
@@ -50,8 +63,8 @@ type API struct {
 }
 
 // New returns a new Api instance.
-func New(ds datastore.Datastore, iid ffs.APIID, sch *scheduler.Scheduler, wm ffs.WalletManager, drm ffs.DealRecordsManager, dc ffs.StorageConfig, addrInfo AddrInfo) (*API, error) {
-	is := newInstanceStore(namespace.Wrap(ds, datastore.NewKey("istore")))
+func New(ds datastore.TxnDatastore, iid ffs.APIID, sch *scheduler.Scheduler, wm ffs.WalletManager, drm ffs.DealRecordsManager, dc ffs.StorageConfig, addrInfo AddrInfo) (*API, error) {
+	is := newInstanceStore(txndstr.Wrap(ds, "istore"))
 
 	dc.Cold.Filecoin.Addr = addrInfo.Addr
 
@@ -73,10 +86,14 @@ func New(ds datastore.Datastore, iid ffs.APIID, sch *scheduler.Scheduler, wm ffs
 	return i, nil
 }
 
-// Load loads a saved Api instance from its ConfigStore.
-func Load(ds datastore.Datastore, iid ffs.APIID, sched *scheduler.Scheduler, wm ffs.WalletManager, drm ffs.DealRecordsManager) (*API, error) {
-	is := newInstanceStore(namespace.Wrap(ds, datastore.NewKey("istore")))
+// Load loads a saved Api instance from its ConfigStore. If the instance
+// doesn't exist, it returns ErrNotFound.
+func Load(ds datastore.TxnDatastore, iid ffs.APIID, sched *scheduler.Scheduler, wm ffs.WalletManager, drm ffs.DealRecordsManager) (*API, error) {
+	is := newInstanceStore(txndstr.Wrap(ds, "istore"))
 	c, err := is.getInstanceConfig()
+	if err == ErrNotFound {
+		return nil, ErrNotFound
+	}
 	if err != nil {
 		return nil, fmt.Errorf("loading instance: %s", err)
 	}
@@ -84,6 +101,32 @@ func Load(ds datastore.Datastore, iid ffs.APIID, sched *scheduler.Scheduler, wm
 	return new(ctx, is, wm, drm, c, sched, cancel), nil
 }
 
+// LoadAddrs returns the wallet addresses of the instance saved in ds,
+// without loading the instance.
+func LoadAddrs(ds datastore.TxnDatastore) ([]AddrInfo, error) {
+	is := newInstanceStore(txndstr.Wrap(ds, "istore"))
+	c, err := is.getInstanceConfig()
+	if err != nil {
+		return nil, fmt.Errorf("loading instance config: %s", err)
+	}
+	addrs := make([]AddrInfo, 0, len(c.Addrs))
+	for _, addr := range c.Addrs {
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// LoadStorageConfigs returns the storage configs of all Cids of the instance
+// saved in ds, without loading the instance.
+func LoadStorageConfigs(ds datastore.TxnDatastore) (map[cid.Cid]ffs.StorageConfig, error) {
+	is := newInstanceStore(txndstr.Wrap(ds, "istore"))
+	cfgs, err := is.getStorageConfigs()
+	if err != nil {
+		return nil, fmt.Errorf("getting storage configs: %s", err)
+	}
+	return cfgs, nil
+}
+
 func new(ctx context.Context, is *instanceStore, wm ffs.WalletManager, drm ffs.DealRecordsManager, config InstanceConfig, sch *scheduler.Scheduler, cancel context.CancelFunc) *API {
 	i := &API{
 		is:     is,
@@ -93,6 +136,8 @@ func new(ctx context.Context, is *instanceStore, wm ffs.WalletManager, drm ffs.D
 		sched:  sch,
 		cancel: cancel,
 		ctx:    ctx,
+
+		unfreezeLocks: map[cid.Cid]*unfreezeCidLock{},
 	}
 	return i
 }
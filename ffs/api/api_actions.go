@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/ipfs/go-cid"
 	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler"
 )
 
 // PushStorageConfig push a new configuration for the Cid in the hot and
@@ -57,9 +59,63 @@ func (i *API) PushStorageConfig(c cid.Cid, opts ...PushStorageConfigOption) (ffs
 	return jid, nil
 }
 
+// UpdateStorageConfig replaces the StorageConfig of an already pushed Cid without
+// scheduling a new Job. The new StorageConfig is used in the following repair and
+// renewal evaluations, or when the Cid is pushed again.
+func (i *API) UpdateStorageConfig(c cid.Cid, cfg ffs.StorageConfig) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if _, err := i.is.getStorageConfigs(c); err != nil {
+		if err == ErrNotFound {
+			return err
+		}
+		return fmt.Errorf("getting cid config: %s", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if err := i.ensureValidColdCfg(cfg.Cold); err != nil {
+		return err
+	}
+	// Save the config and its tracking state atomically, so a failure
+	// can't leave the scheduler with a different config.
+	txn, err := i.is.newRootTransaction()
+	if err != nil {
+		return fmt.Errorf("creating transaction: %s", err)
+	}
+	defer txn.Discard()
+	if err := i.sched.TrackTxn(txn, i.cfg.ID, c, cfg); err != nil {
+		return fmt.Errorf("tracking new config for cid %s: %s", c, err)
+	}
+	if err := i.is.putStorageConfigTxn(txn, c, cfg); err != nil {
+		return fmt.Errorf("saving new config for cid %s: %s", c, err)
+	}
+	if err := txn.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %s", err)
+	}
+	return nil
+}
+
 // Remove removes a Cid from being tracked as an active storage. The Cid should have
 // both Hot and Cold storage disabled, if that isn't the case it will return ErrActiveInStorage.
+// Cids referenced by named references, or their history, can't be removed and
+// return ErrNamedCid.
 func (i *API) Remove(c cid.Cid) error {
+	i.namesLock.Lock()
+	defer i.namesLock.Unlock()
+
+	named, err := i.isNamed(c)
+	if err != nil {
+		return err
+	}
+	if named {
+		return ErrNamedCid
+	}
+	return i.remove(c)
+}
+
+func (i *API) remove(c cid.Cid) error {
 	i.lock.Lock()
 	defer i.lock.Unlock()
 
@@ -84,15 +140,26 @@ func (i *API) Remove(c cid.Cid) error {
 
 // Replace pushes a StorageConfig for c2 equal to that of c1, and removes c1. This operation
 // is more efficient than manually removing and adding in two separate operations.
-// c1 and c2 must not be equal.
+// c1 and c2 must not be equal. c1 can't be referenced by named references, or
+// their history, otherwise it returns ErrNamedCid.
 func (i *API) Replace(c1 cid.Cid, c2 cid.Cid) (ffs.JobID, error) {
-	i.lock.Lock()
-	defer i.lock.Unlock()
-
 	if c1.Equals(c2) {
 		return ffs.EmptyJobID, fmt.Errorf("the old and new cid should be different")
 	}
 
+	i.namesLock.Lock()
+	defer i.namesLock.Unlock()
+	named, err := i.isNamed(c1)
+	if err != nil {
+		return ffs.EmptyJobID, err
+	}
+	if named {
+		return ffs.EmptyJobID, ErrNamedCid
+	}
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
 	cfgs, err := i.is.getStorageConfigs(c1)
 	if err == ErrNotFound {
 		return ffs.EmptyJobID, ErrReplacedCidNotFound
@@ -118,6 +185,29 @@ func (i *API) Replace(c1 cid.Cid, c2 cid.Cid) (ffs.JobID, error) {
 	return jid, nil
 }
 
+// Verify schedules a Job which verifies the integrity of the stored data of c,
+// in Hot Storage and in active deals as configured by opts.
+func (i *API) Verify(c cid.Cid, opts ffs.VerifyOptions) (ffs.JobID, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	cfgs, err := i.is.getStorageConfigs(c)
+	if err == ErrNotFound {
+		return ffs.EmptyJobID, err
+	}
+	if err != nil {
+		return ffs.EmptyJobID, fmt.Errorf("getting cid config from store: %s", err)
+	}
+	jid, err := i.sched.PushVerify(i.cfg.ID, c, cfgs[c], opts)
+	if err == scheduler.ErrNotFound {
+		return ffs.EmptyJobID, ErrNotFound
+	}
+	if err != nil {
+		return ffs.EmptyJobID, fmt.Errorf("scheduling verification of %s: %s", c, err)
+	}
+	return jid, nil
+}
+
 // Get returns an io.Reader for reading a stored Cid from hot storage.
 func (i *API) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
 	if !c.Defined() {
@@ -130,13 +220,171 @@ func (i *API) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
 	if !cfgs[c].Hot.Enabled {
 		return nil, ErrHotStorageDisabled
 	}
+	if cfgs[c].Hot.Cache.Enabled {
+		if err := i.unfreezeEvicted(ctx, c); err != nil {
+			return nil, fmt.Errorf("unfreezing evicted data: %s", err)
+		}
+	}
 	r, err := i.sched.GetCidFromHot(ctx, c)
 	if err != nil {
 		return nil, fmt.Errorf("getting from hot storage %s: %s", c, err)
 	}
+	if err := i.sched.TouchHot(ctx, i.cfg.ID, c); err != nil {
+		log.Warnf("registering access of %s: %s", c, err)
+	}
 	return r, nil
 }
 
+// GetCar writes a CAR file of the DAG of a stored Cid, which should be enabled
+// in hot storage.
+func (i *API) GetCar(ctx context.Context, c cid.Cid, w io.Writer, opts ffs.CarOptions) error {
+	if !c.Defined() {
+		return fmt.Errorf("cid is undefined")
+	}
+	cfgs, err := i.is.getStorageConfigs(c)
+	if err != nil {
+		return fmt.Errorf("getting cid config: %s", err)
+	}
+	if !cfgs[c].Hot.Enabled {
+		return ErrHotStorageDisabled
+	}
+	if cfgs[c].Hot.Cache.Enabled {
+		if err := i.unfreezeEvicted(ctx, c); err != nil {
+			return fmt.Errorf("unfreezing evicted data: %s", err)
+		}
+	}
+	if err := i.sched.GetCarFromHot(ctx, c, w, opts); err != nil {
+		return fmt.Errorf("getting car from hot storage %s: %s", c, err)
+	}
+	if err := i.sched.TouchHot(ctx, i.cfg.ID, c); err != nil {
+		log.Warnf("registering access of %s: %s", c, err)
+	}
+	return nil
+}
+
+// unfreezeEvicted unfreezes a Cid evicted from the Hot Storage cache by pushing
+// its storage config, and waits until the Job finishes. Pushing a Job cancels
+// queued Jobs for the Cid, so active Jobs are waited first, and concurrent calls
+// for the same Cid wait on a single Job.
+func (i *API) unfreezeEvicted(ctx context.Context, c cid.Cid) error {
+	unlock := i.lockUnfreeze(c)
+	defer unlock()
+
+	for {
+		jid, pushed, err := i.pushUnfreeze(c)
+		if err != nil {
+			return err
+		}
+		if jid == ffs.EmptyJobID {
+			return nil
+		}
+		j, err := i.waitJob(ctx, jid)
+		if err != nil {
+			return err
+		}
+		// Active Jobs might have unfrozen the Cid or changed its config,
+		// so it's checked again after they finish.
+		if !pushed {
+			continue
+		}
+		if j.Status != ffs.Success {
+			return fmt.Errorf("job %s finished with status %s: %s", jid, ffs.JobStatusStr[j.Status], j.ErrCause)
+		}
+		return nil
+	}
+}
+
+// pushUnfreeze returns the Job to wait for unfreezing c if it's evicted from
+// the Hot Storage cache. If c has an active Job it's returned, otherwise its
+// storage config is pushed. If c doesn't need to be unfrozen, it returns
+// ffs.EmptyJobID.
+func (i *API) pushUnfreeze(c cid.Cid) (ffs.JobID, bool, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	cfgs, err := i.is.getStorageConfigs(c)
+	if err != nil {
+		return ffs.EmptyJobID, false, fmt.Errorf("getting cid config: %s", err)
+	}
+	if !cfgs[c].Hot.Enabled {
+		return ffs.EmptyJobID, false, ErrHotStorageDisabled
+	}
+	info, err := i.sched.GetStorageInfo(i.cfg.ID, c)
+	if err == scheduler.ErrNotFound {
+		return ffs.EmptyJobID, false, nil
+	}
+	if err != nil {
+		return ffs.EmptyJobID, false, fmt.Errorf("getting storage info: %s", err)
+	}
+	if !info.Hot.Evicted {
+		return ffs.EmptyJobID, false, nil
+	}
+	if jid, ok := i.sched.ActiveJob(i.cfg.ID, c); ok {
+		return jid, false, nil
+	}
+	jid, err := i.sched.PushConfig(i.cfg.ID, c, cfgs[c])
+	if err != nil {
+		return ffs.EmptyJobID, false, fmt.Errorf("pushing unfreeze job: %s", err)
+	}
+	return jid, true, nil
+}
+
+// waitJob waits until the Job jid reaches a final status, and returns it.
+func (i *API) waitJob(ctx context.Context, jid ffs.JobID) (ffs.StorageJob, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	ch := make(chan ffs.StorageJob, 1)
+	var werr error
+	go func() {
+		werr = i.WatchJobs(ctx, ch, jid)
+		close(ch)
+	}()
+	defer func() {
+		cancel()
+		for range ch {
+		}
+	}()
+	for j := range ch {
+		switch j.Status {
+		case ffs.Success, ffs.Failed, ffs.Canceled:
+			return j, nil
+		}
+	}
+	if werr != nil {
+		return ffs.StorageJob{}, fmt.Errorf("watching job %s: %s", jid, werr)
+	}
+	return ffs.StorageJob{}, fmt.Errorf("waiting for job %s: %s", jid, ctx.Err())
+}
+
+// unfreezeCidLock serializes unfreezing a Cid.
+type unfreezeCidLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lockUnfreeze locks c for unfreezing it, and returns a function
+// to unlock it.
+func (i *API) lockUnfreeze(c cid.Cid) func() {
+	i.unfreezeLock.Lock()
+	ul, ok := i.unfreezeLocks[c]
+	if !ok {
+		ul = &unfreezeCidLock{}
+		i.unfreezeLocks[c] = ul
+	}
+	ul.refs++
+	i.unfreezeLock.Unlock()
+
+	ul.Lock()
+	return func() {
+		ul.Unlock()
+		i.unfreezeLock.Lock()
+		defer i.unfreezeLock.Unlock()
+		ul.refs--
+		if ul.refs == 0 {
+			delete(i.unfreezeLocks, c)
+		}
+	}
+}
+
 // CancelJob cancels an executing Job. If no Job is executing
 // with that JobID, it won't fail.
 func (i *API) CancelJob(jid ffs.JobID) error {
@@ -29,6 +29,12 @@ func (i *API) StorageDealRecords(opts ...deals.DealRecordsOption) ([]deals.Stora
 		deals.WithIncludeFinal(c.IncludeFinal),
 		deals.WithIncludePending(c.IncludePending),
 		deals.WithIncludeFailed(c.IncludeFailed),
+		deals.WithMiners(c.Miners...),
+		deals.WithDealStates(c.DealStates...),
+		deals.WithExpirationRange(c.MinExpiration, c.MaxExpiration),
+		deals.WithSortBy(c.SortBy),
+		deals.WithLimit(c.Limit),
+		deals.WithCursor(c.Cursor),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("calling ListStorageDealRecords: %v", err)
@@ -30,7 +30,7 @@ func (i *API) WatchLogs(ctx context.Context, ch chan<- ffs.LogEntry, c cid.Cid,
 			return fmt.Errorf("getting history logs of %s: %s", c, err)
 		}
 		for _, l := range lgs {
-			if config.jid == ffs.EmptyJobID || config.jid == l.Jid {
+			if config.match(l) {
 				ch <- l
 			}
 		}
@@ -42,7 +42,7 @@ func (i *API) WatchLogs(ctx context.Context, ch chan<- ffs.LogEntry, c cid.Cid,
 		close(ichan)
 	}()
 	for le := range ichan {
-		if c == le.Cid && le.APIID == i.cfg.ID && (config.jid == ffs.EmptyJobID || config.jid == le.Jid) {
+		if c == le.Cid && le.APIID == i.cfg.ID && config.match(le) {
 			ch <- le
 		}
 	}
@@ -0,0 +1,313 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+// SetName creates or updates the NamedRef name to point to c. A new NamedRef
+// must point to a stored Cid. Updating a NamedRef stores c with the
+// StorageConfig of its current Cid, unless c is already stored, and saves the
+// current version in the NamedRef history. Versions in the history are kept
+// stored, and their storage is removed when they're trimmed from the history
+// by its retention, unless other NamedRefs reference them. The returned JobID
+// is empty if no Job was scheduled.
+func (i *API) SetName(ctx context.Context, name string, c cid.Cid, opts ...SetNameOption) (NamedRef, ffs.JobID, error) {
+	if err := validateName(name); err != nil {
+		return NamedRef{}, ffs.EmptyJobID, err
+	}
+	if !c.Defined() {
+		return NamedRef{}, ffs.EmptyJobID, fmt.Errorf("cid is undefined")
+	}
+	var cfg SetNameConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.retention != nil && *cfg.retention < 0 {
+		return NamedRef{}, ffs.EmptyJobID, fmt.Errorf("retention can't be negative")
+	}
+
+	ref, jid, err := i.setName(name, c, cfg)
+	if err != nil {
+		return NamedRef{}, ffs.EmptyJobID, err
+	}
+	if !ref.Publish {
+		return ref, jid, nil
+	}
+	// If a Job was scheduled, c is published after it's stored in
+	// Hot Storage.
+	if jid != ffs.EmptyJobID {
+		go i.publishAfterJob(name, c, jid)
+		return ref, jid, nil
+	}
+	if err := i.publishName(ctx, name, c); err != nil {
+		return NamedRef{}, ffs.EmptyJobID, err
+	}
+	ref, err = i.GetName(name)
+	if err != nil {
+		return NamedRef{}, ffs.EmptyJobID, err
+	}
+	return ref, jid, nil
+}
+
+// GetName returns the NamedRef name.
+func (i *API) GetName(name string) (NamedRef, error) {
+	if err := validateName(name); err != nil {
+		return NamedRef{}, err
+	}
+	ref, err := i.is.getNamedRef(name)
+	if err == ErrNotFound {
+		return NamedRef{}, err
+	}
+	if err != nil {
+		return NamedRef{}, fmt.Errorf("getting named reference: %s", err)
+	}
+	return ref, nil
+}
+
+// ListNames returns the NamedRefs with names starting with prefix, sorted
+// by name. An empty prefix returns all NamedRefs.
+func (i *API) ListNames(prefix string) ([]NamedRef, error) {
+	refs, err := i.is.getNamedRefs(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("listing named references: %s", err)
+	}
+	return refs, nil
+}
+
+// RemoveName removes the NamedRef name. The referenced Cid is still stored
+// with its current StorageConfig.
+func (i *API) RemoveName(name string) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+	i.namesLock.Lock()
+	defer i.namesLock.Unlock()
+
+	if _, err := i.is.getNamedRef(name); err != nil {
+		if err == ErrNotFound {
+			return err
+		}
+		return fmt.Errorf("getting named reference: %s", err)
+	}
+	if err := i.is.removeNamedRef(name); err != nil {
+		return fmt.Errorf("removing named reference: %s", err)
+	}
+	return nil
+}
+
+func (i *API) setName(name string, c cid.Cid, cfg SetNameConfig) (NamedRef, ffs.JobID, error) {
+	i.namesLock.Lock()
+	defer i.namesLock.Unlock()
+
+	ref, err := i.is.getNamedRef(name)
+	if err != nil && err != ErrNotFound {
+		return NamedRef{}, ffs.EmptyJobID, fmt.Errorf("getting named reference: %s", err)
+	}
+	now := time.Now()
+	var jid ffs.JobID
+	if err == ErrNotFound {
+		if _, err := i.is.getStorageConfigs(c); err != nil {
+			if err == ErrNotFound {
+				return NamedRef{}, ffs.EmptyJobID, ErrUnstoredCid
+			}
+			return NamedRef{}, ffs.EmptyJobID, fmt.Errorf("getting cid config: %s", err)
+		}
+		ref = NamedRef{Name: name, Cid: c, Version: 1, UpdatedAt: now}
+	} else if !ref.Cid.Equals(c) {
+		jid, err = i.storeNamedCid(ref.Cid, c)
+		if err != nil {
+			return NamedRef{}, ffs.EmptyJobID, err
+		}
+		prev := NamedRefVersion{Cid: ref.Cid, Version: ref.Version, UpdatedAt: ref.UpdatedAt}
+		ref.History = append([]NamedRefVersion{prev}, ref.History...)
+		ref.Cid = c
+		ref.Version++
+		ref.UpdatedAt = now
+		ref.IpnsName = ""
+	}
+	if cfg.retention != nil {
+		ref.Retention = *cfg.retention
+	}
+	if cfg.publish != nil {
+		ref.Publish = *cfg.publish
+	}
+	var dropped []NamedRefVersion
+	if ref.Retention > 0 && len(ref.History) > ref.Retention {
+		dropped = ref.History[ref.Retention:]
+		ref.History = ref.History[:ref.Retention]
+	}
+	if !ref.Publish {
+		ref.IpnsName = ""
+	}
+	if err := i.is.putNamedRef(ref); err != nil {
+		return NamedRef{}, ffs.EmptyJobID, fmt.Errorf("saving named reference: %s", err)
+	}
+	for _, v := range dropped {
+		if err := i.removeNamedCid(v.Cid); err != nil {
+			return NamedRef{}, ffs.EmptyJobID, fmt.Errorf("removing storage of version %d: %s", v.Version, err)
+		}
+	}
+	return ref, jid, nil
+}
+
+// storeNamedCid stores c with the StorageConfig of old, which is the current
+// Cid of a NamedRef. If c is already stored, its StorageConfig is kept.
+func (i *API) storeNamedCid(old, c cid.Cid) (ffs.JobID, error) {
+	if _, err := i.is.getStorageConfigs(c); err == nil {
+		return ffs.EmptyJobID, nil
+	} else if err != ErrNotFound {
+		return ffs.EmptyJobID, fmt.Errorf("getting cid config: %s", err)
+	}
+
+	cfgs, err := i.is.getStorageConfigs(old)
+	if err == ErrNotFound {
+		return ffs.EmptyJobID, ErrReplacedCidNotFound
+	}
+	if err != nil {
+		return ffs.EmptyJobID, fmt.Errorf("getting cid config: %s", err)
+	}
+	jid, err := i.PushStorageConfig(c, WithStorageConfig(cfgs[old]), WithOverride(true))
+	if err != nil {
+		return ffs.EmptyJobID, fmt.Errorf("pushing config for %s: %s", c, err)
+	}
+	return jid, nil
+}
+
+// removeNamedCid removes the storage of c, a version trimmed from the history
+// of a NamedRef, unless other versions or NamedRefs still reference it. c is
+// disabled in Hot and Cold storage, and then removed.
+func (i *API) removeNamedCid(c cid.Cid) error {
+	named, err := i.isNamed(c)
+	if err != nil {
+		return err
+	}
+	if named {
+		return nil
+	}
+	cfgs, err := i.is.getStorageConfigs(c)
+	if err == ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting cid config: %s", err)
+	}
+	cfg := cfgs[c]
+	if cfg.Hot.Enabled || cfg.Cold.Enabled {
+		cfg.Hot.Enabled = false
+		cfg.Cold.Enabled = false
+		jid, err := i.PushStorageConfig(c, WithStorageConfig(cfg), WithOverride(true))
+		if err != nil {
+			return fmt.Errorf("disabling storage of %s: %s", c, err)
+		}
+		log.Infof("disabling storage of unnamed cid %s with job %s", c, jid)
+	}
+	if err := i.remove(c); err != nil {
+		return fmt.Errorf("removing %s: %s", c, err)
+	}
+	return nil
+}
+
+// isNamed returns true if c is the current Cid, or a version in the
+// history, of any NamedRef.
+func (i *API) isNamed(c cid.Cid) (bool, error) {
+	refs, err := i.is.getNamedRefs("")
+	if err != nil {
+		return false, fmt.Errorf("listing named references: %s", err)
+	}
+	for _, r := range refs {
+		if r.Cid.Equals(c) {
+			return true, nil
+		}
+		for _, v := range r.History {
+			if v.Cid.Equals(c) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// publishAfterJob publishes the NamedRef name pointing to c after the Job jid
+// storing c is successful.
+func (i *API) publishAfterJob(name string, c cid.Cid, jid ffs.JobID) {
+	ctx, cancel := context.WithCancel(i.ctx)
+	defer cancel()
+
+	ch := make(chan ffs.StorageJob, 1)
+	go func() {
+		if err := i.WatchJobs(ctx, ch, jid); err != nil {
+			log.Errorf("watching job %s of named reference %s: %s", jid, name, err)
+		}
+		close(ch)
+	}()
+	var done bool
+	for j := range ch {
+		if done {
+			continue
+		}
+		switch j.Status {
+		case ffs.Success:
+			done = true
+			if err := i.publishName(ctx, name, c); err != nil {
+				log.Errorf("publishing named reference %s: %s", name, err)
+			}
+			cancel()
+		case ffs.Failed, ffs.Canceled:
+			done = true
+			log.Warnf("not publishing named reference %s since job %s didn't succeed", name, jid)
+			cancel()
+		}
+	}
+}
+
+// publishName publishes c to IPNS, and saves the IPNS name in the NamedRef
+// name if it still points to c.
+func (i *API) publishName(ctx context.Context, name string, c cid.Cid) error {
+	ipnsName, err := i.sched.PublishName(ctx, namedRefKeyName(i.cfg.ID, name), c)
+	if err != nil {
+		return fmt.Errorf("publishing named reference: %s", err)
+	}
+
+	i.namesLock.Lock()
+	defer i.namesLock.Unlock()
+	ref, err := i.is.getNamedRef(name)
+	if err == ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting named reference: %s", err)
+	}
+	if !ref.Publish || !ref.Cid.Equals(c) {
+		return nil
+	}
+	ref.IpnsName = ipnsName
+	if err := i.is.putNamedRef(ref); err != nil {
+		return fmt.Errorf("saving named reference: %s", err)
+	}
+	return nil
+}
+
+// namedRefKeyName returns the name of the IPNS key of a NamedRef, which
+// is unique per instance and name.
+func namedRefKeyName(iid ffs.APIID, name string) string {
+	return fmt.Sprintf("pow-%x", sha256.Sum256([]byte(iid.String()+"/"+name)))
+}
+
+func validateName(name string) error {
+	if name == "" {
+		return ErrInvalidName
+	}
+	for _, s := range strings.Split(name, "/") {
+		if s == "" || s == "." || s == ".." {
+			return ErrInvalidName
+		}
+	}
+	return nil
+}
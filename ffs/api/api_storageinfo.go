@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ipfs/go-cid"
@@ -25,3 +26,35 @@ func (i *API) StorageInfo(cid cid.Cid) (ffs.StorageInfo, error) {
 func (i *API) ListStorageInfo(cids ...cid.Cid) ([]ffs.StorageInfo, error) {
 	return i.sched.ListStorageInfo([]ffs.APIID{i.cfg.ID}, cids)
 }
+
+// ExpirationForecasts returns the expiration forecasts of stored cids having deals
+// expiring within the next window epochs, and the chain height used for the forecast.
+func (i *API) ExpirationForecasts(ctx context.Context, window uint64) ([]ffs.ExpirationForecast, uint64, error) {
+	res, height, err := i.sched.ForecastExpirations(ctx, []ffs.APIID{i.cfg.ID}, window)
+	if err != nil {
+		return nil, 0, fmt.Errorf("forecasting expirations: %s", err)
+	}
+	return res, height, nil
+}
+
+// AuditReports returns the last audit reports of stored cids, filtered by cids if provided.
+func (i *API) AuditReports(cids ...cid.Cid) ([]ffs.AuditReport, error) {
+	res, err := i.sched.AuditReports([]ffs.APIID{i.cfg.ID}, cids)
+	if err != nil {
+		return nil, fmt.Errorf("getting audit reports: %s", err)
+	}
+	return res, nil
+}
+
+// Usage returns the effective usage of the instance, which attributes data
+// stored by multiple users in equal parts to each of them.
+func (i *API) Usage() (ffs.Usage, error) {
+	res, err := i.sched.Usage([]ffs.APIID{i.cfg.ID})
+	if err != nil {
+		return ffs.Usage{}, fmt.Errorf("getting usage: %s", err)
+	}
+	if len(res) == 0 {
+		return ffs.Usage{APIID: i.cfg.ID}, nil
+	}
+	return res[0], nil
+}
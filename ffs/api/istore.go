@@ -3,6 +3,8 @@ package api
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-datastore/query"
 	"github.com/textileio/powergate/v2/ffs"
+	txndstr "github.com/textileio/powergate/v2/txndstransform"
 	"github.com/textileio/powergate/v2/util"
 )
 
@@ -17,14 +20,15 @@ var (
 	dsInstanceConfig       = datastore.NewKey("instanceconfig")
 	dsBaseCidStorageConfig = datastore.NewKey("cidstorageconfig")
 	dsBaseRetrievalRequest = datastore.NewKey("retrievalrequest")
+	dsBaseNamedRef         = datastore.NewKey("namedref")
 )
 
 type instanceStore struct {
 	lock sync.Mutex
-	ds   datastore.Datastore
+	ds   *txndstr.Datastore
 }
 
-func newInstanceStore(ds datastore.Datastore) *instanceStore {
+func newInstanceStore(ds *txndstr.Datastore) *instanceStore {
 	return &instanceStore{
 		ds: ds,
 	}
@@ -61,6 +65,22 @@ func (s *instanceStore) getInstanceConfig() (InstanceConfig, error) {
 }
 
 func (s *instanceStore) putStorageConfig(c cid.Cid, sc ffs.StorageConfig) error {
+	return s.putStorageConfigTo(s.ds, c, sc)
+}
+
+// newRootTransaction returns a transaction of the root datastore, which can
+// be shared with stores in other namespaces of the root datastore.
+func (s *instanceStore) newRootTransaction() (datastore.Txn, error) {
+	return s.ds.NewRootTransaction(false)
+}
+
+// putStorageConfigTxn is like putStorageConfig, but writes in txn, which
+// should be a transaction returned by newRootTransaction.
+func (s *instanceStore) putStorageConfigTxn(txn datastore.Txn, c cid.Cid, sc ffs.StorageConfig) error {
+	return s.putStorageConfigTo(s.ds.WrapRootTxn(txn), c, sc)
+}
+
+func (s *instanceStore) putStorageConfigTo(w datastore.Write, c cid.Cid, sc ffs.StorageConfig) error {
 	if !c.Defined() {
 		return fmt.Errorf("cid can't be undefined")
 	}
@@ -68,7 +88,7 @@ func (s *instanceStore) putStorageConfig(c cid.Cid, sc ffs.StorageConfig) error
 	if err != nil {
 		return fmt.Errorf("marshaling cid config to datastore: %s", err)
 	}
-	if err := s.ds.Put(makeStorageConfigKey(c), buf); err != nil {
+	if err := w.Put(makeStorageConfigKey(c), buf); err != nil {
 		return fmt.Errorf("saving cid config to datastore: %s", err)
 	}
 	return nil
@@ -212,3 +232,72 @@ func (s *instanceStore) getRetrievalRequest(rid ffs.RetrievalID) (retrievalReque
 func makeRetrievalRequestKey(rid ffs.RetrievalID) datastore.Key {
 	return dsBaseRetrievalRequest.ChildString(rid.String())
 }
+
+func (s *instanceStore) putNamedRef(ref NamedRef) error {
+	buf, err := json.Marshal(ref)
+	if err != nil {
+		return fmt.Errorf("marshaling named reference for datastore: %s", err)
+	}
+	if err := s.ds.Put(makeNamedRefKey(ref.Name), buf); err != nil {
+		return fmt.Errorf("saving named reference to datastore: %s", err)
+	}
+	return nil
+}
+
+func (s *instanceStore) getNamedRef(name string) (NamedRef, error) {
+	buf, err := s.ds.Get(makeNamedRefKey(name))
+	if err != nil {
+		if err == datastore.ErrNotFound {
+			return NamedRef{}, ErrNotFound
+		}
+		return NamedRef{}, fmt.Errorf("getting named reference from datastore: %s", err)
+	}
+	var ref NamedRef
+	if err := json.Unmarshal(buf, &ref); err != nil {
+		return NamedRef{}, fmt.Errorf("unmarshaling named reference from datastore: %s", err)
+	}
+	return ref, nil
+}
+
+func (s *instanceStore) removeNamedRef(name string) error {
+	if err := s.ds.Delete(makeNamedRefKey(name)); err != nil {
+		return fmt.Errorf("removing named reference from datastore: %s", err)
+	}
+	return nil
+}
+
+// getNamedRefs returns the named references with names starting
+// with prefix, sorted by name.
+func (s *instanceStore) getNamedRefs(prefix string) ([]NamedRef, error) {
+	q := query.Query{
+		Prefix: dsBaseNamedRef.String(),
+	}
+	res, err := s.ds.Query(q)
+	if err != nil {
+		return nil, fmt.Errorf("querying named references: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+	var refs []NamedRef
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", r.Error)
+		}
+		var ref NamedRef
+		if err := json.Unmarshal(r.Value, &ref); err != nil {
+			return nil, fmt.Errorf("unmarshaling named reference from datastore: %s", err)
+		}
+		if strings.HasPrefix(ref.Name, prefix) {
+			refs = append(refs, ref)
+		}
+	}
+	sort.Slice(refs, func(a, b int) bool { return refs[a].Name < refs[b].Name })
+	return refs, nil
+}
+
+func makeNamedRefKey(name string) datastore.Key {
+	return dsBaseNamedRef.Child(datastore.NewKey(name))
+}
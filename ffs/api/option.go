@@ -94,6 +94,25 @@ func WithHistory(enabled bool) GetLogsOption {
 	}
 }
 
+// WithMinLevel filters only log messages with a level equal
+// or higher than level.
+func WithMinLevel(level ffs.LogLevel) GetLogsOption {
+	return func(c *GetLogsConfig) {
+		c.minLevel = level
+	}
+}
+
+// WithEventTypes filters only log messages with any of the
+// provided event types. If none are provided, no filtering is done.
+func WithEventTypes(types ...ffs.LogEventType) GetLogsOption {
+	return func(c *GetLogsConfig) {
+		c.eventTypes = make(map[ffs.LogEventType]struct{}, len(types))
+		for _, t := range types {
+			c.eventTypes[t] = struct{}{}
+		}
+	}
+}
+
 // RetrievalOption provides a retrieval configuration setup.
 type RetrievalOption func(*retrievalConfig)
 
@@ -112,3 +131,21 @@ func WithRetrievalMaxPrice(maxPrice uint64) RetrievalOption {
 		prc.maxPrice = maxPrice
 	}
 }
+
+// SetNameOption provides a configuration setup for setting a NamedRef.
+type SetNameOption func(*SetNameConfig)
+
+// WithNameRetention indicates the maximum number of previous versions
+// kept stored in the history of the NamedRef. Zero means no limit.
+func WithNameRetention(retention int) SetNameOption {
+	return func(c *SetNameConfig) {
+		c.retention = &retention
+	}
+}
+
+// WithNamePublish indicates if the NamedRef is published to IPNS.
+func WithNamePublish(publish bool) SetNameOption {
+	return func(c *SetNameConfig) {
+		c.publish = &publish
+	}
+}
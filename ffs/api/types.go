@@ -2,13 +2,19 @@ package api
 
 import (
 	"errors"
+	"time"
 
+	"github.com/ipfs/go-cid"
 	"github.com/textileio/powergate/v2/ffs"
 )
 
 var (
 	// ErrNotFound returned when instance configuration doesn't exist.
 	ErrNotFound = errors.New("stored item not found")
+	// ErrInvalidName returned when a name of a NamedRef isn't valid.
+	ErrInvalidName = errors.New("invalid name, it should be a non-empty path without empty, . or .. segments")
+	// ErrUnstoredCid returned when creating a NamedRef to a Cid without a StorageConfig.
+	ErrUnstoredCid = errors.New("cid isn't stored, push a storage config for it first")
 )
 
 // InstanceConfig has general information about a Api instance.
@@ -34,6 +40,54 @@ type NewAddressConfig struct {
 // GetLogsConfig contains configuration for a stream-log
 // of human-friendly messages for a Cid execution.
 type GetLogsConfig struct {
-	jid     ffs.JobID
-	history bool
+	jid        ffs.JobID
+	history    bool
+	minLevel   ffs.LogLevel
+	eventTypes map[ffs.LogEventType]struct{}
+}
+
+func (c *GetLogsConfig) match(le ffs.LogEntry) bool {
+	if c.jid != ffs.EmptyJobID && c.jid != le.Jid {
+		return false
+	}
+	if le.Level < c.minLevel {
+		return false
+	}
+	if len(c.eventTypes) > 0 {
+		if _, ok := c.eventTypes[le.Type]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// NamedRef is a mutable reference, identified by a name, to a stored Cid.
+type NamedRef struct {
+	Name      string
+	Cid       cid.Cid
+	Version   int
+	UpdatedAt time.Time
+	// History contains the previous versions of the reference,
+	// from newest to oldest.
+	History []NamedRefVersion
+	// Retention is the maximum number of previous versions kept
+	// stored in History. Zero means no limit.
+	Retention int
+	// Publish indicates if the reference is published to IPNS, and
+	// IpnsName is the IPNS name where it's published.
+	Publish  bool
+	IpnsName string
+}
+
+// NamedRefVersion is a previous version of a NamedRef.
+type NamedRefVersion struct {
+	Cid       cid.Cid
+	Version   int
+	UpdatedAt time.Time
+}
+
+// SetNameConfig contains options for setting a NamedRef.
+type SetNameConfig struct {
+	retention *int
+	publish   *bool
 }
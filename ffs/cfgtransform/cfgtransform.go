@@ -0,0 +1,297 @@
+// Package cfgtransform implements a declarative language to bulk
+// transform storage configs.
+//
+// A transformation selects storage configs by user id, cid, and field
+// conditions, and sets new values to fields of the selected configs.
+// Fields are referenced by their dot-separated path in ffs.StorageConfig,
+// matched case-insensitively, e.g: cold.filecoin.maxPrice.
+//
+// Conditions have the form <path><op><value>, where op is one of
+// =, !=, <, <=, > or >=. Ordering operators are only allowed for
+// numeric fields. Assignments have the form <path>=<value>.
+//
+// Values are JSON literals of the field type, e.g: 100, true, or
+// ["f01000","f02000"]. String values can be provided without quotes.
+package cfgtransform
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/util"
+)
+
+// Spec is a declarative storage config transformation.
+type Spec struct {
+	// UserIDs selects only storage configs of these users. If empty,
+	// configs of all users are selected.
+	UserIDs []string `json:"user_ids,omitempty"`
+	// Cids selects only storage configs of these cids. If empty,
+	// configs of all cids are selected.
+	Cids []string `json:"cids,omitempty"`
+	// Where are conditions that selected storage configs must satisfy.
+	Where []string `json:"where,omitempty"`
+	// Set are the assignments applied to selected storage configs.
+	Set []string `json:"set"`
+}
+
+// Transform is a compiled Spec.
+type Transform struct {
+	userIDs map[ffs.APIID]struct{}
+	cids    map[cid.Cid]struct{}
+	conds   []condition
+	sets    []assignment
+}
+
+type operator string
+
+const (
+	opEq  operator = "="
+	opNeq operator = "!="
+	opLt  operator = "<"
+	opLte operator = "<="
+	opGt  operator = ">"
+	opGte operator = ">="
+)
+
+type condition struct {
+	path  []int
+	op    operator
+	value reflect.Value
+}
+
+type assignment struct {
+	path  []int
+	value reflect.Value
+}
+
+var storageConfigType = reflect.TypeOf(ffs.StorageConfig{})
+
+// Compile validates a Spec and returns the corresponding Transform.
+func Compile(s Spec) (*Transform, error) {
+	if len(s.Set) == 0 {
+		return nil, fmt.Errorf("at least one assignment is required")
+	}
+	t := &Transform{
+		userIDs: make(map[ffs.APIID]struct{}, len(s.UserIDs)),
+		cids:    make(map[cid.Cid]struct{}, len(s.Cids)),
+	}
+	for _, id := range s.UserIDs {
+		iid := ffs.APIID(id)
+		if !iid.Valid() {
+			return nil, fmt.Errorf("invalid user id %s", id)
+		}
+		t.userIDs[iid] = struct{}{}
+	}
+	for _, cs := range s.Cids {
+		c, err := util.CidFromString(cs)
+		if err != nil {
+			return nil, fmt.Errorf("parsing cid %s: %s", cs, err)
+		}
+		t.cids[c] = struct{}{}
+	}
+	for _, w := range s.Where {
+		c, err := parseCondition(w)
+		if err != nil {
+			return nil, fmt.Errorf("parsing condition %q: %s", w, err)
+		}
+		t.conds = append(t.conds, c)
+	}
+	for _, a := range s.Set {
+		path, op, raw := splitExpr(a)
+		if op != opEq {
+			return nil, fmt.Errorf("assignment %q must have the form <path>=<value>", a)
+		}
+		idx, typ, err := resolvePath(path)
+		if err != nil {
+			return nil, fmt.Errorf("parsing assignment %q: %s", a, err)
+		}
+		v, err := parseValue(raw, typ)
+		if err != nil {
+			return nil, fmt.Errorf("parsing assignment %q: %s", a, err)
+		}
+		t.sets = append(t.sets, assignment{path: idx, value: v})
+	}
+	return t, nil
+}
+
+// Selects returns true if storage configs of the user and cid are
+// selected by the transformation.
+func (t *Transform) Selects(iid ffs.APIID, c cid.Cid) bool {
+	if len(t.userIDs) > 0 {
+		if _, ok := t.userIDs[iid]; !ok {
+			return false
+		}
+	}
+	if len(t.cids) > 0 {
+		if _, ok := t.cids[c]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply applies the transformation to cfg if it satisfies all conditions.
+// It returns true if cfg was modified. The resulting storage config
+// is validated, and cfg is left untouched if it's invalid.
+func (t *Transform) Apply(cfg *ffs.StorageConfig) (bool, error) {
+	v := reflect.ValueOf(cfg).Elem()
+	for _, c := range t.conds {
+		if !c.eval(v.FieldByIndex(c.path)) {
+			return false, nil
+		}
+	}
+
+	res := *cfg
+	resv := reflect.ValueOf(&res).Elem()
+	var modified bool
+	for _, a := range t.sets {
+		f := resv.FieldByIndex(a.path)
+		if reflect.DeepEqual(f.Interface(), a.value.Interface()) {
+			continue
+		}
+		f.Set(a.value)
+		modified = true
+	}
+	if !modified {
+		return false, nil
+	}
+	if err := res.Validate(); err != nil {
+		return false, fmt.Errorf("transformed storage config is invalid: %s", err)
+	}
+	*cfg = res
+	return true, nil
+}
+
+func (c condition) eval(f reflect.Value) bool {
+	switch c.op {
+	case opEq:
+		return reflect.DeepEqual(f.Interface(), c.value.Interface())
+	case opNeq:
+		return !reflect.DeepEqual(f.Interface(), c.value.Interface())
+	}
+	cmp := compare(f, c.value)
+	switch c.op {
+	case opLt:
+		return cmp < 0
+	case opLte:
+		return cmp <= 0
+	case opGt:
+		return cmp > 0
+	case opGte:
+		return cmp >= 0
+	}
+	return false
+}
+
+func compare(a, b reflect.Value) int {
+	var x, y float64
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if a.Int() < b.Int() {
+			return -1
+		} else if a.Int() > b.Int() {
+			return 1
+		}
+		return 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if a.Uint() < b.Uint() {
+			return -1
+		} else if a.Uint() > b.Uint() {
+			return 1
+		}
+		return 0
+	default:
+		x, y = a.Float(), b.Float()
+	}
+	if x < y {
+		return -1
+	} else if x > y {
+		return 1
+	}
+	return 0
+}
+
+func parseCondition(expr string) (condition, error) {
+	path, op, raw := splitExpr(expr)
+	if op == "" {
+		return condition{}, fmt.Errorf("missing operator")
+	}
+	idx, typ, err := resolvePath(path)
+	if err != nil {
+		return condition{}, err
+	}
+	if op != opEq && op != opNeq && !isNumeric(typ.Kind()) {
+		return condition{}, fmt.Errorf("operator %s is only allowed for numeric fields", op)
+	}
+	v, err := parseValue(raw, typ)
+	if err != nil {
+		return condition{}, err
+	}
+	return condition{path: idx, op: op, value: v}, nil
+}
+
+// splitExpr splits an expression in its path, operator and value parts.
+func splitExpr(expr string) (string, operator, string) {
+	i := strings.IndexAny(expr, "!<>=")
+	if i == -1 {
+		return expr, "", ""
+	}
+	op := operator(expr[i : i+1])
+	if i+1 < len(expr) && expr[i+1] == '=' && op != opEq {
+		op = operator(expr[i : i+2])
+	}
+	if op == "!" {
+		return expr, "", ""
+	}
+	return strings.TrimSpace(expr[:i]), op, strings.TrimSpace(expr[i+len(op):])
+}
+
+// resolvePath returns the field index sequence and type of a
+// dot-separated path of ffs.StorageConfig.
+func resolvePath(path string) ([]int, reflect.Type, error) {
+	if path == "" {
+		return nil, nil, fmt.Errorf("empty field path")
+	}
+	var idx []int
+	typ := storageConfigType
+	for _, name := range strings.Split(path, ".") {
+		if typ.Kind() != reflect.Struct {
+			return nil, nil, fmt.Errorf("%s isn't a struct field", name)
+		}
+		f, ok := typ.FieldByNameFunc(func(n string) bool {
+			return strings.EqualFold(n, name)
+		})
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown field %s in %s", name, path)
+		}
+		idx = append(idx, f.Index...)
+		typ = f.Type
+	}
+	return idx, typ, nil
+}
+
+func parseValue(raw string, typ reflect.Type) (reflect.Value, error) {
+	v := reflect.New(typ)
+	if err := json.Unmarshal([]byte(raw), v.Interface()); err != nil {
+		if typ.Kind() != reflect.String {
+			return reflect.Value{}, fmt.Errorf("invalid %s value %q: %s", typ, raw, err)
+		}
+		v.Elem().SetString(raw)
+	}
+	return v.Elem(), nil
+}
+
+func isNumeric(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
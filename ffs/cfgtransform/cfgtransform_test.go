@@ -0,0 +1,140 @@
+package cfgtransform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/util"
+)
+
+var baseConfig = ffs.StorageConfig{
+	Hot: ffs.HotConfig{
+		Enabled: true,
+		Ipfs:    ffs.IpfsConfig{AddTimeout: 30},
+	},
+	Cold: ffs.ColdConfig{
+		Enabled: true,
+		Filecoin: ffs.FilConfig{
+			RepFactor:       1,
+			DealMinDuration: 518400,
+			Addr:            "f3addr",
+		},
+	},
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		spec     Spec
+		modified bool
+		check    func(t *testing.T, cfg ffs.StorageConfig)
+	}{
+		{
+			name:     "unconditional",
+			spec:     Spec{Set: []string{"hot.ipfs.addTimeout=480"}},
+			modified: true,
+			check: func(t *testing.T, cfg ffs.StorageConfig) {
+				require.Equal(t, 480, cfg.Hot.Ipfs.AddTimeout)
+			},
+		},
+		{
+			name:     "matching condition",
+			spec:     Spec{Where: []string{"cold.filecoin.maxPrice=0"}, Set: []string{"Cold.Filecoin.MaxPrice=1000", "cold.filecoin.excludedMiners=[\"f01\",\"f02\"]"}},
+			modified: true,
+			check: func(t *testing.T, cfg ffs.StorageConfig) {
+				require.Equal(t, uint64(1000), cfg.Cold.Filecoin.MaxPrice)
+				require.Equal(t, []string{"f01", "f02"}, cfg.Cold.Filecoin.ExcludedMiners)
+			},
+		},
+		{
+			name: "non-matching condition",
+			spec: Spec{Where: []string{"hot.ipfs.addTimeout>=30", "hot.enabled=false"}, Set: []string{"hot.ipfs.addTimeout=480"}},
+		},
+		{
+			name:     "ordering condition",
+			spec:     Spec{Where: []string{"hot.ipfs.addTimeout<480"}, Set: []string{"hot.ipfs.addTimeout=480"}},
+			modified: true,
+		},
+		{
+			name:     "unquoted string",
+			spec:     Spec{Where: []string{"cold.filecoin.addr!=f3other"}, Set: []string{"cold.filecoin.addr=f3other"}},
+			modified: true,
+			check: func(t *testing.T, cfg ffs.StorageConfig) {
+				require.Equal(t, "f3other", cfg.Cold.Filecoin.Addr)
+			},
+		},
+		{
+			name: "same value",
+			spec: Spec{Set: []string{"hot.ipfs.addTimeout=30"}},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tr, err := Compile(tt.spec)
+			require.NoError(t, err)
+			cfg := baseConfig
+			modified, err := tr.Apply(&cfg)
+			require.NoError(t, err)
+			require.Equal(t, tt.modified, modified)
+			if !tt.modified {
+				require.Equal(t, baseConfig, cfg)
+			}
+			if tt.check != nil {
+				tt.check(t, cfg)
+			}
+		})
+	}
+}
+
+func TestApplyInvalidResult(t *testing.T) {
+	t.Parallel()
+	tr, err := Compile(Spec{Set: []string{"cold.filecoin.repFactor=0"}})
+	require.NoError(t, err)
+	cfg := baseConfig
+	_, err = tr.Apply(&cfg)
+	require.Error(t, err)
+	require.Equal(t, baseConfig, cfg)
+}
+
+func TestCompileErrors(t *testing.T) {
+	t.Parallel()
+	invalid := []Spec{
+		{},
+		{Set: []string{"hot.unknown=1"}},
+		{Set: []string{"hot.ipfs.addTimeout<1"}},
+		{Set: []string{"hot.ipfs.addTimeout=abc"}},
+		{Set: []string{"hot.ipfs=1"}},
+		{Set: []string{"hot.ipfs.addTimeout.x=1"}},
+		{Where: []string{"hot.enabled<true"}, Set: []string{"hot.enabled=true"}},
+		{Where: []string{"hot.enabled"}, Set: []string{"hot.enabled=true"}},
+		{UserIDs: []string{""}, Set: []string{"hot.enabled=true"}},
+		{Cids: []string{"invalid"}, Set: []string{"hot.enabled=true"}},
+	}
+	for _, s := range invalid {
+		_, err := Compile(s)
+		require.Error(t, err, "%v", s)
+	}
+}
+
+func TestSelects(t *testing.T) {
+	t.Parallel()
+	c1, _ := util.CidFromString("QmPewMLNYZS5ehyCRWnYpGMMvw5TJhLkHFkhYTNJBjnQZp")
+	c2, _ := util.CidFromString("QmVZW8Sz9hHmJTEnMRXmwNohN9Qd6gHvGSvbBUWUtCzoHk")
+	iid1 := ffs.NewAPIID()
+	iid2 := ffs.NewAPIID()
+
+	tr, err := Compile(Spec{Set: []string{"hot.enabled=true"}})
+	require.NoError(t, err)
+	require.True(t, tr.Selects(iid1, c1))
+
+	tr, err = Compile(Spec{UserIDs: []string{iid1.String()}, Cids: []string{c2.String()}, Set: []string{"hot.enabled=true"}})
+	require.NoError(t, err)
+	require.True(t, tr.Selects(iid1, c2))
+	require.False(t, tr.Selects(iid1, c1))
+	require.False(t, tr.Selects(iid2, c2))
+}
@@ -0,0 +1,231 @@
+package coreipfs
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	iface "github.com/ipfs/interface-go-ipfs-core"
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	car "github.com/ipld/go-car"
+	"github.com/ipld/go-ipld-prime"
+	_ "github.com/ipld/go-ipld-prime/codec/dagcbor" // Register dag-cbor for selector traversals.
+	"github.com/ipld/go-ipld-prime/codec/dagjson"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+	"github.com/ipld/go-ipld-prime/traversal/selector"
+	"github.com/ipld/go-ipld-prime/traversal/selector/builder"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+// carV2Pragma is the prefix of CARv2 files, which is a CARv1
+// header declaring version 2.
+var carV2Pragma = []byte{0x0a, 0xa1, 0x67, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x02}
+
+// carV2HeaderSize is the size of the CARv2 header after the pragma, which
+// has 16 bytes of characteristics, and the data offset, data size and
+// index offset as little-endian uint64s.
+const carV2HeaderSize = 40
+
+// importCar puts the blocks of a CARv1 or CARv2 file in an IPFS node, and
+// returns its roots. Every block is validated against its Cid, and roots
+// should be included in the file so pinning them doesn't fetch data from
+// the IPFS network.
+func importCar(ctx context.Context, ipfs iface.CoreAPI, r io.Reader) ([]cid.Cid, error) {
+	payload, err := carV1Payload(r)
+	if err != nil {
+		return nil, err
+	}
+	cr, err := car.NewCarReader(payload)
+	if err != nil {
+		return nil, fmt.Errorf("reading car header: %s", err)
+	}
+	if len(cr.Header.Roots) == 0 {
+		return nil, fmt.Errorf("car file has no roots")
+	}
+	imported := cid.NewSet()
+	for {
+		b, err := cr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading car block: %s", err)
+		}
+		if err := putBlock(ctx, ipfs, b); err != nil {
+			return nil, err
+		}
+		imported.Add(b.Cid())
+	}
+	for _, root := range cr.Header.Roots {
+		if !imported.Has(root) {
+			return nil, fmt.Errorf("root %s isn't included in the car file", root)
+		}
+	}
+	return cr.Header.Roots, nil
+}
+
+// checkDagComplete walks the whole DAG of c reading blocks only from the
+// local blockstore of an IPFS node, so it fails if any block is missing.
+func checkDagComplete(ctx context.Context, ipfs iface.CoreAPI, c cid.Cid) error {
+	offline, err := ipfs.WithOptions(options.Api.Offline(true))
+	if err != nil {
+		return fmt.Errorf("creating offline ipfs api: %s", err)
+	}
+	sel, err := parseSelector("")
+	if err != nil {
+		return err
+	}
+	sc := car.NewSelectiveCar(ctx, &blockStore{ctx: ctx, ipfs: offline}, []car.Dag{{Root: c, Selector: sel}})
+	if err := sc.Write(ioutil.Discard); err != nil {
+		return fmt.Errorf("dag of root %s isn't complete: %s", c, err)
+	}
+	return nil
+}
+
+// carV1Payload returns the CARv1 data of a CARv1 or CARv2 file. Indexes of
+// CARv2 files are ignored.
+func carV1Payload(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	pragma, err := br.Peek(len(carV2Pragma))
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("reading car pragma: %s", err)
+	}
+	if !bytes.Equal(pragma, carV2Pragma) {
+		return br, nil
+	}
+	if _, err := br.Discard(len(carV2Pragma)); err != nil {
+		return nil, fmt.Errorf("discarding car pragma: %s", err)
+	}
+	var header [carV2HeaderSize]byte
+	if _, err := io.ReadFull(br, header[:]); err != nil {
+		return nil, fmt.Errorf("reading carv2 header: %s", err)
+	}
+	dataOffset := binary.LittleEndian.Uint64(header[16:])
+	dataSize := binary.LittleEndian.Uint64(header[24:])
+	read := uint64(len(carV2Pragma) + carV2HeaderSize)
+	if dataOffset < read {
+		return nil, fmt.Errorf("invalid carv2 data offset %d", dataOffset)
+	}
+	if _, err := io.CopyN(ioutil.Discard, br, int64(dataOffset-read)); err != nil {
+		return nil, fmt.Errorf("skipping to carv2 data: %s", err)
+	}
+	return io.LimitReader(br, int64(dataSize)), nil
+}
+
+// writeCarV2Header writes the pragma and header of a CARv2 file without
+// index, which wraps CARv1 data of the provided size.
+func writeCarV2Header(w io.Writer, dataSize uint64) error {
+	var header [carV2HeaderSize]byte
+	binary.LittleEndian.PutUint64(header[16:], uint64(len(carV2Pragma)+carV2HeaderSize))
+	binary.LittleEndian.PutUint64(header[24:], dataSize)
+	if _, err := w.Write(carV2Pragma); err != nil {
+		return fmt.Errorf("writing car pragma: %s", err)
+	}
+	if _, err := w.Write(header[:]); err != nil {
+		return fmt.Errorf("writing carv2 header: %s", err)
+	}
+	return nil
+}
+
+// putBlock validates that the data of b matches its Cid, and puts it in
+// an IPFS node.
+func putBlock(ctx context.Context, ipfs iface.CoreAPI, b blocks.Block) error {
+	prefix := b.Cid().Prefix()
+	c, err := prefix.Sum(b.RawData())
+	if err != nil {
+		return fmt.Errorf("hashing block %s: %s", b.Cid(), err)
+	}
+	if !c.Equals(b.Cid()) {
+		return fmt.Errorf("block data doesn't match cid %s", b.Cid())
+	}
+	format := cid.CodecToStr[prefix.Codec]
+	if prefix.Version == 0 {
+		format = "v0"
+	}
+	stat, err := ipfs.Block().Put(ctx, bytes.NewReader(b.RawData()),
+		options.Block.Hash(prefix.MhType, prefix.MhLength),
+		options.Block.Format(format))
+	if err != nil {
+		return fmt.Errorf("putting block %s: %s", b.Cid(), err)
+	}
+	if !stat.Path().Cid().Equals(b.Cid()) {
+		return fmt.Errorf("ipfs node put block %s as %s", b.Cid(), stat.Path().Cid())
+	}
+	return nil
+}
+
+// exportCar writes a CAR file of the DAG of c, restricted to the blocks
+// traversed by the configured selector.
+func exportCar(ctx context.Context, ipfs iface.CoreAPI, c cid.Cid, w io.Writer, opts ffs.CarOptions) error {
+	sel, err := parseSelector(opts.Selector)
+	if err != nil {
+		return err
+	}
+	sc := car.NewSelectiveCar(ctx, &blockStore{ctx: ctx, ipfs: ipfs}, []car.Dag{{Root: c, Selector: sel}})
+	switch opts.Version {
+	case 0, 1:
+		if err := sc.Write(w); err != nil {
+			return fmt.Errorf("writing car: %s", err)
+		}
+	case 2:
+		// The CARv2 header has the size of the CARv1 data, so the
+		// DAG is traversed before writing anything.
+		prepared, err := sc.Prepare()
+		if err != nil {
+			return fmt.Errorf("traversing dag: %s", err)
+		}
+		if err := writeCarV2Header(w, prepared.Size()); err != nil {
+			return err
+		}
+		if err := prepared.Dump(w); err != nil {
+			return fmt.Errorf("writing car: %s", err)
+		}
+	default:
+		return fmt.Errorf("unknown car version %d", opts.Version)
+	}
+	return nil
+}
+
+// parseSelector parses a dag-json encoded IPLD selector. An empty
+// selector traverses the whole DAG.
+func parseSelector(s string) (ipld.Node, error) {
+	if s == "" {
+		ssb := builder.NewSelectorSpecBuilder(basicnode.Prototype.Any)
+		return ssb.ExploreRecursive(selector.RecursionLimitNone(), ssb.ExploreAll(ssb.ExploreRecursiveEdge())).Node(), nil
+	}
+	nb := basicnode.Prototype.Any.NewBuilder()
+	if err := dagjson.Decoder(nb, strings.NewReader(s)); err != nil {
+		return nil, fmt.Errorf("decoding selector: %s", err)
+	}
+	sel := nb.Build()
+	if _, err := selector.ParseSelector(sel); err != nil {
+		return nil, fmt.Errorf("parsing selector: %s", err)
+	}
+	return sel, nil
+}
+
+// blockStore reads blocks from an IPFS node.
+type blockStore struct {
+	ctx  context.Context
+	ipfs iface.CoreAPI
+}
+
+func (bs *blockStore) Get(c cid.Cid) (blocks.Block, error) {
+	r, err := bs.ipfs.Block().Get(bs.ctx, path.IpldPath(c))
+	if err != nil {
+		return nil, fmt.Errorf("getting block %s: %s", c, err)
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading block %s: %s", c, err)
+	}
+	return blocks.NewBlockWithCid(data, c)
+}
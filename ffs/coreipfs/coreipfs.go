@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 	"sync"
 	"time"
 
@@ -32,40 +33,107 @@ var (
 )
 
 // CoreIpfs is an implementation of HotStorage interface which saves data
-// into a remote go-ipfs using the HTTP API.
+// into remote go-ipfs nodes using the HTTP API. Pinned data is sharded
+// across the primary node and the configured extra nodes.
 type CoreIpfs struct {
-	ipfs iface.CoreAPI
-	ps   *pinstore.Store
+	ipfs        iface.CoreAPI
+	ps          *pinstore.Store
+	nodes       []*node
+	nodesByName map[string]*node
+
+	healthCheckFrequency time.Duration
 
 	lock sync.Mutex
+	// stageLock is held for reading while staging data, since go-ipfs
+	// nodes pin the data before it's saved in the pinstore. Reconcile
+	// holds it for writing to avoid considering those pins unknown.
+	stageLock sync.RWMutex
+	// pending contains the Cids being pinned in nodes by moves and
+	// reconciliations without holding locks, keyed by node name and
+	// multihash, which Reconcile doesn't consider unknown.
+	pending map[pendingPin]int
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	finished chan struct{}
 }
 
 var _ ffs.HotStorage = (*CoreIpfs)(nil)
 
-// New returns a new CoreIpfs instance.
-func New(ds datastore.TxnDatastore, ipfs iface.CoreAPI, l ffs.JobLogger) (*CoreIpfs, error) {
+// New returns a new CoreIpfs instance, where ipfs is the primary go-ipfs node.
+// If extra nodes are added to the configured ones, pinned data is rebalanced
+// across all nodes in the background.
+func New(ds datastore.TxnDatastore, ipfs iface.CoreAPI, l ffs.JobLogger, opts ...Option) (*CoreIpfs, error) {
+	var cfg config
+	for _, o := range opts {
+		o(&cfg)
+	}
 	ps, err := pinstore.New(txndstr.Wrap(ds, "pinstore"))
 	if err != nil {
 		return nil, fmt.Errorf("loading pinstore: %s", err)
 	}
+	primary := &node{api: ipfs, healthy: true}
+	nodes := []*node{primary}
+	nodesByName := map[string]*node{"": primary}
+	for _, n := range cfg.nodes {
+		if n.Name == "" {
+			return nil, fmt.Errorf("ipfs node name can't be empty")
+		}
+		if _, ok := nodesByName[n.Name]; ok {
+			return nil, fmt.Errorf("duplicated ipfs node %s", n.Name)
+		}
+		nn := &node{name: n.Name, api: n.API, healthy: true}
+		nodes = append(nodes, nn)
+		nodesByName[n.Name] = nn
+	}
+	added, err := updateNodes(ds, nodes)
+	if err != nil {
+		return nil, fmt.Errorf("updating ipfs nodes: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
 	ci := &CoreIpfs{
-		ipfs: ipfs,
-		ps:   ps,
+		ipfs:                 ipfs,
+		ps:                   ps,
+		nodes:                nodes,
+		nodesByName:          nodesByName,
+		healthCheckFrequency: cfg.healthCheckFrequency,
+		ctx:                  ctx,
+		cancel:               cancel,
+		finished:             make(chan struct{}),
+		pending:              map[pendingPin]int{},
 	}
+	go ci.run(added)
 	return ci, nil
 }
 
+// Close stops health checks and any rebalancing in progress.
+func (ci *CoreIpfs) Close() error {
+	ci.cancel()
+	<-ci.finished
+	return nil
+}
+
 // Stage adds the data of io.Reader in the storage, and creates a stage-pin on the resulting cid.
-func (ci *CoreIpfs) Stage(ctx context.Context, iid ffs.APIID, r io.Reader) (cid.Cid, error) {
-	p, err := ci.ipfs.Unixfs().Add(ctx, ipfsfiles.NewReaderFile(r), options.Unixfs.Pin(true))
+func (ci *CoreIpfs) Stage(ctx context.Context, iid ffs.APIID, r io.Reader, opts ...options.UnixfsAddOption) (cid.Cid, error) {
+	ci.stageLock.RLock()
+	defer ci.stageLock.RUnlock()
+
+	n, err := ci.placeNode()
+	if err != nil {
+		return cid.Undef, fmt.Errorf("placing data: %s", err)
+	}
+	cr := &countingReader{r: r}
+	p, err := n.api.Unixfs().Add(ctx, ipfsfiles.NewReaderFile(cr), append(opts, options.Unixfs.Pin(true))...)
 	if err != nil {
 		return cid.Undef, fmt.Errorf("adding data to ipfs: %s", err)
 	}
+	n.addUsage(cr.n)
 	ci.lock.Lock()
 	defer ci.lock.Unlock()
 
-	if err := ci.ps.AddStaged(iid, p.Cid()); err != nil {
-		return cid.Undef, fmt.Errorf("saving new pin in pinstore: %s", err)
+	if err := ci.addStaged(ctx, iid, p.Cid(), n); err != nil {
+		return cid.Undef, err
 	}
 
 	return p.Cid(), nil
@@ -73,22 +141,95 @@ func (ci *CoreIpfs) Stage(ctx context.Context, iid ffs.APIID, r io.Reader) (cid.
 
 // StageCid pull the Cid data and stage-pin it.
 func (ci *CoreIpfs) StageCid(ctx context.Context, iid ffs.APIID, c cid.Cid) error {
-	if err := ci.ipfs.Pin().Add(ctx, path.IpfsPath(c), options.Pin.Recursive(true)); err != nil {
+	ci.stageLock.RLock()
+	defer ci.stageLock.RUnlock()
+
+	n, err := ci.nodeFor(c)
+	if err != nil {
+		return fmt.Errorf("placing data: %s", err)
+	}
+	if err := n.api.Pin().Add(ctx, path.IpfsPath(c), options.Pin.Recursive(true)); err != nil {
 		return fmt.Errorf("adding data to ipfs: %s", err)
 	}
 	ci.lock.Lock()
 	defer ci.lock.Unlock()
 
-	if err := ci.ps.AddStaged(iid, c); err != nil {
-		return fmt.Errorf("saving new pin in pinstore: %s", err)
+	return ci.addStaged(ctx, iid, c, n)
+}
+
+// StageCar imports the blocks of a CARv1 or CARv2 file, and stage-pins its roots.
+// The CAR file should include the whole DAG of every root. If staging fails, roots
+// which were already pinned are unpinned.
+func (ci *CoreIpfs) StageCar(ctx context.Context, iid ffs.APIID, r io.Reader) (res []cid.Cid, err error) {
+	ci.stageLock.RLock()
+	defer ci.stageLock.RUnlock()
+
+	n, err := ci.placeNode()
+	if err != nil {
+		return nil, fmt.Errorf("placing data: %s", err)
+	}
+	roots, err := importCar(ctx, n.api, r)
+	if err != nil {
+		return nil, fmt.Errorf("importing car: %s", err)
+	}
+	for _, c := range roots {
+		if err := checkDagComplete(ctx, n.api, c); err != nil {
+			return nil, err
+		}
+	}
+	var pinned []cid.Cid
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, c := range pinned {
+			if ci.ps.IsPinned(c) {
+				continue
+			}
+			if err := n.api.Pin().Rm(ctx, path.IpfsPath(c), options.Pin.RmRecursive(true)); err != nil {
+				log.Errorf("unpinning car root %s after failed staging: %s", c, err)
+			}
+		}
+	}()
+	for _, c := range roots {
+		if err := n.api.Pin().Add(ctx, path.IpfsPath(c), options.Pin.Recursive(true)); err != nil {
+			return nil, fmt.Errorf("pinning car root %s: %s", c, err)
+		}
+		pinned = append(pinned, c)
 	}
+	ci.lock.Lock()
+	defer ci.lock.Unlock()
+
+	for _, c := range roots {
+		if err := ci.addStaged(ctx, iid, c, n); err != nil {
+			return nil, err
+		}
+	}
+	return roots, nil
+}
 
+// addStaged saves a stage-pin of c by iid, which was pinned in n. If c is
+// concurrently held by another node, the pin in n is removed.
+func (ci *CoreIpfs) addStaged(ctx context.Context, iid ffs.APIID, c cid.Cid, n *node) error {
+	if name, ok := ci.ps.Node(c); ok && name != n.name {
+		if err := n.api.Pin().Rm(ctx, path.IpfsPath(c), options.Pin.RmRecursive(true)); err != nil {
+			return fmt.Errorf("unpinning duplicated cid from ipfs node: %s", err)
+		}
+	}
+	if err := ci.ps.AddStaged(iid, c, n.name); err != nil {
+		return fmt.Errorf("saving new pin in pinstore: %s", err)
+	}
 	return nil
 }
 
-// Get retrieves a cid data from the IPFS node.
+// Get retrieves a cid data from the IPFS node holding it. If c isn't
+// pinned, it's fetched from the IPFS network by any healthy node.
 func (ci *CoreIpfs) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
-	n, err := ci.ipfs.Unixfs().Get(ctx, path.IpfsPath(c))
+	nd, err := ci.nodeFor(c)
+	if err != nil {
+		return nil, fmt.Errorf("getting node for cid %s: %s", c, err)
+	}
+	n, err := nd.api.Unixfs().Get(ctx, path.IpfsPath(c))
 	if err != nil {
 		return nil, fmt.Errorf("getting cid %s from ipfs: %s", c, err)
 	}
@@ -99,6 +240,18 @@ func (ci *CoreIpfs) Get(ctx context.Context, c cid.Cid) (io.Reader, error) {
 	return file, nil
 }
 
+// GetCar writes a CAR file of the DAG of c from the IPFS node holding it.
+func (ci *CoreIpfs) GetCar(ctx context.Context, c cid.Cid, w io.Writer, opts ffs.CarOptions) error {
+	nd, err := ci.nodeFor(c)
+	if err != nil {
+		return fmt.Errorf("getting node for cid %s: %s", c, err)
+	}
+	if err := exportCar(ctx, nd.api, c, w, opts); err != nil {
+		return fmt.Errorf("exporting cid %s: %s", c, err)
+	}
+	return nil
+}
+
 // Pin a cid for an APIID. If the cid was already pinned by a stage from APIID,
 // the Cid is considered fully-pinned and not a candidate to be unpinned by GCStaged().
 func (ci *CoreIpfs) Pin(ctx context.Context, iid ffs.APIID, c cid.Cid) (int, error) {
@@ -107,20 +260,24 @@ func (ci *CoreIpfs) Pin(ctx context.Context, iid ffs.APIID, c cid.Cid) (int, err
 
 	p := path.IpfsPath(c)
 
+	n, err := ci.nodeFor(c)
+	if err != nil {
+		return 0, fmt.Errorf("placing data: %s", err)
+	}
 	// If some APIID already pinned this Cid in the underlying go-ipfs node, then
 	// we don't need to call the Pin API, just count the reference from this APIID.
 	if !ci.ps.IsPinned(c) {
-		if err := ci.ipfs.Pin().Add(ctx, p, options.Pin.Recursive(true)); err != nil {
+		if err := n.api.Pin().Add(ctx, p, options.Pin.Recursive(true)); err != nil {
 			return 0, fmt.Errorf("pinning cid %s: %s", c, err)
 		}
 	}
-	s, err := ci.ipfs.Object().Stat(ctx, p)
+	s, err := n.api.Object().Stat(ctx, p)
 	if err != nil {
 		return 0, fmt.Errorf("getting stats of cid %s: %s", c, err)
 	}
 
 	// Count +1 reference to this Cid by APIID.
-	if err := ci.ps.Add(iid, p.Cid()); err != nil {
+	if err := ci.ps.Add(iid, p.Cid(), n.name); err != nil {
 		return 0, fmt.Errorf("saving new pin in pinstore: %s", err)
 	}
 
@@ -150,23 +307,29 @@ func (ci *CoreIpfs) Replace(ctx context.Context, iid ffs.APIID, c1 cid.Cid, c2 c
 	if c1refcount == 0 {
 		return 0, ErrReplaceFromNotPinned
 	}
+	// If c2 isn't pinned, it's placed in the node holding c1
+	// since both versions of the data probably share blocks.
+	n1, err := ci.heldNode(c1)
+	if err != nil {
+		return 0, fmt.Errorf("getting node of cid %s: %s", c1, err)
+	}
 
 	// If c1 has a single reference, which must be from iid...
 	if c1refcount == 1 {
 		// If c2 isn't pinned, then we can move the pin so to unpin c1 and pin c2.
 		if c2refcount == 0 {
-			if err := ci.ipfs.Pin().Update(ctx, p1, p2); err != nil {
+			if err := n1.api.Pin().Update(ctx, p1, p2); err != nil {
 				return 0, fmt.Errorf("updating pin %s to %s: %s", c1, c2, err)
 			}
 		} else { // If c2 is pinned, then we need to unpin c1 (c2 is already pinned by other iid).
-			if err := ci.ipfs.Pin().Rm(ctx, path.IpfsPath(c1), options.Pin.RmRecursive(true)); err != nil {
+			if err := n1.api.Pin().Rm(ctx, path.IpfsPath(c1), options.Pin.RmRecursive(true)); err != nil {
 				return 0, fmt.Errorf("unpinning cid from ipfs node: %s", err)
 			}
 		}
 	} else if c2refcount == 0 {
 		// - c1 is pinned by another iid, so we can't unpin it.
 		// - c2 isn't pinned by anyone, so we should pin it.
-		if err := ci.ipfs.Pin().Add(ctx, p2, options.Pin.Recursive(true)); err != nil {
+		if err := n1.api.Pin().Add(ctx, p2, options.Pin.Recursive(true)); err != nil {
 			return 0, fmt.Errorf("pinning cid %s: %s", c2, err)
 		}
 	}
@@ -178,11 +341,15 @@ func (ci *CoreIpfs) Replace(ctx context.Context, iid ffs.APIID, c1 cid.Cid, c2 c
 	if err := ci.ps.Remove(iid, c1); err != nil {
 		return 0, fmt.Errorf("removing cid in pinstore: %s", err)
 	}
-	if err := ci.ps.Add(iid, c2); err != nil {
+	if err := ci.ps.Add(iid, c2, n1.name); err != nil {
 		return 0, fmt.Errorf("adding cid in pinstore: %s", err)
 	}
 
-	stat, err := ci.ipfs.Object().Stat(ctx, p2)
+	n2, err := ci.heldNode(c2)
+	if err != nil {
+		return 0, fmt.Errorf("getting node of cid %s: %s", c2, err)
+	}
+	stat, err := n2.api.Object().Stat(ctx, p2)
 	if err != nil {
 		return 0, fmt.Errorf("getting stats of cid %s: %s", c2, err)
 	}
@@ -195,6 +362,23 @@ func (ci *CoreIpfs) IsPinned(ctx context.Context, iid ffs.APIID, c cid.Cid) (boo
 	return ci.ps.IsPinnedBy(iid, c), nil
 }
 
+// SetCache configures if the full-pin of c by iid is cached, and the
+// amount of seconds without accesses after which it expires.
+func (ci *CoreIpfs) SetCache(ctx context.Context, iid ffs.APIID, c cid.Cid, enabled bool, ttl int64) error {
+	if err := ci.ps.SetCache(iid, c, enabled, ttl); err != nil {
+		return fmt.Errorf("setting cache config in pinstore: %s", err)
+	}
+	return nil
+}
+
+// Touch registers an access to c by iid.
+func (ci *CoreIpfs) Touch(ctx context.Context, iid ffs.APIID, c cid.Cid) error {
+	if err := ci.ps.Touch(iid, c); err != nil {
+		return fmt.Errorf("touching pin in pinstore: %s", err)
+	}
+	return nil
+}
+
 // GCStaged unpins Cids that are only pinned by Stage() calls and all pins satisfy the filters.
 func (ci *CoreIpfs) GCStaged(ctx context.Context, exclude []cid.Cid, olderThan time.Time) ([]cid.Cid, error) {
 	ci.lock.Lock()
@@ -226,23 +410,202 @@ func (ci *CoreIpfs) PinnedCids(ctx context.Context) ([]ffs.PinnedCid, error) {
 
 	res := make([]ffs.PinnedCid, len(ps))
 	for i, pc := range ps {
-		npc := ffs.PinnedCid{
-			Cid:    pc.Cid,
-			APIIDs: make([]ffs.APIIDPinnedCid, len(pc.Pins)),
+		res[i] = toPinnedCid(pc)
+	}
+
+	return res, nil
+}
+
+// Reconcile compares the pinstore with the recursive pins of each go-ipfs node.
+// Cids missing in the node holding them are re-pinned from the IPFS network,
+// and removed from the pinstore if that isn't possible. Node pins unknown to
+// the pinstore are unpinned if opts.UnpinUnknown is set. Cids held by nodes
+// which can't list their pins are skipped.
+func (ci *CoreIpfs) Reconcile(ctx context.Context, opts ffs.HotReconcileOptions) (ffs.HotReconciliation, error) {
+	res, missing, err := ci.reconcilePins(ctx, opts)
+	if err != nil {
+		return ffs.HotReconciliation{}, err
+	}
+	if opts.DryRun {
+		return res, nil
+	}
+
+	// Missing Cids are re-pinned without holding locks, since it can
+	// take up to RepinTimeout for each Cid.
+	repinned := make([]bool, len(missing))
+	if opts.RepinTimeout > 0 {
+		for i, m := range missing {
+			done := ci.addPending(m.node, m.pc.Cid)
+			defer done()
+			rctx, cancel := context.WithTimeout(ctx, opts.RepinTimeout)
+			err := m.node.api.Pin().Add(rctx, path.IpfsPath(m.pc.Cid), options.Pin.Recursive(true))
+			cancel()
+			if err != nil {
+				log.Warnf("re-pinning missing cid %s in node %s: %s", m.pc.Cid, m.node, err)
+				continue
+			}
+			repinned[i] = true
+		}
+	}
+
+	ci.stageLock.Lock()
+	defer ci.stageLock.Unlock()
+	ci.lock.Lock()
+	defer ci.lock.Unlock()
+	for i, m := range missing {
+		// The Cid might have been unpinned or moved while re-pinning,
+		// so the pinstore is updated only if it's unchanged.
+		pc, ok := ci.ps.Get(m.pc.Cid)
+		if !ok || pc.Node != m.pc.Node {
+			if repinned[i] && !ok {
+				if err := m.node.api.Pin().Rm(ctx, path.IpfsPath(m.pc.Cid), options.Pin.RmRecursive(true)); err != nil {
+					log.Warnf("unpinning re-pinned cid %s which isn't pinned anymore: %s", m.pc.Cid, err)
+				}
+			}
+			continue
+		}
+		if repinned[i] {
+			if err := ci.ps.SetNode(pc.Cid, m.node.name); err != nil {
+				return ffs.HotReconciliation{}, fmt.Errorf("saving node of re-pinned cid in pinstore: %s", err)
+			}
+			res.Repinned = append(res.Repinned, pc.Cid)
+			continue
+		}
+		if err := ci.ps.RemoveAll(pc.Cid); err != nil {
+			return ffs.HotReconciliation{}, fmt.Errorf("removing lost cid from pinstore: %s", err)
+		}
+		res.Lost = append(res.Lost, toPinnedCid(pc))
+	}
+
+	return res, nil
+}
+
+// missingPin is a Cid in the pinset which is missing from the node holding
+// it, and should be re-pinned in node.
+type missingPin struct {
+	pc   pinstore.PinnedCid
+	node *node
+}
+
+// reconcilePins compares the pinset with the pins of the nodes, and unpins
+// unknown Cids if configured. It returns the Cids missing from nodes, which
+// aren't updated in the pinstore.
+func (ci *CoreIpfs) reconcilePins(ctx context.Context, opts ffs.HotReconcileOptions) (ffs.HotReconciliation, []missingPin, error) {
+	ci.stageLock.Lock()
+	defer ci.stageLock.Unlock()
+	ci.lock.Lock()
+	defer ci.lock.Unlock()
+
+	nodePins := make(map[string]map[string]cid.Cid, len(ci.nodes))
+	for _, n := range ci.nodes {
+		pins, err := listPins(ctx, n)
+		if err != nil {
+			log.Warnf("skipping reconciliation of ipfs node %s: %s", n, err)
+			continue
+		}
+		nodePins[n.name] = pins
+	}
+	ps, err := ci.ps.GetAll()
+	if err != nil {
+		return ffs.HotReconciliation{}, nil, fmt.Errorf("getting pins from pinstore: %s", err)
+	}
+
+	var res ffs.HotReconciliation
+	var missing []missingPin
+	known := make(map[string]map[string]struct{}, len(ci.nodes))
+	for _, pc := range ps {
+		if known[pc.Node] == nil {
+			known[pc.Node] = map[string]struct{}{}
+		}
+		known[pc.Node][string(pc.Cid.Hash())] = struct{}{}
+		// If the node holding the Cid isn't configured anymore,
+		// the Cid is re-pinned in a new placement.
+		n, ok := ci.nodesByName[pc.Node]
+		if ok {
+			pins, listed := nodePins[n.name]
+			if !listed {
+				continue
+			}
+			if _, ok := pins[string(pc.Cid.Hash())]; ok {
+				continue
+			}
+			// Pins being repaired are temporarily removed.
+			if _, ok := ci.pending[pendingPin{node: n.name, hash: string(pc.Cid.Hash())}]; ok {
+				continue
+			}
+		} else if n, err = ci.placeNode(); err != nil {
+			return ffs.HotReconciliation{}, nil, fmt.Errorf("placing cid held by unconfigured node: %s", err)
+		}
+		res.Missing = append(res.Missing, pc.Cid)
+		missing = append(missing, missingPin{pc: pc, node: n})
+	}
+
+	for _, n := range ci.nodes {
+		pins, ok := nodePins[n.name]
+		if !ok {
+			continue
+		}
+		unknown := make([]cid.Cid, 0, len(pins))
+		for h, c := range pins {
+			if _, ok := ci.pending[pendingPin{node: n.name, hash: h}]; ok {
+				continue
+			}
+			if _, ok := known[n.name][h]; !ok {
+				unknown = append(unknown, c)
+			}
 		}
-		for j, upc := range pc.Pins {
-			npc.APIIDs[j] = ffs.APIIDPinnedCid{
-				ID:        upc.APIID,
-				Staged:    upc.Staged,
-				CreatedAt: upc.CreatedAt,
+		sort.Slice(unknown, func(i, j int) bool { return unknown[i].String() < unknown[j].String() })
+		for _, c := range unknown {
+			res.Unknown = append(res.Unknown, c)
+			if opts.DryRun || !opts.UnpinUnknown {
+				continue
+			}
+			if err := n.api.Pin().Rm(ctx, path.IpfsPath(c), options.Pin.RmRecursive(true)); err != nil {
+				return ffs.HotReconciliation{}, nil, fmt.Errorf("unpinning unknown cid %s from node %s: %s", c, n, err)
 			}
+			res.Unpinned = append(res.Unpinned, c)
 		}
-		res[i] = npc
 	}
 
+	return res, missing, nil
+}
+
+// listPins returns the recursive pins of a go-ipfs node keyed by multihash,
+// since the node might not keep the Cid version used when pinning.
+func listPins(ctx context.Context, n *node) (map[string]cid.Cid, error) {
+	ch, err := n.api.Pin().Ls(ctx, options.Pin.Ls.Recursive())
+	if err != nil {
+		return nil, fmt.Errorf("calling pin ls: %s", err)
+	}
+	res := map[string]cid.Cid{}
+	for p := range ch {
+		if p.Err() != nil {
+			return nil, fmt.Errorf("getting pin: %s", p.Err())
+		}
+		c := p.Path().Cid()
+		res[string(c.Hash())] = c
+	}
 	return res, nil
 }
 
+func toPinnedCid(pc pinstore.PinnedCid) ffs.PinnedCid {
+	npc := ffs.PinnedCid{
+		Cid:    pc.Cid,
+		APIIDs: make([]ffs.APIIDPinnedCid, len(pc.Pins)),
+	}
+	for j, upc := range pc.Pins {
+		npc.APIIDs[j] = ffs.APIIDPinnedCid{
+			ID:             upc.APIID,
+			Staged:         upc.Staged,
+			CreatedAt:      upc.CreatedAt,
+			Cached:         upc.Cached,
+			CacheTTL:       upc.CacheTTL,
+			LastAccessedAt: upc.LastAccessedAt,
+		}
+	}
+	return npc
+}
+
 func (ci *CoreIpfs) getGCCandidates(exclude []cid.Cid, olderThan time.Time) ([]cid.Cid, error) {
 	lst, err := ci.ps.GetAllOnlyStaged()
 	if err != nil {
@@ -294,7 +657,11 @@ func (ci *CoreIpfs) removeAndUnpinIfApplies(ctx context.Context, iid ffs.APIID,
 	if count == 1 {
 		// There aren't more pinnings for this Cid, let's unpin from IPFS.
 		log.Infof("unpinning cid %s with ref count 0", c)
-		if err := ci.ipfs.Pin().Rm(ctx, path.IpfsPath(c), options.Pin.RmRecursive(true)); err != nil {
+		n, err := ci.heldNode(c)
+		if err != nil {
+			return fmt.Errorf("getting node of cid: %s", err)
+		}
+		if err := n.api.Pin().Rm(ctx, path.IpfsPath(c), options.Pin.RmRecursive(true)); err != nil {
 			return fmt.Errorf("unpinning cid from ipfs node: %s", err)
 		}
 	}
@@ -315,7 +682,11 @@ func (ci *CoreIpfs) unpinStaged(ctx context.Context, c cid.Cid) error {
 		return fmt.Errorf("cid %s hasn't only stage-pins, total %d staged %d", c, count, stagedCount)
 	}
 
-	if err := ci.ipfs.Pin().Rm(ctx, path.IpfsPath(c), options.Pin.RmRecursive(true)); err != nil {
+	n, err := ci.heldNode(c)
+	if err != nil {
+		return fmt.Errorf("getting node of cid: %s", err)
+	}
+	if err := n.api.Pin().Rm(ctx, path.IpfsPath(c), options.Pin.RmRecursive(true)); err != nil {
 		return fmt.Errorf("unpinning cid from ipfs node: %s", err)
 	}
 
@@ -325,3 +696,15 @@ func (ci *CoreIpfs) unpinStaged(ctx context.Context, c cid.Cid) error {
 
 	return nil
 }
+
+// countingReader counts the bytes read from r.
+type countingReader struct {
+	r io.Reader
+	n uint64
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += uint64(n)
+	return n, err
+}
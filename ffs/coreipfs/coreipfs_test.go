@@ -3,7 +3,10 @@ package coreipfs
 import (
 	"bytes"
 	"context"
+	"io"
+	"io/ioutil"
 	"math/rand"
+	"path/filepath"
 	"sort"
 	"testing"
 	"time"
@@ -11,11 +14,16 @@ import (
 	"github.com/ipfs/go-cid"
 	ipfsfiles "github.com/ipfs/go-ipfs-files"
 	httpapi "github.com/ipfs/go-ipfs-http-client"
+	mdutils "github.com/ipfs/go-merkledag/test"
 	"github.com/ipfs/interface-go-ipfs-core/options"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	car "github.com/ipld/go-car"
 	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/dataprep"
 	"github.com/textileio/powergate/v2/ffs"
 	it "github.com/textileio/powergate/v2/ffs/integrationtest"
 	"github.com/textileio/powergate/v2/ffs/joblogger"
+	"github.com/textileio/powergate/v2/importopts"
 	"github.com/textileio/powergate/v2/tests"
 	txndstr "github.com/textileio/powergate/v2/txndstransform"
 )
@@ -44,6 +52,36 @@ func TestStage(t *testing.T) {
 	requireRefCount(t, ci, c, 0, 1)
 }
 
+func TestStageDagifyCid(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(22))
+
+	ci, _ := newCoreIPFS(t)
+	iid := ffs.NewAPIID()
+	data := it.RandomBytes(r, 600000)
+	path := filepath.Join(t.TempDir(), "data")
+	require.NoError(t, ioutil.WriteFile(path, data, 0600))
+
+	// The same bytes with the same import options result in the same
+	// cid when staged, and when dagified offline.
+	optss := []importopts.Options{
+		{},
+		{CidVersion: 1},
+		{CidVersion: 1, RawLeaves: false, RawLeavesSet: true, Chunker: "size-1024"},
+		{Layout: importopts.LayoutTrickle, HashFunction: "blake2b-256", CidVersion: 1},
+	}
+	for _, opts := range optss {
+		addOpts, err := opts.UnixfsAddOptions()
+		require.NoError(t, err)
+		c, err := ci.Stage(ctx, iid, bytes.NewReader(data), addOpts...)
+		require.NoError(t, err)
+		dc, err := dataprep.Dagify(ctx, mdutils.Mock(), path, nil, addOpts...)
+		require.NoError(t, err)
+		require.Equal(t, dc, c, "import options %+v", opts)
+	}
+}
+
 func TestStageCid(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -72,6 +110,155 @@ func TestStageCid(t *testing.T) {
 	requireRefCount(t, ci, c, 0, 1)
 }
 
+func TestStageCar(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(22))
+
+	ci, ipfs := newCoreIPFS(t)
+	data := it.RandomBytes(r, 600*1024)
+	p, err := ipfs.Unixfs().Add(ctx, ipfsfiles.NewReaderFile(bytes.NewReader(data)), options.Unixfs.Pin(false))
+	require.NoError(t, err)
+	c := p.Cid()
+
+	for _, version := range []int{1, 2} {
+		var buf bytes.Buffer
+		err = ci.GetCar(ctx, c, &buf, ffs.CarOptions{Version: version})
+		require.NoError(t, err)
+
+		iid := ffs.NewAPIID()
+		roots, err := ci.StageCar(ctx, iid, &buf)
+		require.NoError(t, err)
+		require.Equal(t, []cid.Cid{c}, roots)
+		it.RequireIpfsPinnedCid(ctx, t, c, ipfs)
+		requireCidIsGCable(t, ci, c)
+		okPinned, err := ci.IsPinned(ctx, iid, c)
+		require.NoError(t, err)
+		require.True(t, okPinned)
+	}
+
+	// A block which doesn't match its Cid fails the import.
+	var buf bytes.Buffer
+	err = ci.GetCar(ctx, c, &buf, ffs.CarOptions{})
+	require.NoError(t, err)
+	corrupted := buf.Bytes()
+	corrupted[len(corrupted)-1]++
+	_, err = ci.StageCar(ctx, ffs.NewAPIID(), bytes.NewReader(corrupted))
+	require.Error(t, err)
+
+	// A car file without the whole dag of its roots is rejected, and
+	// missing blocks aren't fetched from other nodes.
+	ipfs2, _ := it.CreateIPFS(t)
+	p, err = ipfs2.Unixfs().Add(ctx, ipfsfiles.NewReaderFile(bytes.NewReader(it.RandomBytes(r, 600*1024))), options.Unixfs.Pin(false))
+	require.NoError(t, err)
+	buf.Reset()
+	err = exportCar(ctx, ipfs2, p.Cid(), &buf, ffs.CarOptions{Selector: `{".": {}}`})
+	require.NoError(t, err)
+	_, err = ci.StageCar(ctx, ffs.NewAPIID(), &buf)
+	require.Error(t, err)
+	it.RequireIpfsUnpinnedCid(ctx, t, p.Cid(), ipfs)
+	require.False(t, ci.ps.IsPinned(p.Cid()))
+}
+
+func TestGetCarSelector(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(22))
+
+	ci, ipfs := newCoreIPFS(t)
+	data := it.RandomBytes(r, 600*1024)
+	p, err := ipfs.Unixfs().Add(ctx, ipfsfiles.NewReaderFile(bytes.NewReader(data)), options.Unixfs.Pin(false))
+	require.NoError(t, err)
+
+	// Matching only the root node includes only the root block.
+	var buf bytes.Buffer
+	err = ci.GetCar(ctx, p.Cid(), &buf, ffs.CarOptions{Selector: `{".": {}}`})
+	require.NoError(t, err)
+	cr, err := car.NewCarReader(&buf)
+	require.NoError(t, err)
+	b, err := cr.Next()
+	require.NoError(t, err)
+	require.Equal(t, p.Cid(), b.Cid())
+	_, err = cr.Next()
+	require.Equal(t, io.EOF, err)
+
+	err = ci.GetCar(ctx, p.Cid(), &buf, ffs.CarOptions{Selector: `{"invalid": {}}`})
+	require.Error(t, err)
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(22))
+
+	ci, ipfs := newCoreIPFS(t)
+	data := it.RandomBytes(r, 600*1024)
+	iid := ffs.NewAPIID()
+	c, err := ci.Stage(ctx, iid, bytes.NewReader(data))
+	require.NoError(t, err)
+
+	hv, err := ci.Verify(ctx, c)
+	require.NoError(t, err)
+	require.True(t, hv.Healthy())
+	require.Greater(t, hv.Blocks, 1)
+	require.GreaterOrEqual(t, hv.Size, uint64(len(data)))
+
+	// Repairing intact data pins it again.
+	err = ci.Repair(ctx, c, nil)
+	require.NoError(t, err)
+	hv, err = ci.Verify(ctx, c)
+	require.NoError(t, err)
+	require.True(t, hv.Healthy())
+
+	// If removed blocks can't be fetched again, the root stays
+	// pinned and missing blocks fail verification.
+	links, err := ipfs.Object().Links(ctx, path.IpfsPath(c))
+	require.NoError(t, err)
+	require.NotEmpty(t, links)
+	rctx, cancel := context.WithTimeout(ctx, time.Second*5)
+	defer cancel()
+	err = ci.Repair(rctx, c, []cid.Cid{links[0].Cid})
+	require.Error(t, err)
+	pinned, err := ipfs.Pin().Ls(ctx, options.Pin.Ls.Direct())
+	require.NoError(t, err)
+	var direct []cid.Cid
+	for p := range pinned {
+		direct = append(direct, p.Path().Cid())
+	}
+	require.Contains(t, direct, c)
+	hv, err = ci.Verify(ctx, c)
+	require.NoError(t, err)
+	require.False(t, hv.Healthy())
+
+	// Unpinned data can't be verified.
+	p, err := ipfs.Unixfs().Add(ctx, ipfsfiles.NewReaderFile(bytes.NewReader(it.RandomBytes(r, 1024))), options.Unixfs.Pin(false))
+	require.NoError(t, err)
+	_, err = ci.Verify(ctx, p.Cid())
+	require.Error(t, err)
+}
+
+func TestCarV1Payload(t *testing.T) {
+	t.Parallel()
+	data := []byte("carv1 data")
+
+	r, err := carV1Payload(bytes.NewReader(data))
+	require.NoError(t, err)
+	payload, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, data, payload)
+
+	var buf bytes.Buffer
+	err = writeCarV2Header(&buf, uint64(len(data)))
+	require.NoError(t, err)
+	buf.Write(data)
+	buf.WriteString("index")
+	r, err = carV1Payload(&buf)
+	require.NoError(t, err)
+	payload, err = ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, data, payload)
+}
+
 func TestStagePinStage(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -451,6 +638,128 @@ func TestPinnedCids(t *testing.T) {
 	require.True(t, all[1].APIIDs[1].Staged)
 }
 
+func TestReconcile(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(22))
+
+	ci, ipfs := newCoreIPFS(t)
+
+	// Pin c1 with iid1, and unpin it directly from the node.
+	iid1 := ffs.NewAPIID()
+	c1, err := ci.Stage(ctx, iid1, bytes.NewReader(it.RandomBytes(r, 1500)))
+	require.NoError(t, err)
+	_, err = ci.Pin(ctx, iid1, c1)
+	require.NoError(t, err)
+	err = ipfs.Pin().Rm(ctx, path.IpfsPath(c1))
+	require.NoError(t, err)
+
+	// Pin c2 directly in the node.
+	p, err := ipfs.Unixfs().Add(ctx, ipfsfiles.NewReaderFile(bytes.NewReader(it.RandomBytes(r, 1500))), options.Unixfs.Pin(true))
+	require.NoError(t, err)
+	c2 := p.Cid()
+
+	// Dry-run only reports.
+	res, err := ci.Reconcile(ctx, ffs.HotReconcileOptions{DryRun: true, RepinTimeout: time.Second * 10, UnpinUnknown: true})
+	require.NoError(t, err)
+	require.Equal(t, []cid.Cid{c1}, res.Missing)
+	require.Empty(t, res.Repinned)
+	require.Empty(t, res.Lost)
+	require.Contains(t, res.Unknown, c2)
+	require.Empty(t, res.Unpinned)
+
+	// c1 data is still available, so it's re-pinned.
+	res, err = ci.Reconcile(ctx, ffs.HotReconcileOptions{RepinTimeout: time.Second * 10})
+	require.NoError(t, err)
+	require.Equal(t, []cid.Cid{c1}, res.Repinned)
+	require.Empty(t, res.Unpinned)
+	it.RequireIpfsPinnedCid(ctx, t, c1, ipfs)
+
+	// Without re-pinning from the network, c1 is lost and
+	// forgotten, and c2 is unpinned.
+	err = ipfs.Pin().Rm(ctx, path.IpfsPath(c1))
+	require.NoError(t, err)
+	res, err = ci.Reconcile(ctx, ffs.HotReconcileOptions{UnpinUnknown: true})
+	require.NoError(t, err)
+	require.Len(t, res.Lost, 1)
+	require.Equal(t, c1, res.Lost[0].Cid)
+	require.Equal(t, iid1, res.Lost[0].APIIDs[0].ID)
+	require.Contains(t, res.Unpinned, c2)
+	it.RequireIpfsUnpinnedCid(ctx, t, c2, ipfs)
+	okPinned, err := ci.IsPinned(ctx, iid1, c1)
+	require.NoError(t, err)
+	require.False(t, okPinned)
+}
+
+func TestShardedNodes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(22))
+
+	ds := tests.NewTxMapDatastore()
+	ipfs1, _ := it.CreateIPFS(t)
+	l := joblogger.New(txndstr.Wrap(ds, "ffs/joblogger"))
+	ci, err := New(ds, ipfs1, l)
+	require.NoError(t, err)
+
+	// With a single node, everything is placed in the primary node.
+	iid := ffs.NewAPIID()
+	var cids []cid.Cid
+	for i := 0; i < 4; i++ {
+		c, err := ci.Stage(ctx, iid, bytes.NewReader(it.RandomBytes(r, 1500)))
+		require.NoError(t, err)
+		_, err = ci.Pin(ctx, iid, c)
+		require.NoError(t, err)
+		cids = append(cids, c)
+	}
+	require.Equal(t, map[string]int{"": 4}, ci.ps.NodeCids())
+	require.NoError(t, ci.Close())
+
+	// Adding a node rebalances the pinned data.
+	ipfs2, _ := it.CreateIPFS(t)
+	ci, err = New(ds, ipfs1, l, WithNodes(Node{Name: "n2", API: ipfs2}))
+	require.NoError(t, err)
+	<-ci.finished
+	require.Equal(t, map[string]int{"": 2, "n2": 2}, ci.ps.NodeCids())
+	for _, c := range cids {
+		n, err := ci.heldNode(c)
+		require.NoError(t, err)
+		it.RequireIpfsPinnedCid(ctx, t, c, n.api.(*httpapi.HttpApi))
+		_, err = ci.Get(ctx, c)
+		require.NoError(t, err)
+	}
+
+	// New data is placed in the node with more free storage.
+	ci.nodesByName[""].setRepoStat(1000, 10000)
+	ci.nodesByName["n2"].setRepoStat(5000, 10000)
+	n, err := ci.placeNode()
+	require.NoError(t, err)
+	require.Equal(t, "", n.name)
+	c, err := ci.Stage(ctx, iid, bytes.NewReader(it.RandomBytes(r, 1500)))
+	require.NoError(t, err)
+	it.RequireIpfsPinnedCid(ctx, t, c, ipfs1)
+	it.RequireIpfsUnpinnedCid(ctx, t, c, ipfs2)
+	require.Equal(t, map[string]int{"": 3, "n2": 2}, ci.ps.NodeCids())
+	free, ok := ci.nodesByName[""].freeStorage()
+	require.True(t, ok)
+	require.Equal(t, uint64(10000-1000-1500), free)
+
+	// If the free storage of a node is unknown, new data is placed in
+	// the node with less pinned cids.
+	ci.nodesByName["n2"].hasRepoStat = false
+	n, err = ci.placeNode()
+	require.NoError(t, err)
+	require.Equal(t, "n2", n.name)
+
+	// Unpinning is routed to the node holding the data.
+	for _, c := range cids {
+		require.NoError(t, ci.Unpin(ctx, iid, c))
+		it.RequireIpfsUnpinnedCid(ctx, t, c, ipfs1)
+		it.RequireIpfsUnpinnedCid(ctx, t, c, ipfs2)
+	}
+	require.NoError(t, ci.Close())
+}
+
 func TestGCSingleAPIID(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
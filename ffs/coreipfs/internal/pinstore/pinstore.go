@@ -24,24 +24,34 @@ var (
 // Stage-pins indicate a form of soft-pinning that clients might
 // use as an indication of unpinnable Cids by GC processes.
 type Store struct {
-	lock  sync.Mutex
-	ds    datastore.TxnDatastore
-	cache map[cid.Cid]PinnedCid
+	lock     sync.Mutex
+	ds       datastore.TxnDatastore
+	cache    map[cid.Cid]PinnedCid
+	nodeCids map[string]int
 }
 
 // PinnedCid contains information about a pinned
-// Cid from multiple APIIDs.
+// Cid from multiple APIIDs. Node is the name of the
+// go-ipfs node holding the data, where the empty name
+// is the primary node.
 type PinnedCid struct {
 	Cid  cid.Cid
+	Node string
 	Pins []Pin
 }
 
 // Pin describes a pin of a Cid from a APIID.
 // The Stage field indicates if the pin is a stage-pin.
+// The Cached field indicates if the pin is a full-pin
+// that can be evicted by caching, considering CacheTTL
+// and LastAccessedAt.
 type Pin struct {
-	APIID     ffs.APIID
-	Staged    bool
-	CreatedAt int64
+	APIID          ffs.APIID
+	Staged         bool
+	CreatedAt      int64
+	Cached         bool
+	CacheTTL       int64
+	LastAccessedAt int64
 }
 
 // New returns a new Store.
@@ -50,13 +60,18 @@ func New(ds datastore.TxnDatastore) (*Store, error) {
 	if err != nil {
 		return nil, fmt.Errorf("populating cache: %s", err)
 	}
-	return &Store{ds: ds, cache: cache}, nil
+	nodeCids := map[string]int{}
+	for _, pc := range cache {
+		nodeCids[pc.Node]++
+	}
+	return &Store{ds: ds, cache: cache, nodeCids: nodeCids}, nil
 }
 
 // AddStaged pins a Cid for APIID with a staged-pin.
 // If c is already stage-pinned, its stage-pin timestamp will be refreshed.
 // If c is already fully-pinned, this call is a noop (full-pin will be kept).
-func (s *Store) AddStaged(iid ffs.APIID, c cid.Cid) error {
+// If c isn't pinned by any APIID, node is saved as the node holding c.
+func (s *Store) AddStaged(iid ffs.APIID, c cid.Cid, node string) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
@@ -64,7 +79,7 @@ func (s *Store) AddStaged(iid ffs.APIID, c cid.Cid) error {
 	if cr, ok := s.cache[c]; ok {
 		r = cr
 	} else {
-		r = PinnedCid{Cid: c}
+		r = PinnedCid{Cid: c, Node: node}
 	}
 
 	for i, p := range r.Pins {
@@ -101,7 +116,8 @@ func (s *Store) AddStaged(iid ffs.APIID, c cid.Cid) error {
 // Add marks c as fully-pinned by iid.
 // If c is already stage-pinned, then is switched to fully-pinned.
 // If c is already fully-pinned, then only its timestamp gets refreshed.
-func (s *Store) Add(iid ffs.APIID, c cid.Cid) error {
+// If c isn't pinned by any APIID, node is saved as the node holding c.
+func (s *Store) Add(iid ffs.APIID, c cid.Cid, node string) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
@@ -109,7 +125,7 @@ func (s *Store) Add(iid ffs.APIID, c cid.Cid) error {
 	if cr, ok := s.cache[c]; ok {
 		r = cr
 	} else {
-		r = PinnedCid{Cid: c}
+		r = PinnedCid{Cid: c, Node: node}
 	}
 
 	var p *Pin
@@ -127,15 +143,103 @@ func (s *Store) Add(iid ffs.APIID, c cid.Cid) error {
 		r.Pins = append(r.Pins, Pin{})
 		p = &r.Pins[len(r.Pins)-1]
 	}
+	now := time.Now().Unix()
 	*p = Pin{
-		APIID:     iid,
-		Staged:    false,
-		CreatedAt: time.Now().Unix(),
+		APIID:          iid,
+		Staged:         false,
+		CreatedAt:      now,
+		Cached:         p.Cached,
+		CacheTTL:       p.CacheTTL,
+		LastAccessedAt: now,
 	}
 
 	return s.persist(r)
 }
 
+// SetCache sets the caching configuration of the pin of c by iid.
+// ttl is the amount of seconds without accesses after which the pin can
+// be evicted, where zero means no expiration.
+func (s *Store) SetCache(iid ffs.APIID, c cid.Cid, enabled bool, ttl int64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	r, ok := s.cache[c]
+	if !ok {
+		return fmt.Errorf("c isn't pinned")
+	}
+	for i, p := range r.Pins {
+		if p.APIID != iid {
+			continue
+		}
+		if p.Cached == enabled && p.CacheTTL == ttl {
+			return nil
+		}
+		r.Pins[i].Cached = enabled
+		r.Pins[i].CacheTTL = ttl
+		return s.persist(r)
+	}
+	return fmt.Errorf("c isn't pinned by %s", iid)
+}
+
+// Touch refreshes the last access timestamp of c for iid.
+// If c isn't pinned by iid, this is a noop.
+func (s *Store) Touch(iid ffs.APIID, c cid.Cid) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	r, ok := s.cache[c]
+	if !ok {
+		return nil
+	}
+	for i, p := range r.Pins {
+		if p.APIID == iid {
+			r.Pins[i].LastAccessedAt = time.Now().Unix()
+			return s.persist(r)
+		}
+	}
+	return nil
+}
+
+// Node returns the name of the node holding c, and false if
+// c isn't pinned.
+func (s *Store) Node(c cid.Cid) (string, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	r, ok := s.cache[c]
+	return r.Node, ok
+}
+
+// SetNode sets the node holding c, which should be pinned.
+func (s *Store) SetNode(c cid.Cid, node string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	r, ok := s.cache[c]
+	if !ok {
+		return fmt.Errorf("c isn't pinned")
+	}
+	if r.Node == node {
+		return nil
+	}
+	r.Node = node
+	return s.persist(r)
+}
+
+// NodeCids returns the number of pinned cids held by each node.
+func (s *Store) NodeCids() map[string]int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	res := make(map[string]int, len(s.nodeCids))
+	for n, count := range s.nodeCids {
+		if count > 0 {
+			res[n] = count
+		}
+	}
+	return res
+}
+
 // RefCount returns two integers (total, staged).
 // total is the total number of ref counts for the Cid.
 // staged is the total number of ref counts corresponding to
@@ -240,11 +344,46 @@ func (s *Store) RemoveStaged(c cid.Cid) error {
 	if err := s.ds.Delete(makeKey(c)); err != nil {
 		return fmt.Errorf("deleting from datastore: %s", err)
 	}
+	s.nodeCids[pc.Node]--
+	delete(s.cache, c)
+
+	return nil
+}
+
+// RemoveAll deletes all pins of c from the pinstore regardless
+// of their type. It's used to forget Cids which aren't pinned
+// anymore in the go-ipfs node.
+func (s *Store) RemoveAll(c cid.Cid) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	pc, ok := s.cache[c]
+	if !ok {
+		return nil
+	}
+	if err := s.ds.Delete(makeKey(c)); err != nil {
+		return fmt.Errorf("deleting from datastore: %s", err)
+	}
+	s.nodeCids[pc.Node]--
 	delete(s.cache, c)
 
 	return nil
 }
 
+// Get returns the pins of c, and false if c isn't pinned.
+func (s *Store) Get(c cid.Cid) (PinnedCid, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	r, ok := s.cache[c]
+	if !ok {
+		return PinnedCid{}, false
+	}
+	pins := make([]Pin, len(r.Pins))
+	copy(pins, r.Pins)
+	return PinnedCid{Cid: r.Cid, Node: r.Node, Pins: pins}, true
+}
+
 // GetAll returns all pinned cids.
 func (s *Store) GetAll() ([]PinnedCid, error) {
 	s.lock.Lock()
@@ -254,6 +393,7 @@ func (s *Store) GetAll() ([]PinnedCid, error) {
 	for _, v := range s.cache {
 		v1 := PinnedCid{
 			Cid:  v.Cid,
+			Node: v.Node,
 			Pins: make([]Pin, len(v.Pins)),
 		}
 		for i, p := range v.Pins {
@@ -286,11 +426,15 @@ Loop:
 // persist persists a PinnedCid in the datastore.
 func (s *Store) persist(r PinnedCid) error {
 	k := makeKey(r.Cid)
+	prev, exists := s.cache[r.Cid]
 
 	if len(r.Pins) == 0 {
 		if err := s.ds.Delete(k); err != nil {
 			return fmt.Errorf("delete from datastore: %s", err)
 		}
+		if exists {
+			s.nodeCids[prev.Node]--
+		}
 		delete(s.cache, r.Cid)
 
 		return nil
@@ -302,6 +446,10 @@ func (s *Store) persist(r PinnedCid) error {
 	if err := s.ds.Put(k, buf); err != nil {
 		return fmt.Errorf("put in datastore: %s", err)
 	}
+	if exists {
+		s.nodeCids[prev.Node]--
+	}
+	s.nodeCids[r.Node]++
 	s.cache[r.Cid] = r
 
 	return nil
@@ -0,0 +1,121 @@
+package pinstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/tests"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func TestSetCache(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxnDatastore(t)
+	s, err := New(ds)
+	require.NoError(t, err)
+
+	iid := ffs.NewAPIID()
+	c, _ := util.CidFromString("QmY7gN6AfKSoR7DNEjcUyXRYS85giD1YXN62cWVzS5zfus")
+
+	err = s.SetCache(iid, c, true, 100)
+	require.Error(t, err)
+
+	require.NoError(t, s.Add(iid, c, ""))
+	require.NoError(t, s.SetCache(iid, c, true, 100))
+	require.NoError(t, s.Add(iid, c, ""))
+
+	// Reload from the datastore to check the cache
+	// config is persisted, and kept on re-pins.
+	s, err = New(ds)
+	require.NoError(t, err)
+	all, err := s.GetAll()
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Len(t, all[0].Pins, 1)
+	p := all[0].Pins[0]
+	require.True(t, p.Cached)
+	require.Equal(t, int64(100), p.CacheTTL)
+	require.NotZero(t, p.LastAccessedAt)
+}
+
+func TestTouch(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxnDatastore(t)
+	s, err := New(ds)
+	require.NoError(t, err)
+
+	iid := ffs.NewAPIID()
+	c, _ := util.CidFromString("QmY7gN6AfKSoR7DNEjcUyXRYS85giD1YXN62cWVzS5zfus")
+
+	// Touching an unpinned cid is a noop.
+	require.NoError(t, s.Touch(iid, c))
+	require.False(t, s.IsPinned(c))
+
+	require.NoError(t, s.Add(iid, c, ""))
+	s.cache[c].Pins[0].LastAccessedAt = 0
+	require.NoError(t, s.Touch(iid, c))
+	require.NotZero(t, s.cache[c].Pins[0].LastAccessedAt)
+}
+
+func TestRemoveAll(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxnDatastore(t)
+	s, err := New(ds)
+	require.NoError(t, err)
+
+	c, _ := util.CidFromString("QmY7gN6AfKSoR7DNEjcUyXRYS85giD1YXN62cWVzS5zfus")
+
+	// Removing an unpinned cid is a noop.
+	require.NoError(t, s.RemoveAll(c))
+
+	require.NoError(t, s.Add(ffs.NewAPIID(), c, ""))
+	require.NoError(t, s.AddStaged(ffs.NewAPIID(), c, ""))
+	require.True(t, s.IsPinned(c))
+
+	require.NoError(t, s.RemoveAll(c))
+	require.False(t, s.IsPinned(c))
+
+	// Reload from the datastore to check the removal
+	// is persisted.
+	s, err = New(ds)
+	require.NoError(t, err)
+	require.False(t, s.IsPinned(c))
+}
+
+func TestNodes(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxnDatastore(t)
+	s, err := New(ds)
+	require.NoError(t, err)
+
+	c1, _ := util.CidFromString("QmY7gN6AfKSoR7DNEjcUyXRYS85giD1YXN62cWVzS5zfus")
+	c2, _ := util.CidFromString("QmZTR5bcpQD7cFgTorqxZDYaew1Wqgfbd2ud9QqGPAkK2V")
+
+	_, ok := s.Node(c1)
+	require.False(t, ok)
+	require.Error(t, s.SetNode(c1, "n1"))
+
+	// The node is only saved for new pins.
+	require.NoError(t, s.AddStaged(ffs.NewAPIID(), c1, "n1"))
+	require.NoError(t, s.Add(ffs.NewAPIID(), c1, "n2"))
+	require.NoError(t, s.Add(ffs.NewAPIID(), c2, ""))
+	n, ok := s.Node(c1)
+	require.True(t, ok)
+	require.Equal(t, "n1", n)
+	require.Equal(t, map[string]int{"n1": 1, "": 1}, s.NodeCids())
+
+	require.NoError(t, s.SetNode(c1, "n2"))
+	require.Equal(t, map[string]int{"n2": 1, "": 1}, s.NodeCids())
+
+	// Reload from the datastore to check the nodes
+	// are persisted.
+	s, err = New(ds)
+	require.NoError(t, err)
+	n, _ = s.Node(c1)
+	require.Equal(t, "n2", n)
+	require.Equal(t, map[string]int{"n2": 1, "": 1}, s.NodeCids())
+
+	require.NoError(t, s.RemoveAll(c1))
+	require.Equal(t, map[string]int{"": 1}, s.NodeCids())
+}
@@ -0,0 +1,47 @@
+package coreipfs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+)
+
+// Publish publishes c under the IPNS key keyName, generating the key if it
+// doesn't exist. It returns the IPNS name of the key. Keys are always held
+// by the primary node, so a key keeps its IPNS name regardless of the node
+// holding the data of the published Cid.
+func (ci *CoreIpfs) Publish(ctx context.Context, keyName string, c cid.Cid) (string, error) {
+	ci.lock.Lock()
+	_, err := ci.heldNode(c)
+	n := ci.nodes[0]
+	ci.lock.Unlock()
+	if err != nil {
+		return "", fmt.Errorf("getting node of cid %s: %s", c, err)
+	}
+
+	keys, err := n.api.Key().List(ctx)
+	if err != nil {
+		return "", fmt.Errorf("listing keys: %s", err)
+	}
+	var found bool
+	for _, k := range keys {
+		if k.Name() == keyName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		if _, err := n.api.Key().Generate(ctx, keyName); err != nil {
+			return "", fmt.Errorf("generating key %s: %s", keyName, err)
+		}
+	}
+
+	e, err := n.api.Name().Publish(ctx, path.IpfsPath(c), options.Name.Key(keyName))
+	if err != nil {
+		return "", fmt.Errorf("publishing cid %s: %s", c, err)
+	}
+	return e.Name(), nil
+}
@@ -0,0 +1,440 @@
+package coreipfs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	httpapi "github.com/ipfs/go-ipfs-http-client"
+	iface "github.com/ipfs/interface-go-ipfs-core"
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+var (
+	nodesKey = datastore.NewKey("nodes")
+
+	healthCheckTimeout = time.Second * 10
+)
+
+// Node is an extra go-ipfs node where CoreIpfs places pinned data.
+type Node struct {
+	// Name identifies the node in the saved placement of Cids, so it
+	// should be stable between restarts. e.g: its API multiaddress.
+	Name string
+	API  iface.CoreAPI
+}
+
+type config struct {
+	nodes                []Node
+	healthCheckFrequency time.Duration
+}
+
+// Option configures a CoreIpfs.
+type Option func(*config)
+
+// WithNodes configures extra go-ipfs nodes besides the primary one, so
+// pinned data is sharded across all of them.
+func WithNodes(nodes ...Node) Option {
+	return func(c *config) {
+		c.nodes = append(c.nodes, nodes...)
+	}
+}
+
+// WithHealthCheckFrequency configures how often the health of go-ipfs
+// nodes is checked. Unhealthy nodes aren't considered for placing new
+// data. The storage usage of node repos, used to place new data, is
+// refreshed with each check. Zero disables health checks, and storage
+// usage is only refreshed on startup.
+func WithHealthCheckFrequency(freq time.Duration) Option {
+	return func(c *config) {
+		c.healthCheckFrequency = freq
+	}
+}
+
+// node is a go-ipfs node of the pool. The primary node
+// has an empty name.
+type node struct {
+	name string
+	api  iface.CoreAPI
+
+	lock    sync.Mutex
+	healthy bool
+	// repoSize and storageMax are the storage usage of the node
+	// repo, if hasRepoStat is true.
+	repoSize    uint64
+	storageMax  uint64
+	hasRepoStat bool
+}
+
+func (n *node) isHealthy() bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.healthy
+}
+
+func (n *node) setHealthy(healthy bool) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.healthy = healthy
+}
+
+// freeStorage returns the free storage of the node repo, and false
+// if it's unknown.
+func (n *node) freeStorage() (uint64, bool) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if !n.hasRepoStat {
+		return 0, false
+	}
+	if n.repoSize >= n.storageMax {
+		return 0, true
+	}
+	return n.storageMax - n.repoSize, true
+}
+
+func (n *node) setRepoStat(repoSize, storageMax uint64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.repoSize = repoSize
+	n.storageMax = storageMax
+	n.hasRepoStat = true
+}
+
+// addUsage accounts data added to the node repo until its storage
+// usage is refreshed.
+func (n *node) addUsage(size uint64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.repoSize += size
+}
+
+func (n *node) String() string {
+	if n.name == "" {
+		return "primary"
+	}
+	return n.name
+}
+
+// heldNode returns the node holding the data of a pinned Cid.
+func (ci *CoreIpfs) heldNode(c cid.Cid) (*node, error) {
+	name, ok := ci.ps.Node(c)
+	if !ok {
+		return nil, ErrUnpinnedCid
+	}
+	n, ok := ci.nodesByName[name]
+	if !ok {
+		return nil, fmt.Errorf("cid %s is held by unconfigured node %s", c, name)
+	}
+	return n, nil
+}
+
+// nodeFor returns the node holding the data of c if it's pinned,
+// or the node where it should be placed otherwise.
+func (ci *CoreIpfs) nodeFor(c cid.Cid) (*node, error) {
+	if _, ok := ci.ps.Node(c); ok {
+		return ci.heldNode(c)
+	}
+	return ci.placeNode()
+}
+
+// placeNode returns the healthy node with more free storage in its repo.
+// If the storage usage of some healthy node is unknown, it returns the
+// healthy node with less pinned Cids.
+func (ci *CoreIpfs) placeNode() (*node, error) {
+	var healthy []*node
+	for _, n := range ci.nodes {
+		if n.isHealthy() {
+			healthy = append(healthy, n)
+		}
+	}
+	if len(healthy) == 0 {
+		return nil, fmt.Errorf("no healthy ipfs node available")
+	}
+
+	res := healthy[0]
+	maxFree, ok := res.freeStorage()
+	for _, n := range healthy[1:] {
+		if !ok {
+			break
+		}
+		var free uint64
+		free, ok = n.freeStorage()
+		if ok && free > maxFree {
+			res, maxFree = n, free
+		}
+	}
+	if ok {
+		return res, nil
+	}
+
+	counts := ci.ps.NodeCids()
+	res = healthy[0]
+	for _, n := range healthy[1:] {
+		if counts[n.name] < counts[res.name] {
+			res = n
+		}
+	}
+	return res, nil
+}
+
+// run checks the health of nodes periodically, and rebalances
+// pinned data if nodes were added to the pool.
+func (ci *CoreIpfs) run(rebalance bool) {
+	defer close(ci.finished)
+
+	if ci.healthCheckFrequency > 0 {
+		ci.checkHealth(ci.ctx)
+	} else {
+		ci.refreshRepoStats(ci.ctx)
+	}
+	if rebalance {
+		moved, err := ci.rebalance(ci.ctx)
+		if err != nil {
+			log.Errorf("rebalancing ipfs nodes: %s", err)
+		} else {
+			log.Infof("rebalanced %d cids across %d ipfs nodes", moved, len(ci.nodes))
+		}
+	}
+	if ci.healthCheckFrequency == 0 {
+		return
+	}
+	for {
+		select {
+		case <-ci.ctx.Done():
+			return
+		case <-time.After(ci.healthCheckFrequency):
+			ci.checkHealth(ci.ctx)
+		}
+	}
+}
+
+// checkHealth checks that the API of each node is responsive.
+func (ci *CoreIpfs) checkHealth(ctx context.Context) {
+	for _, n := range ci.nodes {
+		hctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		_, err := n.api.Key().Self(hctx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		healthy := err == nil
+		if healthy != n.isHealthy() {
+			if healthy {
+				log.Infof("ipfs node %s is healthy again", n)
+			} else {
+				log.Warnf("ipfs node %s is unhealthy: %s", n, err)
+			}
+		}
+		n.setHealthy(healthy)
+	}
+	ci.refreshRepoStats(ctx)
+}
+
+// repoStater is implemented by go-ipfs HTTP API clients, which can
+// report the storage usage of the node repo.
+type repoStater interface {
+	Request(command string, args ...string) httpapi.RequestBuilder
+}
+
+// refreshRepoStats refreshes the storage usage of the repo of healthy
+// nodes. Nodes whose usage can't be refreshed keep the last known one.
+func (ci *CoreIpfs) refreshRepoStats(ctx context.Context) {
+	for _, n := range ci.nodes {
+		rs, ok := n.api.(repoStater)
+		if !ok || !n.isHealthy() {
+			continue
+		}
+		var stat struct {
+			RepoSize   uint64
+			StorageMax uint64
+		}
+		sctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+		err := rs.Request("repo/stat").Option("size-only", true).Exec(sctx, &stat)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Warnf("getting repo stats of ipfs node %s: %s", n, err)
+			continue
+		}
+		n.setRepoStat(stat.RepoSize, stat.StorageMax)
+	}
+}
+
+// rebalance moves pinned Cids from nodes holding more Cids than
+// the average to healthy nodes holding less. It returns the number
+// of moved Cids.
+func (ci *CoreIpfs) rebalance(ctx context.Context) (int, error) {
+	var healthy []*node
+	for _, n := range ci.nodes {
+		if n.isHealthy() {
+			healthy = append(healthy, n)
+		}
+	}
+	if len(healthy) < 2 {
+		return 0, nil
+	}
+	pcs, err := ci.ps.GetAll()
+	if err != nil {
+		return 0, fmt.Errorf("getting pins from pinstore: %s", err)
+	}
+	sort.Slice(pcs, func(i, j int) bool { return pcs[i].Cid.String() < pcs[j].Cid.String() })
+
+	counts := ci.ps.NodeCids()
+	var total int
+	for _, n := range healthy {
+		total += counts[n.name]
+	}
+	target := (total + len(healthy) - 1) / len(healthy)
+
+	var moved int
+	for _, pc := range pcs {
+		if ctx.Err() != nil {
+			return moved, nil
+		}
+		src, ok := ci.nodesByName[pc.Node]
+		if !ok || !src.isHealthy() || counts[src.name] <= target {
+			continue
+		}
+		dst := healthy[0]
+		for _, n := range healthy[1:] {
+			if counts[n.name] < counts[dst.name] {
+				dst = n
+			}
+		}
+		if counts[dst.name] >= target {
+			break
+		}
+		ok, err := ci.move(ctx, pc.Cid, src, dst)
+		if err != nil {
+			log.Errorf("moving cid %s from node %s to %s: %s", pc.Cid, src, dst, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		counts[src.name]--
+		counts[dst.name]++
+		moved++
+	}
+	return moved, nil
+}
+
+// move pins the data of c in dst and unpins it from src. It returns false
+// if c was unpinned or moved meanwhile.
+func (ci *CoreIpfs) move(ctx context.Context, c cid.Cid, src, dst *node) (bool, error) {
+	// The pin in dst isn't saved in the pinstore until the data is
+	// fetched, so it's flagged as pending for reconciliations.
+	done := ci.addPending(dst, c)
+	defer done()
+
+	if err := connect(ctx, src, dst); err != nil {
+		log.Warnf("connecting node %s to %s: %s", dst, src, err)
+	}
+	p := path.IpfsPath(c)
+	if err := dst.api.Pin().Add(ctx, p, options.Pin.Recursive(true)); err != nil {
+		return false, fmt.Errorf("pinning in destination node: %s", err)
+	}
+
+	ci.lock.Lock()
+	defer ci.lock.Unlock()
+
+	if name, ok := ci.ps.Node(c); !ok || name != src.name {
+		if err := dst.api.Pin().Rm(ctx, p, options.Pin.RmRecursive(true)); err != nil {
+			return false, fmt.Errorf("unpinning from destination node: %s", err)
+		}
+		return false, nil
+	}
+	if err := ci.ps.SetNode(c, dst.name); err != nil {
+		return false, fmt.Errorf("saving node in pinstore: %s", err)
+	}
+	if err := src.api.Pin().Rm(ctx, p, options.Pin.RmRecursive(true)); err != nil {
+		// The data is already held by dst, so the leftover pin in src
+		// is only an unknown pin to be cleaned by reconciliation.
+		log.Warnf("unpinning moved cid %s from node %s: %s", c, src, err)
+	}
+	return true, nil
+}
+
+// pendingPin is a Cid multihash being pinned in a node.
+type pendingPin struct {
+	node string
+	hash string
+}
+
+// addPending flags c as being pinned in n, and returns a function to
+// clear the flag.
+func (ci *CoreIpfs) addPending(n *node, c cid.Cid) func() {
+	pp := pendingPin{node: n.name, hash: string(c.Hash())}
+	ci.lock.Lock()
+	defer ci.lock.Unlock()
+	ci.pending[pp]++
+	return func() {
+		ci.lock.Lock()
+		defer ci.lock.Unlock()
+		ci.pending[pp]--
+		if ci.pending[pp] == 0 {
+			delete(ci.pending, pp)
+		}
+	}
+}
+
+// connect connects dst to src, so dst can fetch data from src.
+func connect(ctx context.Context, src, dst *node) error {
+	k, err := src.api.Key().Self(ctx)
+	if err != nil {
+		return fmt.Errorf("getting source node identity: %s", err)
+	}
+	addrs, err := src.api.Swarm().LocalAddrs(ctx)
+	if err != nil {
+		return fmt.Errorf("getting source node addresses: %s", err)
+	}
+	if err := dst.api.Swarm().Connect(ctx, peer.AddrInfo{ID: k.ID(), Addrs: addrs}); err != nil {
+		return fmt.Errorf("connecting nodes: %s", err)
+	}
+	return nil
+}
+
+// updateNodes saves the names of the nodes of the pool, and returns
+// true if nodes were added since the last time.
+func updateNodes(ds datastore.Datastore, nodes []*node) (bool, error) {
+	// The primary node was the only one before sharding.
+	prev := []string{""}
+	buf, err := ds.Get(nodesKey)
+	if err != nil && err != datastore.ErrNotFound {
+		return false, fmt.Errorf("getting saved nodes: %s", err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(buf, &prev); err != nil {
+			return false, fmt.Errorf("unmarshaling saved nodes: %s", err)
+		}
+	}
+	known := make(map[string]struct{}, len(prev))
+	for _, name := range prev {
+		known[name] = struct{}{}
+	}
+	var added bool
+	names := make([]string, len(nodes))
+	for i, n := range nodes {
+		names[i] = n.name
+		if _, ok := known[n.name]; !ok {
+			added = true
+		}
+	}
+	buf, err = json.Marshal(names)
+	if err != nil {
+		return false, fmt.Errorf("marshaling nodes: %s", err)
+	}
+	if err := ds.Put(nodesKey, buf); err != nil {
+		return false, fmt.Errorf("saving nodes: %s", err)
+	}
+	return added, nil
+}
@@ -0,0 +1,134 @@
+package coreipfs
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	blocks "github.com/ipfs/go-block-format"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	car "github.com/ipld/go-car"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+// Verify walks the DAG of c in the IPFS node holding it, validating the
+// data of every block against its Cid. Blocks are read offline, so missing
+// blocks fail the verification instead of being fetched from the network.
+// A failed verification is reported in the result, and errors are only
+// returned if the walk couldn't start.
+func (ci *CoreIpfs) Verify(ctx context.Context, c cid.Cid) (ffs.HotVerification, error) {
+	n, err := ci.heldNode(c)
+	if err != nil {
+		return ffs.HotVerification{}, fmt.Errorf("getting node of cid %s: %s", c, err)
+	}
+	offline, err := n.api.WithOptions(options.Api.Offline(true))
+	if err != nil {
+		return ffs.HotVerification{}, fmt.Errorf("creating offline ipfs api: %s", err)
+	}
+	sel, err := parseSelector("")
+	if err != nil {
+		return ffs.HotVerification{}, err
+	}
+
+	var res ffs.HotVerification
+	vs := &verifyingStore{blockStore: blockStore{ctx: ctx, ipfs: offline}, res: &res}
+	sc := car.NewSelectiveCar(ctx, vs, []car.Dag{{Root: c, Selector: sel}})
+	count := func(b car.Block) error {
+		res.Blocks++
+		res.Size += uint64(len(b.Data))
+		return nil
+	}
+	if err := sc.Write(ioutil.Discard, count); err != nil {
+		res.Error = err.Error()
+	}
+	return res, nil
+}
+
+// Repair removes the invalid blocks of c from the IPFS node holding it, and
+// pins c again, which fetches missing blocks from the IPFS network. The pin
+// of c is flagged as pending while it's removed, so reconciliations leave it
+// alone. Other APIIDs may share c, so if it can't be pinned again its pin is
+// restored: recursively if its blocks are still complete, or directly on its
+// root otherwise, until a reconciliation pins it again.
+func (ci *CoreIpfs) Repair(ctx context.Context, c cid.Cid, invalid []cid.Cid) error {
+	ci.lock.Lock()
+	n, err := ci.heldNode(c)
+	ci.lock.Unlock()
+	if err != nil {
+		return fmt.Errorf("getting node of cid %s: %s", c, err)
+	}
+	done := ci.addPending(n, c)
+	defer done()
+
+	p := path.IpfsPath(c)
+	// Pinned blocks can't be removed, so the pin is removed first.
+	if len(invalid) > 0 {
+		if err := n.api.Pin().Rm(ctx, p, options.Pin.RmRecursive(true)); err != nil {
+			return fmt.Errorf("unpinning cid %s: %s", c, err)
+		}
+		for _, b := range invalid {
+			if err := n.api.Block().Rm(ctx, path.IpldPath(b), options.Block.Force(true)); err != nil {
+				err = fmt.Errorf("removing invalid block %s: %s", b, err)
+				return restorePin(n, c, err)
+			}
+		}
+	}
+	if err := n.api.Pin().Add(ctx, p, options.Pin.Recursive(true)); err != nil {
+		err = fmt.Errorf("pinning cid %s: %s", c, err)
+		if len(invalid) > 0 {
+			return restorePin(n, c, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// restorePin pins c again in n after a failed repair, and returns the repair
+// error cause. c is pinned recursively from the blocks left in n, or directly
+// if its DAG isn't complete.
+func restorePin(n *node, c cid.Cid, cause error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), restorePinTimeout)
+	defer cancel()
+	offline, err := n.api.WithOptions(options.Api.Offline(true))
+	if err != nil {
+		return fmt.Errorf("%s, and creating offline ipfs api: %s", cause, err)
+	}
+	p := path.IpfsPath(c)
+	if err := offline.Pin().Add(ctx, p, options.Pin.Recursive(true)); err == nil {
+		return cause
+	}
+	if err := offline.Pin().Add(ctx, p, options.Pin.Recursive(false)); err != nil {
+		return fmt.Errorf("%s, and restoring pin: %s", cause, err)
+	}
+	return fmt.Errorf("%s, the root was pinned directly", cause)
+}
+
+// restorePinTimeout bounds restoring the pin of a Cid from the blocks in a
+// node after a failed repair.
+const restorePinTimeout = time.Minute
+
+// verifyingStore reads blocks from an IPFS node, validating their
+// data against their Cid.
+type verifyingStore struct {
+	blockStore
+	res *ffs.HotVerification
+}
+
+func (vs *verifyingStore) Get(c cid.Cid) (blocks.Block, error) {
+	b, err := vs.blockStore.Get(c)
+	if err != nil {
+		return nil, err
+	}
+	sum, err := c.Prefix().Sum(b.RawData())
+	if err != nil {
+		return nil, fmt.Errorf("hashing block %s: %s", c, err)
+	}
+	if !sum.Equals(c) {
+		vs.res.Invalid = append(vs.res.Invalid, c)
+		return nil, fmt.Errorf("block data doesn't match cid %s", c)
+	}
+	return b, nil
+}
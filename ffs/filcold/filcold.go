@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"math/big"
+	"strconv"
 	"time"
 
 	"github.com/dustin/go-humanize"
@@ -16,6 +18,8 @@ import (
 	"github.com/ipfs/go-cid"
 	logger "github.com/ipfs/go-log/v2"
 	iface "github.com/ipfs/interface-go-ipfs-core"
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	"github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/textileio/powergate/v2/deals"
 	"github.com/textileio/powergate/v2/deals/module"
 	dealsModule "github.com/textileio/powergate/v2/deals/module"
@@ -28,6 +32,9 @@ import (
 
 const (
 	unsyncedThreshold = 10
+	// maxLoggedExclusions is the maximum number of excluded candidate
+	// miners logged in a single miner selection.
+	maxLoggedExclusions = 20
 )
 
 var (
@@ -81,11 +88,11 @@ func New(ms ffs.MinerSelector, dm *dealsModule.Module, wm wallet.Module, ipfs if
 // Fetch fetches the stored Cid data.The data will be considered available
 // to the underlying blockstore.
 func (fc *FilCold) Fetch(ctx context.Context, pyCid cid.Cid, piCid *cid.Cid, waddr string, miners []string, maxPrice uint64, selector string) (ffs.FetchInfo, error) {
-	miner, events, err := fc.dm.Fetch(ctx, waddr, pyCid, piCid, miners)
+	miner, events, err := fc.dm.Fetch(ctx, waddr, pyCid, piCid, miners, maxPrice, selector)
 	if err != nil {
 		return ffs.FetchInfo{}, fmt.Errorf("fetching from deal module: %s", err)
 	}
-	fc.l.Log(ctx, "Fetching from %s...", miner)
+	fc.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventUnfreezeStarted, Fields: map[string]string{ffs.LogFieldMiner: miner}}, "Fetching from %s...", miner)
 
 	var (
 		fundsSpent uint64
@@ -109,7 +116,14 @@ Loop:
 			fundsSpent = e.FundsSpent.Uint64()
 			newMsg := fmt.Sprintf("Received %s, total spent: %sFIL (%s/%s)", humanize.IBytes(e.BytesReceived), util.AttoFilToFil(fundsSpent), strEvent, strDealStatus)
 			if newMsg != lastMsg {
-				fc.l.Log(ctx, newMsg)
+				ev := ffs.LogEvent{
+					Type: ffs.EventUnfreezeProgress,
+					Fields: map[string]string{
+						ffs.LogFieldMiner:  miner,
+						ffs.LogFieldAmount: strconv.FormatUint(fundsSpent, 10),
+					},
+				}
+				fc.l.LogEvent(ctx, ev, newMsg)
 				lastMsg = newMsg
 			}
 			lastEvent = e
@@ -118,10 +132,39 @@ Loop:
 	if lastEvent.Status != retrievalmarket.DealStatusCompleted {
 		return ffs.FetchInfo{}, fmt.Errorf("retrieval failed with status %s and message %s", retrievalmarket.DealStatuses[lastEvent.Status], lastMsg)
 	}
+	if err := fc.checkRetrievedRoot(ctx, pyCid); err != nil {
+		return ffs.FetchInfo{}, ffs.CorruptDataError{Message: fmt.Sprintf("retrieval from %s completed with wrong data: %s", miner, err)}
+	}
 
 	return ffs.FetchInfo{RetrievedMiner: miner, FundsSpent: fundsSpent}, nil
 }
 
+// checkRetrievedRoot checks that the root block of a completed retrieval is
+// in the blockstore of the Filecoin client, and its data matches c. The
+// retrieval validates the rest of the blocks against the links of the root.
+func (fc *FilCold) checkRetrievedRoot(ctx context.Context, c cid.Cid) error {
+	offline, err := fc.ipfs.WithOptions(options.Api.Offline(true))
+	if err != nil {
+		return fmt.Errorf("creating offline ipfs api: %s", err)
+	}
+	r, err := offline.Block().Get(ctx, path.IpldPath(c))
+	if err != nil {
+		return fmt.Errorf("getting root block: %s", err)
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("reading root block: %s", err)
+	}
+	sum, err := c.Prefix().Sum(data)
+	if err != nil {
+		return fmt.Errorf("hashing root block: %s", err)
+	}
+	if !sum.Equals(c) {
+		return fmt.Errorf("root block data doesn't match cid %s", c)
+	}
+	return nil
+}
+
 func (fc *FilCold) calculateDealPiece(ctx context.Context, c cid.Cid) (int64, abi.PaddedPieceSize, cid.Cid, error) {
 	fc.l.Log(ctx, "Entering deal preprocessing queue...")
 	fc.metricPreprocessingTotal.Add(ctx, 1, metricTagPreprocessingWaiting)
@@ -159,8 +202,9 @@ func (fc *FilCold) calculateDealPiece(ctx context.Context, c cid.Cid) (int64, ab
 // Store stores a Cid in Filecoin considering the configuration provided. The Cid is retrieved using
 // the DAGService registered on instance creation. It returns a slice of ProposalCids that were correctly
 // started, and a slice of with Proposal Cids rejected. Returned proposed deals can be tracked
-// with the WaitForDeal API.
-func (fc *FilCold) Store(ctx context.Context, c cid.Cid, cfg ffs.FilConfig) ([]cid.Cid, []ffs.DealError, abi.PaddedPieceSize, error) {
+// with the WaitForDeal API. The current miners storing the data are considered for diversity
+// constraints when selecting new miners.
+func (fc *FilCold) Store(ctx context.Context, c cid.Cid, cfg ffs.FilConfig, currentMiners []string) ([]cid.Cid, []ffs.DealError, abi.PaddedPieceSize, error) {
 	payloadSize, pieceSize, pieceCid, err := fc.calculateDealPiece(ctx, c)
 	if err != nil {
 		return nil, nil, 0, fmt.Errorf("getting cid cummulative size: %s", err)
@@ -194,10 +238,13 @@ func (fc *FilCold) Store(ctx context.Context, c cid.Cid, cfg ffs.FilConfig) ([]c
 		MaxPrice:       cfg.MaxPrice,
 		PieceSize:      uint64(pieceSize),
 		VerifiedDeal:   cfg.VerifiedDeal,
+		Diversity:      cfg.Diversity,
+		CurrentMiners:  currentMiners,
+		APIID:          apiIDFromCtx(ctx),
 	}
-	cfgs, err := makeDealConfigs(fc.ms, cfg.RepFactor, f, cfg.FastRetrieval, cfg.DealStartOffset)
+	cfgs, exclusions, err := fc.makeDealConfigs(ctx, cfg.RepFactor, f, cfg.FastRetrieval, cfg.DealStartOffset)
 	if err != nil {
-		return nil, nil, 0, fmt.Errorf("making deal configs: %s", err)
+		return nil, exclusions, 0, fmt.Errorf("making deal configs: %s", err)
 	}
 
 	okDeals, failedStartingDeals, err := fc.makeDeals(ctx, c, payloadSize, pieceSize, pieceCid, cfgs, cfg)
@@ -223,6 +270,15 @@ func (fc *FilCold) GetDealInfo(ctx context.Context, dealID uint64) (api.MarketDe
 	return di, nil
 }
 
+// GetHeight returns the current chain height.
+func (fc *FilCold) GetHeight(ctx context.Context) (uint64, error) {
+	height, err := fc.chain.GetHeight(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get current filecoin height: %s", err)
+	}
+	return height, nil
+}
+
 // EnsureRenewals analyzes a FilInfo state for a Cid and executes renewals considering the FilConfig desired configuration.
 // Deal status updates are sent on the provided dealUpdates channel.
 // The caller should close the channel once all calls to EnsureRenewals have returned.
@@ -269,6 +325,7 @@ func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInf
 
 	newInf := ffs.FilInfo{
 		DataCid:   inf.DataCid,
+		Encrypted: inf.Encrypted,
 		Size:      inf.Size,
 		Proposals: make([]ffs.FilStorage, len(inf.Proposals)),
 	}
@@ -280,7 +337,8 @@ func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInf
 	var newDealErrors []ffs.DealError
 	for i, p := range toRenew {
 		var dealError ffs.DealError
-		newProposal, err := fc.renewDeal(ctx, c, p, cfg, dealFinalityTimeout, dealUpdates)
+		currentMiners := otherMiners(newInf.Proposals, p)
+		newProposal, err := fc.renewDeal(ctx, c, p, cfg, currentMiners, dealFinalityTimeout, dealUpdates)
 		if err != nil {
 			if errors.As(err, &dealError) {
 				newDealErrors = append(newDealErrors, dealError)
@@ -288,6 +346,7 @@ func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInf
 			}
 			continue
 		}
+		newProposal.FastRetrieval = cfg.FastRetrieval
 		newInf.Proposals = append(newInf.Proposals, newProposal)
 		newInf.Proposals[i].Renewed = true
 	}
@@ -295,7 +354,7 @@ func (fc *FilCold) EnsureRenewals(ctx context.Context, c cid.Cid, inf ffs.FilInf
 	return newInf, newDealErrors, nil
 }
 
-func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, p ffs.FilStorage, fcfg ffs.FilConfig, waitDealTimeout time.Duration, dealUpdates chan deals.StorageDealInfo) (ffs.FilStorage, error) {
+func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, p ffs.FilStorage, fcfg ffs.FilConfig, currentMiners []string, waitDealTimeout time.Duration, dealUpdates chan deals.StorageDealInfo) (ffs.FilStorage, error) {
 	payloadSize, pieceSize, pieceCid, err := fc.calculateDealPiece(ctx, c)
 	if err != nil {
 		return ffs.FilStorage{}, fmt.Errorf("getting cid cummulative size: %s", err)
@@ -308,9 +367,17 @@ func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, p ffs.FilStorage, f
 		MaxPrice:       fcfg.MaxPrice,
 		PieceSize:      uint64(pieceSize),
 		VerifiedDeal:   fcfg.VerifiedDeal,
+		Diversity:      fcfg.Diversity,
+		CurrentMiners:  currentMiners,
+		APIID:          apiIDFromCtx(ctx),
 	}
-	dealConfig, err := makeDealConfigs(fc.ms, 1, f, fcfg.FastRetrieval, fcfg.DealStartOffset)
+	dealConfig, exclusions, err := fc.makeDealConfigs(ctx, 1, f, fcfg.FastRetrieval, fcfg.DealStartOffset)
 	if err != nil {
+		for _, de := range exclusions {
+			if de.Miner == p.Miner {
+				return ffs.FilStorage{}, de
+			}
+		}
 		return ffs.FilStorage{}, fmt.Errorf("making new deal config: %s", err)
 	}
 
@@ -322,7 +389,7 @@ func (fc *FilCold) renewDeal(ctx context.Context, c cid.Cid, p ffs.FilStorage, f
 		if len(failedStartedDeals) != 1 {
 			return ffs.FilStorage{}, fmt.Errorf("failed started deals must be of size 1, this should never happen")
 		}
-		fc.l.Log(ctx, "Starting renewal deal proposal failed: %s", failedStartedDeals[0].Message)
+		fc.l.LogEvent(ctx, dealErrorEvent(ffs.EventDealProposalFailed, failedStartedDeals[0]), "Starting renewal deal proposal failed: %s", failedStartedDeals[0].Message)
 		return ffs.FilStorage{}, failedStartedDeals[0]
 	}
 
@@ -350,7 +417,14 @@ func (fc *FilCold) makeDeals(ctx context.Context, c cid.Cid, payloadSize int64,
 	}
 
 	for _, cfg := range cfgs {
-		fc.l.Log(ctx, "Proposing deal to miner %s with %s FIL per epoch...", cfg.Miner, util.AttoFilToFil(cfg.EpochPrice))
+		ev := ffs.LogEvent{
+			Type: ffs.EventDealProposed,
+			Fields: map[string]string{
+				ffs.LogFieldMiner:  cfg.Miner,
+				ffs.LogFieldAmount: strconv.FormatUint(cfg.EpochPrice, 10),
+			},
+		}
+		fc.l.LogEvent(ctx, ev, "Proposing deal to miner %s with %s FIL per epoch...", cfg.Miner, util.AttoFilToFil(cfg.EpochPrice))
 	}
 
 	sres, err := fc.dm.Store(ctx, fcfg.Addr, c, payloadSize, pieceSize, pieceCid, cfgs, uint64(fcfg.DealMinDuration))
@@ -361,13 +435,13 @@ func (fc *FilCold) makeDeals(ctx context.Context, c cid.Cid, payloadSize int64,
 	var failedDeals []ffs.DealError
 	for _, r := range sres {
 		if !r.Success {
-			fc.l.Log(ctx, "Proposal with miner %s failed: %s", r.Config.Miner, r.Message)
 			log.Warnf("failed store result: %s", r.Message)
 			de := ffs.DealError{
 				ProposalCid: r.ProposalCid,
 				Message:     r.Message,
 				Miner:       r.Config.Miner,
 			}
+			fc.l.LogEvent(ctx, dealErrorEvent(ffs.EventDealProposalFailed, de), "Proposal with miner %s failed: %s", r.Config.Miner, r.Message)
 			failedDeals = append(failedDeals, de)
 			continue
 		}
@@ -396,8 +470,9 @@ Loop:
 		select {
 		case <-time.After(timeout):
 			msg := fmt.Sprintf("DealID %d with miner %s tracking timed out after waiting for %.0f hours.", last.DealID, last.Miner, timeout.Hours())
-			fc.l.Log(ctx, msg)
-			return ffs.FilStorage{}, ffs.DealError{ProposalCid: proposal, Miner: last.Miner, Message: msg}
+			de := ffs.DealError{ProposalCid: proposal, Miner: last.Miner, Message: msg}
+			fc.l.LogEvent(ctx, dealErrorEvent(ffs.EventDealFailed, de), msg)
+			return ffs.FilStorage{}, de
 		case di, ok := <-chDi:
 			if !ok {
 				break Loop
@@ -418,19 +493,22 @@ Loop:
 					StartEpoch: di.StartEpoch,
 					EpochPrice: di.PricePerEpoch,
 				}
-				fc.l.Log(ctx, "Deal %d with miner %s is active on-chain", di.DealID, di.Miner)
+				fc.l.LogEvent(ctx, dealEvent(ffs.LogLevelInfo, ffs.EventDealActive, di), "Deal %d with miner %s is active on-chain", di.DealID, di.Miner)
 
 				return activeProposal, nil
 			case storagemarket.StorageDealError, storagemarket.StorageDealFailing:
 				log.Errorf("deal %d & proposal %s failed with state %s: %s", di.DealID, proposal, storagemarket.DealStates[di.StateID], di.Message)
-				fc.l.Log(ctx, "DealID %d with miner %s failed and won't be active on-chain: %s", di.DealID, di.Miner, di.Message)
+				ev := dealEvent(ffs.LogLevelError, ffs.EventDealFailed, di)
+				ev.Fields[ffs.LogFieldError] = di.Message
+				fc.l.LogEvent(ctx, ev, "DealID %d with miner %s failed and won't be active on-chain: %s", di.DealID, di.Miner, di.Message)
 
 				return ffs.FilStorage{}, ffs.DealError{ProposalCid: di.ProposalCid, Miner: di.Miner, Message: di.Message}
 			default:
+				ev := dealEvent(ffs.LogLevelInfo, dealStateEventType(di.StateID), di)
 				if di.DealID != 0 {
-					fc.l.Log(ctx, "Deal %d with miner %s changed state to %s", di.DealID, di.Miner, storagemarket.DealStates[di.StateID])
+					fc.l.LogEvent(ctx, ev, "Deal %d with miner %s changed state to %s", di.DealID, di.Miner, storagemarket.DealStates[di.StateID])
 				} else {
-					fc.l.Log(ctx, "Deal with miner %s changed state to %s", di.Miner, storagemarket.DealStates[di.StateID])
+					fc.l.LogEvent(ctx, ev, "Deal with miner %s changed state to %s", di.Miner, storagemarket.DealStates[di.StateID])
 				}
 			}
 		}
@@ -438,10 +516,46 @@ Loop:
 	return ffs.FilStorage{}, fmt.Errorf("aborted due to cancellation")
 }
 
-func makeDealConfigs(ms ffs.MinerSelector, cntMiners int, f ffs.MinerSelectorFilter, fastRetrieval bool, dealStartOffset int64) ([]deals.StorageDealConfig, error) {
-	mps, err := ms.GetMiners(cntMiners, f)
+// otherMiners returns the miners of the proposals other than p.
+func otherMiners(proposals []ffs.FilStorage, p ffs.FilStorage) []string {
+	var res []string
+	for _, op := range proposals {
+		if op.DealID == p.DealID {
+			continue
+		}
+		res = append(res, op.Miner)
+	}
+	return res
+}
+
+func apiIDFromCtx(ctx context.Context) ffs.APIID {
+	iid, _ := ctx.Value(ffs.CtxAPIID).(ffs.APIID)
+	return iid
+}
+
+// makeDealConfigs returns deal configurations for miners selected with the
+// filter. Candidate miners excluded by the miner selector are logged, and if
+// not enough miners could be selected they're returned as DealErrors.
+func (fc *FilCold) makeDealConfigs(ctx context.Context, cntMiners int, f ffs.MinerSelectorFilter, fastRetrieval bool, dealStartOffset int64) ([]deals.StorageDealConfig, []ffs.DealError, error) {
+	var exclusions []ffs.DealError
+	f.OnExclusion = func(e ffs.MinerExclusion) {
+		exclusions = append(exclusions, ffs.DealError{Miner: e.Miner, Message: fmt.Sprintf("excluded by miner selector: %s", e.Reason)})
+	}
+	mps, err := fc.ms.GetMiners(cntMiners, f)
+	for i, de := range exclusions {
+		if i == maxLoggedExclusions {
+			fc.l.LogEvent(ctx, ffs.LogEvent{Level: ffs.LogLevelWarn, Type: ffs.EventMinerExcluded}, "Miner selector excluded %d more candidates.", len(exclusions)-maxLoggedExclusions)
+			break
+		}
+		ev := ffs.LogEvent{
+			Level:  ffs.LogLevelWarn,
+			Type:   ffs.EventMinerExcluded,
+			Fields: map[string]string{ffs.LogFieldMiner: de.Miner, ffs.LogFieldError: de.Message},
+		}
+		fc.l.LogEvent(ctx, ev, "Miner %s was %s", de.Miner, de.Message)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("getting miners from minerselector: %s", err)
+		return nil, exclusions, fmt.Errorf("getting miners from minerselector: %s", err)
 	}
 	res := make([]deals.StorageDealConfig, len(mps))
 	for i, m := range mps {
@@ -453,5 +567,46 @@ func makeDealConfigs(ms ffs.MinerSelector, cntMiners int, f ffs.MinerSelectorFil
 			VerifiedDeal:    f.VerifiedDeal,
 		}
 	}
-	return res, nil
+	return res, nil, nil
+}
+
+// dealEvent returns a log event describing the current state of a deal.
+func dealEvent(level ffs.LogLevel, typ ffs.LogEventType, di deals.StorageDealInfo) ffs.LogEvent {
+	fields := map[string]string{
+		ffs.LogFieldMiner:     di.Miner,
+		ffs.LogFieldDealState: storagemarket.DealStates[di.StateID],
+	}
+	if di.ProposalCid.Defined() {
+		fields[ffs.LogFieldProposalCid] = util.CidToString(di.ProposalCid)
+	}
+	if di.DealID != 0 {
+		fields[ffs.LogFieldDealID] = strconv.FormatUint(di.DealID, 10)
+	}
+	return ffs.LogEvent{Level: level, Type: typ, Fields: fields}
+}
+
+// dealErrorEvent returns an error log event describing a failed deal.
+func dealErrorEvent(typ ffs.LogEventType, de ffs.DealError) ffs.LogEvent {
+	fields := map[string]string{
+		ffs.LogFieldMiner: de.Miner,
+		ffs.LogFieldError: de.Message,
+	}
+	if de.ProposalCid.Defined() {
+		fields[ffs.LogFieldProposalCid] = util.CidToString(de.ProposalCid)
+	}
+	return ffs.LogEvent{Level: ffs.LogLevelError, Type: typ, Fields: fields}
+}
+
+// dealStateEventType maps a non-final deal state to the most descriptive event type.
+func dealStateEventType(state uint64) ffs.LogEventType {
+	switch state {
+	case storagemarket.StorageDealProposalAccepted, storagemarket.StorageDealWaitingForData:
+		return ffs.EventDealAccepted
+	case storagemarket.StorageDealTransferring, storagemarket.StorageDealStartDataTransfer:
+		return ffs.EventTransferStarted
+	case storagemarket.StorageDealSealing:
+		return ffs.EventSealing
+	default:
+		return ffs.EventDealStateChanged
+	}
 }
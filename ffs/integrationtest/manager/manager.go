@@ -89,13 +89,13 @@ func NewCustomFFSManager(t require.TestingT, ds datastore.TxnDatastore, cb lotus
 	cl := filcold.New(ms, dm, nil, ipfsClient, fchain, l, lsm, minimumPieceSize, 1, time.Hour)
 	hl, err := coreipfs.New(ds, ipfsClient, l)
 	require.NoError(t, err)
-	sched, err := scheduler.New(txndstr.Wrap(ds, "ffs/scheduler"), l, hl, cl, 10, time.Minute*10, nil, scheduler.GCConfig{AutoGCInterval: 0})
+	sched, err := scheduler.New(txndstr.Wrap(ds, "ffs/scheduler"), l, hl, cl, scheduler.Config{MaxParallel: 10, DealFinalityTimeout: time.Minute * 10})
 	require.NoError(t, err)
 
 	wm, err := lotusWallet.New(cb, masterAddr, *big.NewInt(iWalletBal), false, "")
 	require.NoError(t, err)
 
-	manager, err := manager.New(ds, wm, dm, sched, false, true)
+	manager, err := manager.New(ds, wm, dm, sched, false, true, manager.MetricsConfig{})
 	require.NoError(t, err)
 	err = manager.SetDefaultStorageConfig(ffs.StorageConfig{
 		Hot: ffs.HotConfig{
@@ -125,6 +125,10 @@ func NewCustomFFSManager(t require.TestingT, ds datastore.TxnDatastore, cb lotus
 			t.Errorf("closing scheduler: %s", err)
 			t.FailNow()
 		}
+		if err := hl.Close(); err != nil {
+			t.Errorf("closing hot storage: %s", err)
+			t.FailNow()
+		}
 		if err := l.Close(); err != nil {
 			t.Errorf("closing joblogger: %s", err)
 			t.FailNow()
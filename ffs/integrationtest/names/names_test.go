@@ -0,0 +1,110 @@
+package names
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/api"
+	it "github.com/textileio/powergate/v2/ffs/integrationtest"
+	itmanager "github.com/textileio/powergate/v2/ffs/integrationtest/manager"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func TestMain(m *testing.M) {
+	util.AvgBlockTime = time.Millisecond * 500
+	logging.SetAllLoggers(logging.LevelError)
+	os.Exit(m.Run())
+}
+
+func TestSetName(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ipfs, _, fapi, cls := itmanager.NewAPI(t, 1, 300)
+	defer cls()
+
+	r := rand.New(rand.NewSource(22))
+	c1, _ := it.AddRandomFile(t, r, ipfs)
+
+	// Names can't point to unstored cids, and should be clean paths.
+	_, _, err := fapi.SetName(ctx, "docs/readme", c1)
+	require.Equal(t, api.ErrUnstoredCid, err)
+	_, _, err = fapi.SetName(ctx, "docs//readme", c1)
+	require.Equal(t, api.ErrInvalidName, err)
+
+	config := fapi.DefaultStorageConfig().WithColdEnabled(false)
+	jid, err := fapi.PushStorageConfig(c1, api.WithStorageConfig(config))
+	require.NoError(t, err)
+	it.RequireEventualJobState(t, fapi, jid, ffs.Success)
+
+	ref, jid, err := fapi.SetName(ctx, "docs/readme", c1, api.WithNameRetention(1))
+	require.NoError(t, err)
+	require.Equal(t, ffs.EmptyJobID, jid)
+	require.Equal(t, 1, ref.Version)
+	require.True(t, c1.Equals(ref.Cid))
+
+	// Updating the name stores c2 with the config of c1, and c1 is
+	// kept stored in the history.
+	c2, _ := it.AddRandomFile(t, r, ipfs)
+	ref, jid, err = fapi.SetName(ctx, "docs/readme", c2)
+	require.NoError(t, err)
+	it.RequireEventualJobState(t, fapi, jid, ffs.Success)
+	require.Equal(t, 2, ref.Version)
+	require.Len(t, ref.History, 1)
+	require.True(t, c1.Equals(ref.History[0].Cid))
+	it.RequireStorageConfig(t, fapi, c1, &config)
+	it.RequireStorageConfig(t, fapi, c2, &config)
+	it.RequireIpfsPinnedCid(ctx, t, c1, ipfs)
+	it.RequireIpfsPinnedCid(ctx, t, c2, ipfs)
+
+	// Named cids, and their history, can't be removed.
+	require.Equal(t, api.ErrNamedCid, fapi.Remove(c1))
+	require.Equal(t, api.ErrNamedCid, fapi.Remove(c2))
+
+	// History is trimmed to the retention, and the storage of
+	// trimmed versions is removed.
+	c3, _ := it.AddRandomFile(t, r, ipfs)
+	ref, jid, err = fapi.SetName(ctx, "docs/readme", c3)
+	require.NoError(t, err)
+	it.RequireEventualJobState(t, fapi, jid, ffs.Success)
+	require.Equal(t, 3, ref.Version)
+	require.Len(t, ref.History, 1)
+	require.True(t, c2.Equals(ref.History[0].Cid))
+	_, err = fapi.GetStorageConfigs(c1)
+	require.Equal(t, api.ErrNotFound, err)
+	require.Eventually(t, func() bool {
+		_, pinned, err := ipfs.Pin().IsPinned(ctx, path.IpfsPath(c1))
+		return err == nil && !pinned
+	}, time.Minute, time.Second)
+	it.RequireStorageConfig(t, fapi, c2, &config)
+
+	// A cid referenced by other names is kept when updating.
+	_, _, err = fapi.SetName(ctx, "docs/latest", c3)
+	require.NoError(t, err)
+	c4, _ := it.AddRandomFile(t, r, ipfs)
+	_, jid, err = fapi.SetName(ctx, "docs/readme", c4)
+	require.NoError(t, err)
+	it.RequireEventualJobState(t, fapi, jid, ffs.Success)
+	it.RequireStorageConfig(t, fapi, c3, &config)
+	it.RequireStorageConfig(t, fapi, c4, &config)
+
+	refs, err := fapi.ListNames("docs/")
+	require.NoError(t, err)
+	require.Len(t, refs, 2)
+	require.Equal(t, "docs/latest", refs[0].Name)
+	require.Equal(t, "docs/readme", refs[1].Name)
+	refs, err = fapi.ListNames("other")
+	require.NoError(t, err)
+	require.Len(t, refs, 0)
+
+	require.NoError(t, fapi.RemoveName("docs/latest"))
+	_, err = fapi.GetName("docs/latest")
+	require.Equal(t, api.ErrNotFound, err)
+	it.RequireStorageConfig(t, fapi, c3, &config)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/filecoin-project/go-state-types/abi"
 	"github.com/filecoin-project/lotus/api"
 	"github.com/ipfs/go-cid"
+	"github.com/ipfs/interface-go-ipfs-core/options"
 	"github.com/textileio/powergate/v2/deals"
 )
 
@@ -39,18 +40,27 @@ type DealRecordsManager interface {
 
 // HotStorage is a fast storage layer for Cid data.
 type HotStorage interface {
-	// Stage adds io.Reader and stage-pins it.
-	Stage(context.Context, APIID, io.Reader) (cid.Cid, error)
+	// Stage adds io.Reader with the provided UnixFS import options and
+	// stage-pins it.
+	Stage(context.Context, APIID, io.Reader, ...options.UnixfsAddOption) (cid.Cid, error)
 
 	// StageCid pulls Cid data and stage-pin it.
 	StageCid(context.Context, APIID, cid.Cid) error
 
+	// StageCar imports the blocks of a CAR file and stage-pins
+	// its roots, which are returned. The CAR file should include
+	// the whole DAG of every root.
+	StageCar(context.Context, APIID, io.Reader) ([]cid.Cid, error)
+
 	// Unpin unpins a Cid.
 	Unpin(context.Context, APIID, cid.Cid) error
 
 	// Get retrieves a stored Cid data.
 	Get(context.Context, cid.Cid) (io.Reader, error)
 
+	// GetCar writes a CAR file of the DAG of a stored Cid.
+	GetCar(context.Context, cid.Cid, io.Writer, CarOptions) error
+
 	// Pin pins a Cid. If the data wasn't previously Added,
 	// depending on the implementation it may use internal mechanisms
 	// for pulling the data, e.g: IPFS network
@@ -64,12 +74,35 @@ type HotStorage interface {
 	// otherwise.
 	IsPinned(context.Context, APIID, cid.Cid) (bool, error)
 
+	// SetCache configures if a pinned Cid is cached, and the amount of
+	// seconds without accesses after which it can be evicted.
+	SetCache(context.Context, APIID, cid.Cid, bool, int64) error
+
+	// Touch registers an access to a pinned Cid.
+	Touch(context.Context, APIID, cid.Cid) error
+
 	// GCStaged unpins Cids that are stage-pinned that aren't
 	// contained in a exclude list, and were pinned before a time.
 	GCStaged(context.Context, []cid.Cid, time.Time) ([]cid.Cid, error)
 
 	// PinnedCids returns pinned cids information.
 	PinnedCids(context.Context) ([]PinnedCid, error)
+
+	// Reconcile reconciles the pinset with the pins of the underlying
+	// storage.
+	Reconcile(context.Context, HotReconcileOptions) (HotReconciliation, error)
+
+	// Verify walks the DAG of a stored Cid validating the data
+	// of every block against its Cid.
+	Verify(context.Context, cid.Cid) (HotVerification, error)
+
+	// Repair removes the provided invalid blocks of a stored Cid, and
+	// pins it again fetching missing blocks from the IPFS network.
+	Repair(context.Context, cid.Cid, []cid.Cid) error
+
+	// Publish publishes a stored Cid under an IPNS key, identified
+	// by its name, and returns the IPNS name.
+	Publish(context.Context, string, cid.Cid) (string, error)
 }
 
 // DealError contains information about a failed deal.
@@ -85,6 +118,18 @@ func (de DealError) Error() string {
 	return de.Message
 }
 
+// CorruptDataError is returned by ColdStorage.Fetch if a retrieval
+// completed, but the retrieved data doesn't match the requested Cid.
+type CorruptDataError struct {
+	Message string
+}
+
+// Error returns an stringified message of the
+// underlying error cause.
+func (cde CorruptDataError) Error() string {
+	return cde.Message
+}
+
 // FetchInfo describes information about a Filecoin retrieval.
 type FetchInfo struct {
 	RetrievedMiner string
@@ -101,9 +146,11 @@ var (
 // native support for Filecoin storage.
 type ColdStorage interface {
 	// Store stores a Cid using the provided configuration and
-	// account address. It returns a slice of accepted proposed deals,
-	// a slice of rejected proposal deals, and the size of the data.
-	Store(context.Context, cid.Cid, FilConfig) ([]cid.Cid, []DealError, abi.PaddedPieceSize, error)
+	// account address. The provided miners are the ones already storing
+	// the data, which are considered when evaluating diversity constraints.
+	// It returns a slice of accepted proposed deals, a slice of rejected
+	// proposal deals, and the size of the data.
+	Store(context.Context, cid.Cid, FilConfig, []string) ([]cid.Cid, []DealError, abi.PaddedPieceSize, error)
 
 	// WaitForDeal blocks the provided Deal Proposal reach a
 	// final state. If the deal finishes successfully it returns a FilStorage
@@ -111,7 +158,10 @@ type ColdStorage interface {
 	// error result, so it should be considered in error handling.
 	WaitForDeal(context.Context, cid.Cid, cid.Cid, time.Duration, chan deals.StorageDealInfo) (FilStorage, error)
 
-	// Fetch fetches the cid data in the underlying storage.
+	// Fetch fetches the cid data in the underlying storage. If the selector
+	// isn't empty, only the blocks matched by the dag-json encoded selector
+	// are fetched. If the retrieved data doesn't match the cid, it returns a
+	// CorruptDataError.
 	Fetch(context.Context, cid.Cid, *cid.Cid, string, []string, uint64, string) (FetchInfo, error)
 
 	// EnsureRenewals executes renewal logic for a Cid under a particular
@@ -122,6 +172,16 @@ type ColdStorage interface {
 	// If a deal ID doesn't exist, the deal isn't active anymore, or was
 	// slashed, then ErrOnChainDealNotFound is returned.
 	GetDealInfo(context.Context, uint64) (api.MarketDeal, error)
+
+	// GetHeight returns the current chain height.
+	GetHeight(context.Context) (uint64, error)
+}
+
+// KeyStore provides the keys used to encrypt data of APIIDs.
+type KeyStore interface {
+	// Key returns the encryption key of an APIID, which
+	// is created if it doesn't exist.
+	Key(APIID) ([]byte, error)
 }
 
 // MinerSelector returns miner addresses and ask storage information using a
@@ -153,6 +213,25 @@ type MinerSelectorFilter struct {
 	PieceSize uint64
 	// VerifiedDeal indicates it should take verified storage prices.
 	VerifiedDeal bool
+	// Diversity contains constraints on how selected miners should be
+	// spread. Not every MinerSelector implementation enforces them.
+	Diversity FilDiversity
+	// CurrentMiners contains miners already storing the data. They won't be
+	// returned, but are considered when evaluating Diversity constraints.
+	CurrentMiners []string
+	// APIID is the user on whose behalf miners are selected. It might be
+	// empty if unknown.
+	APIID APIID
+	// OnExclusion, if not nil, is called with candidate miners which
+	// weren't selected and the reason why. Not every MinerSelector
+	// implementation reports exclusions.
+	OnExclusion func(MinerExclusion)
+}
+
+// MinerExclusion describes why a candidate miner wasn't selected.
+type MinerExclusion struct {
+	Miner  string
+	Reason string
 }
 
 // MinerProposal contains a miners address and storage ask information
@@ -170,7 +249,62 @@ type PinnedCid struct {
 
 // APIIDPinnedCid has information about a Cid pinned by a user.
 type APIIDPinnedCid struct {
-	ID        APIID
-	Staged    bool
-	CreatedAt int64
+	ID             APIID
+	Staged         bool
+	CreatedAt      int64
+	Cached         bool
+	CacheTTL       int64
+	LastAccessedAt int64
+}
+
+// CarOptions configures the CAR file of a DAG.
+type CarOptions struct {
+	// Version is the CAR format version, 1 or 2. Zero means 1.
+	Version int
+	// Selector is a dag-json encoded IPLD selector which restricts
+	// the blocks of the DAG written to the CAR file. Empty means
+	// the whole DAG.
+	Selector string
+}
+
+// HotReconcileOptions configures a reconciliation of the Hot Storage
+// pinset with the pins of the underlying storage.
+type HotReconcileOptions struct {
+	// DryRun only reports differences without fixing them.
+	DryRun bool
+	// RepinTimeout bounds re-pinning each missing Cid from the network.
+	// Zero skips re-pinning from the network.
+	RepinTimeout time.Duration
+	// UnpinUnknown unpins Cids pinned in the underlying storage which
+	// aren't in the pinset.
+	UnpinUnknown bool
+}
+
+// HotReconciliation is the result of reconciling the Hot Storage pinset
+// with the pins of the underlying storage.
+type HotReconciliation struct {
+	// Missing are Cids in the pinset which aren't pinned in the
+	// underlying storage.
+	Missing []cid.Cid
+	// Repinned are Missing Cids which were re-pinned from the network.
+	Repinned []cid.Cid
+	// Lost are Missing Cids which couldn't be re-pinned, and were
+	// removed from the pinset.
+	Lost []PinnedCid
+	// Unknown are Cids pinned in the underlying storage which aren't
+	// in the pinset.
+	Unknown []cid.Cid
+	// Unpinned are Unknown Cids which were unpinned.
+	Unpinned []cid.Cid
+	// Unfreezes are Jobs scheduled to unfreeze Lost Cids from Cold
+	// Storage.
+	Unfreezes []HotUnfreeze
+}
+
+// HotUnfreeze is a Job scheduled to unfreeze a Cid lost from
+// Hot Storage.
+type HotUnfreeze struct {
+	APIID APIID
+	Cid   cid.Cid
+	JobID JobID
 }
@@ -23,11 +23,16 @@ var (
 
 // Logger is a datastore backed implementation of ffs.Logger.
 type Logger struct {
-	ds datastore.Datastore
+	ds  datastore.Datastore
+	cfg config
 
 	lock     sync.Mutex
 	watchers []chan<- ffs.LogEntry
 	closed   bool
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	finished chan struct{}
 }
 
 type logEntry struct {
@@ -36,21 +41,54 @@ type logEntry struct {
 	Timestamp   int64
 	Jid         ffs.JobID
 	Msg         string
+	Level       ffs.LogLevel      `json:",omitempty"`
+	Type        ffs.LogEventType  `json:",omitempty"`
+	Fields      map[string]string `json:",omitempty"`
 }
 
 var _ ffs.JobLogger = (*Logger)(nil)
 
-// New returns a new CidLogger.
-func New(ds datastore.Datastore) *Logger {
-	return &Logger{
-		ds: ds,
+// New returns a new CidLogger. If a retention policy is configured, a
+// background worker periodically compacts stored log entries.
+func New(ds datastore.Datastore, opts ...Option) *Logger {
+	cfg := defaultConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cl := &Logger{
+		ds:       ds,
+		cfg:      cfg,
+		ctx:      ctx,
+		cancel:   cancel,
+		finished: make(chan struct{}),
+	}
+	if cfg.hasRetention() && cfg.compactionInterval > 0 {
+		go cl.compactionDaemon()
+	} else {
+		close(cl.finished)
 	}
+	return cl
 }
 
-// Log logs a log entry for a Cid. The ctx can contain an optional ffs.CtxKeyJid to add
-// additional metadata about the log entry being part of a Job execution.
+// Log logs an informational log entry for a Cid. The ctx can contain an optional
+// ffs.CtxKeyJid to add additional metadata about the log entry being part of a Job execution.
 func (cl *Logger) Log(ctx context.Context, format string, a ...interface{}) {
-	log.Infof(format, a...)
+	cl.LogEvent(ctx, ffs.LogEvent{}, format, a...)
+}
+
+// LogEvent logs a log entry for a Cid, including the level, event type and fields
+// of ev besides the human readable message. The ctx is used in the same way as in Log.
+func (cl *Logger) LogEvent(ctx context.Context, ev ffs.LogEvent, format string, a ...interface{}) {
+	msg := fmt.Sprintf(format, a...)
+	switch ev.Level {
+	case ffs.LogLevelWarn:
+		log.Warn(msg)
+	case ffs.LogLevelError:
+		log.Error(msg)
+	default:
+		log.Info(msg)
+	}
 
 	// Retrieve context values.
 	c, _ := ctx.Value(ffs.CtxStorageCid).(cid.Cid)
@@ -65,8 +103,11 @@ func (cl *Logger) Log(ctx context.Context, format string, a ...interface{}) {
 		Cid:         c,
 		RetrievalID: rid,
 		Jid:         jid,
-		Msg:         fmt.Sprintf(format, a...),
+		Msg:         msg,
 		Timestamp:   nowNano,
+		Level:       ev.Level,
+		Type:        ev.Type,
+		Fields:      ev.Fields,
 	}
 	b, err := json.Marshal(le)
 	if err != nil {
@@ -79,11 +120,15 @@ func (cl *Logger) Log(ctx context.Context, format string, a ...interface{}) {
 	}
 
 	entry := ffs.LogEntry{
-		APIID:     iid,
-		Cid:       le.Cid,
-		Jid:       le.Jid,
-		Timestamp: now,
-		Msg:       fmt.Sprintf(format, a...),
+		APIID:       iid,
+		Cid:         le.Cid,
+		RetrievalID: le.RetrievalID,
+		Jid:         le.Jid,
+		Timestamp:   now,
+		Msg:         msg,
+		Level:       le.Level,
+		Type:        le.Type,
+		Fields:      le.Fields,
 	}
 	cl.lock.Lock()
 	defer cl.lock.Unlock()
@@ -117,12 +162,7 @@ func (cl *Logger) GetByCid(ctx context.Context, iid ffs.APIID, c cid.Cid) ([]ffs
 		if err := json.Unmarshal(r.Value, &le); err != nil {
 			return nil, fmt.Errorf("unmarshaling log entry: %s", err)
 		}
-		lgs = append(lgs, ffs.LogEntry{
-			Cid:       le.Cid,
-			Jid:       le.Jid,
-			Msg:       le.Msg,
-			Timestamp: time.Unix(0, le.Timestamp),
-		})
+		lgs = append(lgs, le.toLogEntry(iid))
 	}
 	sort.Slice(lgs, func(a, b int) bool {
 		return lgs[a].Timestamp.Before(lgs[b].Timestamp)
@@ -172,12 +212,28 @@ func (cl *Logger) Close() error {
 		return nil
 	}
 	cl.closed = true
+	cl.cancel()
+	<-cl.finished
 	for _, w := range cl.watchers {
 		close(w)
 	}
 	return nil
 }
 
+func (le logEntry) toLogEntry(iid ffs.APIID) ffs.LogEntry {
+	return ffs.LogEntry{
+		APIID:       iid,
+		Cid:         le.Cid,
+		RetrievalID: le.RetrievalID,
+		Jid:         le.Jid,
+		Msg:         le.Msg,
+		Timestamp:   time.Unix(0, le.Timestamp),
+		Level:       le.Level,
+		Type:        le.Type,
+		Fields:      le.Fields,
+	}
+}
+
 func makeKey(iid ffs.APIID, c cid.Cid, rid ffs.RetrievalID, timestamp int64) datastore.Key {
 	if !iid.Valid() {
 		panic("iid can't be empty")
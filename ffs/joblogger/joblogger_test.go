@@ -0,0 +1,206 @@
+package joblogger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/tests"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func TestLogEvent(t *testing.T) {
+	t.Parallel()
+	l := New(tests.NewTxnDatastore(t))
+	iid := ffs.NewAPIID()
+	c, _ := util.CidFromString("QmPewMLNYZS5ehyCRWnYpGMMvw5TJhLkHFkhYTNJBjnQZp")
+	ctx := context.WithValue(context.Background(), ffs.CtxAPIID, iid)
+	ctx = context.WithValue(ctx, ffs.CtxStorageCid, c)
+	ctx = context.WithValue(ctx, ffs.CtxKeyJid, ffs.JobID("job1"))
+
+	ch := make(chan ffs.LogEntry, 2)
+	wctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = l.Watch(wctx, ch) }()
+	time.Sleep(100 * time.Millisecond)
+
+	l.Log(ctx, "plain message %d", 1)
+	time.Sleep(time.Millisecond)
+	ev := ffs.LogEvent{
+		Level:  ffs.LogLevelError,
+		Type:   ffs.EventDealFailed,
+		Fields: map[string]string{ffs.LogFieldMiner: "f01000"},
+	}
+	l.LogEvent(ctx, ev, "deal with %s failed", "f01000")
+
+	watched := []ffs.LogEntry{<-ch, <-ch}
+	lgs, err := l.GetByCid(ctx, iid, c)
+	require.NoError(t, err)
+	require.Len(t, lgs, 2)
+	for _, entries := range [][]ffs.LogEntry{watched, lgs} {
+		require.Equal(t, "plain message 1", entries[0].Msg)
+		require.Equal(t, ffs.LogLevelInfo, entries[0].Level)
+		require.Equal(t, ffs.EventUnspecified, entries[0].Type)
+		require.Empty(t, entries[0].Fields)
+
+		require.Equal(t, "deal with f01000 failed", entries[1].Msg)
+		require.Equal(t, ffs.JobID("job1"), entries[1].Jid)
+		require.Equal(t, ffs.LogLevelError, entries[1].Level)
+		require.Equal(t, ffs.EventDealFailed, entries[1].Type)
+		require.Equal(t, "f01000", entries[1].Fields[ffs.LogFieldMiner])
+	}
+}
+
+func TestLegacyEntries(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxnDatastore(t)
+	l := New(ds)
+	iid := ffs.NewAPIID()
+	c, _ := util.CidFromString("QmPewMLNYZS5ehyCRWnYpGMMvw5TJhLkHFkhYTNJBjnQZp")
+
+	// Entries saved before levels and event types existed.
+	legacy := struct {
+		Cid       cid.Cid
+		Timestamp int64
+		Jid       ffs.JobID
+		Msg       string
+	}{Cid: c, Timestamp: time.Now().UnixNano(), Jid: "job1", Msg: "old message"}
+	b, err := json.Marshal(legacy)
+	require.NoError(t, err)
+	key := makeKey(iid, c, ffs.EmptyRetrievalID, legacy.Timestamp)
+	require.NoError(t, ds.Put(key, b))
+
+	lgs, err := l.GetByCid(context.Background(), iid, c)
+	require.NoError(t, err)
+	require.Len(t, lgs, 1)
+	require.Equal(t, "old message", lgs[0].Msg)
+	require.Equal(t, ffs.LogLevelInfo, lgs[0].Level)
+	require.Equal(t, ffs.EventUnspecified, lgs[0].Type)
+}
+
+func TestCompact(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	c1, _ := util.CidFromString("QmPewMLNYZS5ehyCRWnYpGMMvw5TJhLkHFkhYTNJBjnQZp")
+	c2, _ := util.CidFromString("QmVZW8Sz9hHmJTEnMRXmwNohN9Qd6gHvGSvbBUWUtCzoHk")
+
+	cases := []struct {
+		name       string
+		opts       []Option
+		expectedC1 int
+		expectedC2 int
+	}{
+		{name: "no retention", expectedC1: 5, expectedC2: 2},
+		{name: "max age", opts: []Option{WithMaxAge(150 * time.Minute)}, expectedC1: 3, expectedC2: 2},
+		{name: "max entries", opts: []Option{WithMaxEntriesPerCid(2)}, expectedC1: 2, expectedC2: 2},
+		{name: "both", opts: []Option{WithMaxAge(30 * time.Minute), WithMaxEntriesPerCid(3)}, expectedC1: 1, expectedC2: 1},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ds := tests.NewTxnDatastore(t)
+			l := New(ds, append(tt.opts, WithCompactionInterval(0))...)
+			defer func() { require.NoError(t, l.Close()) }()
+			iid := ffs.NewAPIID()
+			for i := 0; i < 5; i++ {
+				putEntry(t, ds, iid, c1, now.Add(-time.Duration(i)*time.Hour), fmt.Sprintf("c1 %d", i))
+			}
+			for i := 0; i < 2; i++ {
+				putEntry(t, ds, iid, c2, now.Add(-time.Duration(i)*time.Hour), fmt.Sprintf("c2 %d", i))
+			}
+
+			pruned, err := l.Compact(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, 7-tt.expectedC1-tt.expectedC2, pruned)
+
+			lgs, err := l.GetByCid(context.Background(), iid, c1)
+			require.NoError(t, err)
+			require.Len(t, lgs, tt.expectedC1)
+			// Most recent entries are kept.
+			require.Equal(t, "c1 0", lgs[len(lgs)-1].Msg)
+			lgs, err = l.GetByCid(context.Background(), iid, c2)
+			require.NoError(t, err)
+			require.Len(t, lgs, tt.expectedC2)
+		})
+	}
+}
+
+func TestCompactFailure(t *testing.T) {
+	t.Parallel()
+	ds := &failingDeleteDatastore{MapDatastore: datastore.NewMapDatastore(), allowed: 2}
+	l := New(ds, WithMaxEntriesPerCid(1), WithCompactionInterval(0))
+	defer func() { require.NoError(t, l.Close()) }()
+	iid := ffs.NewAPIID()
+	c, _ := util.CidFromString("QmPewMLNYZS5ehyCRWnYpGMMvw5TJhLkHFkhYTNJBjnQZp")
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		putEntry(t, ds, iid, c, now.Add(-time.Duration(i)*time.Hour), fmt.Sprintf("c %d", i))
+	}
+
+	// Entries deleted before the failure are reported.
+	pruned, err := l.Compact(context.Background())
+	require.Error(t, err)
+	require.Equal(t, 2, pruned)
+	lgs, err := l.GetByCid(context.Background(), iid, c)
+	require.NoError(t, err)
+	require.Len(t, lgs, 3)
+}
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxnDatastore(t)
+	l := New(ds)
+	defer func() { require.NoError(t, l.Close()) }()
+	now := time.Now()
+	c, _ := util.CidFromString("QmPewMLNYZS5ehyCRWnYpGMMvw5TJhLkHFkhYTNJBjnQZp")
+	iid1 := ffs.NewAPIID()
+	iid2 := ffs.NewAPIID()
+	for i := 0; i < 4; i++ {
+		putEntry(t, ds, iid1, c, now.Add(-time.Duration(i)*time.Hour), fmt.Sprintf("iid1 %d", i))
+	}
+	putEntry(t, ds, iid2, c, now, "iid2 0")
+
+	export := func(iid ffs.APIID, from, to time.Time) []string {
+		var msgs []string
+		err := l.Export(context.Background(), iid, from, to, func(le ffs.LogEntry) error {
+			require.True(t, iid == ffs.EmptyInstanceID || le.APIID == iid)
+			msgs = append(msgs, le.Msg)
+			return nil
+		})
+		require.NoError(t, err)
+		return msgs
+	}
+
+	require.Equal(t, []string{"iid1 3", "iid1 2", "iid1 1", "iid1 0"}, export(iid1, time.Time{}, time.Time{}))
+	require.Equal(t, []string{"iid1 2", "iid1 1"}, export(iid1, now.Add(-150*time.Minute), now.Add(-30*time.Minute)))
+	require.Equal(t, []string{"iid1 0"}, export(iid1, now.Add(-time.Minute), time.Time{}))
+	require.Len(t, export(ffs.EmptyInstanceID, time.Time{}, time.Time{}), 5)
+}
+
+// failingDeleteDatastore is a datastore without transactions which fails
+// deleting keys after allowed deletions.
+type failingDeleteDatastore struct {
+	*datastore.MapDatastore
+	allowed int
+}
+
+func (ds *failingDeleteDatastore) Delete(k datastore.Key) error {
+	if ds.allowed == 0 {
+		return fmt.Errorf("delete failed")
+	}
+	ds.allowed--
+	return ds.MapDatastore.Delete(k)
+}
+
+func putEntry(t *testing.T, ds datastore.Datastore, iid ffs.APIID, c cid.Cid, ts time.Time, msg string) {
+	b, err := json.Marshal(logEntry{Cid: c, Timestamp: ts.UnixNano(), Msg: msg})
+	require.NoError(t, err)
+	require.NoError(t, ds.Put(makeKey(iid, c, ffs.EmptyRetrievalID, ts.UnixNano()), b))
+}
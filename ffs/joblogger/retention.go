@@ -0,0 +1,272 @@
+package joblogger
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+const (
+	// deleteBatchSize is the maximum number of log entries deleted
+	// in a single transaction by compaction.
+	deleteBatchSize = 1000
+)
+
+var defaultConfig = config{
+	compactionInterval: time.Hour,
+}
+
+type config struct {
+	maxAge             time.Duration
+	maxEntriesPerCid   int
+	compactionInterval time.Duration
+}
+
+func (c config) hasRetention() bool {
+	return c.maxAge > 0 || c.maxEntriesPerCid > 0
+}
+
+// Option configures a Logger.
+type Option func(*config)
+
+// WithMaxAge configures the Logger to prune log entries older
+// than maxAge. Zero means entries never expire by age.
+func WithMaxAge(maxAge time.Duration) Option {
+	return func(c *config) {
+		c.maxAge = maxAge
+	}
+}
+
+// WithMaxEntriesPerCid configures the Logger to keep only the most
+// recent max log entries of each Cid or retrieval. Zero means no limit.
+func WithMaxEntriesPerCid(max int) Option {
+	return func(c *config) {
+		c.maxEntriesPerCid = max
+	}
+}
+
+// WithCompactionInterval configures how often the retention policy
+// is enforced. Zero disables the background compaction.
+func WithCompactionInterval(interval time.Duration) Option {
+	return func(c *config) {
+		c.compactionInterval = interval
+	}
+}
+
+// storedKey is a datastore key of a log entry with its parsed
+// components.
+type storedKey struct {
+	key       datastore.Key
+	iid       ffs.APIID
+	timestamp int64
+}
+
+func (cl *Logger) compactionDaemon() {
+	defer close(cl.finished)
+	for {
+		select {
+		case <-cl.ctx.Done():
+			return
+		case <-time.After(cl.cfg.compactionInterval):
+			pruned, err := cl.Compact(cl.ctx)
+			if err != nil {
+				log.Errorf("compacting job logs, pruned %d entries: %s", pruned, err)
+				continue
+			}
+			if pruned > 0 {
+				log.Infof("compaction pruned %d job log entries", pruned)
+			}
+		}
+	}
+}
+
+// Compact enforces the configured retention policy, deleting log entries older
+// than the max age and the oldest entries of each Cid or retrieval exceeding the
+// max number of entries. It returns the number of deleted entries, which
+// were already deleted if an error is returned too.
+func (cl *Logger) Compact(ctx context.Context) (int, error) {
+	if !cl.cfg.hasRetention() {
+		return 0, nil
+	}
+
+	var minTimestamp int64
+	if cl.cfg.maxAge > 0 {
+		minTimestamp = time.Now().Add(-cl.cfg.maxAge).UnixNano()
+	}
+	bd := newBatchDeleter(cl.ds)
+	// Keys are iterated in order, so only entries of a single Cid or
+	// retrieval are kept in memory.
+	var group []storedKey
+	pruneGroup := func() error {
+		sort.Slice(group, func(a, b int) bool {
+			return group[a].timestamp > group[b].timestamp
+		})
+		for i, k := range group {
+			expired := k.timestamp < minTimestamp
+			exceeded := cl.cfg.maxEntriesPerCid > 0 && i >= cl.cfg.maxEntriesPerCid
+			if !expired && !exceeded {
+				continue
+			}
+			if err := bd.delete(k.key); err != nil {
+				return fmt.Errorf("deleting log entry %s: %s", k.key, err)
+			}
+		}
+		group = group[:0]
+		return nil
+	}
+	err := cl.iterate(ffs.EmptyInstanceID, true, func(k storedKey, _ []byte) error {
+		if ctx.Err() != nil {
+			return fmt.Errorf("compaction canceled: %s", ctx.Err())
+		}
+		if len(group) > 0 && !group[0].key.Parent().Equal(k.key.Parent()) {
+			if err := pruneGroup(); err != nil {
+				return err
+			}
+		}
+		group = append(group, k)
+		return nil
+	})
+	if err == nil {
+		err = pruneGroup()
+	}
+	if err != nil {
+		bd.discard()
+		return bd.deleted, err
+	}
+	if err := bd.flush(); err != nil {
+		return bd.deleted, fmt.Errorf("deleting log entries: %s", err)
+	}
+	return bd.deleted, nil
+}
+
+// Export calls fn with every log entry of iid created in the [from, to]
+// time range. Entries are ordered by instance and Cid or retrieval, and by
+// creation time within each of them. If iid is empty, log entries of all
+// instances are exported. Zero from or to values mean unbounded ranges.
+func (cl *Logger) Export(ctx context.Context, iid ffs.APIID, from, to time.Time, fn func(ffs.LogEntry) error) error {
+	var fromNano, toNano int64
+	if !from.IsZero() {
+		fromNano = from.UnixNano()
+	}
+	if !to.IsZero() {
+		toNano = to.UnixNano()
+	}
+	return cl.iterate(iid, false, func(k storedKey, value []byte) error {
+		if ctx.Err() != nil {
+			return fmt.Errorf("export canceled: %s", ctx.Err())
+		}
+		if k.timestamp < fromNano || (toNano != 0 && k.timestamp > toNano) {
+			return nil
+		}
+		var le logEntry
+		if err := json.Unmarshal(value, &le); err != nil {
+			return fmt.Errorf("unmarshaling log entry: %s", err)
+		}
+		return fn(le.toLogEntry(k.iid))
+	})
+}
+
+// iterate calls fn with the log entries of iid, or of every instance if iid
+// is empty, ordered by key. If keysOnly is true, values aren't read.
+func (cl *Logger) iterate(iid ffs.APIID, keysOnly bool, fn func(storedKey, []byte) error) error {
+	q := query.Query{KeysOnly: keysOnly, Orders: []query.Order{query.OrderByKey{}}}
+	if iid != ffs.EmptyInstanceID {
+		q.Prefix = datastore.NewKey(iid.String()).String()
+	}
+	res, err := cl.ds.Query(q)
+	if err != nil {
+		return fmt.Errorf("running query: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+	for r := range res.Next() {
+		if r.Error != nil {
+			return fmt.Errorf("iter next: %s", r.Error)
+		}
+		k := datastore.NewKey(r.Key)
+		nss := k.Namespaces()
+		if len(nss) != 3 {
+			log.Warnf("ignoring unexpected job log key %s", k)
+			continue
+		}
+		ts, err := strconv.ParseInt(nss[2], 10, 64)
+		if err != nil {
+			log.Warnf("ignoring job log key %s with invalid timestamp: %s", k, err)
+			continue
+		}
+		if err := fn(storedKey{key: k, iid: ffs.APIID(nss[0]), timestamp: ts}, r.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// batchDeleter deletes keys in transactions of up to deleteBatchSize keys
+// if the datastore supports transactions, or one by one otherwise.
+type batchDeleter struct {
+	ds      datastore.Datastore
+	txn     datastore.Txn
+	pending int
+	deleted int
+}
+
+func newBatchDeleter(ds datastore.Datastore) *batchDeleter {
+	return &batchDeleter{ds: ds}
+}
+
+func (bd *batchDeleter) delete(k datastore.Key) error {
+	tds, ok := bd.ds.(datastore.TxnDatastore)
+	if !ok {
+		if err := bd.ds.Delete(k); err != nil {
+			return err
+		}
+		bd.deleted++
+		return nil
+	}
+	if bd.txn == nil {
+		txn, err := tds.NewTransaction(false)
+		if err != nil {
+			return fmt.Errorf("creating transaction: %s", err)
+		}
+		bd.txn = txn
+	}
+	if err := bd.txn.Delete(k); err != nil {
+		return err
+	}
+	bd.pending++
+	if bd.pending == deleteBatchSize {
+		return bd.flush()
+	}
+	return nil
+}
+
+func (bd *batchDeleter) flush() error {
+	if bd.txn == nil {
+		return nil
+	}
+	txn, pending := bd.txn, bd.pending
+	bd.txn, bd.pending = nil, 0
+	if err := txn.Commit(); err != nil {
+		txn.Discard()
+		return fmt.Errorf("committing transaction: %s", err)
+	}
+	bd.deleted += pending
+	return nil
+}
+
+func (bd *batchDeleter) discard() {
+	if bd.txn != nil {
+		bd.txn.Discard()
+		bd.txn, bd.pending = nil, 0
+	}
+}
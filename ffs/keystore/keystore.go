@@ -0,0 +1,72 @@
+package keystore
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+const (
+	// keySize is the size of created keys, which allows
+	// using them for AES-256.
+	keySize = 32
+)
+
+// FileKeyStore is an implementation of ffs.KeyStore which saves
+// each APIID key in a file of a local folder.
+type FileKeyStore struct {
+	lock sync.Mutex
+	path string
+}
+
+var _ ffs.KeyStore = (*FileKeyStore)(nil)
+
+// New returns a new FileKeyStore which saves keys in path.
+func New(path string) (*FileKeyStore, error) {
+	if err := os.MkdirAll(path, 0700); err != nil {
+		return nil, fmt.Errorf("creating keystore folder: %s", err)
+	}
+	return &FileKeyStore{path: path}, nil
+}
+
+// Key returns the key of an APIID, which is created if it doesn't exist.
+func (ks *FileKeyStore) Key(iid ffs.APIID) ([]byte, error) {
+	if !iid.Valid() {
+		return nil, fmt.Errorf("invalid API ID")
+	}
+
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+
+	p := filepath.Join(ks.path, iid.String()+".key")
+	key, err := ioutil.ReadFile(p)
+	if err == nil {
+		if len(key) != keySize {
+			return nil, fmt.Errorf("key file has an invalid size %d", len(key))
+		}
+		return key, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("reading key file: %s", err)
+	}
+
+	key = make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generating key: %s", err)
+	}
+	// Write to a temporary file first, so a crash can't
+	// leave a truncated key.
+	tmp := p + ".tmp"
+	if err := ioutil.WriteFile(tmp, key, 0600); err != nil {
+		return nil, fmt.Errorf("writing key file: %s", err)
+	}
+	if err := os.Rename(tmp, p); err != nil {
+		return nil, fmt.Errorf("renaming key file: %s", err)
+	}
+	return key, nil
+}
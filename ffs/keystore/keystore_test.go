@@ -0,0 +1,37 @@
+package keystore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+func TestKey(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	ks, err := New(dir)
+	require.NoError(t, err)
+
+	iid1 := ffs.NewAPIID()
+	k1, err := ks.Key(iid1)
+	require.NoError(t, err)
+	require.Len(t, k1, keySize)
+	k1Again, err := ks.Key(iid1)
+	require.NoError(t, err)
+	require.Equal(t, k1, k1Again)
+
+	k2, err := ks.Key(ffs.NewAPIID())
+	require.NoError(t, err)
+	require.NotEqual(t, k1, k2)
+
+	_, err = ks.Key(ffs.EmptyInstanceID)
+	require.Error(t, err)
+
+	// Keys are persisted.
+	ks, err = New(dir)
+	require.NoError(t, err)
+	k1Again, err = ks.Key(iid1)
+	require.NoError(t, err)
+	require.Equal(t, k1, k1Again)
+}
@@ -8,8 +8,8 @@ import (
 	"sync"
 
 	"github.com/filecoin-project/go-address"
+	"github.com/ipfs/go-cid"
 	"github.com/ipfs/go-datastore"
-	"github.com/ipfs/go-datastore/namespace"
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/ffs/api"
@@ -22,6 +22,8 @@ import (
 var (
 	// ErrAuthTokenNotFound returns when an auth-token doesn't exist.
 	ErrAuthTokenNotFound = errors.New("auth token not found")
+	// ErrAPIIDNotFound returns when an APIID doesn't exist.
+	ErrAPIIDNotFound = errors.New("api id not found")
 
 	log = logging.Logger("ffs-manager")
 
@@ -65,6 +67,7 @@ var (
 		},
 	}
 	dsDefaultStorageConfigKey = datastore.NewKey("defaultstorageconfig")
+	dsBaseMinerSelectorParams = datastore.NewKey("minerselectorparams")
 )
 
 // Manager creates Api instances, or loads existing ones them from an auth-token.
@@ -74,17 +77,25 @@ type Manager struct {
 	sched *scheduler.Scheduler
 
 	lock             sync.Mutex
-	ds               datastore.Datastore
+	ds               datastore.TxnDatastore
 	auth             *auth.Auth
 	instances        map[ffs.APIID]*api.API
 	defaultConfig    ffs.StorageConfig
 	ffsUseMasterAddr bool
 
+	metrics         metrics
+	metricsConfig   MetricsConfig
+	metricsCtx      context.Context
+	metricsCancel   context.CancelFunc
+	metricsFinished chan struct{}
+
 	closed bool
 }
 
-// New returns a new Manager.
-func New(ds datastore.TxnDatastore, wm ffs.WalletManager, drm ffs.DealRecordsManager, sched *scheduler.Scheduler, ffsUseMasterAddr bool, onLocalnet bool) (*Manager, error) {
+// New returns a new Manager. The datastore of sched should share the root
+// datastore of ds, so instances update storage configs and their scheduler
+// tracking state atomically.
+func New(ds datastore.TxnDatastore, wm ffs.WalletManager, drm ffs.DealRecordsManager, sched *scheduler.Scheduler, ffsUseMasterAddr bool, onLocalnet bool, metricsConfig MetricsConfig) (*Manager, error) {
 	if ffsUseMasterAddr && wm.MasterAddr() == address.Undef {
 		return nil, fmt.Errorf("ffsUseMasterAddr requires that master address is defined")
 	}
@@ -92,7 +103,7 @@ func New(ds datastore.TxnDatastore, wm ffs.WalletManager, drm ffs.DealRecordsMan
 	if err != nil {
 		return nil, fmt.Errorf("loading default storage config: %s", err)
 	}
-	return &Manager{
+	m := &Manager{
 		auth:             auth.New(txndstr.Wrap(ds, "auth")),
 		ds:               ds,
 		wm:               wm,
@@ -101,7 +112,15 @@ func New(ds datastore.TxnDatastore, wm ffs.WalletManager, drm ffs.DealRecordsMan
 		instances:        make(map[ffs.APIID]*api.API),
 		defaultConfig:    storageConfig,
 		ffsUseMasterAddr: ffsUseMasterAddr,
-	}, nil
+		metricsConfig:    metricsConfig,
+	}
+	if metricsConfig.RefreshInterval > 0 {
+		m.metricsCtx, m.metricsCancel = context.WithCancel(context.Background())
+		m.metricsFinished = make(chan struct{})
+		m.initMetrics()
+		go m.runMetrics()
+	}
+	return m, nil
 }
 
 // Create creates a new Api instance and an auth-token mapped to it.
@@ -140,7 +159,7 @@ func (m *Manager) Create(ctx context.Context) (ffs.AuthEntry, error) {
 
 	iid := ffs.NewAPIID()
 
-	fapi, err := api.New(namespace.Wrap(m.ds, datastore.NewKey("api/"+iid.String())), iid, m.sched, m.wm, m.drm, m.defaultConfig, addrInfo)
+	fapi, err := api.New(m.instanceDatastore(iid), iid, m.sched, m.wm, m.drm, m.defaultConfig, addrInfo)
 	if err != nil {
 		return ffs.AuthEntry{}, fmt.Errorf("creating new instance: %s", err)
 	}
@@ -204,10 +223,74 @@ func (m *Manager) GetByAuthToken(token string) (*api.API, error) {
 		return nil, ErrAuthTokenNotFound
 	}
 
+	return m.getOrLoad(iid)
+}
+
+// GetByAPIID loads an existing instance using its APIID. If the instance
+// doesn't exist, it returns ErrAPIIDNotFound.
+func (m *Manager) GetByAPIID(iid ffs.APIID) (*api.API, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	i, err := m.getOrLoad(iid)
+	if err == api.ErrNotFound {
+		return nil, ErrAPIIDNotFound
+	}
+	return i, err
+}
+
+// StorageConfigs returns the storage configs of all Cids of a user without
+// loading its instance.
+func (m *Manager) StorageConfigs(iid ffs.APIID) (map[cid.Cid]ffs.StorageConfig, error) {
+	return api.LoadStorageConfigs(m.instanceDatastore(iid))
+}
+
+// SetMinerSelectorParams sets the composite miner selector configuration
+// override of a user, indexing it by APIID. An empty value removes the override.
+// The manager datastore is the only place where overrides are saved, so they
+// can be read without loading instances.
+func (m *Manager) SetMinerSelectorParams(iid ffs.APIID, params string) error {
+	if _, err := m.GetByAPIID(iid); err != nil {
+		return err
+	}
+	key := dsBaseMinerSelectorParams.ChildString(iid.String())
+	if params == "" {
+		if err := m.ds.Delete(key); err != nil {
+			return fmt.Errorf("deleting miner selector params: %s", err)
+		}
+		return nil
+	}
+	if err := m.ds.Put(key, []byte(params)); err != nil {
+		return fmt.Errorf("saving miner selector params: %s", err)
+	}
+	return nil
+}
+
+// MinerSelectorParams returns the composite miner selector configuration
+// override of a user without loading its instance. An empty value means
+// the user has no override.
+func (m *Manager) MinerSelectorParams(iid ffs.APIID) (string, error) {
+	buf, err := m.ds.Get(dsBaseMinerSelectorParams.ChildString(iid.String()))
+	if err == datastore.ErrNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("getting miner selector params: %s", err)
+	}
+	return string(buf), nil
+}
+
+// getOrLoad returns a cached instance, or loads it from the datastore. If the
+// instance doesn't exist, it returns api.ErrNotFound. This method must be guarded.
+func (m *Manager) getOrLoad(iid ffs.APIID) (*api.API, error) {
+	var err error
 	i, ok := m.instances[iid]
 	if !ok {
 		log.Debugf("loading uncached instance %s", iid)
-		i, err = api.Load(namespace.Wrap(m.ds, datastore.NewKey("api/"+iid.String())), iid, m.sched, m.wm, m.drm)
+		i, err = api.Load(m.instanceDatastore(iid), iid, m.sched, m.wm, m.drm)
+		if err == api.ErrNotFound {
+			return nil, err
+		}
 		if err != nil {
 			return nil, fmt.Errorf("loading instance %s: %s", iid, err)
 		}
@@ -219,6 +302,11 @@ func (m *Manager) GetByAuthToken(token string) (*api.API, error) {
 	return i, nil
 }
 
+// instanceDatastore returns the datastore of the instance iid.
+func (m *Manager) instanceDatastore(iid ffs.APIID) datastore.TxnDatastore {
+	return txndstr.Wrap(m.ds, "api/"+iid.String())
+}
+
 // GetDefaultStorageConfig returns the current default StorageConfig used
 // for newly created FFS instances.
 func (m *Manager) GetDefaultStorageConfig() ffs.StorageConfig {
@@ -231,6 +319,10 @@ func (m *Manager) GetDefaultStorageConfig() ffs.StorageConfig {
 func (m *Manager) Close() error {
 	log.Info("closing...")
 	defer log.Info("closed")
+	if m.metricsCancel != nil {
+		m.metricsCancel()
+		<-m.metricsFinished
+	}
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	if m.closed {
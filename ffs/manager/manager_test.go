@@ -12,6 +12,7 @@ import (
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/stretchr/testify/require"
 	dealsModule "github.com/textileio/powergate/v2/deals/module"
+	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/lotus"
 	"github.com/textileio/powergate/v2/tests"
 	txndstr "github.com/textileio/powergate/v2/txndstransform"
@@ -186,6 +187,38 @@ func TestRegenerateAuthToken(t *testing.T) {
 	require.NotEmpty(t, n.Addrs())
 }
 
+func TestMinerSelectorParams(t *testing.T) {
+	t.Parallel()
+	ds := tests.NewTxMapDatastore()
+	ctx := context.Background()
+	client, addr, _ := tests.CreateLocalDevnet(t, 1, 300)
+	m, cls, err := newManager(client, ds, addr, false)
+	require.NoError(t, err)
+	defer require.NoError(t, cls())
+	auth, err := m.Create(ctx)
+	require.NoError(t, err)
+
+	params, err := m.MinerSelectorParams(auth.APIID)
+	require.NoError(t, err)
+	require.Empty(t, params)
+
+	override := `{"Selectors":[{"Type":"fixed"}]}`
+	err = m.SetMinerSelectorParams(auth.APIID, override)
+	require.NoError(t, err)
+	params, err = m.MinerSelectorParams(auth.APIID)
+	require.NoError(t, err)
+	require.Equal(t, override, params)
+
+	err = m.SetMinerSelectorParams(auth.APIID, "")
+	require.NoError(t, err)
+	params, err = m.MinerSelectorParams(auth.APIID)
+	require.NoError(t, err)
+	require.Empty(t, params)
+
+	err = m.SetMinerSelectorParams(ffs.APIID("unknown"), override)
+	require.Equal(t, ErrAPIIDNotFound, err)
+}
+
 func TestDefaultStorageConfig(t *testing.T) {
 	t.Parallel()
 	ds := tests.NewTxMapDatastore()
@@ -227,7 +260,7 @@ func newManager(clientBuilder lotus.ClientBuilder, ds datastore.TxnDatastore, ma
 	if err != nil {
 		return nil, func() error { return nil }, err
 	}
-	m, err := New(ds, wm, dm, nil, ffsUseMasterAddr, true)
+	m, err := New(ds, wm, dm, nil, ffsUseMasterAddr, true, MetricsConfig{})
 	if err != nil {
 		return nil, func() error { return nil }, err
 	}
@@ -0,0 +1,303 @@
+package manager
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/api"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/unit"
+)
+
+const (
+	// otherUsersLabel is the user label value of users which aren't
+	// labeled individually.
+	otherUsersLabel = "other"
+)
+
+var (
+	attrKeyUser    = attribute.Key("user")
+	attrKeyMiner   = attribute.Key("miner")
+	attrStorageHot = attribute.Key("storage").String("hot")
+	attrStorageCol = attribute.Key("storage").String("cold")
+	attrResultOK   = attribute.Key("result").String("success")
+	attrResultFail = attribute.Key("result").String("failed")
+
+	nanoFil = big.NewInt(1_000_000_000)
+)
+
+// MetricsConfig configures the metrics of FFS state.
+type MetricsConfig struct {
+	// RefreshInterval is the interval in which metrics are recomputed.
+	// Zero disables these metrics.
+	RefreshInterval time.Duration
+	// MaxUserLabels is the maximum number of users labeled individually,
+	// ranked by their amount of stored Cids. Remaining users are aggregated
+	// under the "other" user label, except for wallet balances, which are
+	// only reported for individually labeled users.
+	MaxUserLabels int
+	// ExpiringWindow is the amount of epochs in which expiring deals are
+	// considered to be expiring soon.
+	ExpiringWindow uint64
+}
+
+// metrics contains the last computed values of FFS state metrics.
+// Values are keyed by the user label, or by miner for active deals.
+type metrics struct {
+	lock sync.Mutex
+
+	users            int64
+	cids             map[string]int64
+	hotBytes         map[string]int64
+	coldBytes        map[string]int64
+	activeDeals      map[string]int64
+	expiringDeals    map[string]int64
+	balances         map[string]int64
+	retrievalsOK     map[string]int64
+	retrievalsFailed map[string]int64
+}
+
+func (m *Manager) initMetrics() {
+	meter := global.Meter("powergate")
+
+	_ = metric.Must(meter).NewInt64ValueObserver("powergate.ffs.users", func(ctx context.Context, result metric.Int64ObserverResult) {
+		m.metrics.lock.Lock()
+		defer m.metrics.lock.Unlock()
+		result.Observe(m.metrics.users)
+	}, metric.WithDescription("Number of users"))
+	_ = metric.Must(meter).NewInt64ValueObserver("powergate.ffs.cids", func(ctx context.Context, result metric.Int64ObserverResult) {
+		m.metrics.lock.Lock()
+		defer m.metrics.lock.Unlock()
+		observeByKey(result, attrKeyUser, m.metrics.cids)
+	}, metric.WithDescription("Number of stored cids by user"))
+	_ = metric.Must(meter).NewInt64ValueObserver("powergate.ffs.bytes", func(ctx context.Context, result metric.Int64ObserverResult) {
+		m.metrics.lock.Lock()
+		defer m.metrics.lock.Unlock()
+		observeByKey(result, attrKeyUser, m.metrics.hotBytes, attrStorageHot)
+		observeByKey(result, attrKeyUser, m.metrics.coldBytes, attrStorageCol)
+	}, metric.WithDescription("Stored bytes by user in hot and cold storage"), metric.WithUnit(unit.Bytes))
+	_ = metric.Must(meter).NewInt64ValueObserver("powergate.ffs.deals.active", func(ctx context.Context, result metric.Int64ObserverResult) {
+		m.metrics.lock.Lock()
+		defer m.metrics.lock.Unlock()
+		observeByKey(result, attrKeyMiner, m.metrics.activeDeals)
+	}, metric.WithDescription("Number of active deals by miner"))
+	_ = metric.Must(meter).NewInt64ValueObserver("powergate.ffs.deals.expiring", func(ctx context.Context, result metric.Int64ObserverResult) {
+		m.metrics.lock.Lock()
+		defer m.metrics.lock.Unlock()
+		observeByKey(result, attrKeyUser, m.metrics.expiringDeals)
+	}, metric.WithDescription("Number of deals expiring soon by user"))
+	_ = metric.Must(meter).NewInt64ValueObserver("powergate.ffs.wallet.balance", func(ctx context.Context, result metric.Int64ObserverResult) {
+		m.metrics.lock.Lock()
+		defer m.metrics.lock.Unlock()
+		observeByKey(result, attrKeyUser, m.metrics.balances)
+	}, metric.WithDescription("Wallet balance by individually labeled user in nanoFIL"))
+	_ = metric.Must(meter).NewInt64ValueObserver("powergate.ffs.retrievals", func(ctx context.Context, result metric.Int64ObserverResult) {
+		m.metrics.lock.Lock()
+		defer m.metrics.lock.Unlock()
+		observeByKey(result, attrKeyUser, m.metrics.retrievalsOK, attrResultOK)
+		observeByKey(result, attrKeyUser, m.metrics.retrievalsFailed, attrResultFail)
+	}, metric.WithDescription("Number of retrievals by user and result"))
+}
+
+func observeByKey(result metric.Int64ObserverResult, key attribute.Key, values map[string]int64, attrs ...attribute.KeyValue) {
+	for k, v := range values {
+		result.Observe(v, append([]attribute.KeyValue{key.String(k)}, attrs...)...)
+	}
+}
+
+// runMetrics refreshes metrics every configured interval until
+// the manager is closed.
+func (m *Manager) runMetrics() {
+	defer close(m.metricsFinished)
+	for {
+		m.refreshMetrics(m.metricsCtx)
+		select {
+		case <-m.metricsCtx.Done():
+			return
+		case <-time.After(m.metricsConfig.RefreshInterval):
+		}
+	}
+}
+
+func (m *Manager) refreshMetrics(ctx context.Context) {
+	entries, err := m.List()
+	if err != nil {
+		log.Errorf("listing users for metrics: %s", err)
+		return
+	}
+	infos, err := m.sched.ListStorageInfo(nil, nil)
+	if err != nil {
+		log.Errorf("listing storage info for metrics: %s", err)
+		return
+	}
+	forecasts, height, err := m.sched.ForecastExpirations(ctx, nil, m.metricsConfig.ExpiringWindow)
+	if err != nil {
+		log.Errorf("forecasting expirations for metrics: %s", err)
+		return
+	}
+
+	cidsByUser := make(map[ffs.APIID]int64, len(entries))
+	for _, e := range entries {
+		cidsByUser[e.APIID] = 0
+	}
+	for _, info := range infos {
+		cidsByUser[info.APIID]++
+	}
+	labels := userLabels(cidsByUser, m.metricsConfig.MaxUserLabels)
+
+	sm := aggregateStorage(infos, labels, height)
+	expiringDeals := map[string]int64{}
+	for _, f := range forecasts {
+		expiringDeals[labels[f.APIID]] += int64(len(f.Expiring))
+	}
+
+	// Balances are only queried for individually labeled users, since
+	// the number of remaining users is unbounded.
+	addrLabels := map[string]string{}
+	balances := map[string]int64{}
+	for _, e := range entries {
+		addrs, err := m.instanceAddrs(e.APIID)
+		if err != nil {
+			log.Errorf("loading addresses of user %s for metrics: %s", e.APIID, err)
+			continue
+		}
+		label := labels[e.APIID]
+		for _, a := range addrs {
+			addrLabels[a.Addr] = label
+			if label == otherUsersLabel {
+				continue
+			}
+			bal, err := m.wm.Balance(ctx, a.Addr)
+			if err != nil {
+				log.Errorf("getting balance of %s for metrics: %s", a.Addr, err)
+				continue
+			}
+			balances[label] += new(big.Int).Div(bal, nanoFil).Int64()
+		}
+	}
+
+	rrs, err := m.drm.ListRetrievalDealRecords(deals.WithIncludeFailed(true))
+	if err != nil {
+		log.Errorf("listing retrieval records for metrics: %s", err)
+		return
+	}
+	retrievalsOK, retrievalsFailed := aggregateRetrievals(rrs, addrLabels)
+
+	m.metrics.lock.Lock()
+	defer m.metrics.lock.Unlock()
+	m.metrics.users = int64(len(entries))
+	m.metrics.cids = sm.cids
+	m.metrics.hotBytes = sm.hotBytes
+	m.metrics.coldBytes = sm.coldBytes
+	m.metrics.activeDeals = sm.activeDeals
+	m.metrics.expiringDeals = expiringDeals
+	m.metrics.balances = balances
+	m.metrics.retrievalsOK = retrievalsOK
+	m.metrics.retrievalsFailed = retrievalsFailed
+}
+
+// instanceAddrs returns the wallet addresses of a user. Instances which
+// aren't loaded aren't cached, so refreshing metrics doesn't load every
+// instance in memory.
+func (m *Manager) instanceAddrs(iid ffs.APIID) ([]api.AddrInfo, error) {
+	m.lock.Lock()
+	i, ok := m.instances[iid]
+	m.lock.Unlock()
+	if ok {
+		return i.Addrs(), nil
+	}
+	return api.LoadAddrs(m.instanceDatastore(iid))
+}
+
+// storageMetrics contains storage metrics values by user label, and
+// active deals by miner.
+type storageMetrics struct {
+	cids        map[string]int64
+	hotBytes    map[string]int64
+	coldBytes   map[string]int64
+	activeDeals map[string]int64
+}
+
+// aggregateStorage computes storage metrics of infos at the provided height.
+// Cold Storage bytes are only accounted for Cids with active deals.
+func aggregateStorage(infos []ffs.StorageInfo, labels map[ffs.APIID]string, height uint64) storageMetrics {
+	sm := storageMetrics{
+		cids:        map[string]int64{},
+		hotBytes:    map[string]int64{},
+		coldBytes:   map[string]int64{},
+		activeDeals: map[string]int64{},
+	}
+	for _, info := range infos {
+		label, ok := labels[info.APIID]
+		if !ok {
+			label = otherUsersLabel
+		}
+		sm.cids[label]++
+		if info.Hot.Enabled {
+			sm.hotBytes[label] += int64(info.Hot.Size)
+		}
+		var active bool
+		for _, p := range info.Cold.Filecoin.Proposals {
+			if p.StartEpoch+uint64(p.Duration) <= height {
+				continue
+			}
+			sm.activeDeals[p.Miner]++
+			active = true
+		}
+		if active {
+			sm.coldBytes[label] += int64(info.Cold.Filecoin.Size)
+		}
+	}
+	return sm
+}
+
+// aggregateRetrievals returns the number of successful and failed retrievals
+// by user label. Retrievals from addresses without label are ignored.
+func aggregateRetrievals(rrs []deals.RetrievalDealRecord, addrLabels map[string]string) (map[string]int64, map[string]int64) {
+	ok := map[string]int64{}
+	failed := map[string]int64{}
+	for _, r := range rrs {
+		label, labeled := addrLabels[r.Addr]
+		if !labeled {
+			continue
+		}
+		if r.ErrMsg != "" {
+			failed[label]++
+			continue
+		}
+		ok[label]++
+	}
+	return ok, failed
+}
+
+// userLabels returns the metric user label of each user. The max users
+// with most stored Cids are labeled with their APIID, and the remaining
+// ones with otherUsersLabel.
+func userLabels(cidsByUser map[ffs.APIID]int64, max int) map[ffs.APIID]string {
+	iids := make([]ffs.APIID, 0, len(cidsByUser))
+	for iid := range cidsByUser {
+		iids = append(iids, iid)
+	}
+	sort.Slice(iids, func(i, j int) bool {
+		if cidsByUser[iids[i]] != cidsByUser[iids[j]] {
+			return cidsByUser[iids[i]] > cidsByUser[iids[j]]
+		}
+		return iids[i] < iids[j]
+	})
+	labels := make(map[ffs.APIID]string, len(iids))
+	for i, iid := range iids {
+		if i < max {
+			labels[iid] = iid.String()
+			continue
+		}
+		labels[iid] = otherUsersLabel
+	}
+	return labels
+}
@@ -0,0 +1,97 @@
+package manager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+func TestUserLabels(t *testing.T) {
+	t.Parallel()
+
+	cidsByUser := map[ffs.APIID]int64{
+		ffs.APIID("a"): 1,
+		ffs.APIID("b"): 5,
+		ffs.APIID("c"): 5,
+		ffs.APIID("d"): 0,
+	}
+
+	labels := userLabels(cidsByUser, 2)
+	require.Equal(t, map[ffs.APIID]string{
+		ffs.APIID("a"): otherUsersLabel,
+		ffs.APIID("b"): "b",
+		ffs.APIID("c"): "c",
+		ffs.APIID("d"): otherUsersLabel,
+	}, labels)
+
+	labels = userLabels(cidsByUser, 0)
+	for _, l := range labels {
+		require.Equal(t, otherUsersLabel, l)
+	}
+}
+
+func TestAggregateStorage(t *testing.T) {
+	t.Parallel()
+
+	labels := map[ffs.APIID]string{
+		ffs.APIID("a"): "a",
+		ffs.APIID("b"): otherUsersLabel,
+	}
+	infos := []ffs.StorageInfo{
+		{
+			APIID: ffs.APIID("a"),
+			Hot:   ffs.HotInfo{Enabled: true, Size: 10},
+			Cold: ffs.ColdInfo{Filecoin: ffs.FilInfo{Size: 100, Proposals: []ffs.FilStorage{
+				{Miner: "f01", StartEpoch: 50, Duration: 100},
+				{Miner: "f02", StartEpoch: 10, Duration: 50},
+			}}},
+		},
+		{
+			APIID: ffs.APIID("a"),
+			Hot:   ffs.HotInfo{Enabled: false, Size: 20},
+			Cold: ffs.ColdInfo{Filecoin: ffs.FilInfo{Size: 200, Proposals: []ffs.FilStorage{
+				{Miner: "f02", StartEpoch: 10, Duration: 50},
+			}}},
+		},
+		{
+			APIID: ffs.APIID("b"),
+			Hot:   ffs.HotInfo{Enabled: true, Size: 30},
+			Cold: ffs.ColdInfo{Filecoin: ffs.FilInfo{Size: 300, Proposals: []ffs.FilStorage{
+				{Miner: "f01", StartEpoch: 90, Duration: 100},
+			}}},
+		},
+		{
+			// Users without label are aggregated as other users.
+			APIID: ffs.APIID("c"),
+			Hot:   ffs.HotInfo{Enabled: true, Size: 40},
+		},
+	}
+
+	sm := aggregateStorage(infos, labels, 100)
+	require.Equal(t, map[string]int64{"a": 2, otherUsersLabel: 2}, sm.cids)
+	require.Equal(t, map[string]int64{"a": 10, otherUsersLabel: 70}, sm.hotBytes)
+	require.Equal(t, map[string]int64{"a": 100, otherUsersLabel: 300}, sm.coldBytes)
+	require.Equal(t, map[string]int64{"f01": 2}, sm.activeDeals)
+}
+
+func TestAggregateRetrievals(t *testing.T) {
+	t.Parallel()
+
+	addrLabels := map[string]string{
+		"f3a": "a",
+		"f3b": otherUsersLabel,
+	}
+	rrs := []deals.RetrievalDealRecord{
+		{Addr: "f3a"},
+		{Addr: "f3a", ErrMsg: "failed"},
+		{Addr: "f3a"},
+		{Addr: "f3b", ErrMsg: "failed"},
+		{Addr: "f3unknown"},
+	}
+
+	ok, failed := aggregateRetrievals(rrs, addrLabels)
+	require.Equal(t, map[string]int64{"a": 2}, ok)
+	require.Equal(t, map[string]int64{"a": 1, otherUsersLabel: 1}, failed)
+}
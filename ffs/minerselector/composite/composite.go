@@ -0,0 +1,325 @@
+package composite
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	lru "github.com/hashicorp/golang-lru"
+	logger "github.com/ipfs/go-log/v2"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+const (
+	// ModeChain asks child selectors in order, filling the remaining
+	// amount of miners with the next selector.
+	ModeChain = "chain"
+	// ModeWeighted splits the wanted amount of miners between child
+	// selectors proportionally to their weights. If a child can't provide
+	// its share, the remaining miners are asked to the rest in order.
+	ModeWeighted = "weighted"
+
+	// maxCachedChains is the maximum number of built per-user
+	// configurations kept in memory.
+	maxCachedChains = 128
+)
+
+var (
+	log = logger.Logger("composite-miner-selector")
+)
+
+// Config is the declarative configuration of a composite MinerSelector.
+type Config struct {
+	// Mode indicates how child selectors are combined. It can be
+	// ModeChain or ModeWeighted. Defaults to ModeChain.
+	Mode string
+	// Selectors are the child selectors configurations.
+	Selectors []SelectorConfig
+}
+
+// SelectorConfig is the configuration of a child selector.
+type SelectorConfig struct {
+	// Type is the child selector type, e.g: "fixed", "sr2", "reputation".
+	Type string
+	// Params are type-specific parameters of the child selector.
+	Params json.RawMessage
+	// Weight is the relative weight of the child in ModeWeighted.
+	Weight int
+	// Max is the maximum number of miners taken from this child
+	// in a single selection. Zero means no limit.
+	Max int
+}
+
+// Builder creates a child selector from its type and parameters.
+type Builder func(typ string, params json.RawMessage) (ffs.MinerSelector, error)
+
+// Overrides returns the composite configuration override of a user, if any.
+// An empty string means the user has no override.
+type Overrides func(ffs.APIID) (string, error)
+
+// MinerSelector is a ffs.MinerSelector implementation which combines
+// child selectors according to a declarative configuration. Users might
+// have their own configuration overriding the default one.
+type MinerSelector struct {
+	build     Builder
+	overrides Overrides
+	def       *chain
+	cached    *lru.Cache
+}
+
+var _ ffs.MinerSelector = (*MinerSelector)(nil)
+
+// New returns a new composite MinerSelector with default configuration params.
+// The builder is used to create child selectors, and overrides to resolve
+// per-user configurations. overrides can be nil.
+func New(params string, build Builder, overrides Overrides) (*MinerSelector, error) {
+	def, err := newChain(params, build)
+	if err != nil {
+		return nil, fmt.Errorf("creating default composite selector: %s", err)
+	}
+	cached, err := lru.New(maxCachedChains)
+	if err != nil {
+		return nil, fmt.Errorf("creating cache: %s", err)
+	}
+	return &MinerSelector{
+		build:     build,
+		overrides: overrides,
+		def:       def,
+		cached:    cached,
+	}, nil
+}
+
+// ParseConfig parses and validates a composite configuration.
+func ParseConfig(params string) (Config, error) {
+	var c Config
+	if err := json.Unmarshal([]byte(params), &c); err != nil {
+		return Config{}, fmt.Errorf("unmarshaling config: %s", err)
+	}
+	if c.Mode == "" {
+		c.Mode = ModeChain
+	}
+	if c.Mode != ModeChain && c.Mode != ModeWeighted {
+		return Config{}, fmt.Errorf("unknown mode %s", c.Mode)
+	}
+	if len(c.Selectors) == 0 {
+		return Config{}, fmt.Errorf("at least one child selector is required")
+	}
+	for i, s := range c.Selectors {
+		if s.Type == "" {
+			return Config{}, fmt.Errorf("child selector %d has empty type", i)
+		}
+		if s.Weight < 0 || s.Max < 0 {
+			return Config{}, fmt.Errorf("child selector %d weight and max can't be negative", i)
+		}
+		if c.Mode == ModeWeighted && s.Weight == 0 {
+			c.Selectors[i].Weight = 1
+		}
+	}
+	return c, nil
+}
+
+// GetMiners returns n miners using the configuration of the user
+// making the selection, or the default configuration.
+func (ms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.MinerProposal, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("the number of miners should be greater than zero")
+	}
+	c, err := ms.chainFor(f.APIID)
+	if err != nil {
+		return nil, fmt.Errorf("resolving composite configuration: %s", err)
+	}
+	return c.getMiners(n, f)
+}
+
+func (ms *MinerSelector) chainFor(iid ffs.APIID) (*chain, error) {
+	if ms.overrides == nil || iid == ffs.EmptyInstanceID {
+		return ms.def, nil
+	}
+	params, err := ms.overrides(iid)
+	if err != nil {
+		return nil, fmt.Errorf("getting overrides for user %s: %s", iid, err)
+	}
+	if params == "" {
+		return ms.def, nil
+	}
+
+	if c, ok := ms.cached.Get(params); ok {
+		return c.(*chain), nil
+	}
+	c, err := newChain(params, ms.build)
+	if err != nil {
+		return nil, fmt.Errorf("creating composite selector for user %s: %s", iid, err)
+	}
+	ms.cached.Add(params, c)
+	return c, nil
+}
+
+type child struct {
+	SelectorConfig
+	ms ffs.MinerSelector
+}
+
+type chain struct {
+	mode     string
+	children []child
+}
+
+func newChain(params string, build Builder) (*chain, error) {
+	conf, err := ParseConfig(params)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config: %s", err)
+	}
+	children := make([]child, len(conf.Selectors))
+	for i, sc := range conf.Selectors {
+		ms, err := build(sc.Type, sc.Params)
+		if err != nil {
+			return nil, fmt.Errorf("building child selector %d of type %s: %s", i, sc.Type, err)
+		}
+		children[i] = child{SelectorConfig: sc, ms: ms}
+	}
+	return &chain{mode: conf.Mode, children: children}, nil
+}
+
+func (c *chain) getMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.MinerProposal, error) {
+	shares := make([]int, len(c.children))
+	if c.mode == ModeWeighted {
+		shares = weightedShares(n, c.children)
+	}
+
+	var res []ffs.MinerProposal
+	taken := make([]int, len(c.children))
+	inner := f
+	inner.ExcludedMiners = append([]string{}, f.ExcludedMiners...)
+	inner.TrustedMiners = append([]string{}, f.TrustedMiners...)
+	if f.OnExclusion != nil {
+		// Many children may exclude the same miner, but it's
+		// reported only once.
+		reported := make(map[string]struct{})
+		inner.OnExclusion = func(e ffs.MinerExclusion) {
+			if _, ok := reported[e.Miner]; ok {
+				return
+			}
+			reported[e.Miner] = struct{}{}
+			f.OnExclusion(e)
+		}
+	}
+	take := func(i, want int) {
+		ch := c.children[i]
+		if ch.Max > 0 && want > ch.Max-taken[i] {
+			want = ch.Max - taken[i]
+		}
+		if want <= 0 {
+			return
+		}
+		mps := getAtMost(ch, want, inner)
+		for _, mp := range mps {
+			res = append(res, mp)
+			taken[i]++
+			inner.ExcludedMiners = append(inner.ExcludedMiners, mp.Addr)
+			inner.TrustedMiners = remove(inner.TrustedMiners, mp.Addr)
+		}
+	}
+
+	// First, every child provides its share. In ModeChain, shares
+	// are zero so this is a noop.
+	for i, share := range shares {
+		if share > 0 {
+			take(i, share)
+		}
+	}
+	// Then, the remaining miners are filled in order.
+	for i := range c.children {
+		if len(res) == n {
+			break
+		}
+		take(i, n-len(res))
+	}
+	if len(res) < n {
+		return nil, fmt.Errorf("not enough miners from child selectors, want %d, got %d", n, len(res))
+	}
+
+	return res, nil
+}
+
+// PartialSelector is implemented by child selectors which can
+// provide fewer miners than asked instead of failing.
+type PartialSelector interface {
+	GetMinersAtMost(n int, f ffs.MinerSelectorFilter) []ffs.MinerProposal
+}
+
+// getAtMost asks a child selector once for up to want miners. Child
+// selectors which don't implement PartialSelector fail if they can't
+// provide the exact amount asked, so they provide none in that case.
+func getAtMost(ch child, want int, f ffs.MinerSelectorFilter) []ffs.MinerProposal {
+	var mps []ffs.MinerProposal
+	if ps, ok := ch.ms.(PartialSelector); ok {
+		mps = ps.GetMinersAtMost(want, f)
+	} else {
+		var err error
+		mps, err = ch.ms.GetMiners(want, f)
+		if err != nil {
+			log.Debugf("child selector %s can't provide %d miners: %s", ch.Type, want, err)
+			return nil
+		}
+	}
+	var res []ffs.MinerProposal
+	for _, mp := range mps {
+		if contains(f.ExcludedMiners, mp.Addr) {
+			continue
+		}
+		res = append(res, mp)
+		if len(res) == want {
+			break
+		}
+	}
+	return res
+}
+
+// weightedShares splits n between children proportionally to their
+// weights using the largest remainder method.
+func weightedShares(n int, children []child) []int {
+	var total int
+	for _, ch := range children {
+		total += ch.Weight
+	}
+	shares := make([]int, len(children))
+	if total == 0 {
+		return shares
+	}
+	type remainder struct {
+		idx int
+		rem int
+	}
+	rems := make([]remainder, len(children))
+	assigned := 0
+	for i, ch := range children {
+		shares[i] = n * ch.Weight / total
+		assigned += shares[i]
+		rems[i] = remainder{idx: i, rem: n * ch.Weight % total}
+	}
+	sort.SliceStable(rems, func(i, j int) bool { return rems[i].rem > rems[j].rem })
+	for i := 0; assigned < n; i++ {
+		shares[rems[i%len(rems)].idx]++
+		assigned++
+	}
+	return shares
+}
+
+func contains(l []string, s string) bool {
+	for _, e := range l {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+func remove(l []string, s string) []string {
+	res := l[:0]
+	for _, e := range l {
+		if e != s {
+			res = append(res, e)
+		}
+	}
+	return res
+}
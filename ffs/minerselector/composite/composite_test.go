@@ -0,0 +1,211 @@
+package composite
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/minerselector/fixed"
+)
+
+func TestChain(t *testing.T) {
+	t.Parallel()
+	params := `{"Mode":"chain","Selectors":[
+		{"Type":"fixed","Params":["f01","f02"]},
+		{"Type":"fixed","Params":["f03","f04","f05"]}]}`
+	cms, err := New(params, build, nil)
+	require.NoError(t, err)
+
+	mps, err := cms.GetMiners(1, ffs.MinerSelectorFilter{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01"}, addrs(mps))
+
+	mps, err = cms.GetMiners(4, ffs.MinerSelectorFilter{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01", "f02", "f03", "f04"}, addrs(mps))
+
+	f := ffs.MinerSelectorFilter{ExcludedMiners: []string{"f01", "f03"}}
+	mps, err = cms.GetMiners(3, f)
+	require.NoError(t, err)
+	require.Equal(t, []string{"f02", "f04", "f05"}, addrs(mps))
+
+	_, err = cms.GetMiners(6, ffs.MinerSelectorFilter{})
+	require.Error(t, err)
+}
+
+func TestChainMax(t *testing.T) {
+	t.Parallel()
+	params := `{"Selectors":[
+		{"Type":"fixed","Params":["f01","f02","f03"],"Max":1},
+		{"Type":"fixed","Params":["f02","f04","f05"]}]}`
+	cms, err := New(params, build, nil)
+	require.NoError(t, err)
+
+	mps, err := cms.GetMiners(3, ffs.MinerSelectorFilter{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01", "f02", "f04"}, addrs(mps))
+}
+
+func TestWeighted(t *testing.T) {
+	t.Parallel()
+	params := `{"Mode":"weighted","Selectors":[
+		{"Type":"fixed","Params":["f01","f02","f03"],"Weight":2},
+		{"Type":"fixed","Params":["f04","f05","f06"],"Weight":1}]}`
+	cms, err := New(params, build, nil)
+	require.NoError(t, err)
+
+	mps, err := cms.GetMiners(3, ffs.MinerSelectorFilter{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01", "f02", "f04"}, addrs(mps))
+
+	// The first child can only provide one miner, so the second
+	// child fills the missing share.
+	f := ffs.MinerSelectorFilter{ExcludedMiners: []string{"f01", "f02"}}
+	mps, err = cms.GetMiners(3, f)
+	require.NoError(t, err)
+	require.Equal(t, []string{"f03", "f04", "f05"}, addrs(mps))
+}
+
+func TestChildAskedOnce(t *testing.T) {
+	t.Parallel()
+	strict := &strictSelector{miners: []string{"f01", "f02", "f03"}}
+	buildStrict := func(typ string, params json.RawMessage) (ffs.MinerSelector, error) {
+		if typ == "strict" {
+			return strict, nil
+		}
+		return build(typ, params)
+	}
+	params := `{"Selectors":[
+		{"Type":"strict"},
+		{"Type":"fixed","Params":["f04","f05","f06","f07"]}]}`
+	cms, err := New(params, buildStrict, nil)
+	require.NoError(t, err)
+
+	var exclusions []string
+	f := ffs.MinerSelectorFilter{OnExclusion: func(e ffs.MinerExclusion) {
+		exclusions = append(exclusions, e.Miner)
+	}}
+	mps, err := cms.GetMiners(4, f)
+	require.NoError(t, err)
+	require.Equal(t, []string{"f04", "f05", "f06", "f07"}, addrs(mps))
+	require.Equal(t, 1, strict.calls)
+	require.Equal(t, []string{"f01", "f02", "f03"}, exclusions)
+}
+
+func TestOverrides(t *testing.T) {
+	t.Parallel()
+	def := `{"Selectors":[{"Type":"fixed","Params":["f01","f02"]}]}`
+	user := `{"Selectors":[{"Type":"fixed","Params":["f05","f06"]}]}`
+	overrides := func(iid ffs.APIID) (string, error) {
+		switch iid {
+		case "user":
+			return user, nil
+		case "broken":
+			return "", fmt.Errorf("user not found")
+		}
+		return "", nil
+	}
+	cms, err := New(def, build, overrides)
+	require.NoError(t, err)
+
+	mps, err := cms.GetMiners(2, ffs.MinerSelectorFilter{APIID: "user"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"f05", "f06"}, addrs(mps))
+
+	mps, err = cms.GetMiners(2, ffs.MinerSelectorFilter{APIID: "other"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01", "f02"}, addrs(mps))
+
+	mps, err = cms.GetMiners(2, ffs.MinerSelectorFilter{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01", "f02"}, addrs(mps))
+
+	_, err = cms.GetMiners(2, ffs.MinerSelectorFilter{APIID: "broken"})
+	require.Error(t, err)
+}
+
+func TestParseConfig(t *testing.T) {
+	t.Parallel()
+	c, err := ParseConfig(`{"Mode":"weighted","Selectors":[{"Type":"fixed"}]}`)
+	require.NoError(t, err)
+	require.Equal(t, 1, c.Selectors[0].Weight)
+
+	c, err = ParseConfig(`{"Selectors":[{"Type":"fixed"}]}`)
+	require.NoError(t, err)
+	require.Equal(t, ModeChain, c.Mode)
+
+	invalid := []string{
+		`not json`,
+		`{"Mode":"random","Selectors":[{"Type":"fixed"}]}`,
+		`{"Selectors":[]}`,
+		`{"Selectors":[{"Params":[]}]}`,
+		`{"Selectors":[{"Type":"fixed","Max":-1}]}`,
+	}
+	for _, params := range invalid {
+		_, err := ParseConfig(params)
+		require.Error(t, err, params)
+	}
+
+	_, err = New(`{"Selectors":[{"Type":"unknown"}]}`, build, nil)
+	require.Error(t, err)
+}
+
+func TestWeightedShares(t *testing.T) {
+	t.Parallel()
+	children := []child{
+		{SelectorConfig: SelectorConfig{Weight: 1}},
+		{SelectorConfig: SelectorConfig{Weight: 1}},
+		{SelectorConfig: SelectorConfig{Weight: 1}},
+	}
+	require.Equal(t, []int{1, 1, 1}, weightedShares(3, children))
+	require.Equal(t, []int{2, 1, 1}, weightedShares(4, children))
+	require.Equal(t, []int{1, 0, 0}, weightedShares(1, children))
+}
+
+func build(typ string, params json.RawMessage) (ffs.MinerSelector, error) {
+	if typ != "fixed" {
+		return nil, fmt.Errorf("unknown type %s", typ)
+	}
+	var addrs []string
+	if err := json.Unmarshal(params, &addrs); err != nil {
+		return nil, err
+	}
+	miners := make([]fixed.Miner, len(addrs))
+	for i, a := range addrs {
+		miners[i] = fixed.Miner{Addr: a, EpochPrice: 100}
+	}
+	return fixed.New(miners), nil
+}
+
+// strictSelector fails if it can't provide the exact amount of
+// miners asked, excluding all of them.
+type strictSelector struct {
+	miners []string
+	calls  int
+}
+
+func (s *strictSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.MinerProposal, error) {
+	s.calls++
+	if n > len(s.miners) {
+		for _, m := range s.miners {
+			f.OnExclusion(ffs.MinerExclusion{Miner: m, Reason: "query asking failed"})
+			f.OnExclusion(ffs.MinerExclusion{Miner: m, Reason: "query asking failed"})
+		}
+		return nil, fmt.Errorf("not enough miners")
+	}
+	res := make([]ffs.MinerProposal, n)
+	for i := range res {
+		res[i] = ffs.MinerProposal{Addr: s.miners[i]}
+	}
+	return res, nil
+}
+
+func addrs(mps []ffs.MinerProposal) []string {
+	res := make([]string, len(mps))
+	for i, mp := range mps {
+		res[i] = mp.Addr
+	}
+	return res
+}
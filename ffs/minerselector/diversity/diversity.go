@@ -0,0 +1,286 @@
+package diversity
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/lotus/chain/types"
+	logger "github.com/ipfs/go-log/v2"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/index/miner"
+	"github.com/textileio/powergate/v2/lotus"
+)
+
+const (
+	earthRadiusKm = 6371.0
+	// maxRounds is the maximum number of times the wrapped selector is
+	// asked for candidates before giving up.
+	maxRounds = 10
+	// operatorsCacheTTL is how long resolved owner and worker addresses
+	// of a miner are cached.
+	operatorsCacheTTL = time.Hour
+)
+
+var (
+	log = logger.Logger("diversity-miner-selector")
+)
+
+// MinerSelector is a ffs.MinerSelector implementation that wraps another
+// ffs.MinerSelector and enforces the diversity constraints specified in
+// the filter. Candidates returned by the wrapped selector which don't satisfy
+// the constraints are excluded, and the wrapped selector is asked for
+// replacements.
+type MinerSelector struct {
+	ms ffs.MinerSelector
+	mi miner.Module
+
+	resolveOperators func(string) ([]string, error)
+
+	lock      sync.Mutex
+	operators map[string]operatorsEntry
+}
+
+type operatorsEntry struct {
+	addrs     []string
+	updatedAt time.Time
+}
+
+var _ ffs.MinerSelector = (*MinerSelector)(nil)
+
+// New returns a new diversity MinerSelector wrapping ms. The miner index
+// provides miners location information, and the Lotus client is used to
+// resolve owner and worker addresses of miners.
+func New(ms ffs.MinerSelector, mi miner.Module, cb lotus.ClientBuilder) *MinerSelector {
+	return newMinerSelector(ms, mi, lotusOperatorsResolver(cb))
+}
+
+func newMinerSelector(ms ffs.MinerSelector, mi miner.Module, resolveOperators func(string) ([]string, error)) *MinerSelector {
+	return &MinerSelector{
+		ms:               ms,
+		mi:               mi,
+		resolveOperators: resolveOperators,
+		operators:        make(map[string]operatorsEntry),
+	}
+}
+
+// GetMiners returns n miners from the wrapped selector satisfying the diversity
+// constraints of the filter, considering the current miners storing the data.
+func (dms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.MinerProposal, error) {
+	if !f.Diversity.Enabled() {
+		return dms.ms.GetMiners(n, f)
+	}
+	if n < 1 {
+		return nil, fmt.Errorf("the number of miners should be greater than zero")
+	}
+
+	meta := dms.mi.Get().Meta.Info
+	var selected []candidate
+	for _, addr := range f.CurrentMiners {
+		c, err := dms.newCandidate(addr, f.Diversity, meta)
+		if err != nil {
+			log.Warnf("resolving current miner %s information: %s", addr, err)
+			continue
+		}
+		selected = append(selected, c)
+	}
+
+	var res []ffs.MinerProposal
+	inner := f
+	inner.ExcludedMiners = append(append([]string{}, f.ExcludedMiners...), f.CurrentMiners...)
+	inner.TrustedMiners = append([]string{}, f.TrustedMiners...)
+	var rejections []string
+	for round := 0; round < maxRounds && len(res) < n; round++ {
+		mps, err := dms.ms.GetMiners(n-len(res), inner)
+		if err != nil {
+			return nil, fmt.Errorf("getting candidates from wrapped miner selector: %s (rejected candidates: %v)", err, rejections)
+		}
+		for _, mp := range mps {
+			c, err := dms.newCandidate(mp.Addr, f.Diversity, meta)
+			if err == nil {
+				err = satisfies(c, selected, f.Diversity)
+			}
+			if err != nil {
+				rejections = append(rejections, fmt.Sprintf("%s: %s", mp.Addr, err))
+				if f.OnExclusion != nil {
+					f.OnExclusion(ffs.MinerExclusion{Miner: mp.Addr, Reason: fmt.Sprintf("diversity constraint: %s", err)})
+				}
+				inner.ExcludedMiners = append(inner.ExcludedMiners, mp.Addr)
+				if !contains(inner.TrustedMiners, mp.Addr) {
+					continue
+				}
+				// An empty trusted list means no restriction for the wrapped
+				// selector, so rejecting the last trusted miner can't fall
+				// back to arbitrary miners.
+				inner.TrustedMiners = remove(inner.TrustedMiners, mp.Addr)
+				if len(inner.TrustedMiners) == 0 {
+					return nil, fmt.Errorf("trusted miners don't satisfy diversity constraints (rejected candidates: %v)", rejections)
+				}
+				continue
+			}
+			selected = append(selected, c)
+			res = append(res, mp)
+			inner.TrustedMiners = remove(inner.TrustedMiners, mp.Addr)
+			inner.ExcludedMiners = append(inner.ExcludedMiners, mp.Addr)
+			if len(res) == n {
+				break
+			}
+		}
+	}
+	if len(res) < n {
+		return nil, fmt.Errorf("not enough miners satisfy diversity constraints, want %d, got %d (rejected candidates: %v)", n, len(res), rejections)
+	}
+
+	return res, nil
+}
+
+type candidate struct {
+	addr      string
+	location  *miner.Location
+	continent string
+	operators []string
+}
+
+func (dms *MinerSelector) newCandidate(addr string, d ffs.FilDiversity, meta map[string]miner.Meta) (candidate, error) {
+	c := candidate{addr: addr}
+	if d.MaxPerCountry > 0 || d.MaxPerContinent > 0 || d.MinDistanceKm > 0 {
+		m, ok := meta[addr]
+		if !ok || m.Location.Country == "" {
+			return candidate{}, fmt.Errorf("unknown location")
+		}
+		loc := m.Location
+		c.location = &loc
+		c.continent = continentOf(loc.Country)
+	}
+	if d.DistinctOperators {
+		ops, err := dms.getOperators(addr)
+		if err != nil {
+			return candidate{}, fmt.Errorf("resolving operators: %s", err)
+		}
+		c.operators = ops
+	}
+	return c, nil
+}
+
+// satisfies returns a non-nil error describing the violated constraint if c
+// can't be added to the selected set of miners.
+func satisfies(c candidate, selected []candidate, d ffs.FilDiversity) error {
+	var sameCountry, sameContinent int
+	for _, s := range selected {
+		if c.location != nil && s.location != nil {
+			if c.location.Country == s.location.Country {
+				sameCountry++
+			}
+			if c.continent != "" && c.continent == s.continent {
+				sameContinent++
+			}
+			if d.MinDistanceKm > 0 {
+				if dist := distanceKm(*c.location, *s.location); dist < d.MinDistanceKm {
+					return fmt.Errorf("too close to miner %s (%.0fkm<%.0fkm)", s.addr, dist, d.MinDistanceKm)
+				}
+			}
+		}
+		if d.DistinctOperators && shareAny(c.operators, s.operators) {
+			return fmt.Errorf("shares operator with miner %s", s.addr)
+		}
+	}
+	if d.MaxPerCountry > 0 && sameCountry >= d.MaxPerCountry {
+		return fmt.Errorf("country %s already has %d replicas", c.location.Country, sameCountry)
+	}
+	if d.MaxPerContinent > 0 {
+		if c.continent == "" {
+			return fmt.Errorf("unknown continent for country %s", c.location.Country)
+		}
+		if sameContinent >= d.MaxPerContinent {
+			return fmt.Errorf("continent %s already has %d replicas", c.continent, sameContinent)
+		}
+	}
+	return nil
+}
+
+func (dms *MinerSelector) getOperators(addr string) ([]string, error) {
+	dms.lock.Lock()
+	e, ok := dms.operators[addr]
+	dms.lock.Unlock()
+	if ok && time.Since(e.updatedAt) < operatorsCacheTTL {
+		return e.addrs, nil
+	}
+
+	ops, err := dms.resolveOperators(addr)
+	if err != nil {
+		return nil, err
+	}
+	dms.lock.Lock()
+	dms.operators[addr] = operatorsEntry{addrs: ops, updatedAt: time.Now()}
+	dms.lock.Unlock()
+
+	return ops, nil
+}
+
+func lotusOperatorsResolver(cb lotus.ClientBuilder) func(string) ([]string, error) {
+	return func(addrStr string) ([]string, error) {
+		addr, err := address.NewFromString(addrStr)
+		if err != nil {
+			return nil, fmt.Errorf("miner address is invalid: %s", err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+		c, cls, err := cb(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("creating lotus client: %s", err)
+		}
+		defer cls()
+		mi, err := c.StateMinerInfo(ctx, addr, types.EmptyTSK)
+		if err != nil {
+			return nil, fmt.Errorf("getting miner %s info: %s", addr, err)
+		}
+		return []string{mi.Owner.String(), mi.Worker.String()}, nil
+	}
+}
+
+// distanceKm returns the great-circle distance between two locations
+// using the haversine formula.
+func distanceKm(a, b miner.Location) float64 {
+	lat1, lat2 := toRadians(a.Latitude), toRadians(b.Latitude)
+	dLat := lat2 - lat1
+	dLon := toRadians(b.Longitude - a.Longitude)
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLon/2), 2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+func shareAny(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func remove(l []string, s string) []string {
+	res := l[:0]
+	for _, e := range l {
+		if e != s {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+func contains(l []string, s string) bool {
+	for _, e := range l {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,191 @@
+package diversity
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/minerselector/fixed"
+	"github.com/textileio/powergate/v2/index/miner"
+)
+
+var (
+	locations = map[string]miner.Location{
+		"f01": {Country: "US", Latitude: 40.71, Longitude: -74.00},  // New York
+		"f02": {Country: "US", Latitude: 40.73, Longitude: -73.93},  // Brooklyn
+		"f03": {Country: "CA", Latitude: 43.65, Longitude: -79.38},  // Toronto
+		"f04": {Country: "DE", Latitude: 52.52, Longitude: 13.40},   // Berlin
+		"f05": {Country: "FR", Latitude: 48.85, Longitude: 2.35},    // Paris
+		"f06": {Country: "JP", Latitude: 35.68, Longitude: 139.69},  // Tokyo
+		"f07": {Country: "AU", Latitude: -33.86, Longitude: 151.20}, // Sydney
+	}
+	operators = map[string][]string{
+		"f01": {"owner1", "worker1"},
+		"f02": {"owner1", "worker2"},
+		"f03": {"owner3", "worker3"},
+		"f04": {"owner4", "worker1"},
+		"f05": {"owner5", "worker5"},
+		"f06": {"owner6", "worker6"},
+		"f07": {"owner7", "worker7"},
+	}
+)
+
+func TestNoConstraints(t *testing.T) {
+	t.Parallel()
+	dms := newTestSelector("f01", "f02", "f03")
+	mps, err := dms.GetMiners(3, ffs.MinerSelectorFilter{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01", "f02", "f03"}, addrs(mps))
+}
+
+func TestMaxPerCountry(t *testing.T) {
+	t.Parallel()
+	dms := newTestSelector("f01", "f02", "f03", "f04")
+	f := ffs.MinerSelectorFilter{Diversity: ffs.FilDiversity{MaxPerCountry: 1}}
+	mps, err := dms.GetMiners(3, f)
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01", "f03", "f04"}, addrs(mps))
+}
+
+func TestMaxPerContinent(t *testing.T) {
+	t.Parallel()
+	dms := newTestSelector("f01", "f02", "f03", "f04", "f05", "f06")
+	f := ffs.MinerSelectorFilter{Diversity: ffs.FilDiversity{MaxPerContinent: 1}}
+	mps, err := dms.GetMiners(3, f)
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01", "f04", "f06"}, addrs(mps))
+}
+
+func TestMinDistance(t *testing.T) {
+	t.Parallel()
+	dms := newTestSelector("f01", "f02", "f03", "f04", "f05")
+	f := ffs.MinerSelectorFilter{Diversity: ffs.FilDiversity{MinDistanceKm: 1000}}
+	mps, err := dms.GetMiners(2, f)
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01", "f04"}, addrs(mps))
+
+	f.Diversity.MinDistanceKm = 500
+	mps, err = dms.GetMiners(4, f)
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01", "f03", "f04", "f05"}, addrs(mps))
+}
+
+func TestDistinctOperators(t *testing.T) {
+	t.Parallel()
+	dms := newTestSelector("f01", "f02", "f03", "f04")
+	f := ffs.MinerSelectorFilter{Diversity: ffs.FilDiversity{DistinctOperators: true}}
+	mps, err := dms.GetMiners(2, f)
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01", "f03"}, addrs(mps))
+}
+
+func TestCurrentMiners(t *testing.T) {
+	t.Parallel()
+	dms := newTestSelector("f01", "f02", "f03", "f04", "f05")
+	f := ffs.MinerSelectorFilter{
+		Diversity:     ffs.FilDiversity{MaxPerContinent: 1},
+		CurrentMiners: []string{"f04"},
+	}
+	mps, err := dms.GetMiners(1, f)
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01"}, addrs(mps))
+}
+
+func TestTrustedMinersRejected(t *testing.T) {
+	t.Parallel()
+	dms := newTestSelector("f01", "f02", "f03")
+	f := ffs.MinerSelectorFilter{
+		Diversity:     ffs.FilDiversity{MaxPerCountry: 1},
+		TrustedMiners: []string{"f01", "f02"},
+	}
+	_, err := dms.GetMiners(2, f)
+	require.Error(t, err)
+
+	// A renewal trusting the renewed miner shouldn't fall back
+	// to other miners.
+	dms = newTestSelector("f99", "f01")
+	f = ffs.MinerSelectorFilter{
+		Diversity:     ffs.FilDiversity{MaxPerCountry: 1},
+		TrustedMiners: []string{"f99"},
+	}
+	_, err = dms.GetMiners(1, f)
+	require.Error(t, err)
+}
+
+func TestTrustedMinersSelected(t *testing.T) {
+	t.Parallel()
+	dms := newTestSelector("f01", "f02", "f03")
+	f := ffs.MinerSelectorFilter{
+		Diversity:     ffs.FilDiversity{MaxPerCountry: 1},
+		TrustedMiners: []string{"f01"},
+	}
+	var excluded []string
+	f.OnExclusion = func(e ffs.MinerExclusion) { excluded = append(excluded, e.Miner) }
+	mps, err := dms.GetMiners(2, f)
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01", "f03"}, addrs(mps))
+	require.Equal(t, []string{"f02"}, excluded)
+}
+
+func TestUnknownLocation(t *testing.T) {
+	t.Parallel()
+	dms := newTestSelector("f01", "f99", "f03")
+	f := ffs.MinerSelectorFilter{Diversity: ffs.FilDiversity{MaxPerCountry: 2}}
+	mps, err := dms.GetMiners(2, f)
+	require.NoError(t, err)
+	require.Equal(t, []string{"f01", "f03"}, addrs(mps))
+}
+
+func TestNotEnoughMiners(t *testing.T) {
+	t.Parallel()
+	dms := newTestSelector("f01", "f02")
+	f := ffs.MinerSelectorFilter{Diversity: ffs.FilDiversity{MaxPerCountry: 1}}
+	_, err := dms.GetMiners(2, f)
+	require.Error(t, err)
+}
+
+func TestDistance(t *testing.T) {
+	t.Parallel()
+	d := distanceKm(locations["f04"], locations["f05"])
+	require.InDelta(t, 878, d, 5)
+}
+
+func newTestSelector(addrs ...string) *MinerSelector {
+	miners := make([]fixed.Miner, len(addrs))
+	for i, a := range addrs {
+		miners[i] = fixed.Miner{Addr: a, Country: locations[a].Country, EpochPrice: 100}
+	}
+	resolve := func(addr string) ([]string, error) {
+		ops, ok := operators[addr]
+		if !ok {
+			return nil, fmt.Errorf("unknown miner %s", addr)
+		}
+		return ops, nil
+	}
+	return newMinerSelector(fixed.New(miners), &minerIndex{}, resolve)
+}
+
+func addrs(mps []ffs.MinerProposal) []string {
+	res := make([]string, len(mps))
+	for i, mp := range mps {
+		res[i] = mp.Addr
+	}
+	return res
+}
+
+type minerIndex struct{}
+
+func (mi *minerIndex) Get() miner.IndexSnapshot {
+	info := make(map[string]miner.Meta, len(locations))
+	for addr, l := range locations {
+		info[addr] = miner.Meta{Location: l}
+	}
+	return miner.IndexSnapshot{Meta: miner.MetaIndex{Info: info}}
+}
+
+func (mi *minerIndex) Listen() <-chan struct{} {
+	return make(chan struct{})
+}
+
+func (mi *minerIndex) Unregister(c chan struct{}) {}
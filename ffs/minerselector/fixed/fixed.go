@@ -33,6 +33,15 @@ func New(miners []Miner) *MinerSelector {
 
 // GetMiners returns the single allowed miner in the selector.
 func (fms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.MinerProposal, error) {
+	res := fms.GetMinersAtMost(n, f)
+	if len(res) != n {
+		return nil, fmt.Errorf("not enough fixed miners to provide, want %d, got %d", n, len(res))
+	}
+	return res, nil
+}
+
+// GetMinersAtMost returns up to n miners satisfying the filter.
+func (fms *MinerSelector) GetMinersAtMost(n int, f ffs.MinerSelectorFilter) []ffs.MinerProposal {
 	res := make([]ffs.MinerProposal, 0, n)
 	mres := make(map[string]struct{})
 	for _, pm := range f.TrustedMiners {
@@ -50,7 +59,7 @@ func (fms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.Min
 			}
 		}
 		if len(res) == n {
-			return res, nil
+			return res
 		}
 	}
 
@@ -91,8 +100,5 @@ func (fms *MinerSelector) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.Min
 			break
 		}
 	}
-	if len(res) != n {
-		return nil, fmt.Errorf("not enough fixed miners to provide, want %d, got %d", n, len(res))
-	}
-	return res, nil
+	return res
 }
@@ -0,0 +1,156 @@
+package reptop
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/index/ask"
+	"github.com/textileio/powergate/v2/reputation"
+)
+
+const (
+	maxReportedExclusions = 20
+)
+
+// Exclusion describes why a candidate miner wasn't selected.
+type Exclusion struct {
+	Addr   string
+	Reason string
+}
+
+// Exclusions is a list of excluded candidates.
+type Exclusions []Exclusion
+
+// String returns a human-friendly description of the exclusions. Only the
+// first maxReportedExclusions are described to keep it readable.
+func (e Exclusions) String() string {
+	if len(e) == 0 {
+		return "no excluded candidates"
+	}
+	reasons := make([]string, 0, maxReportedExclusions+1)
+	for i, ex := range e {
+		if i == maxReportedExclusions {
+			reasons = append(reasons, fmt.Sprintf("and %d more", len(e)-maxReportedExclusions))
+			break
+		}
+		reasons = append(reasons, fmt.Sprintf("%s: %s", ex.Addr, ex.Reason))
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// report notifies the exclusions to the filter exclusion callback, if any.
+func (e Exclusions) report(f ffs.MinerSelectorFilter) {
+	if f.OnExclusion == nil {
+		return
+	}
+	for _, ex := range e {
+		f.OnExclusion(ffs.MinerExclusion{Miner: ex.Addr, Reason: ex.Reason})
+	}
+}
+
+type costCandidate struct {
+	addr  string
+	score int
+	price uint64
+}
+
+// genFromCost returns the n cheapest miners satisfying the filter that have
+// at least the configured minimum reputation score. Asks are taken from the
+// Ask Index, and confirmed with a fresh query-ask before being proposed.
+func (rt *RepTop) genFromCost(f ffs.MinerSelectorFilter, n int) ([]ffs.MinerProposal, error) {
+	if n == 0 {
+		return nil, nil
+	}
+	excluded := append(append([]string{}, f.ExcludedMiners...), f.TrustedMiners...)
+	ms, err := rt.rm.QueryMiners(excluded, f.CountryCodes, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting miners from reputation module: %s", err)
+	}
+	height, err := rt.chainHeight()
+	if err != nil {
+		return nil, fmt.Errorf("getting current chain height: %s", err)
+	}
+
+	cands, exclusions := rankByCost(ms, rt.ai.Get().Storage, height, f, rt.minScore)
+	res := make([]ffs.MinerProposal, 0, n)
+	for _, c := range cands {
+		if len(res) == n {
+			break
+		}
+		mp, err := rt.getMinerProposal(f, c.addr)
+		if err != nil {
+			exclusions = append(exclusions, Exclusion{Addr: c.addr, Reason: err.Error()})
+			continue
+		}
+		res = append(res, mp)
+	}
+	exclusions.report(f)
+	if len(res) < n {
+		return nil, fmt.Errorf("not enough miners satisfy the miner selector constraints, want %d, got %d: %s", n, len(res), exclusions)
+	}
+	log.Debugf("cost selection excluded candidates: %s", exclusions)
+
+	return res, nil
+}
+
+// rankByCost returns the candidates which satisfy the filter constraints
+// sorted by ascending price, with ties broken by reputation score. Candidates
+// that don't satisfy the constraints are returned with the exclusion reason.
+// Since every replica is paid independently, taking the first n candidates
+// minimizes the total cost of n replicas.
+func rankByCost(ms []reputation.MinerScore, asks map[string]ask.StorageAsk, height int64, f ffs.MinerSelectorFilter, minScore int) ([]costCandidate, Exclusions) {
+	var cands []costCandidate
+	var exclusions Exclusions
+	for _, m := range ms {
+		if m.Score < minScore {
+			exclusions = append(exclusions, Exclusion{Addr: m.Addr, Reason: fmt.Sprintf("reputation score is below minimum: %d<%d", m.Score, minScore)})
+			continue
+		}
+		a, ok := asks[m.Addr]
+		if !ok {
+			exclusions = append(exclusions, Exclusion{Addr: m.Addr, Reason: "no ask in the ask index"})
+			continue
+		}
+		if a.Expiry <= height {
+			exclusions = append(exclusions, Exclusion{Addr: m.Addr, Reason: fmt.Sprintf("ask expired at epoch %d", a.Expiry)})
+			continue
+		}
+		if f.PieceSize < a.MinPieceSize || f.PieceSize > a.MaxPieceSize {
+			exclusions = append(exclusions, Exclusion{Addr: m.Addr, Reason: fmt.Sprintf("piece size out of bounds: %d<%d<%d", a.MinPieceSize, f.PieceSize, a.MaxPieceSize)})
+			continue
+		}
+		price := a.Price
+		if f.VerifiedDeal {
+			price = a.VerifiedPrice
+		}
+		if f.MaxPrice > 0 && price > f.MaxPrice {
+			exclusions = append(exclusions, Exclusion{Addr: m.Addr, Reason: fmt.Sprintf("price is above max price: %d>%d", price, f.MaxPrice)})
+			continue
+		}
+		cands = append(cands, costCandidate{addr: m.Addr, score: m.Score, price: price})
+	}
+	sort.SliceStable(cands, func(i, j int) bool {
+		if cands[i].price != cands[j].price {
+			return cands[i].price < cands[j].price
+		}
+		return cands[i].score > cands[j].score
+	})
+
+	return cands, exclusions
+}
+
+func (rt *RepTop) chainHeight() (int64, error) {
+	c, cls, err := rt.cb(context.Background())
+	if err != nil {
+		return 0, fmt.Errorf("creating lotus client: %s", err)
+	}
+	defer cls()
+	h, err := c.ChainHead(context.Background())
+	if err != nil {
+		return 0, fmt.Errorf("getting chain head: %s", err)
+	}
+	return int64(h.Height()), nil
+}
@@ -0,0 +1,99 @@
+package reptop
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/index/ask"
+	"github.com/textileio/powergate/v2/reputation"
+)
+
+var (
+	scores = []reputation.MinerScore{
+		{Addr: "f01", Score: 90},
+		{Addr: "f02", Score: 80},
+		{Addr: "f03", Score: 70},
+		{Addr: "f04", Score: 60},
+		{Addr: "f05", Score: 50},
+		{Addr: "f06", Score: 40},
+		{Addr: "f07", Score: 30},
+	}
+	asks = map[string]ask.StorageAsk{
+		"f01": {Miner: "f01", Price: 300, VerifiedPrice: 30, MinPieceSize: 256, MaxPieceSize: 1 << 30, Expiry: 1000},
+		"f02": {Miner: "f02", Price: 100, VerifiedPrice: 0, MinPieceSize: 256, MaxPieceSize: 1 << 30, Expiry: 1000},
+		"f03": {Miner: "f03", Price: 100, VerifiedPrice: 10, MinPieceSize: 256, MaxPieceSize: 1 << 30, Expiry: 1000},
+		"f04": {Miner: "f04", Price: 50, VerifiedPrice: 0, MinPieceSize: 256, MaxPieceSize: 1 << 30, Expiry: 10},
+		"f05": {Miner: "f05", Price: 10, VerifiedPrice: 0, MinPieceSize: 1 << 31, MaxPieceSize: 1 << 32, Expiry: 1000},
+		"f06": {Miner: "f06", Price: 200, VerifiedPrice: 20, MinPieceSize: 256, MaxPieceSize: 1 << 30, Expiry: 1000},
+	}
+)
+
+func TestRankByCost(t *testing.T) {
+	t.Parallel()
+	f := ffs.MinerSelectorFilter{PieceSize: 1 << 20}
+	cands, exclusions := rankByCost(scores, asks, 100, f, 0)
+	require.Equal(t, []string{"f02", "f03", "f06", "f01"}, candAddrs(cands))
+	require.Equal(t, []string{"f04", "f05", "f07"}, exclAddrs(exclusions))
+	require.Contains(t, exclusions[0].Reason, "expired")
+	require.Contains(t, exclusions[1].Reason, "piece size out of bounds")
+	require.Contains(t, exclusions[2].Reason, "no ask")
+}
+
+func TestRankByCostMinScore(t *testing.T) {
+	t.Parallel()
+	f := ffs.MinerSelectorFilter{PieceSize: 1 << 20}
+	cands, exclusions := rankByCost(scores, asks, 100, f, 75)
+	require.Equal(t, []string{"f02", "f01"}, candAddrs(cands))
+	require.Contains(t, exclusions[0].Reason, "reputation score is below minimum")
+}
+
+func TestRankByCostVerifiedAndMaxPrice(t *testing.T) {
+	t.Parallel()
+	f := ffs.MinerSelectorFilter{PieceSize: 1 << 20, VerifiedDeal: true, MaxPrice: 25}
+	cands, exclusions := rankByCost(scores, asks, 100, f, 0)
+	require.Equal(t, []string{"f02", "f03", "f06"}, candAddrs(cands))
+	require.Equal(t, uint64(0), cands[0].price)
+	require.Contains(t, exclusions[0].Reason, "price is above max price")
+}
+
+func TestExclusionsString(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, "no excluded candidates", Exclusions{}.String())
+
+	var e Exclusions
+	for i := 0; i < maxReportedExclusions+3; i++ {
+		e = append(e, Exclusion{Addr: "f01", Reason: "reason"})
+	}
+	s := e.String()
+	require.Equal(t, maxReportedExclusions, strings.Count(s, "f01: reason"))
+	require.True(t, strings.HasSuffix(s, "and 3 more"))
+}
+
+func TestExclusionsReport(t *testing.T) {
+	t.Parallel()
+	e := Exclusions{{Addr: "f01", Reason: "reason1"}, {Addr: "f02", Reason: "reason2"}}
+	e.report(ffs.MinerSelectorFilter{})
+
+	var reported []ffs.MinerExclusion
+	f := ffs.MinerSelectorFilter{OnExclusion: func(me ffs.MinerExclusion) { reported = append(reported, me) }}
+	e.report(f)
+	require.Equal(t, []ffs.MinerExclusion{{Miner: "f01", Reason: "reason1"}, {Miner: "f02", Reason: "reason2"}}, reported)
+}
+
+func candAddrs(cands []costCandidate) []string {
+	res := make([]string, len(cands))
+	for i, c := range cands {
+		res[i] = c.addr
+	}
+	return res
+}
+
+func exclAddrs(e Exclusions) []string {
+	res := make([]string, len(e))
+	for i, ex := range e {
+		res[i] = ex.Addr
+	}
+	return res
+}
@@ -25,18 +25,39 @@ type RepTop struct {
 	rm *reputation.Module
 	ai *askRunner.Runner
 	cb lotus.ClientBuilder
+
+	costOptimized bool
+	minScore      int
+}
+
+// Option configures a RepTop instance.
+type Option func(*RepTop)
+
+// WithCostOptimization makes the selection minimize the total cost of the
+// proposed miners instead of maximizing reputation. Only miners with at
+// least minScore reputation score, a non-expired ask and piece size bounds
+// compatible with the data are considered.
+func WithCostOptimization(minScore int) Option {
+	return func(rt *RepTop) {
+		rt.costOptimized = true
+		rt.minScore = minScore
+	}
 }
 
 var _ ffs.MinerSelector = (*RepTop)(nil)
 
 // New returns a new RetTop instance that uses the specified Reputation Module
 // to select miners and the AskIndex for their epoch prices.
-func New(cb lotus.ClientBuilder, rm *reputation.Module, ai *askRunner.Runner) *RepTop {
-	return &RepTop{
+func New(cb lotus.ClientBuilder, rm *reputation.Module, ai *askRunner.Runner, opts ...Option) *RepTop {
+	rt := &RepTop{
 		rm: rm,
 		ai: ai,
 		cb: cb,
 	}
+	for _, opt := range opts {
+		opt(rt)
+	}
+	return rt
 }
 
 // GetMiners returns n miners using the configured Reputation Module and
@@ -52,8 +73,13 @@ func (rt *RepTop) GetMiners(n int, f ffs.MinerSelectorFilter) ([]ffs.MinerPropos
 	// power, that's a risk that the client is accepting.
 	trustedMiners := rt.genTrustedMiners(f, n)
 
-	// The remaining needed miners are gathered from the reputation index.
-	reputationMiners, err := rt.genFromReputation(f, n-len(trustedMiners))
+	// The remaining needed miners are gathered from the reputation index,
+	// or from the cheapest reputable asks if optimizing for cost.
+	gen := rt.genFromReputation
+	if rt.costOptimized {
+		gen = rt.genFromCost
+	}
+	reputationMiners, err := gen(f, n-len(trustedMiners))
 	if err != nil {
 		return nil, fmt.Errorf("getting miners from reputation module: %s", err)
 	}
@@ -68,6 +94,7 @@ func (rt *RepTop) genTrustedMiners(f ffs.MinerSelectorFilter, n int) []ffs.Miner
 		mp, err := rt.getMinerProposal(f, m)
 		if err != nil {
 			log.Warnf("trusted miner %s query asking: %s", m, err)
+			Exclusions{{Addr: m, Reason: fmt.Sprintf("query asking: %s", err)}}.report(f)
 			continue
 		}
 		ret = append(ret, mp)
@@ -83,7 +110,8 @@ func (rt *RepTop) genFromReputation(f ffs.MinerSelectorFilter, n int) ([]ffs.Min
 	if n == 0 {
 		return nil, nil
 	}
-	ms, err := rt.rm.QueryMiners(f.TrustedMiners, f.CountryCodes, nil)
+	excluded := append(append([]string{}, f.ExcludedMiners...), f.TrustedMiners...)
+	ms, err := rt.rm.QueryMiners(excluded, f.CountryCodes, nil)
 	if err != nil {
 		return nil, fmt.Errorf("getting miners from reputation module: %s", err)
 	}
@@ -97,8 +125,9 @@ func (rt *RepTop) genFromReputation(f ffs.MinerSelectorFilter, n int) ([]ffs.Min
 	for _, m := range ms {
 		mp, err := rt.getMinerProposal(f, m.Addr)
 		if err != nil {
+			Exclusions{{Addr: m.Addr, Reason: fmt.Sprintf("query asking: %s", err)}}.report(f)
 			if len(minerErrors) < maxMinerErrors {
-				minerErrors = append(minerErrors, err)
+				minerErrors = append(minerErrors, fmt.Errorf("%s: %s", m.Addr, err))
 			} else if len(minerErrors) == maxMinerErrors {
 				minerErrors = append(minerErrors, fmt.Errorf("and more ... "))
 			}
@@ -165,7 +194,7 @@ func (rt *RepTop) getMinerProposal(f ffs.MinerSelectorFilter, addrStr string) (f
 			price = r.Ask.VerifiedPrice.Uint64()
 		}
 		if f.MaxPrice > 0 && price > f.MaxPrice {
-			return ffs.MinerProposal{}, fmt.Errorf("miner's price doesn't satisfy price constraints: %d>%d", r.Ask.Price, f.MaxPrice)
+			return ffs.MinerProposal{}, fmt.Errorf("miner's price doesn't satisfy price constraints: %d>%d", price, f.MaxPrice)
 		}
 		if f.PieceSize < uint64(r.Ask.MinPieceSize) || f.PieceSize > uint64(r.Ask.MaxPieceSize) {
 			return ffs.MinerProposal{}, fmt.Errorf("miner doesn't satisfy piece size constraints: %d<%d<%d", r.Ask.MinPieceSize, f.PieceSize, r.Ask.MaxPieceSize)
@@ -25,6 +25,23 @@ type StorageAction struct {
 	Cid         cid.Cid
 	Cfg         ffs.StorageConfig
 	ReplacedCid cid.Cid
+	// Rehydrate indicates that data evicted from the
+	// Hot Storage cache should be unfrozen.
+	Rehydrate bool
+	// Verify indicates that the Job verifies the stored
+	// data instead of executing Cfg.
+	Verify *ffs.VerifyOptions
+	// RemoveDeals indicates that the Job removes the deals with
+	// these IDs, which were audited as inactive, from the stored
+	// data instead of executing Cfg.
+	RemoveDeals []uint64
+	// Evict indicates that the Job evicts the cached data from
+	// Hot Storage instead of executing Cfg.
+	Evict bool
+	// Lost indicates that the data was lost from Hot Storage,
+	// and the Job unfreezes it if the current storage config
+	// allows it, instead of executing Cfg.
+	Lost bool
 }
 
 // RetrievalAction contains information necessary to execute a
@@ -0,0 +1,121 @@
+package auditstore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/util"
+)
+
+var (
+	log = logging.Logger("ffs-sched-auditstore")
+
+	// ErrNotFound indicates there isn't an audit report for the Cid.
+	ErrNotFound = errors.New("audit report not found")
+)
+
+// Store stores the last audit report of stored Cids.
+type Store struct {
+	ds datastore.Datastore
+}
+
+// New returns a new audit report store.
+func New(ds datastore.Datastore) *Store {
+	return &Store{
+		ds: ds,
+	}
+}
+
+// Get returns the last audit report of a Cid.
+func (s *Store) Get(iid ffs.APIID, c cid.Cid) (ffs.AuditReport, error) {
+	var ar ffs.AuditReport
+	buf, err := s.ds.Get(makeKey(iid, c))
+	if err == datastore.ErrNotFound {
+		return ar, ErrNotFound
+	}
+	if err != nil {
+		return ar, fmt.Errorf("getting audit report from datastore: %s", err)
+	}
+	if err := json.Unmarshal(buf, &ar); err != nil {
+		return ar, fmt.Errorf("unmarshaling audit report from datastore: %s", err)
+	}
+	return ar, nil
+}
+
+// List returns the last audit reports of Cids, filtered by APIIDs and
+// Cids if provided.
+func (s *Store) List(iids []ffs.APIID, cids []cid.Cid) ([]ffs.AuditReport, error) {
+	iidFilter := make(map[ffs.APIID]struct{}, len(iids))
+	for _, iid := range iids {
+		iidFilter[iid] = struct{}{}
+	}
+	cidFilter := make(map[cid.Cid]struct{}, len(cids))
+	for _, c := range cids {
+		cidFilter[c] = struct{}{}
+	}
+
+	res, err := s.ds.Query(query.Query{})
+	if err != nil {
+		return nil, fmt.Errorf("querying audit reports: %s", err)
+	}
+	defer func() {
+		if err := res.Close(); err != nil {
+			log.Errorf("closing query result: %s", err)
+		}
+	}()
+	var ret []ffs.AuditReport
+	for r := range res.Next() {
+		if r.Error != nil {
+			return nil, fmt.Errorf("iter next: %s", r.Error)
+		}
+		var ar ffs.AuditReport
+		if err := json.Unmarshal(r.Value, &ar); err != nil {
+			return nil, fmt.Errorf("unmarshaling query result: %s", err)
+		}
+		if len(iidFilter) > 0 {
+			if _, ok := iidFilter[ar.APIID]; !ok {
+				continue
+			}
+		}
+		if len(cidFilter) > 0 {
+			if _, ok := cidFilter[ar.Cid]; !ok {
+				continue
+			}
+		}
+		ret = append(ret, ar)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		// Descending.
+		return ret[i].Time.After(ret[j].Time)
+	})
+	return ret, nil
+}
+
+// Put saves the last audit report of a Cid.
+func (s *Store) Put(ar ffs.AuditReport) error {
+	if !ar.APIID.Valid() {
+		return fmt.Errorf("instance id is invalid")
+	}
+	if !ar.Cid.Defined() {
+		return fmt.Errorf("cid can't be undefined")
+	}
+	buf, err := json.Marshal(ar)
+	if err != nil {
+		return fmt.Errorf("marshaling audit report for datastore: %s", err)
+	}
+	if err := s.ds.Put(makeKey(ar.APIID, ar.Cid), buf); err != nil {
+		return fmt.Errorf("putting audit report in datastore: %s", err)
+	}
+	return nil
+}
+
+func makeKey(iid ffs.APIID, c cid.Cid) datastore.Key {
+	return datastore.NewKey(iid.String()).ChildString(util.CidToString(c))
+}
@@ -0,0 +1,71 @@
+package auditstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/tests"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func TestGetPut(t *testing.T) {
+	t.Parallel()
+	s := New(tests.NewTxMapDatastore())
+	iid := ffs.NewAPIID()
+	c, _ := util.CidFromString("QmWATWQ7fVPP2EFGu71UkfnqhYXDYH566qy47CnJDgvs81")
+
+	_, err := s.Get(iid, c)
+	require.Equal(t, ErrNotFound, err)
+
+	ar := ffs.AuditReport{
+		APIID:        iid,
+		Cid:          c,
+		Time:         time.Now().Round(0),
+		Height:       100,
+		AuditedDeals: 2,
+		ActiveDeals:  1,
+		Discrepancies: []ffs.AuditDiscrepancy{
+			{Type: ffs.AuditDealNotActive, DealID: 10, Miner: "f01000", Message: "not active"},
+		},
+	}
+	require.NoError(t, s.Put(ar))
+
+	got, err := s.Get(iid, c)
+	require.NoError(t, err)
+	require.True(t, ar.Time.Equal(got.Time))
+	got.Time = ar.Time
+	require.Equal(t, ar, got)
+	require.False(t, got.Healthy())
+}
+
+func TestList(t *testing.T) {
+	t.Parallel()
+	s := New(tests.NewTxMapDatastore())
+	iid1, iid2 := ffs.NewAPIID(), ffs.NewAPIID()
+	c1, _ := util.CidFromString("QmWATWQ7fVPP2EFGu71UkfnqhYXDYH566qy47CnJDgvs81")
+	c2, _ := util.CidFromString("QmWATWQ7fVPP2EFGu71UkfnqhYXDYH566qy47CnJDgvs82")
+
+	now := time.Now()
+	require.NoError(t, s.Put(ffs.AuditReport{APIID: iid1, Cid: c1, Time: now.Add(-time.Hour)}))
+	require.NoError(t, s.Put(ffs.AuditReport{APIID: iid1, Cid: c2, Time: now}))
+	require.NoError(t, s.Put(ffs.AuditReport{APIID: iid2, Cid: c1, Time: now.Add(-time.Minute)}))
+
+	all, err := s.List(nil, nil)
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	require.Equal(t, c2, all[0].Cid)
+	require.Equal(t, iid2, all[1].APIID)
+
+	byIID, err := s.List([]ffs.APIID{iid1}, nil)
+	require.NoError(t, err)
+	require.Len(t, byIID, 2)
+
+	byBoth, err := s.List([]ffs.APIID{iid1}, []cid.Cid{c1})
+	require.NoError(t, err)
+	require.Len(t, byBoth, 1)
+	require.Equal(t, iid1, byBoth[0].APIID)
+	require.Equal(t, c1, byBoth[0].Cid)
+}
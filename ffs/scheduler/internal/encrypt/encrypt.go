@@ -0,0 +1,161 @@
+package encrypt
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ipfs/go-cid"
+)
+
+const (
+	// version is the first byte of encrypted data, to allow
+	// future changes in the format.
+	version = 1
+	// chunkSize is the size of plaintext chunks, which are
+	// sealed independently to encrypt data as a stream.
+	chunkSize = 64 << 10
+)
+
+var (
+	dataKeyInfo = []byte("powergate cold storage encryption")
+
+	// ErrInvalidData indicates that encrypted data is corrupted, or
+	// wasn't encrypted with the provided key.
+	ErrInvalidData = errors.New("invalid encrypted data")
+)
+
+// DataKey returns the key which encrypts the data of c, derived from the
+// key of its owner. Since each key only encrypts the data of a single Cid,
+// encryption is deterministic, so re-encrypting data results in the same Cid.
+func DataKey(key []byte, c cid.Cid) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(dataKeyInfo)
+	_, _ = mac.Write(c.Bytes())
+	return mac.Sum(nil)
+}
+
+// NewEncryptReader returns a reader of the data of r encrypted with key.
+// Data is split in chunks sealed with AES-256-GCM, where the nonce of each
+// chunk is its position, and flags the last chunk to detect truncations.
+func NewEncryptReader(r io.Reader, key []byte) (io.Reader, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &reader{
+		src:    bufio.NewReaderSize(r, chunkSize+1),
+		aead:   aead,
+		in:     make([]byte, chunkSize),
+		header: []byte{version},
+		seal:   true,
+	}, nil
+}
+
+// NewDecryptReader returns a reader of the data of r decrypted with key.
+// It returns ErrInvalidData if the data doesn't authenticate.
+func NewDecryptReader(r io.Reader, key []byte) (io.Reader, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &reader{
+		src:  bufio.NewReaderSize(r, chunkSize+aead.Overhead()+1),
+		aead: aead,
+		in:   make([]byte, chunkSize+aead.Overhead()),
+	}, nil
+}
+
+type reader struct {
+	src  *bufio.Reader
+	aead cipher.AEAD
+	seal bool
+
+	in     []byte
+	buf    []byte
+	out    []byte
+	header []byte
+	chunk  uint64
+	done   bool
+	err    error
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	if len(r.header) > 0 {
+		n := copy(p, r.header)
+		r.header = r.header[n:]
+		return n, nil
+	}
+	for len(r.out) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		if r.done {
+			return 0, io.EOF
+		}
+		r.err = r.next()
+	}
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
+
+// next seals or opens the next chunk.
+func (r *reader) next() error {
+	if !r.seal && r.chunk == 0 {
+		v, err := r.src.ReadByte()
+		if err != nil || v != version {
+			return ErrInvalidData
+		}
+	}
+	n, err := io.ReadFull(r.src, r.in)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return fmt.Errorf("reading data: %s", err)
+	}
+	last := err != nil
+	if !last {
+		if _, err := r.src.Peek(1); err == io.EOF {
+			last = true
+		} else if err != nil {
+			return fmt.Errorf("reading data: %s", err)
+		}
+	}
+
+	nonce := make([]byte, r.aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce, r.chunk)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	r.chunk++
+	r.done = last
+	if r.seal {
+		r.buf = r.aead.Seal(r.buf[:0], nonce, r.in[:n], nil)
+		r.out = r.buf
+		return nil
+	}
+	buf, err := r.aead.Open(r.buf[:0], nonce, r.in[:n], nil)
+	if err != nil {
+		return ErrInvalidData
+	}
+	r.buf = buf
+	r.out = r.buf
+	return nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("creating cipher: %s", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("creating gcm: %s", err)
+	}
+	return aead, nil
+}
@@ -0,0 +1,89 @@
+package encrypt
+
+import (
+	"bytes"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(22))
+	key := make([]byte, 32)
+	_, _ = r.Read(key)
+
+	sizes := []int{0, 1, chunkSize - 1, chunkSize, chunkSize + 1, 3*chunkSize + 5}
+	for _, size := range sizes {
+		data := make([]byte, size)
+		_, _ = r.Read(data)
+
+		enc := encrypt(t, data, key)
+		require.NotEqual(t, data, enc)
+		require.Equal(t, enc, encrypt(t, data, key))
+
+		dr, err := NewDecryptReader(bytes.NewReader(enc), key)
+		require.NoError(t, err)
+		dec, err := ioutil.ReadAll(dr)
+		require.NoError(t, err)
+		require.Equal(t, len(data), len(dec))
+		require.True(t, bytes.Equal(data, dec))
+	}
+}
+
+func TestDecryptInvalid(t *testing.T) {
+	t.Parallel()
+	r := rand.New(rand.NewSource(22))
+	key := make([]byte, 32)
+	_, _ = r.Read(key)
+	data := make([]byte, 2*chunkSize+10)
+	_, _ = r.Read(data)
+	enc := encrypt(t, data, key)
+
+	otherKey := make([]byte, 32)
+	_, _ = r.Read(otherKey)
+	tampered := append([]byte{}, enc...)
+	tampered[100] ^= 1
+
+	cases := map[string]struct {
+		data []byte
+		key  []byte
+	}{
+		"empty":     {data: nil, key: key},
+		"wrong key": {data: enc, key: otherKey},
+		"tampered":  {data: tampered, key: key},
+		"truncated": {data: enc[:1+chunkSize+16], key: key},
+	}
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			dr, err := NewDecryptReader(bytes.NewReader(tc.data), tc.key)
+			require.NoError(t, err)
+			_, err = ioutil.ReadAll(dr)
+			require.Equal(t, ErrInvalidData, err)
+		})
+	}
+}
+
+func TestDataKey(t *testing.T) {
+	t.Parallel()
+	c1, _ := util.CidFromString("QmY7gN6AfKSoR7DNEjcUyXRYS85giD1YXN62cWVzS5zfus")
+	c2, _ := util.CidFromString("QmZTR5bcpQD7cFgTorqxZDYaew1Wqgfbd2ud9QqGPAkK2V")
+	key := []byte("user key")
+
+	require.Len(t, DataKey(key, c1), 32)
+	require.Equal(t, DataKey(key, c1), DataKey(key, c1))
+	require.NotEqual(t, DataKey(key, c1), DataKey(key, c2))
+	require.NotEqual(t, DataKey(key, c1), DataKey([]byte("other key"), c1))
+}
+
+func encrypt(t *testing.T, data, key []byte) []byte {
+	er, err := NewEncryptReader(bytes.NewReader(data), key)
+	require.NoError(t, err)
+	enc, err := ioutil.ReadAll(er)
+	require.NoError(t, err)
+	return enc
+}
@@ -0,0 +1,77 @@
+package renewstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/util"
+)
+
+// Outcome is the result of the last deal renewal evaluation of a Cid.
+type Outcome struct {
+	// Time is when the evaluation finished.
+	Time time.Time
+	// Failed indicates that the evaluation couldn't be executed, or
+	// some deal renewals failed.
+	Failed bool
+	// Error describes why the evaluation failed.
+	Error string
+}
+
+// Store stores the outcome of the last deal renewal evaluation
+// of stored Cids.
+type Store struct {
+	ds datastore.Datastore
+}
+
+// New returns a new renewal outcome store.
+func New(ds datastore.Datastore) *Store {
+	return &Store{
+		ds: ds,
+	}
+}
+
+// Get returns the last renewal outcome of a Cid from iid. The second return
+// value is false if renewals weren't evaluated yet.
+func (s *Store) Get(iid ffs.APIID, c cid.Cid) (Outcome, bool, error) {
+	var o Outcome
+	buf, err := s.ds.Get(makeKey(iid, c))
+	if err == datastore.ErrNotFound {
+		return o, false, nil
+	}
+	if err != nil {
+		return o, false, fmt.Errorf("getting renewal outcome from datastore: %s", err)
+	}
+	if err := json.Unmarshal(buf, &o); err != nil {
+		return o, false, fmt.Errorf("unmarshaling renewal outcome from datastore: %s", err)
+	}
+	return o, true, nil
+}
+
+// Put saves the last renewal outcome of a Cid from iid.
+func (s *Store) Put(iid ffs.APIID, c cid.Cid, o Outcome) error {
+	buf, err := json.Marshal(o)
+	if err != nil {
+		return fmt.Errorf("marshaling renewal outcome for datastore: %s", err)
+	}
+	if err := s.ds.Put(makeKey(iid, c), buf); err != nil {
+		return fmt.Errorf("putting renewal outcome in datastore: %s", err)
+	}
+	return nil
+}
+
+// Remove removes the renewal outcome of a Cid from iid.
+func (s *Store) Remove(iid ffs.APIID, c cid.Cid) error {
+	if err := s.ds.Delete(makeKey(iid, c)); err != nil {
+		return fmt.Errorf("deleting renewal outcome from datastore: %s", err)
+	}
+	return nil
+}
+
+func makeKey(iid ffs.APIID, c cid.Cid) datastore.Key {
+	return datastore.NewKey(iid.String()).ChildString(util.CidToString(c))
+}
@@ -0,0 +1,40 @@
+package renewstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/tests"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func TestGetPutRemove(t *testing.T) {
+	t.Parallel()
+	s := New(tests.NewTxMapDatastore())
+	iid := ffs.NewAPIID()
+	c, _ := util.CidFromString("QmWATWQ7fVPP2EFGu71UkfnqhYXDYH566qy47CnJDgvs81")
+
+	_, ok, err := s.Get(iid, c)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	o := Outcome{Time: time.Now().Round(0), Failed: true, Error: "no miners available"}
+	require.NoError(t, s.Put(iid, c, o))
+	got, ok, err := s.Get(iid, c)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.True(t, o.Time.Equal(got.Time))
+	got.Time = o.Time
+	require.Equal(t, o, got)
+
+	_, ok, err = s.Get(ffs.NewAPIID(), c)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, s.Remove(iid, c))
+	_, ok, err = s.Get(iid, c)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -1,6 +1,8 @@
 package sjstore
 
 import (
+	"context"
+
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/global"
@@ -17,4 +19,11 @@ var (
 func (s *Store) initMetrics() {
 	meter := global.Meter("powergate")
 	s.metricJobCounter = metric.Must(meter).NewInt64UpDownCounter("powergate.storage.job.total")
+	_ = metric.Must(meter).NewInt64ValueObserver("powergate.storage.job.current", s.jobsValueObserver, metric.WithDescription("Current queued and executing jobs"))
+}
+
+func (s *Store) jobsValueObserver(ctx context.Context, result metric.Int64ObserverResult) {
+	stats := s.GetStats()
+	result.Observe(int64(stats.TotalQueued), attrStatusQueued)
+	result.Observe(int64(stats.TotalExecuting), attrStatusExecuting)
 }
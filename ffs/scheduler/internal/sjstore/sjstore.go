@@ -225,6 +225,21 @@ func (s *Store) Enqueue(j ffs.StorageJob) error {
 	return nil
 }
 
+// EnqueueKeep queues a new Job without canceling other queued Jobs for
+// the same Cid. It's used for maintenance Jobs, which shouldn't cancel
+// queued Jobs executing a storage config.
+func (s *Store) EnqueueKeep(j ffs.StorageJob) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	j.Status = ffs.Queued
+	if err := s.put(j, true); err != nil {
+		return fmt.Errorf("saving to datastore: %s", err)
+	}
+	s.metricJobCounter.Add(context.Background(), 1, attrStatusQueued)
+
+	return nil
+}
+
 // GetExecutingJob returns a JobID that is currently executing for
 // data with cid c in iid. If there's not such job, it returns nil.
 func (s *Store) GetExecutingJob(iid ffs.APIID, c cid.Cid) *ffs.JobID {
@@ -237,6 +252,23 @@ func (s *Store) GetExecutingJob(iid ffs.APIID, c cid.Cid) *ffs.JobID {
 	return &j.ID
 }
 
+// GetActiveJob returns the JobID of the last queued Job for data with cid c
+// in iid, or of the executing one if there aren't queued Jobs. Jobs for the
+// same data are executed in order, so when it finishes every other active Job
+// has finished. If there's not such job, it returns nil.
+func (s *Store) GetActiveJob(iid ffs.APIID, c cid.Cid) *ffs.JobID {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if queued := s.queuedJobs[iid][c]; len(queued) > 0 {
+		return &queued[len(queued)-1].ID
+	}
+	j, ok := s.executingJobs[iid][c]
+	if !ok {
+		return nil
+	}
+	return &j.ID
+}
+
 // GetStats return the current Stats for storage jobs.
 func (s *Store) GetStats() Stats {
 	s.lock.Lock()
@@ -445,6 +445,60 @@ func TestCancelation(t *testing.T) {
 	require.Equal(t, ffs.Queued, j2Queued.Status)
 }
 
+func TestEnqueueKeep(t *testing.T) {
+	t.Parallel()
+	s := create(t)
+
+	j1 := createJob(t, "apiid", cid.Undef)
+	err := s.Enqueue(j1)
+	require.NoError(t, err)
+
+	j2 := createJob(t, "apiid", j1.Cid)
+	err = s.EnqueueKeep(j2)
+	require.NoError(t, err)
+
+	j1Queued, err := s.Get(j1.ID)
+	require.NoError(t, err)
+	require.Equal(t, ffs.Queued, j1Queued.Status)
+
+	// Jobs are still executed one at a time.
+	jq, err := s.Dequeue(ffs.EmptyInstanceID)
+	require.NoError(t, err)
+	require.Equal(t, j1.ID, jq.ID)
+	jq, err = s.Dequeue(ffs.EmptyInstanceID)
+	require.NoError(t, err)
+	require.Nil(t, jq)
+}
+
+func TestGetActiveJob(t *testing.T) {
+	t.Parallel()
+	s := create(t)
+
+	j1 := createJob(t, "apiid", cid.Undef)
+	require.Nil(t, s.GetActiveJob(j1.APIID, j1.Cid))
+
+	err := s.Enqueue(j1)
+	require.NoError(t, err)
+	require.Equal(t, j1.ID, *s.GetActiveJob(j1.APIID, j1.Cid))
+	require.Nil(t, s.GetActiveJob("other", j1.Cid))
+
+	_, err = s.Dequeue(ffs.EmptyInstanceID)
+	require.NoError(t, err)
+	require.Equal(t, j1.ID, *s.GetActiveJob(j1.APIID, j1.Cid))
+
+	// The last queued Job is returned over the executing one.
+	j2 := createJob(t, "apiid", j1.Cid)
+	err = s.EnqueueKeep(j2)
+	require.NoError(t, err)
+	require.Equal(t, j2.ID, *s.GetActiveJob(j1.APIID, j1.Cid))
+
+	err = s.Finalize(j1.ID, ffs.Success, nil, nil)
+	require.NoError(t, err)
+	_, err = s.CancelQueued(j2.ID)
+	require.NoError(t, err)
+	require.Nil(t, s.GetActiveJob(j1.APIID, j1.Cid))
+}
+
 func TestStartedDeals(t *testing.T) {
 	t.Parallel()
 	s := create(t)
@@ -511,7 +565,7 @@ func createJob(_ *testing.T, apiid string, c cid.Cid) ffs.StorageJob {
 }
 
 func create(t *testing.T) *Store {
-	ds := tests.NewTxMapDatastore()
+	ds := tests.NewTxnDatastore(t)
 	store, err := New(ds)
 	require.NoError(t, err)
 	return store
@@ -22,6 +22,11 @@ type Store struct {
 	ds   datastore.Datastore
 }
 
+type readWriter interface {
+	datastore.Read
+	datastore.Write
+}
+
 // TrackedStorageConfig has information about a StorageConfig from
 // an APIID which is repairable/renewable.
 type TrackedStorageConfig struct {
@@ -50,7 +55,20 @@ func (s *Store) Put(iid ffs.APIID, c cid.Cid, sc ffs.StorageConfig) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	scs, err := s.get(c)
+	return s.put(s.ds, iid, c, sc)
+}
+
+// PutTxn is like Put, but writes in txn, which should be a transaction
+// of the Store datastore.
+func (s *Store) PutTxn(txn datastore.Txn, iid ffs.APIID, c cid.Cid, sc ffs.StorageConfig) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.put(txn, iid, c, sc)
+}
+
+func (s *Store) put(rw readWriter, iid ffs.APIID, c cid.Cid, sc ffs.StorageConfig) error {
+	scs, err := s.get(rw, c)
 	if err != nil {
 		return fmt.Errorf("getting current storage configs for cid: %s", err)
 	}
@@ -81,7 +99,7 @@ func (s *Store) Put(iid ffs.APIID, c cid.Cid, sc ffs.StorageConfig) error {
 		}
 	}
 
-	if err := s.persist(c, scs); err != nil {
+	if err := s.persist(rw, c, scs); err != nil {
 		return fmt.Errorf("persisting updated storage configs for cid: %s", err)
 	}
 
@@ -90,7 +108,7 @@ func (s *Store) Put(iid ffs.APIID, c cid.Cid, sc ffs.StorageConfig) error {
 
 // Remove removes tracking the storage config from iid.
 func (s *Store) Remove(iid ffs.APIID, c cid.Cid) error {
-	scs, err := s.get(c)
+	scs, err := s.get(s.ds, c)
 	if err != nil {
 		return fmt.Errorf("getting current storage configs for cid: %s", err)
 	}
@@ -105,12 +123,30 @@ func (s *Store) Remove(iid ffs.APIID, c cid.Cid) error {
 		scs[idx] = scs[len(scs)-1]
 		scs = scs[:len(scs)-1]
 	}
-	if err := s.persist(c, scs); err != nil {
+	if err := s.persist(s.ds, c, scs); err != nil {
 		return fmt.Errorf("persisting updated storage configs for cid: %s", err)
 	}
 	return nil
 }
 
+// Get returns the tracked storage config of a Cid for iid. The second
+// return value is false if it isn't tracked.
+func (s *Store) Get(iid ffs.APIID, c cid.Cid) (ffs.StorageConfig, bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	scs, err := s.get(s.ds, c)
+	if err != nil {
+		return ffs.StorageConfig{}, false, err
+	}
+	for _, sc := range scs {
+		if sc.IID == iid {
+			return sc.StorageConfig, true, nil
+		}
+	}
+	return ffs.StorageConfig{}, false, nil
+}
+
 // GetRepairables returns all the tracked repairable storage configs.
 func (s *Store) GetRepairables() ([]TrackedCid, error) {
 	s.lock.Lock()
@@ -175,11 +211,11 @@ func (s *Store) query(renewable bool, repairable bool) ([]TrackedCid, error) {
 	return res, nil
 }
 
-func (s *Store) persist(c cid.Cid, scs []TrackedStorageConfig) error {
+func (s *Store) persist(rw readWriter, c cid.Cid, scs []TrackedStorageConfig) error {
 	// If the list of storage configs resulted to be empty, then remove it from datastore.
 	key := datastore.NewKey(c.String())
 	if len(scs) == 0 {
-		if err := s.ds.Delete(key); err != nil {
+		if err := rw.Delete(key); err != nil {
 			return fmt.Errorf("deleting empty storage configs for cid: %s", err)
 		}
 		return nil
@@ -190,14 +226,14 @@ func (s *Store) persist(c cid.Cid, scs []TrackedStorageConfig) error {
 	if err != nil {
 		return fmt.Errorf("marshaling storage configs: %s", err)
 	}
-	if err := s.ds.Put(key, buf); err != nil {
+	if err := rw.Put(key, buf); err != nil {
 		return fmt.Errorf("saving storage config list in datastore: %s", err)
 	}
 	return nil
 }
 
-func (s *Store) get(c cid.Cid) ([]TrackedStorageConfig, error) {
-	v, err := s.ds.Get(datastore.NewKey(c.String()))
+func (s *Store) get(r datastore.Read, c cid.Cid) ([]TrackedStorageConfig, error) {
+	v, err := r.Get(datastore.NewKey(c.String()))
 	if err == datastore.ErrNotFound {
 		return nil, nil
 	}
@@ -308,7 +308,7 @@ func sortTC(tcs []TrackedCid) {
 }
 
 func create(t *testing.T) *Store {
-	ds := tests.NewTxMapDatastore()
+	ds := tests.NewTxnDatastore(t)
 	store, err := New(ds)
 	require.NoError(t, err)
 	return store
@@ -0,0 +1,48 @@
+package scheduler
+
+import (
+	"fmt"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/trackstore"
+	txndstr "github.com/textileio/powergate/v2/txndstransform"
+)
+
+// OfflineTracker updates the repair and renewal tracking state of storage
+// configs directly in the Scheduler datastore. It's meant to be used by offline
+// tools while the Scheduler isn't running, otherwise Scheduler.Track should be used.
+type OfflineTracker struct {
+	ts *trackstore.Store
+}
+
+// NewOfflineTracker returns an OfflineTracker for ds, which should be the same
+// datastore provided to New.
+func NewOfflineTracker(ds datastore.TxnDatastore) (*OfflineTracker, error) {
+	ts, err := trackstore.New(txndstr.Wrap(ds, "tstore"))
+	if err != nil {
+		return nil, fmt.Errorf("loading scheduler trackstore: %s", err)
+	}
+	return &OfflineTracker{ts: ts}, nil
+}
+
+// Track updates the StorageConfig used by renewal and repair background crons
+// for a Cid from iid. If the StorageConfig isn't repairable or renewable, the
+// Cid is untracked.
+func (t *OfflineTracker) Track(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig) error {
+	if err := t.ts.Put(iid, c, cfg); err != nil {
+		return fmt.Errorf("saving repairable/renewable storage config: %s", err)
+	}
+	return nil
+}
+
+// TrackTxn is like Track, but writes in txn, which should be a transaction
+// of the datastore provided to NewOfflineTracker. This allows updating the
+// tracking state atomically with the storage config.
+func (t *OfflineTracker) TrackTxn(txn datastore.Txn, iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig) error {
+	if err := t.ts.PutTxn(txndstr.WrapTxn(txn, "tstore"), iid, c, cfg); err != nil {
+		return fmt.Errorf("saving repairable/renewable storage config: %s", err)
+	}
+	return nil
+}
@@ -13,7 +13,9 @@ import (
 	logging "github.com/ipfs/go-log/v2"
 	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/astore"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/auditstore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/cistore"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/renewstore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/ristore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/rjstore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/sjstore"
@@ -43,12 +45,16 @@ var (
 type Scheduler struct {
 	cs  ffs.ColdStorage
 	hs  ffs.HotStorage
+	ks  ffs.KeyStore
 	sjs *sjstore.Store
 	rjs *rjstore.Store
 	as  *astore.Store
 	ts  *trackstore.Store
+	tds *txndstr.Datastore
 	cis *cistore.Store
 	ris *ristore.Store
+	aus *auditstore.Store
+	rns *renewstore.Store
 	l   ffs.JobLogger
 
 	sr2RepFactor        func() (int, error)
@@ -57,6 +63,12 @@ type Scheduler struct {
 	gcLock sync.Mutex
 	gc     GCConfig
 
+	expiry       ExpiryAlertConfig
+	audit        AuditConfig
+	hotCache     HotCacheConfig
+	hotReconcile HotReconcileConfig
+	dedup        DedupConfig
+
 	sd         storageDaemon
 	rd         retrievalDaemon
 	cancelLock sync.Mutex
@@ -87,9 +99,31 @@ type GCConfig struct {
 	AutoGCInterval   time.Duration
 }
 
+// Config configures a Scheduler.
+type Config struct {
+	// MaxParallel is the maximum number of Jobs executed in parallel.
+	MaxParallel int
+	// DealFinalityTimeout is the maximum time to wait for deals to
+	// reach a final state.
+	DealFinalityTimeout time.Duration
+	// SR2RepFactor, if not nil, provides the RepFactor of Storage
+	// Configs using the SR2 miner selector.
+	SR2RepFactor func() (int, error)
+	// KeyStore provides the keys to encrypt data in Cold Storage. If
+	// it's nil, encryption isn't supported.
+	KeyStore ffs.KeyStore
+
+	GC           GCConfig
+	ExpiryAlert  ExpiryAlertConfig
+	Audit        AuditConfig
+	HotCache     HotCacheConfig
+	HotReconcile HotReconcileConfig
+	Dedup        DedupConfig
+}
+
 // New returns a new instance of Scheduler which uses JobStore as its backing repository for state,
 // HotStorage for the hot layer, and ColdStorage for the cold layer.
-func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.ColdStorage, maxParallel int, dealFinalityTimeout time.Duration, sr2rf func() (int, error), gcConfig GCConfig) (*Scheduler, error) {
+func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.ColdStorage, conf Config) (*Scheduler, error) {
 	sjs, err := sjstore.New(txndstr.Wrap(ds, "sjstore"))
 	if err != nil {
 		return nil, fmt.Errorf("loading stroage jobstore: %s", err)
@@ -99,38 +133,50 @@ func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.C
 		return nil, fmt.Errorf("loading retrieval jobstore: %s", err)
 	}
 	as := astore.New(txndstr.Wrap(ds, "astore"))
-	ts, err := trackstore.New(txndstr.Wrap(ds, "tstore"))
+	tds := txndstr.Wrap(ds, "tstore")
+	ts, err := trackstore.New(tds)
 	if err != nil {
 		return nil, fmt.Errorf("loading scheduler trackstore: %s", err)
 	}
 
 	cis := cistore.New(txndstr.Wrap(ds, "cistore_v2"))
 	ris := ristore.New(txndstr.Wrap(ds, "ristore"))
+	aus := auditstore.New(txndstr.Wrap(ds, "auditstore"))
+	rns := renewstore.New(txndstr.Wrap(ds, "renewstore"))
 
 	ctx, cancel := context.WithCancel(context.Background())
 	sch := &Scheduler{
 		cs: cs,
 		hs: hs,
+		ks: conf.KeyStore,
 
 		sjs: sjs,
 		rjs: rjs,
 
-		as: as,
-		ts: ts,
+		as:  as,
+		ts:  ts,
+		tds: tds,
 
 		cis: cis,
 		ris: ris,
+		aus: aus,
+		rns: rns,
 
-		l:  l,
-		gc: gcConfig,
+		l:            l,
+		gc:           conf.GC,
+		expiry:       conf.ExpiryAlert,
+		audit:        conf.Audit,
+		hotCache:     conf.HotCache,
+		hotReconcile: conf.HotReconcile,
+		dedup:        conf.Dedup,
 
 		jobsCancel: make(map[ffs.JobID]chan struct{}),
 		sd: storageDaemon{
-			rateLim:       make(chan struct{}, maxParallel),
+			rateLim:       make(chan struct{}, conf.MaxParallel),
 			evaluateQueue: make(chan struct{}, 1),
 		},
 		rd: retrievalDaemon{
-			rateLim:       make(chan struct{}, maxParallel),
+			rateLim:       make(chan struct{}, conf.MaxParallel),
 			evaluateQueue: make(chan struct{}, 1),
 		},
 
@@ -138,8 +184,8 @@ func New(ds datastore.TxnDatastore, l ffs.JobLogger, hs ffs.HotStorage, cs ffs.C
 		cancel:   cancel,
 		finished: make(chan struct{}),
 
-		sr2RepFactor:        sr2rf,
-		dealFinalityTimeout: dealFinalityTimeout,
+		sr2RepFactor:        conf.SR2RepFactor,
+		dealFinalityTimeout: conf.DealFinalityTimeout,
 	}
 
 	go sch.run()
@@ -156,6 +202,24 @@ func (s *Scheduler) GetCidFromHot(ctx context.Context, c cid.Cid) (io.Reader, er
 	return r, nil
 }
 
+// GetCarFromHot writes a CAR file of the DAG of c from hot storage.
+func (s *Scheduler) GetCarFromHot(ctx context.Context, c cid.Cid, w io.Writer, opts ffs.CarOptions) error {
+	if err := s.hs.GetCar(ctx, c, w, opts); err != nil {
+		return fmt.Errorf("getting car of %s from hot storage: %s", c, err)
+	}
+	return nil
+}
+
+// PublishName publishes c from hot storage under the IPNS key keyName,
+// and returns the IPNS name.
+func (s *Scheduler) PublishName(ctx context.Context, keyName string, c cid.Cid) (string, error) {
+	name, err := s.hs.Publish(ctx, keyName, c)
+	if err != nil {
+		return "", fmt.Errorf("publishing %s from hot storage: %s", c, err)
+	}
+	return name, nil
+}
+
 // Cancel cancels an executing Job.
 func (s *Scheduler) Cancel(jid ffs.JobID) error {
 	s.cancelLock.Lock()
@@ -313,6 +377,90 @@ func (s *Scheduler) run() {
 		}
 	}()
 
+	// Timer for evaluating deal expiration forecasts.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if s.expiry.Window == 0 || s.expiry.Frequency == 0 {
+			return
+		}
+
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(s.expiry.Frequency):
+				log.Debug("running expiry forecast checks...")
+				s.execExpiryAlertCron(s.ctx)
+				log.Debug("expiry forecast cron done")
+			}
+		}
+	}()
+
+	// Timer for auditing recorded deals against the Filecoin state.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if s.audit.Frequency == 0 {
+			return
+		}
+
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(s.audit.Frequency):
+				log.Debug("running deal audit...")
+				s.execAuditCron(s.ctx)
+				log.Debug("deal audit cron done")
+			}
+		}
+	}()
+
+	// Timer for evicting cached Cids from Hot Storage.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if s.hotCache.Frequency == 0 {
+			return
+		}
+
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(s.hotCache.Frequency):
+				log.Debug("running hot storage cache eviction...")
+				s.execHotCacheCron(s.ctx)
+				log.Debug("hot storage cache eviction cron done")
+			}
+		}
+	}()
+
+	// Timer for reconciling Hot Storage with the underlying storage.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		if s.hotReconcile.Frequency == 0 {
+			return
+		}
+
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(s.hotReconcile.Frequency):
+				log.Debug("running hot storage reconciliation...")
+				s.execHotReconcileCron(s.ctx)
+				log.Debug("hot storage reconciliation cron done")
+			}
+		}
+	}()
+
 	// Loop for retrievals jobs.
 	wg.Add(1)
 	go func() {
@@ -403,11 +551,11 @@ func (s *Scheduler) execRepairCron(ctx context.Context) {
 			lCtx := context.WithValue(ctx, ffs.CtxStorageCid, tc.Cid)
 			lCtx = context.WithValue(lCtx, ffs.CtxAPIID, sc.IID)
 			s.l.Log(lCtx, "Scheduling deal repair evaluation...")
-			jid, err := s.push(sc.IID, tc.Cid, sc.StorageConfig, cid.Undef)
+			jid, err := s.push(sc.IID, tc.Cid, sc.StorageConfig, cid.Undef, false)
 			if err != nil {
-				s.l.Log(lCtx, "Scheduling deal repair errored: %s", err)
+				s.l.LogEvent(lCtx, ffs.LogEvent{Level: ffs.LogLevelError, Type: ffs.EventRepairScheduled, Fields: map[string]string{ffs.LogFieldError: err.Error()}}, "Scheduling deal repair errored: %s", err)
 			} else {
-				s.l.Log(lCtx, "Job %s was queued for repair evaluation.", jid)
+				s.l.LogEvent(lCtx, ffs.LogEvent{Type: ffs.EventRepairScheduled, Fields: map[string]string{ffs.LogFieldJobID: jid.String()}}, "Job %s was queued for repair evaluation.", jid)
 			}
 		}
 	}
@@ -426,11 +574,11 @@ func (s *Scheduler) execRenewCron(ctx context.Context) {
 			lCtx := context.WithValue(ctx, ffs.CtxStorageCid, tc.Cid)
 			lCtx = context.WithValue(lCtx, ffs.CtxAPIID, sc.IID)
 			s.l.Log(lCtx, "Scheduling deal renew evaluation...")
-			jid, err := s.push(sc.IID, tc.Cid, sc.StorageConfig, cid.Undef)
+			jid, err := s.push(sc.IID, tc.Cid, sc.StorageConfig, cid.Undef, false)
 			if err != nil {
-				s.l.Log(lCtx, "Scheduling deal renewal errored: %s", err)
+				s.l.LogEvent(lCtx, ffs.LogEvent{Level: ffs.LogLevelError, Type: ffs.EventRenewalScheduled, Fields: map[string]string{ffs.LogFieldError: err.Error()}}, "Scheduling deal renewal errored: %s", err)
 			} else {
-				s.l.Log(lCtx, "Job %s was queued for renew evaluation.", jid)
+				s.l.LogEvent(lCtx, ffs.LogEvent{Type: ffs.EventRenewalScheduled, Fields: map[string]string{ffs.LogFieldJobID: jid.String()}}, "Job %s was queued for renew evaluation.", jid)
 			}
 		}
 	}
@@ -516,12 +664,12 @@ func (s *Scheduler) executeQueuedStorage(j ffs.StorageJob) {
 		if err := s.sjs.Finalize(j.ID, ffs.Failed, err, nil); err != nil {
 			log.Errorf("changing job to failed: %s", err)
 		}
-		s.l.Log(ctx, "Job %s couldn't start: %s.", j.ID, err)
+		s.l.LogEvent(ctx, jobFailedEvent(err), "Job %s couldn't start: %s.", j.ID, err)
 		return
 	}
 
 	// Execute
-	s.l.Log(ctx, "Executing job %s...", j.ID)
+	s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventJobExecuting}, "Executing job %s...", j.ID)
 	dealUpdates := s.sjs.MonitorJob(j)
 	info, dealErrors, err := s.executeStorage(ctx, a, j, dealUpdates)
 	close(dealUpdates)
@@ -532,7 +680,7 @@ func (s *Scheduler) executeQueuedStorage(j ffs.StorageJob) {
 		if err := s.sjs.Finalize(j.ID, ffs.Failed, err, dealErrors); err != nil {
 			log.Errorf("changing job to failed: %s", err)
 		}
-		s.l.Log(ctx, "Job %s execution failed: %s", j.ID, err)
+		s.l.LogEvent(ctx, jobFailedEvent(err), "Job %s execution failed: %s", j.ID, err)
 		return
 	}
 	// Save whatever stored information was completely/partially
@@ -630,12 +778,12 @@ func (s *Scheduler) executeQueuedRetrievals(j ffs.RetrievalJob) {
 		if err := s.rjs.Finalize(j.ID, ffs.Failed, err); err != nil {
 			log.Errorf("changing job to failed: %s", err)
 		}
-		s.l.Log(ctx, "Job %s couldn't start: %s.", j.ID, err)
+		s.l.LogEvent(ctx, jobFailedEvent(err), "Job %s couldn't start: %s.", j.ID, err)
 		return
 	}
 
 	// Execute
-	s.l.Log(ctx, "Executing job %s...", j.ID)
+	s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventJobExecuting}, "Executing job %s...", j.ID)
 	info, err := s.executeRetrieval(ctx, a, j)
 
 	// Something bad-enough happened to make Job
@@ -645,7 +793,7 @@ func (s *Scheduler) executeQueuedRetrievals(j ffs.RetrievalJob) {
 		if err := s.rjs.Finalize(j.ID, ffs.Failed, err); err != nil {
 			log.Errorf("changing retrieval job status to failed: %s", err)
 		}
-		s.l.Log(ctx, "Job %s execution failed: %s", j.ID, err)
+		s.l.LogEvent(ctx, jobFailedEvent(err), "Job %s execution failed: %s", j.ID, err)
 		return
 	}
 	// Save whatever stored information was completely/partially
@@ -668,3 +816,11 @@ func (s *Scheduler) executeQueuedRetrievals(j ffs.RetrievalJob) {
 	}
 	s.l.Log(ctx, "Retrieval job %s execution finished with status %s.", j.ID, ffs.JobStatusStr[finalStatus])
 }
+
+func jobFailedEvent(err error) ffs.LogEvent {
+	return ffs.LogEvent{
+		Level:  ffs.LogLevelError,
+		Type:   ffs.EventJobFailed,
+		Fields: map[string]string{ffs.LogFieldError: err.Error()},
+	}
+}
@@ -0,0 +1,236 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/astore"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/cistore"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/sjstore"
+)
+
+// AuditConfig configures the periodic audit of recorded deals against
+// the Filecoin state.
+type AuditConfig struct {
+	// Frequency is the frequency in which stored Cids are audited. Zero
+	// disables audits.
+	Frequency time.Duration
+}
+
+// AuditReports returns the last audit reports of Cids, filtered by APIIDs
+// and Cids if provided.
+func (s *Scheduler) AuditReports(iids []ffs.APIID, cids []cid.Cid) ([]ffs.AuditReport, error) {
+	res, err := s.aus.List(iids, cids)
+	if err != nil {
+		return nil, fmt.Errorf("listing audit reports: %s", err)
+	}
+	return res, nil
+}
+
+// AuditSummary returns a summary of the last audit reports of Cids stored
+// by the provided APIIDs. If iids is empty, all APIIDs are included.
+func (s *Scheduler) AuditSummary(iids []ffs.APIID) (ffs.AuditSummary, error) {
+	reports, err := s.aus.List(iids, nil)
+	if err != nil {
+		return ffs.AuditSummary{}, fmt.Errorf("listing audit reports: %s", err)
+	}
+	sum := ffs.AuditSummary{Discrepancies: map[ffs.AuditDiscrepancyType]int{}}
+	for _, r := range reports {
+		if r.Time.After(sum.LastAudit) {
+			sum.LastAudit = r.Time
+		}
+		sum.AuditedCids++
+		if r.Healthy() {
+			sum.HealthyCids++
+		}
+		sum.AuditedDeals += r.AuditedDeals
+		sum.ActiveDeals += r.ActiveDeals
+		for _, d := range r.Discrepancies {
+			sum.Discrepancies[d.Type]++
+		}
+	}
+	return sum, nil
+}
+
+// execAuditCron audits the recorded deals of all stored Cids which
+// don't have queued or executing Jobs.
+func (s *Scheduler) execAuditCron(ctx context.Context) {
+	busy, err := s.busyCids()
+	if err != nil {
+		log.Errorf("getting cids with queued or executing jobs: %s", err)
+		return
+	}
+
+	height, err := s.cs.GetHeight(ctx)
+	if err != nil {
+		log.Errorf("getting current height: %s", err)
+		return
+	}
+	infos, err := s.cis.List(nil, nil)
+	if err != nil {
+		log.Errorf("listing storage info from cistore: %s", err)
+		return
+	}
+	var audited, unhealthy int
+	for _, info := range infos {
+		if ctx.Err() != nil {
+			return
+		}
+		if _, ok := busy[info.APIID][info.Cid]; ok {
+			continue
+		}
+		report, err := s.auditStorageInfo(ctx, info, height)
+		if err != nil {
+			log.Errorf("auditing %s of %s: %s", info.Cid, info.APIID, err)
+			continue
+		}
+		audited++
+		if !report.Healthy() {
+			unhealthy++
+		}
+	}
+	log.Infof("audited %d cids, %d with discrepancies", audited, unhealthy)
+}
+
+// busyCids returns the Cids of each APIID which have queued or executing Jobs.
+func (s *Scheduler) busyCids() (map[ffs.APIID]map[cid.Cid]struct{}, error) {
+	busy := map[ffs.APIID]map[cid.Cid]struct{}{}
+	for _, sel := range []sjstore.Select{sjstore.Queued, sjstore.Executing} {
+		jobs, _, _, err := s.sjs.List(sjstore.ListConfig{Select: sel})
+		if err != nil {
+			return nil, fmt.Errorf("listing storage jobs: %s", err)
+		}
+		for _, j := range jobs {
+			if busy[j.APIID] == nil {
+				busy[j.APIID] = map[cid.Cid]struct{}{}
+			}
+			busy[j.APIID][j.Cid] = struct{}{}
+		}
+	}
+	return busy, nil
+}
+
+// auditStorageInfo reconciles the deals recorded in a StorageInfo with their on-chain
+// state, removing deals that aren't active anymore, and saves the report.
+func (s *Scheduler) auditStorageInfo(ctx context.Context, info ffs.StorageInfo, height uint64) (ffs.AuditReport, error) {
+	ctx = context.WithValue(ctx, ffs.CtxStorageCid, info.Cid)
+	ctx = context.WithValue(ctx, ffs.CtxAPIID, info.APIID)
+
+	report := ffs.AuditReport{
+		APIID:        info.APIID,
+		Cid:          info.Cid,
+		Time:         time.Now(),
+		Height:       height,
+		AuditedDeals: len(info.Cold.Filecoin.Proposals),
+	}
+	var inactive []uint64
+	for _, fp := range info.Cold.Filecoin.Proposals {
+		ds, err := s.auditDeal(ctx, fp)
+		if err != nil {
+			return ffs.AuditReport{}, err
+		}
+		report.Discrepancies = append(report.Discrepancies, ds...)
+		if len(ds) > 0 && ds[0].Type == ffs.AuditDealNotActive {
+			inactive = append(inactive, fp.DealID)
+			continue
+		}
+		report.ActiveDeals++
+	}
+
+	if err := s.aus.Put(report); err != nil {
+		return ffs.AuditReport{}, fmt.Errorf("saving audit report: %s", err)
+	}
+	if len(inactive) > 0 {
+		// Inactive deals are removed by a Job, so the StorageInfo
+		// isn't modified while other Jobs for the Cid are executing.
+		a := astore.StorageAction{APIID: info.APIID, Cid: info.Cid, RemoveDeals: inactive}
+		jid, err := s.enqueueAction(a, "inactive deals removal")
+		if err != nil {
+			return ffs.AuditReport{}, fmt.Errorf("queueing inactive deals removal: %s", err)
+		}
+		s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventJobQueued, Fields: map[string]string{ffs.LogFieldJobID: jid.String()}}, "Job %s was queued to remove %d inactive deals.", jid, len(inactive))
+	}
+	return report, nil
+}
+
+// auditDeal returns the discrepancies of a recorded deal with its on-chain
+// state. If the deal isn't active, that's the only returned discrepancy.
+func (s *Scheduler) auditDeal(ctx context.Context, fp ffs.FilStorage) ([]ffs.AuditDiscrepancy, error) {
+	di, err := s.cs.GetDealInfo(ctx, fp.DealID)
+	if err == ffs.ErrOnChainDealNotFound {
+		return []ffs.AuditDiscrepancy{{
+			Type:    ffs.AuditDealNotActive,
+			DealID:  fp.DealID,
+			Miner:   fp.Miner,
+			Message: "deal doesn't exist on-chain, expired or was slashed",
+		}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting deal %d state: %s", fp.DealID, err)
+	}
+
+	var ret []ffs.AuditDiscrepancy
+	if fp.PieceCid.Defined() && !di.Proposal.PieceCID.Equals(fp.PieceCid) {
+		ret = append(ret, ffs.AuditDiscrepancy{
+			Type:    ffs.AuditPieceCidMismatch,
+			DealID:  fp.DealID,
+			Miner:   fp.Miner,
+			Message: fmt.Sprintf("on-chain piece cid is %s", di.Proposal.PieceCID),
+		})
+	}
+	if provider := di.Proposal.Provider.String(); provider != fp.Miner {
+		ret = append(ret, ffs.AuditDiscrepancy{
+			Type:    ffs.AuditMinerMismatch,
+			DealID:  fp.DealID,
+			Miner:   fp.Miner,
+			Message: fmt.Sprintf("on-chain provider is %s", provider),
+		})
+	}
+	if di.State.SectorStartEpoch == -1 {
+		ret = append(ret, ffs.AuditDiscrepancy{
+			Type:    ffs.AuditSectorNotSealed,
+			DealID:  fp.DealID,
+			Miner:   fp.Miner,
+			Message: "deal isn't included in a sealed sector",
+		})
+	}
+	return ret, nil
+}
+
+// executeRemoveDeals removes deals which were audited as inactive from the
+// current StorageInfo of a Cid.
+func (s *Scheduler) executeRemoveDeals(ctx context.Context, a astore.StorageAction) (ffs.StorageInfo, []ffs.DealError, error) {
+	info, err := s.cis.Get(a.APIID, a.Cid)
+	if err == cistore.ErrNotFound {
+		return ffs.StorageInfo{}, nil, fmt.Errorf("the cid isn't stored anymore")
+	}
+	if err != nil {
+		return ffs.StorageInfo{}, nil, fmt.Errorf("getting current storage info: %s", err)
+	}
+	inactive := make(map[uint64]struct{}, len(a.RemoveDeals))
+	for _, id := range a.RemoveDeals {
+		inactive[id] = struct{}{}
+	}
+	activeDeals := make([]ffs.FilStorage, 0, len(info.Cold.Filecoin.Proposals))
+	for _, fp := range info.Cold.Filecoin.Proposals {
+		if _, ok := inactive[fp.DealID]; !ok {
+			activeDeals = append(activeDeals, fp)
+			continue
+		}
+		ev := ffs.LogEvent{
+			Level: ffs.LogLevelWarn,
+			Type:  ffs.EventDealStateChanged,
+			Fields: map[string]string{
+				ffs.LogFieldMiner:  fp.Miner,
+				ffs.LogFieldDealID: strconv.FormatUint(fp.DealID, 10),
+			},
+		}
+		s.l.LogEvent(ctx, ev, "Audit detected that deal %d isn't active anymore, removing from active deals.", fp.DealID)
+	}
+	info.Cold.Filecoin.Proposals = activeDeals
+	return info, nil, nil
+}
@@ -0,0 +1,213 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/filecoin-project/go-address"
+	"github.com/filecoin-project/go-state-types/abi"
+	"github.com/filecoin-project/lotus/api"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/astore"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/auditstore"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/cistore"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/sjstore"
+	"github.com/textileio/powergate/v2/tests"
+	txndstr "github.com/textileio/powergate/v2/txndstransform"
+)
+
+func TestAuditDeal(t *testing.T) {
+	t.Parallel()
+	pieceCid := mustCid(t, "QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	otherPieceCid := mustCid(t, "QmPZ9gcCEpqKTo6aq61g2nXGUhM4iCL3ewB6LDXZCtioEB")
+	miner, err := address.NewIDAddress(1000)
+	require.NoError(t, err)
+	otherMiner, err := address.NewIDAddress(1001)
+	require.NoError(t, err)
+	onChain := func(provider address.Address, piece cid.Cid, sectorStart abi.ChainEpoch) api.MarketDeal {
+		var md api.MarketDeal
+		md.Proposal.Provider = provider
+		md.Proposal.PieceCID = piece
+		md.State.SectorStartEpoch = sectorStart
+		return md
+	}
+
+	cases := []struct {
+		name  string
+		deal  *api.MarketDeal
+		types []ffs.AuditDiscrepancyType
+	}{
+		{
+			name: "healthy",
+			deal: ptr(onChain(miner, pieceCid, 10)),
+		},
+		{
+			name:  "not found",
+			types: []ffs.AuditDiscrepancyType{ffs.AuditDealNotActive},
+		},
+		{
+			name:  "piece mismatch",
+			deal:  ptr(onChain(miner, otherPieceCid, 10)),
+			types: []ffs.AuditDiscrepancyType{ffs.AuditPieceCidMismatch},
+		},
+		{
+			name:  "provider mismatch",
+			deal:  ptr(onChain(otherMiner, pieceCid, 10)),
+			types: []ffs.AuditDiscrepancyType{ffs.AuditMinerMismatch},
+		},
+		{
+			name:  "sector not sealed",
+			deal:  ptr(onChain(miner, pieceCid, -1)),
+			types: []ffs.AuditDiscrepancyType{ffs.AuditSectorNotSealed},
+		},
+		{
+			name:  "many discrepancies",
+			deal:  ptr(onChain(otherMiner, otherPieceCid, -1)),
+			types: []ffs.AuditDiscrepancyType{ffs.AuditPieceCidMismatch, ffs.AuditMinerMismatch, ffs.AuditSectorNotSealed},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			cs := &auditColdStorage{deals: map[uint64]api.MarketDeal{}}
+			if tt.deal != nil {
+				cs.deals[1] = *tt.deal
+			}
+			s := newAuditScheduler(t, cs)
+
+			fp := ffs.FilStorage{DealID: 1, PieceCid: pieceCid, Miner: miner.String()}
+			ds, err := s.auditDeal(context.Background(), fp)
+			require.NoError(t, err)
+			var types []ffs.AuditDiscrepancyType
+			for _, d := range ds {
+				require.Equal(t, uint64(1), d.DealID)
+				require.Equal(t, miner.String(), d.Miner)
+				types = append(types, d.Type)
+			}
+			require.Equal(t, tt.types, types)
+		})
+	}
+}
+
+func TestAuditStorageInfo(t *testing.T) {
+	t.Parallel()
+	c := mustCid(t, "QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	iid := ffs.NewAPIID()
+	miner, err := address.NewIDAddress(1000)
+	require.NoError(t, err)
+	var active api.MarketDeal
+	active.Proposal.Provider = miner
+	active.State.SectorStartEpoch = -1
+	cs := &auditColdStorage{deals: map[uint64]api.MarketDeal{1: active, 3: active}}
+	s := newAuditScheduler(t, cs)
+
+	info := ffs.StorageInfo{
+		APIID: iid,
+		Cid:   c,
+		Cold: ffs.ColdInfo{Filecoin: ffs.FilInfo{Proposals: []ffs.FilStorage{
+			{DealID: 1, Miner: miner.String()},
+			{DealID: 2, Miner: miner.String()},
+			{DealID: 3, Miner: miner.String()},
+		}}},
+	}
+	report, err := s.auditStorageInfo(context.Background(), info, 100)
+	require.NoError(t, err)
+	require.Equal(t, 3, report.AuditedDeals)
+	require.Equal(t, 2, report.ActiveDeals)
+	require.Len(t, report.Discrepancies, 3)
+	saved, err := s.aus.Get(iid, c)
+	require.NoError(t, err)
+	require.Equal(t, report.Discrepancies, saved.Discrepancies)
+
+	// Only the inactive deal is queued to be removed, even if active
+	// deals have discrepancies.
+	jid := s.sjs.GetActiveJob(iid, c)
+	require.NotNil(t, jid)
+	a, err := s.as.GetStorageAction(*jid)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, a.RemoveDeals)
+}
+
+func TestExecuteRemoveDeals(t *testing.T) {
+	t.Parallel()
+	c := mustCid(t, "QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	iid := ffs.NewAPIID()
+	s := newAuditScheduler(t, &auditColdStorage{})
+	a := astore.StorageAction{APIID: iid, Cid: c, RemoveDeals: []uint64{2, 4}}
+
+	_, _, err := s.executeRemoveDeals(context.Background(), a)
+	require.Error(t, err)
+
+	info := ffs.StorageInfo{
+		APIID: iid,
+		Cid:   c,
+		Cold: ffs.ColdInfo{Filecoin: ffs.FilInfo{Proposals: []ffs.FilStorage{
+			{DealID: 1, Miner: "f01000"},
+			{DealID: 2, Miner: "f01001"},
+			{DealID: 3, Miner: "f01002"},
+		}}},
+	}
+	require.NoError(t, s.cis.Put(info))
+	newInfo, dealErrors, err := s.executeRemoveDeals(context.Background(), a)
+	require.NoError(t, err)
+	require.Empty(t, dealErrors)
+	var ids []uint64
+	for _, fp := range newInfo.Cold.Filecoin.Proposals {
+		ids = append(ids, fp.DealID)
+	}
+	require.Equal(t, []uint64{1, 3}, ids)
+
+	l := s.l.(*auditJobLogger)
+	require.Len(t, l.events, 1)
+	require.Equal(t, "2", l.events[0].Fields[ffs.LogFieldDealID])
+	require.Equal(t, "f01001", l.events[0].Fields[ffs.LogFieldMiner])
+}
+
+func newAuditScheduler(t *testing.T, cs ffs.ColdStorage) *Scheduler {
+	ds := tests.NewTxMapDatastore()
+	sjs, err := sjstore.New(txndstr.Wrap(ds, "sjstore"))
+	require.NoError(t, err)
+	return &Scheduler{
+		cs:  cs,
+		sjs: sjs,
+		as:  astore.New(txndstr.Wrap(ds, "astore")),
+		cis: cistore.New(txndstr.Wrap(ds, "cistore")),
+		aus: auditstore.New(txndstr.Wrap(ds, "auditstore")),
+		l:   &auditJobLogger{},
+	}
+}
+
+// auditColdStorage is a ColdStorage which only provides on-chain deals.
+type auditColdStorage struct {
+	ffs.ColdStorage
+	deals map[uint64]api.MarketDeal
+}
+
+func (cs *auditColdStorage) GetDealInfo(ctx context.Context, dealID uint64) (api.MarketDeal, error) {
+	di, ok := cs.deals[dealID]
+	if !ok {
+		return api.MarketDeal{}, ffs.ErrOnChainDealNotFound
+	}
+	return di, nil
+}
+
+// auditJobLogger is a JobLogger which records logged events of removed deals.
+type auditJobLogger struct {
+	ffs.JobLogger
+	events []ffs.LogEvent
+}
+
+func (l *auditJobLogger) Log(context.Context, string, ...interface{}) {}
+
+func (l *auditJobLogger) LogEvent(_ context.Context, ev ffs.LogEvent, _ string, _ ...interface{}) {
+	if ev.Type == ffs.EventDealStateChanged {
+		l.events = append(l.events, ev)
+	}
+}
+
+func ptr(md api.MarketDeal) *api.MarketDeal {
+	return &md
+}
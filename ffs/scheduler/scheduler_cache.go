@@ -0,0 +1,255 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/astore"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/cistore"
+)
+
+// HotCacheConfig configures the eviction of cached Cids from Hot Storage.
+type HotCacheConfig struct {
+	// Capacity is the maximum amount of bytes stored in Hot Storage before
+	// least-recently accessed cached Cids are evicted. Zero means that
+	// cached Cids are only evicted when their TTL expires.
+	Capacity int64
+	// Frequency is the frequency in which cached Cids are evaluated
+	// for eviction. Zero disables evictions.
+	Frequency time.Duration
+}
+
+// evictionCandidate is a cached Cid of an APIID which can be evicted
+// from Hot Storage.
+type evictionCandidate struct {
+	info       ffs.StorageInfo
+	lastAccess int64
+	expired    bool
+}
+
+// TouchHot registers an access of an APIID to the data of a Cid in Hot Storage,
+// which is considered when evicting cached Cids.
+func (s *Scheduler) TouchHot(ctx context.Context, iid ffs.APIID, c cid.Cid) error {
+	if err := s.hs.Touch(ctx, iid, c); err != nil {
+		return fmt.Errorf("touching cid in hot storage: %s", err)
+	}
+	return nil
+}
+
+// execHotCacheCron queues Jobs evicting cached Cids from Hot Storage whose TTL
+// expired, and least-recently accessed ones while the Hot Storage capacity is
+// exceeded. Only Cids with healthy Filecoin deals and without queued or executing
+// Jobs are evicted.
+func (s *Scheduler) execHotCacheCron(ctx context.Context) {
+	pinned, err := s.hs.PinnedCids(ctx)
+	if err != nil {
+		log.Errorf("getting pinned cids: %s", err)
+		return
+	}
+	busy, err := s.busyCids()
+	if err != nil {
+		log.Errorf("getting cids with queued or executing jobs: %s", err)
+		return
+	}
+	height, err := s.cs.GetHeight(ctx)
+	if err != nil {
+		log.Errorf("getting current height: %s", err)
+		return
+	}
+	infos, err := s.cis.List(nil, nil)
+	if err != nil {
+		log.Errorf("listing storage info from cistore: %s", err)
+		return
+	}
+
+	var used int64
+	sizes := map[cid.Cid]int64{}
+	infosByKey := map[ffs.APIID]map[cid.Cid]ffs.StorageInfo{}
+	for _, info := range infos {
+		if infosByKey[info.APIID] == nil {
+			infosByKey[info.APIID] = map[cid.Cid]ffs.StorageInfo{}
+		}
+		infosByKey[info.APIID][info.Cid] = info
+		if info.Hot.Enabled && int64(info.Hot.Size) > sizes[info.Cid] {
+			used += int64(info.Hot.Size) - sizes[info.Cid]
+			sizes[info.Cid] = int64(info.Hot.Size)
+		}
+	}
+
+	now := time.Now().Unix()
+	refs := map[cid.Cid]int{}
+	var candidates []evictionCandidate
+	for _, pc := range pinned {
+		refs[pc.Cid] = len(pc.APIIDs)
+		for _, p := range pc.APIIDs {
+			if p.Staged || !p.Cached {
+				continue
+			}
+			if _, ok := busy[p.ID][pc.Cid]; ok {
+				continue
+			}
+			info, ok := infosByKey[p.ID][pc.Cid]
+			if !ok || !info.Hot.Enabled {
+				continue
+			}
+			cfg, ok, err := s.currentStorageConfig(info.APIID, info.Cid, info.JobID)
+			if err != nil {
+				log.Errorf("getting storage config of %s of %s: %s", info.Cid, info.APIID, err)
+				continue
+			}
+			if !ok {
+				continue
+			}
+			healthy, err := s.coldHealthy(info, cfg, height)
+			if err != nil {
+				log.Errorf("evaluating cold storage health of %s of %s: %s", info.Cid, info.APIID, err)
+				continue
+			}
+			if !healthy {
+				continue
+			}
+			candidates = append(candidates, evictionCandidate{
+				info:       info,
+				lastAccess: p.LastAccessedAt,
+				expired:    p.CacheTTL > 0 && p.LastAccessedAt+p.CacheTTL <= now,
+			})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].expired != candidates[j].expired {
+			return candidates[i].expired
+		}
+		return candidates[i].lastAccess < candidates[j].lastAccess
+	})
+
+	var evicted int
+	for _, ec := range candidates {
+		if ctx.Err() != nil {
+			return
+		}
+		if !ec.expired && (s.hotCache.Capacity == 0 || used <= s.hotCache.Capacity) {
+			break
+		}
+		if _, err := s.enqueueAction(astore.StorageAction{APIID: ec.info.APIID, Cid: ec.info.Cid, Evict: true}, "hot storage eviction"); err != nil {
+			log.Errorf("queueing eviction of %s of %s: %s", ec.info.Cid, ec.info.APIID, err)
+			continue
+		}
+		evicted++
+		refs[ec.info.Cid]--
+		if refs[ec.info.Cid] == 0 {
+			used -= sizes[ec.info.Cid]
+		}
+	}
+	log.Infof("queued eviction of %d cached cids, hot storage usage will be %d bytes", evicted, used)
+}
+
+// executeEvict unpins the data of a cached Cid from Hot Storage, and flags its
+// StorageInfo as evicted. Since the Cid might have changed since the eviction
+// was queued, it's evaluated again. The StorageInfo is flagged before unpinning,
+// so it never claims that unpinned data is in Hot Storage.
+func (s *Scheduler) executeEvict(ctx context.Context, a astore.StorageAction) (ffs.StorageInfo, []ffs.DealError, error) {
+	info, err := s.cis.Get(a.APIID, a.Cid)
+	if err == cistore.ErrNotFound {
+		return ffs.StorageInfo{}, nil, fmt.Errorf("the cid isn't stored anymore")
+	}
+	if err != nil {
+		return ffs.StorageInfo{}, nil, fmt.Errorf("getting storage info: %s", err)
+	}
+	evictable, err := s.evictable(ctx, info)
+	if err != nil {
+		return ffs.StorageInfo{}, nil, err
+	}
+	if !evictable {
+		s.l.Log(ctx, "Data can't be evicted from Hot-Storage anymore, skipping eviction.")
+		return info, nil, nil
+	}
+
+	evicted := info
+	evicted.Hot.Enabled = false
+	evicted.Hot.Evicted = true
+	if err := s.cis.Put(evicted); err != nil {
+		return ffs.StorageInfo{}, nil, fmt.Errorf("saving storage info: %s", err)
+	}
+	if err := s.hs.Unpin(ctx, a.APIID, a.Cid); err != nil {
+		if err := s.cis.Put(info); err != nil {
+			log.Errorf("restoring storage info of %s: %s", a.Cid, err)
+		}
+		return ffs.StorageInfo{}, nil, fmt.Errorf("unpinning from hot storage: %s", err)
+	}
+	s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventHotEvicted}, "Data was evicted from Hot-Storage cache, it will be unfrozen from Filecoin when accessed.")
+	return evicted, nil, nil
+}
+
+// evictable returns true if the data of a Cid is in Hot Storage, and it's
+// cached and has healthy Filecoin deals.
+func (s *Scheduler) evictable(ctx context.Context, info ffs.StorageInfo) (bool, error) {
+	if !info.Hot.Enabled {
+		return false, nil
+	}
+	cfg, ok, err := s.currentStorageConfig(info.APIID, info.Cid, info.JobID)
+	if err != nil {
+		return false, err
+	}
+	if !ok || !cfg.Hot.Cache.Enabled {
+		return false, nil
+	}
+	height, err := s.cs.GetHeight(ctx)
+	if err != nil {
+		return false, fmt.Errorf("getting current height: %s", err)
+	}
+	healthy, err := s.coldHealthy(info, cfg, height)
+	if err != nil {
+		return false, fmt.Errorf("evaluating cold storage health: %s", err)
+	}
+	return healthy, nil
+}
+
+// keepEvicted returns true if the data of a cached Cid was evicted from Hot
+// Storage, and the Job shouldn't unfreeze it. Only Jobs pushed by users
+// unfreeze evicted data, unless it's needed to repair or renew deals.
+func (s *Scheduler) keepEvicted(ctx context.Context, a astore.StorageAction, curr ffs.StorageInfo) (bool, error) {
+	if !a.Cfg.Hot.Cache.Enabled || !curr.Hot.Evicted || curr.Hot.Enabled || a.Rehydrate {
+		return false, nil
+	}
+	height, err := s.cs.GetHeight(ctx)
+	if err != nil {
+		return false, fmt.Errorf("getting current height: %s", err)
+	}
+	healthy, err := s.coldHealthy(curr, a.Cfg, height)
+	if err != nil {
+		return false, fmt.Errorf("evaluating cold storage health: %s", err)
+	}
+	if !healthy {
+		s.l.Log(ctx, "Filecoin deals need to be repaired or renewed, unfreezing evicted data...")
+		return false, nil
+	}
+	s.l.Log(ctx, "Data is evicted from Hot-Storage cache, it will be unfrozen when accessed.")
+	return true, nil
+}
+
+// coldHealthy returns true if the active deals of a Cid which aren't due for
+// renewal satisfy its RepFactor, so its data isn't needed in Hot Storage to
+// repair or renew deals.
+func (s *Scheduler) coldHealthy(info ffs.StorageInfo, cfg ffs.StorageConfig, height uint64) (bool, error) {
+	if !cfg.Cold.Enabled {
+		return false, nil
+	}
+	repFactor := cfg.Cold.Filecoin.RepFactor
+	if s.sr2RepFactor != nil {
+		var err error
+		repFactor, err = s.sr2RepFactor()
+		if err != nil {
+			return false, fmt.Errorf("getting SR2 replication factor: %s", err)
+		}
+	}
+	var window uint64
+	if cfg.Cold.Filecoin.Renew.Enabled {
+		window = uint64(cfg.Cold.Filecoin.Renew.Threshold)
+	}
+	f := forecastExpiration(info, height, window)
+	return repFactor > 0 && f.ForecastActiveDeals >= repFactor, nil
+}
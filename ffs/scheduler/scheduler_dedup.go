@@ -0,0 +1,220 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+// DedupConfig configures the deduplication of data stored
+// by multiple users.
+type DedupConfig struct {
+	// AttachDeals enables attaching active deals made for other users
+	// storing the same data, instead of making new deals, if they
+	// satisfy the FilConfig of the user.
+	AttachDeals bool
+}
+
+// Usage returns the effective usage of users, filtered by iids if provided.
+// Hot Storage bytes of a Cid are attributed in equal parts to the users storing
+// it in Hot Storage, and the cost of a deal is attributed in equal parts to the
+// users having it in their StorageInfo, e.g: attached deals.
+func (s *Scheduler) Usage(iids []ffs.APIID) ([]ffs.Usage, error) {
+	// All users are listed since sharing depends on every user.
+	infos, err := s.cis.List(nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("listing storage info from cistore: %s", err)
+	}
+	return computeUsage(infos, iids), nil
+}
+
+// computeUsage returns the effective usage of users with StorageInfo
+// in infos, filtered by iids if provided.
+func computeUsage(infos []ffs.StorageInfo, iids []ffs.APIID) []ffs.Usage {
+	hotUsers := map[cid.Cid]int{}
+	dealUsers := map[uint64]int{}
+	for _, info := range infos {
+		if info.Hot.Enabled && !info.Hot.Evicted {
+			hotUsers[info.Cid]++
+		}
+		for _, p := range info.Cold.Filecoin.Proposals {
+			dealUsers[p.DealID]++
+		}
+	}
+
+	filter := make(map[ffs.APIID]struct{}, len(iids))
+	for _, iid := range iids {
+		filter[iid] = struct{}{}
+	}
+	usages := map[ffs.APIID]*ffs.Usage{}
+	for _, info := range infos {
+		if _, ok := filter[info.APIID]; len(filter) > 0 && !ok {
+			continue
+		}
+		u, ok := usages[info.APIID]
+		if !ok {
+			u = &ffs.Usage{APIID: info.APIID, ColdCost: new(big.Int), EffectiveColdCost: new(big.Int)}
+			usages[info.APIID] = u
+		}
+		u.Cids++
+		shared := false
+		if info.Hot.Enabled && !info.Hot.Evicted {
+			u.HotSize += int64(info.Hot.Size)
+			u.EffectiveHotSize += int64(info.Hot.Size) / int64(hotUsers[info.Cid])
+			shared = hotUsers[info.Cid] > 1
+		}
+		for _, p := range info.Cold.Filecoin.Proposals {
+			cost := dealCost(p)
+			u.Deals++
+			u.ColdCost.Add(u.ColdCost, cost)
+			u.EffectiveColdCost.Add(u.EffectiveColdCost, new(big.Int).Div(cost, big.NewInt(int64(dealUsers[p.DealID]))))
+			if dealUsers[p.DealID] > 1 {
+				u.SharedDeals++
+				shared = true
+			}
+		}
+		if shared {
+			u.SharedCids++
+		}
+	}
+
+	res := make([]ffs.Usage, 0, len(usages))
+	for _, u := range usages {
+		res = append(res, *u)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].APIID < res[j].APIID })
+	return res
+}
+
+// dealCost returns the total cost of a deal in attoFIL, since its
+// EpochPrice is the price of the whole piece per epoch.
+func dealCost(p ffs.FilStorage) *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(p.EpochPrice), big.NewInt(p.Duration))
+}
+
+// attachDeals returns active deals of the same data made for other users which
+// satisfy the FilConfig of curr, up to the number of deals missing to reach the
+// RepFactor. It also returns the piece size of the attached deals.
+func (s *Scheduler) attachDeals(ctx context.Context, curr ffs.StorageInfo, cfg ffs.ColdConfig) ([]ffs.FilStorage, uint64, error) {
+	if !s.dedup.AttachDeals {
+		return nil, 0, nil
+	}
+	others, err := s.cis.List(nil, []cid.Cid{curr.Cid})
+	if err != nil {
+		return nil, 0, fmt.Errorf("listing storage info of other users: %s", err)
+	}
+	height, err := s.cs.GetHeight(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("getting chain height: %s", err)
+	}
+
+	var res []ffs.FilStorage
+	var size uint64
+	candidates, pieceSize := attachableDeals(curr, cfg, others, height)
+	for _, p := range candidates {
+		if len(res) == cfg.Filecoin.RepFactor-len(curr.Cold.Filecoin.Proposals) {
+			break
+		}
+		// Candidates are checked on-chain, since StorageInfo of
+		// other users may not be refreshed.
+		di, err := s.cs.GetDealInfo(ctx, p.DealID)
+		if err == ffs.ErrOnChainDealNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, 0, fmt.Errorf("getting deal %d state: %s", p.DealID, err)
+		}
+		if di.Proposal.VerifiedDeal != cfg.Filecoin.VerifiedDeal {
+			continue
+		}
+		ev := ffs.LogEvent{
+			Type: ffs.EventDealAttached,
+			Fields: map[string]string{
+				ffs.LogFieldMiner:  p.Miner,
+				ffs.LogFieldDealID: strconv.FormatUint(p.DealID, 10),
+			},
+		}
+		s.l.LogEvent(ctx, ev, "Attached active deal %d with miner %s made for another user storing the same data.", p.DealID, p.Miner)
+		res = append(res, p)
+		size = pieceSize
+	}
+	return res, size, nil
+}
+
+// attachableDeals returns the deals of other users storing the same unencrypted data
+// as curr which satisfy cfg at the provided height, and their piece size. Deals with
+// miners already storing the data are excluded. Configs with constraints about miner
+// locations or diversity don't attach deals, since those aren't tracked in deals.
+// Verified deals are checked on-chain by the caller.
+func attachableDeals(curr ffs.StorageInfo, cfg ffs.ColdConfig, others []ffs.StorageInfo, height uint64) ([]ffs.FilStorage, uint64) {
+	fc := cfg.Filecoin
+	if cfg.Encryption.Enabled || curr.Cold.Filecoin.Encrypted || len(fc.CountryCodes) > 0 || fc.Diversity.Enabled() {
+		return nil, 0
+	}
+	excluded := map[string]struct{}{}
+	for _, m := range fc.ExcludedMiners {
+		excluded[m] = struct{}{}
+	}
+	for _, p := range curr.Cold.Filecoin.Proposals {
+		excluded[p.Miner] = struct{}{}
+	}
+	trusted := map[string]struct{}{}
+	for _, m := range fc.TrustedMiners {
+		trusted[m] = struct{}{}
+	}
+
+	var res []ffs.FilStorage
+	var size uint64
+	for _, info := range others {
+		fi := info.Cold.Filecoin
+		if info.APIID == curr.APIID || fi.Encrypted || !fi.DataCid.Equals(curr.Cid) {
+			continue
+		}
+		for _, p := range fi.Proposals {
+			if _, ok := excluded[p.Miner]; ok {
+				continue
+			}
+			if _, ok := trusted[p.Miner]; len(trusted) > 0 && !ok {
+				continue
+			}
+			if fc.MaxPrice > 0 && exceedsMaxPrice(p.EpochPrice, fc.MaxPrice, fi.Size) {
+				continue
+			}
+			if p.FastRetrieval != fc.FastRetrieval {
+				continue
+			}
+			expiration := p.StartEpoch + uint64(p.Duration)
+			if expiration <= height {
+				continue
+			}
+			// The deal should last at least as long as new deals
+			// made with the config.
+			if expiration-height < uint64(fc.DealMinDuration) {
+				continue
+			}
+			// Deals which should be renewed right away aren't attached.
+			if fc.Renew.Enabled && expiration-height <= uint64(fc.Renew.Threshold) {
+				continue
+			}
+			// The deal is renewed by other users, not by this one.
+			p.Renewed = false
+			res = append(res, p)
+			excluded[p.Miner] = struct{}{}
+			size = fi.Size
+		}
+	}
+	return res, size
+}
+
+// exceedsMaxPrice returns true if the epoch price of a piece of the provided
+// size is greater than a max price in attoFIL per GiB per epoch.
+func exceedsMaxPrice(epochPrice, maxPrice, pieceSize uint64) bool {
+	max := new(big.Int).Mul(new(big.Int).SetUint64(maxPrice), new(big.Int).SetUint64(pieceSize))
+	max.Rsh(max, 30)
+	return new(big.Int).SetUint64(epochPrice).Cmp(max) > 0
+}
@@ -0,0 +1,281 @@
+package scheduler
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+func TestComputeUsage(t *testing.T) {
+	t.Parallel()
+	c1 := mustCid(t, "QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	c2 := mustCid(t, "QmPZ9gcCEpqKTo6aq61g2nXGUhM4iCL3ewB6LDXZCtioEB")
+	iid1, iid2 := ffs.APIID("a"), ffs.APIID("b")
+	deal := func(id uint64, price uint64, duration int64) ffs.FilStorage {
+		return ffs.FilStorage{DealID: id, EpochPrice: price, Duration: duration}
+	}
+	info := func(iid ffs.APIID, c cid.Cid, hotSize int, evicted bool, deals ...ffs.FilStorage) ffs.StorageInfo {
+		return ffs.StorageInfo{
+			APIID: iid,
+			Cid:   c,
+			Hot:   ffs.HotInfo{Enabled: hotSize > 0, Evicted: evicted, Size: hotSize},
+			Cold:  ffs.ColdInfo{Filecoin: ffs.FilInfo{Proposals: deals}},
+		}
+	}
+	usage := func(iid ffs.APIID, cids, sharedCids int, hot, effHot int64, deals, sharedDeals int, cost, effCost int64) ffs.Usage {
+		return ffs.Usage{
+			APIID:             iid,
+			Cids:              cids,
+			SharedCids:        sharedCids,
+			HotSize:           hot,
+			EffectiveHotSize:  effHot,
+			Deals:             deals,
+			SharedDeals:       sharedDeals,
+			ColdCost:          big.NewInt(cost),
+			EffectiveColdCost: big.NewInt(effCost),
+		}
+	}
+
+	tests := []struct {
+		name  string
+		infos []ffs.StorageInfo
+		iids  []ffs.APIID
+		want  []ffs.Usage
+	}{
+		{
+			name: "empty",
+			want: []ffs.Usage{},
+		},
+		{
+			name: "unshared",
+			infos: []ffs.StorageInfo{
+				info(iid1, c1, 100, false, deal(1, 10, 100)),
+				info(iid2, c2, 50, false, deal(2, 20, 100)),
+			},
+			want: []ffs.Usage{
+				usage(iid1, 1, 0, 100, 100, 1, 0, 1000, 1000),
+				usage(iid2, 1, 0, 50, 50, 1, 0, 2000, 2000),
+			},
+		},
+		{
+			name: "shared hot storage and attached deal",
+			infos: []ffs.StorageInfo{
+				info(iid1, c1, 100, false, deal(1, 10, 100), deal(2, 30, 100)),
+				info(iid2, c1, 100, false, deal(1, 10, 100)),
+			},
+			want: []ffs.Usage{
+				usage(iid1, 1, 1, 100, 50, 2, 1, 4000, 3500),
+				usage(iid2, 1, 1, 100, 50, 1, 1, 1000, 500),
+			},
+		},
+		{
+			name: "evicted hot storage isn't shared",
+			infos: []ffs.StorageInfo{
+				info(iid1, c1, 100, false),
+				info(iid2, c1, 100, true),
+			},
+			want: []ffs.Usage{
+				usage(iid1, 1, 0, 100, 100, 0, 0, 0, 0),
+				usage(iid2, 1, 0, 0, 0, 0, 0, 0, 0),
+			},
+		},
+		{
+			name: "filtered",
+			infos: []ffs.StorageInfo{
+				info(iid1, c1, 100, false, deal(1, 10, 100)),
+				info(iid2, c1, 100, false, deal(1, 10, 100)),
+			},
+			iids: []ffs.APIID{iid2},
+			want: []ffs.Usage{
+				usage(iid2, 1, 1, 100, 50, 1, 1, 1000, 500),
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, computeUsage(tt.infos, tt.iids))
+		})
+	}
+
+	// Deal costs don't overflow.
+	huge := deal(1, math.MaxUint64, 2)
+	res := computeUsage([]ffs.StorageInfo{info(iid1, c1, 0, false, huge)}, nil)
+	want := new(big.Int).Mul(new(big.Int).SetUint64(math.MaxUint64), big.NewInt(2))
+	require.Equal(t, want, res[0].ColdCost)
+}
+
+func TestAttachableDeals(t *testing.T) {
+	t.Parallel()
+	c := mustCid(t, "QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	other := mustCid(t, "QmPZ9gcCEpqKTo6aq61g2nXGUhM4iCL3ewB6LDXZCtioEB")
+	curr := ffs.StorageInfo{APIID: "a", Cid: c}
+	const height = 1000
+	// pieceSize is 1GiB, so max prices are epoch prices.
+	const pieceSize = 1 << 30
+	deal := func(id uint64, miner string) ffs.FilStorage {
+		return ffs.FilStorage{DealID: id, Miner: miner, StartEpoch: 500, Duration: 2000, EpochPrice: 10, FastRetrieval: true, Renewed: true}
+	}
+	others := func(iid ffs.APIID, dataCid cid.Cid, encrypted bool, deals ...ffs.FilStorage) ffs.StorageInfo {
+		return ffs.StorageInfo{
+			APIID: iid,
+			Cid:   dataCid,
+			Cold: ffs.ColdInfo{Filecoin: ffs.FilInfo{
+				DataCid:   dataCid,
+				Encrypted: encrypted,
+				Size:      pieceSize,
+				Proposals: deals,
+			}},
+		}
+	}
+	cfg := func(f func(*ffs.FilConfig)) ffs.ColdConfig {
+		fc := ffs.FilConfig{RepFactor: 2, DealMinDuration: 1000, FastRetrieval: true}
+		if f != nil {
+			f(&fc)
+		}
+		return ffs.ColdConfig{Enabled: true, Filecoin: fc}
+	}
+	attached := func(d ffs.FilStorage) ffs.FilStorage {
+		d.Renewed = false
+		return d
+	}
+	withFastRetrieval := func(d ffs.FilStorage, enabled bool) ffs.FilStorage {
+		d.FastRetrieval = enabled
+		return d
+	}
+	withPrice := func(d ffs.FilStorage, price uint64) ffs.FilStorage {
+		d.EpochPrice = price
+		return d
+	}
+	withDuration := func(d ffs.FilStorage, duration int64) ffs.FilStorage {
+		d.Duration = duration
+		return d
+	}
+
+	tests := []struct {
+		name   string
+		curr   ffs.StorageInfo
+		cfg    ffs.ColdConfig
+		others []ffs.StorageInfo
+		want   []ffs.FilStorage
+	}{
+		{
+			name:   "attachable",
+			cfg:    cfg(nil),
+			others: []ffs.StorageInfo{others("b", c, false, deal(1, "f01"), deal(2, "f02"))},
+			want:   []ffs.FilStorage{attached(deal(1, "f01")), attached(deal(2, "f02"))},
+		},
+		{
+			name:   "own and other data",
+			cfg:    cfg(nil),
+			others: []ffs.StorageInfo{others("a", c, false, deal(1, "f01")), others("b", other, false, deal(2, "f02"))},
+		},
+		{
+			name:   "encrypted data",
+			cfg:    cfg(nil),
+			others: []ffs.StorageInfo{others("b", c, true, deal(1, "f01"))},
+		},
+		{
+			name:   "encryption enabled",
+			cfg:    ffs.ColdConfig{Enabled: true, Filecoin: cfg(nil).Filecoin, Encryption: ffs.ColdEncryptionConfig{Enabled: true}},
+			others: []ffs.StorageInfo{others("b", c, false, deal(1, "f01"))},
+		},
+		{
+			name:   "country codes",
+			cfg:    cfg(func(fc *ffs.FilConfig) { fc.CountryCodes = []string{"CN"} }),
+			others: []ffs.StorageInfo{others("b", c, false, deal(1, "f01"))},
+		},
+		{
+			name: "excluded, trusted and current miners",
+			curr: ffs.StorageInfo{APIID: "a", Cid: c, Cold: ffs.ColdInfo{Filecoin: ffs.FilInfo{Proposals: []ffs.FilStorage{deal(9, "f03")}}}},
+			cfg: cfg(func(fc *ffs.FilConfig) {
+				fc.ExcludedMiners = []string{"f01"}
+				fc.TrustedMiners = []string{"f01", "f02", "f03"}
+			}),
+			others: []ffs.StorageInfo{others("b", c, false, deal(1, "f01"), deal(2, "f02"), deal(3, "f03"), deal(4, "f04"))},
+			want:   []ffs.FilStorage{attached(deal(2, "f02"))},
+		},
+		{
+			name:   "one deal per miner",
+			cfg:    cfg(nil),
+			others: []ffs.StorageInfo{others("b", c, false, deal(1, "f01")), others("c", c, false, deal(2, "f01"))},
+			want:   []ffs.FilStorage{attached(deal(1, "f01"))},
+		},
+		{
+			name:   "max price",
+			cfg:    cfg(func(fc *ffs.FilConfig) { fc.MaxPrice = 10 }),
+			others: []ffs.StorageInfo{others("b", c, false, deal(1, "f01"), withPrice(deal(2, "f02"), 11))},
+			want:   []ffs.FilStorage{attached(deal(1, "f01"))},
+		},
+		{
+			name:   "fast retrieval mismatch",
+			cfg:    cfg(func(fc *ffs.FilConfig) { fc.FastRetrieval = false }),
+			others: []ffs.StorageInfo{others("b", c, false, deal(1, "f01"), withFastRetrieval(deal(2, "f02"), false))},
+			want:   []ffs.FilStorage{attached(withFastRetrieval(deal(2, "f02"), false))},
+		},
+		{
+			name:   "expired and shorter than min duration",
+			cfg:    cfg(nil),
+			others: []ffs.StorageInfo{others("b", c, false, withDuration(deal(1, "f01"), 500), withDuration(deal(2, "f02"), 1499), withDuration(deal(3, "f03"), 1500))},
+			want:   []ffs.FilStorage{attached(withDuration(deal(3, "f03"), 1500))},
+		},
+		{
+			name:   "renewal due",
+			cfg:    cfg(func(fc *ffs.FilConfig) { fc.Renew = ffs.FilRenew{Enabled: true, Threshold: 1500} }),
+			others: []ffs.StorageInfo{others("b", c, false, deal(1, "f01"), withDuration(deal(2, "f02"), 3000))},
+			want:   []ffs.FilStorage{attached(withDuration(deal(2, "f02"), 3000))},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if !tt.curr.Cid.Defined() {
+				tt.curr = curr
+			}
+			res, size := attachableDeals(tt.curr, tt.cfg, tt.others, height)
+			require.Equal(t, tt.want, res)
+			if len(tt.want) > 0 {
+				require.Equal(t, uint64(pieceSize), size)
+			} else {
+				require.Zero(t, size)
+			}
+		})
+	}
+}
+
+func TestExceedsMaxPrice(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		epochPrice uint64
+		maxPrice   uint64
+		pieceSize  uint64
+		want       bool
+	}{
+		{name: "1GiB equal", epochPrice: 100, maxPrice: 100, pieceSize: 1 << 30, want: false},
+		{name: "1GiB greater", epochPrice: 101, maxPrice: 100, pieceSize: 1 << 30, want: true},
+		{name: "half GiB", epochPrice: 50, maxPrice: 100, pieceSize: 1 << 29, want: false},
+		{name: "half GiB greater", epochPrice: 51, maxPrice: 100, pieceSize: 1 << 29, want: true},
+		{name: "32GiB", epochPrice: 3200, maxPrice: 100, pieceSize: 32 << 30, want: false},
+		{name: "no overflow", epochPrice: math.MaxUint64, maxPrice: math.MaxUint64, pieceSize: 32 << 30, want: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, exceedsMaxPrice(tt.epochPrice, tt.maxPrice, tt.pieceSize))
+		})
+	}
+}
+
+func mustCid(t *testing.T, s string) cid.Cid {
+	c, err := cid.Decode(s)
+	require.NoError(t, err)
+	return c
+}
@@ -0,0 +1,109 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/encrypt"
+)
+
+// coldDataCid returns the Cid of the data to be stored in Cold Storage. If
+// encryption is enabled, the data is encrypted and staged in Hot Storage so
+// deals can be made with it.
+func (s *Scheduler) coldDataCid(ctx context.Context, curr ffs.StorageInfo, cfg ffs.ColdConfig) (cid.Cid, error) {
+	if !cfg.Encryption.Enabled {
+		return curr.Cid, nil
+	}
+	s.l.Log(ctx, "Encrypting data for Cold-Storage...")
+	ec, err := s.stageEncrypted(ctx, curr.APIID, curr.Cid)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("encrypting data: %s", err)
+	}
+	s.l.Log(ctx, "Data was encrypted with Cid %s.", ec)
+	return ec, nil
+}
+
+// stageEncrypted encrypts a CAR file of the DAG of c with the key of iid, and
+// stages it in Hot Storage. It returns the Cid of the encrypted data. Since the
+// whole DAG is encrypted, the data can be decrypted with the same Cid regardless
+// of how it was added.
+func (s *Scheduler) stageEncrypted(ctx context.Context, iid ffs.APIID, c cid.Cid) (cid.Cid, error) {
+	key, err := s.dataKey(iid, c)
+	if err != nil {
+		return cid.Undef, err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		err := s.hs.GetCar(ctx, c, pw, ffs.CarOptions{})
+		_ = pw.CloseWithError(err)
+	}()
+	er, err := encrypt.NewEncryptReader(pr, key)
+	if err != nil {
+		_ = pr.CloseWithError(err)
+		return cid.Undef, fmt.Errorf("creating encrypter: %s", err)
+	}
+	ec, err := s.hs.Stage(ctx, iid, er)
+	_ = pr.CloseWithError(err)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("staging encrypted data: %s", err)
+	}
+	return ec, nil
+}
+
+// stageDecrypted decrypts the unfrozen encrypted data of c, and imports the
+// decrypted DAG in Hot Storage.
+func (s *Scheduler) stageDecrypted(ctx context.Context, iid ffs.APIID, c, encCid cid.Cid) error {
+	key, err := s.dataKey(iid, c)
+	if err != nil {
+		return err
+	}
+	enc, err := s.hs.Get(ctx, encCid)
+	if err != nil {
+		return fmt.Errorf("getting encrypted data from hot storage: %s", err)
+	}
+	dr, err := encrypt.NewDecryptReader(enc, key)
+	if err != nil {
+		return fmt.Errorf("creating decrypter: %s", err)
+	}
+	roots, err := s.hs.StageCar(ctx, iid, dr)
+	if err != nil {
+		return fmt.Errorf("staging decrypted data: %s", err)
+	}
+	if len(roots) != 1 || !roots[0].Equals(c) {
+		return fmt.Errorf("decrypted data has roots %s", roots)
+	}
+	return nil
+}
+
+// renewalDue returns true if any deal which wasn't renewed is
+// within the renewal threshold.
+func (s *Scheduler) renewalDue(ctx context.Context, inf ffs.FilInfo, cfg ffs.FilConfig) (bool, error) {
+	height, err := s.cs.GetHeight(ctx)
+	if err != nil {
+		return false, fmt.Errorf("getting current height: %s", err)
+	}
+	for _, p := range inf.Proposals {
+		if p.Renewed {
+			continue
+		}
+		if p.StartEpoch+uint64(p.Duration) <= height+uint64(cfg.Renew.Threshold) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *Scheduler) dataKey(iid ffs.APIID, c cid.Cid) ([]byte, error) {
+	if s.ks == nil {
+		return nil, fmt.Errorf("encryption isn't supported")
+	}
+	key, err := s.ks.Key(iid)
+	if err != nil {
+		return nil, fmt.Errorf("getting encryption key: %s", err)
+	}
+	return encrypt.DataKey(key, c), nil
+}
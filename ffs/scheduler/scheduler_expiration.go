@@ -0,0 +1,164 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/astore"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/renewstore"
+)
+
+// ExpiryAlertConfig configures the evaluation of deal expiration forecasts
+// which logs alerts for Cids whose active deals are forecast to drop
+// below their RepFactor.
+type ExpiryAlertConfig struct {
+	// Window is the amount of epochs to forecast deal expirations. Zero
+	// disables expiry alerts.
+	Window uint64
+	// Frequency is the frequency in which forecasts are evaluated.
+	Frequency time.Duration
+}
+
+// ForecastExpirations returns the expiration forecasts of Cids stored by the
+// provided APIIDs having deals expiring within the next window epochs, and the
+// chain height used for the forecast. If iids is empty, all APIIDs are included.
+func (s *Scheduler) ForecastExpirations(ctx context.Context, iids []ffs.APIID, window uint64) ([]ffs.ExpirationForecast, uint64, error) {
+	height, err := s.cs.GetHeight(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("getting current height: %s", err)
+	}
+	infos, err := s.cis.List(iids, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("listing storage info from cistore: %s", err)
+	}
+
+	var res []ffs.ExpirationForecast
+	for _, info := range infos {
+		if ctx.Err() != nil {
+			return nil, 0, fmt.Errorf("forecast canceled: %s", ctx.Err())
+		}
+		f := forecastExpiration(info, height, window)
+		if len(f.Expiring) == 0 {
+			continue
+		}
+		if err := s.fillRenewalState(&f, info.JobID); err != nil {
+			return nil, 0, err
+		}
+		res = append(res, f)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Expiring[0].ExpirationEpoch < res[j].Expiring[0].ExpirationEpoch
+	})
+
+	return res, height, nil
+}
+
+// forecastExpiration returns the expiration forecast of a StorageInfo without
+// considering its storage configuration.
+func forecastExpiration(info ffs.StorageInfo, height, window uint64) ffs.ExpirationForecast {
+	f := ffs.ExpirationForecast{APIID: info.APIID, Cid: info.Cid}
+	for _, p := range info.Cold.Filecoin.Proposals {
+		expiration := p.StartEpoch + uint64(p.Duration)
+		if expiration <= height {
+			continue
+		}
+		f.ActiveDeals++
+		if expiration > height+window {
+			f.ForecastActiveDeals++
+			continue
+		}
+		f.Expiring = append(f.Expiring, ffs.ExpiringDeal{FilStorage: p, ExpirationEpoch: expiration})
+	}
+	sort.Slice(f.Expiring, func(i, j int) bool {
+		return f.Expiring[i].ExpirationEpoch < f.Expiring[j].ExpirationEpoch
+	})
+	return f
+}
+
+// fillRenewalState sets the RepFactor and renewal fields of a forecast. The
+// tracked storage config is used if available, since it's kept updated, and
+// the storage config of the Job which last updated the StorageInfo if not.
+func (s *Scheduler) fillRenewalState(f *ffs.ExpirationForecast, jid ffs.JobID) error {
+	cfg, ok, err := s.currentStorageConfig(f.APIID, f.Cid, jid)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	f.RepFactor = cfg.Cold.Filecoin.RepFactor
+	f.RenewEnabled = cfg.Cold.Enabled && cfg.Cold.Filecoin.Renew.Enabled
+	f.RenewThreshold = cfg.Cold.Filecoin.Renew.Threshold
+	if !f.RenewEnabled {
+		return nil
+	}
+	// If renewals weren't evaluated yet, they're assumed to be healthy
+	// until the first evaluation.
+	o, ok, err := s.rns.Get(f.APIID, f.Cid)
+	if err != nil {
+		return fmt.Errorf("getting renewal outcome: %s", err)
+	}
+	f.RenewHealthy = !ok || !o.Failed
+	return nil
+}
+
+// saveRenewalOutcome saves the outcome of a deal renewal evaluation of a Cid,
+// which failed if err isn't nil or some deal renewals failed.
+func (s *Scheduler) saveRenewalOutcome(iid ffs.APIID, c cid.Cid, err error, dealErrors []ffs.DealError) {
+	o := renewstore.Outcome{Time: time.Now()}
+	if err != nil {
+		o.Failed, o.Error = true, err.Error()
+	} else if len(dealErrors) > 0 {
+		o.Failed = true
+		o.Error = fmt.Sprintf("%d deal renewals failed, last error: %s", len(dealErrors), dealErrors[len(dealErrors)-1].Message)
+	}
+	if err := s.rns.Put(iid, c, o); err != nil {
+		log.Errorf("saving renewal outcome of %s: %s", c, err)
+	}
+}
+
+// currentStorageConfig returns the storage config of a Cid. The tracked storage
+// config is used if available, and the storage config of the Job jid if not.
+func (s *Scheduler) currentStorageConfig(iid ffs.APIID, c cid.Cid, jid ffs.JobID) (ffs.StorageConfig, bool, error) {
+	cfg, ok, err := s.ts.Get(iid, c)
+	if err != nil {
+		return ffs.StorageConfig{}, false, fmt.Errorf("getting tracked storage config: %s", err)
+	}
+	if !ok && jid != ffs.EmptyJobID {
+		a, err := s.as.GetStorageAction(jid)
+		if err != nil && err != astore.ErrNotFound {
+			return ffs.StorageConfig{}, false, fmt.Errorf("getting storage action: %s", err)
+		}
+		cfg, ok = a.Cfg, err == nil
+	}
+	return cfg, ok, nil
+}
+
+// execExpiryAlertCron logs an alert in the Cid log for every Cid whose active
+// deals are forecast to drop below its RepFactor within the alerting window.
+func (s *Scheduler) execExpiryAlertCron(ctx context.Context) {
+	forecasts, height, err := s.ForecastExpirations(ctx, nil, s.expiry.Window)
+	if err != nil {
+		log.Errorf("forecasting deal expirations: %s", err)
+		return
+	}
+	for _, f := range forecasts {
+		if !f.AtRisk() {
+			continue
+		}
+		lCtx := context.WithValue(ctx, ffs.CtxStorageCid, f.Cid)
+		lCtx = context.WithValue(lCtx, ffs.CtxAPIID, f.APIID)
+		first := f.Expiring[0].ExpirationEpoch
+		s.l.LogEvent(lCtx, ffs.LogEvent{
+			Level:  ffs.LogLevelWarn,
+			Type:   ffs.EventExpiryForecast,
+			Fields: map[string]string{ffs.LogFieldEpoch: strconv.FormatUint(first, 10)},
+		}, "Active deals are forecast to drop from %d to %d, below the replication factor of %d, with the first one expiring at epoch %d (current epoch %d).", f.ActiveDeals, f.ForecastActiveDeals, f.RepFactor, first, height)
+	}
+}
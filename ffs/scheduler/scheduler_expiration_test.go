@@ -0,0 +1,144 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/astore"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/renewstore"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/trackstore"
+	"github.com/textileio/powergate/v2/tests"
+	txndstr "github.com/textileio/powergate/v2/txndstransform"
+)
+
+func TestForecastExpiration(t *testing.T) {
+	t.Parallel()
+	c := mustCid(t, "QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	deal := func(id, start uint64, duration int64) ffs.FilStorage {
+		return ffs.FilStorage{DealID: id, StartEpoch: start, Duration: duration}
+	}
+	expiring := func(p ffs.FilStorage) ffs.ExpiringDeal {
+		return ffs.ExpiringDeal{FilStorage: p, ExpirationEpoch: p.StartEpoch + uint64(p.Duration)}
+	}
+
+	// The forecast is made at height 1000 with a window of 100 epochs.
+	expired := deal(1, 100, 900)
+	inside1 := deal(2, 500, 550)
+	inside2 := deal(3, 100, 920)
+	windowEnd := deal(4, 600, 500)
+	outside := deal(5, 1000, 1000)
+	cases := []struct {
+		name  string
+		deals []ffs.FilStorage
+		want  ffs.ExpirationForecast
+	}{
+		{
+			name:  "no deals",
+			deals: nil,
+			want:  ffs.ExpirationForecast{},
+		},
+		{
+			name:  "already expired",
+			deals: []ffs.FilStorage{expired},
+			want:  ffs.ExpirationForecast{},
+		},
+		{
+			name:  "inside window",
+			deals: []ffs.FilStorage{inside1, inside2, windowEnd},
+			want: ffs.ExpirationForecast{
+				ActiveDeals: 3,
+				Expiring:    []ffs.ExpiringDeal{expiring(inside2), expiring(inside1), expiring(windowEnd)},
+			},
+		},
+		{
+			name:  "outside window",
+			deals: []ffs.FilStorage{outside},
+			want: ffs.ExpirationForecast{
+				ActiveDeals:         1,
+				ForecastActiveDeals: 1,
+			},
+		},
+		{
+			name:  "mixed",
+			deals: []ffs.FilStorage{outside, expired, inside1},
+			want: ffs.ExpirationForecast{
+				ActiveDeals:         2,
+				ForecastActiveDeals: 1,
+				Expiring:            []ffs.ExpiringDeal{expiring(inside1)},
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			info := ffs.StorageInfo{
+				APIID: "a",
+				Cid:   c,
+				Cold:  ffs.ColdInfo{Filecoin: ffs.FilInfo{Proposals: tt.deals}},
+			}
+			tt.want.APIID, tt.want.Cid = "a", c
+			require.Equal(t, tt.want, forecastExpiration(info, 1000, 100))
+		})
+	}
+}
+
+func TestExpirationForecastAtRisk(t *testing.T) {
+	t.Parallel()
+	c := mustCid(t, "QmY7Yh4UquoXHLPFo2XbhXkhBvFoPwmQUSa92pxnxjQuPU")
+	cfg := func(renew bool) ffs.StorageConfig {
+		sc := ffs.StorageConfig{Repairable: true}
+		sc.Cold.Enabled = true
+		sc.Cold.Filecoin.RepFactor = 2
+		sc.Cold.Filecoin.Renew = ffs.FilRenew{Enabled: renew, Threshold: 100}
+		return sc
+	}
+	cases := []struct {
+		name    string
+		renew   bool
+		outcome *renewstore.Outcome
+		healthy bool
+	}{
+		{name: "renewals disabled", renew: false, healthy: false},
+		{name: "renewals not yet evaluated", renew: true, healthy: true},
+		{name: "healthy renewals", renew: true, outcome: &renewstore.Outcome{Time: time.Now()}, healthy: true},
+		{name: "failed renewals", renew: true, outcome: &renewstore.Outcome{Time: time.Now(), Failed: true, Error: "no miners"}, healthy: false},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			s := newForecastScheduler(t)
+			require.NoError(t, s.ts.Put("a", c, cfg(tt.renew)))
+			if tt.outcome != nil {
+				require.NoError(t, s.rns.Put("a", c, *tt.outcome))
+			}
+
+			f := ffs.ExpirationForecast{APIID: "a", Cid: c, ActiveDeals: 2, ForecastActiveDeals: 1}
+			require.NoError(t, s.fillRenewalState(&f, ffs.EmptyJobID))
+			require.Equal(t, 2, f.RepFactor)
+			require.Equal(t, tt.renew, f.RenewEnabled)
+			require.Equal(t, tt.healthy, f.RenewHealthy)
+			// Active deals are forecast to drop below the RepFactor, so
+			// the Cid is only at risk if renewals can't be relied on.
+			require.Equal(t, !tt.healthy, f.AtRisk())
+
+			// Enough deals remain active, so the Cid isn't at risk.
+			f.ForecastActiveDeals = 2
+			require.False(t, f.AtRisk())
+		})
+	}
+}
+
+func newForecastScheduler(t *testing.T) *Scheduler {
+	ds := tests.NewTxMapDatastore()
+	ts, err := trackstore.New(txndstr.Wrap(ds, "tstore"))
+	require.NoError(t, err)
+	return &Scheduler{
+		as:  astore.New(txndstr.Wrap(ds, "astore")),
+		ts:  ts,
+		rns: renewstore.New(txndstr.Wrap(ds, "renewstore")),
+	}
+}
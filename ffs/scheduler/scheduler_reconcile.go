@@ -0,0 +1,127 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/astore"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/cistore"
+)
+
+// HotReconcileConfig configures the periodic reconciliation of the Hot Storage
+// pinset with the pins of the underlying storage.
+type HotReconcileConfig struct {
+	// Frequency is the frequency of reconciliations. Zero disables
+	// them.
+	Frequency time.Duration
+	// RepinTimeout bounds re-pinning each missing Cid from the network.
+	RepinTimeout time.Duration
+	// UnpinUnknown unpins Cids pinned in the underlying storage which
+	// aren't known by Hot Storage.
+	UnpinUnknown bool
+}
+
+// ReconcileHotStorage reconciles the Hot Storage pinset with the pins of the underlying
+// storage. Jobs are scheduled to update the StorageInfo of Cids which are lost from
+// Hot Storage, and unfreeze them from Cold Storage if their storage config allows it.
+func (s *Scheduler) ReconcileHotStorage(ctx context.Context, opts ffs.HotReconcileOptions) (ffs.HotReconciliation, error) {
+	res, err := s.hs.Reconcile(ctx, opts)
+	if err != nil {
+		return ffs.HotReconciliation{}, fmt.Errorf("reconciling hot-storage: %s", err)
+	}
+	for _, pc := range res.Lost {
+		for _, p := range pc.APIIDs {
+			if p.Staged {
+				continue
+			}
+			jid, err := s.unfreezeLost(p.ID, pc.Cid)
+			if err != nil {
+				log.Errorf("unfreezing lost %s of %s: %s", pc.Cid, p.ID, err)
+				continue
+			}
+			if jid != ffs.EmptyJobID {
+				res.Unfreezes = append(res.Unfreezes, ffs.HotUnfreeze{APIID: p.ID, Cid: pc.Cid, JobID: jid})
+			}
+		}
+	}
+	return res, nil
+}
+
+// unfreezeLost schedules a Job which updates the StorageInfo of a Cid lost
+// from Hot Storage, and unfreezes it if its storage config allows it. If the
+// Job isn't expected to unfreeze the Cid, it returns ffs.EmptyJobID.
+func (s *Scheduler) unfreezeLost(iid ffs.APIID, c cid.Cid) (ffs.JobID, error) {
+	info, err := s.cis.Get(iid, c)
+	if err == cistore.ErrNotFound {
+		return ffs.EmptyJobID, nil
+	}
+	if err != nil {
+		return ffs.EmptyJobID, fmt.Errorf("getting storage info: %s", err)
+	}
+	cfg, ok, err := s.currentStorageConfig(iid, c, info.JobID)
+	if err != nil {
+		return ffs.EmptyJobID, err
+	}
+	jid, err := s.enqueueAction(astore.StorageAction{APIID: iid, Cid: c, Cfg: cfg, Lost: true}, "lost hot storage data update")
+	if err != nil {
+		return ffs.EmptyJobID, err
+	}
+	if !ok || !unfreezable(info, cfg) {
+		return ffs.EmptyJobID, nil
+	}
+	return jid, nil
+}
+
+// executeLost flags the data of a Cid as lost from Hot Storage in its
+// StorageInfo, and unfreezes it if its current storage config allows it.
+func (s *Scheduler) executeLost(ctx context.Context, a astore.StorageAction, job ffs.StorageJob, dealUpdates chan deals.StorageDealInfo) (ffs.StorageInfo, []ffs.DealError, error) {
+	info, err := s.cis.Get(a.APIID, a.Cid)
+	if err == cistore.ErrNotFound {
+		return ffs.StorageInfo{}, nil, fmt.Errorf("the cid isn't stored anymore")
+	}
+	if err != nil {
+		return ffs.StorageInfo{}, nil, fmt.Errorf("getting storage info: %s", err)
+	}
+	info.Hot.Enabled = false
+	if err := s.cis.Put(info); err != nil {
+		return ffs.StorageInfo{}, nil, fmt.Errorf("saving storage info: %s", err)
+	}
+
+	cfg, ok, err := s.currentStorageConfig(a.APIID, a.Cid, info.JobID)
+	if err != nil {
+		return ffs.StorageInfo{}, nil, err
+	}
+	if !ok || !cfg.Hot.Enabled {
+		return info, nil, nil
+	}
+	if !unfreezable(info, cfg) {
+		s.l.LogEvent(ctx, ffs.LogEvent{Level: ffs.LogLevelError, Type: ffs.EventHotStorage}, "Data was lost from Hot-Storage, and unfreeze is disabled or active Filecoin deals are unavailable.")
+		return info, nil, nil
+	}
+	s.l.LogEvent(ctx, ffs.LogEvent{Level: ffs.LogLevelWarn, Type: ffs.EventHotStorage}, "Data was lost from Hot-Storage, unfreezing from Filecoin...")
+	return s.executeStorage(ctx, astore.StorageAction{APIID: a.APIID, Cid: a.Cid, Cfg: cfg, Rehydrate: true}, job, dealUpdates)
+}
+
+// unfreezable returns true if data lost from Hot Storage can be unfrozen
+// from Cold Storage.
+func unfreezable(info ffs.StorageInfo, cfg ffs.StorageConfig) bool {
+	return cfg.Hot.Enabled && (cfg.Hot.AllowUnfreeze || cfg.Hot.Cache.Enabled) && len(info.Cold.Filecoin.Proposals) > 0
+}
+
+// execHotReconcileCron reconciles Hot Storage with the configured options.
+func (s *Scheduler) execHotReconcileCron(ctx context.Context) {
+	opts := ffs.HotReconcileOptions{
+		RepinTimeout: s.hotReconcile.RepinTimeout,
+		UnpinUnknown: s.hotReconcile.UnpinUnknown,
+	}
+	res, err := s.ReconcileHotStorage(ctx, opts)
+	if err != nil {
+		log.Errorf("reconciling hot storage: %s", err)
+		return
+	}
+	log.Infof("hot storage reconciled: %d missing, %d repinned, %d lost, %d unfreezing, %d unknown, %d unpinned", len(res.Missing), len(res.Repinned), len(res.Lost), len(res.Unfreezes), len(res.Unknown), len(res.Unpinned))
+}
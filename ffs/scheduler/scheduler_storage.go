@@ -4,21 +4,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
 	"github.com/textileio/powergate/v2/deals"
 	"github.com/textileio/powergate/v2/ffs"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/astore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/cistore"
 	"github.com/textileio/powergate/v2/ffs/scheduler/internal/sjstore"
+	"github.com/textileio/powergate/v2/util"
 )
 
 // PushConfig queues the specified StorageConfig to be executed as a new Job. It returns
 // the created JobID for further tracking of its state.
 func (s *Scheduler) PushConfig(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig) (ffs.JobID, error) {
-	return s.push(iid, c, cfg, cid.Undef)
+	return s.push(iid, c, cfg, cid.Undef, true)
 }
 
 // PushReplace queues a new StorageConfig to be executed as a new Job, replacing an oldCid that will be
@@ -27,10 +30,10 @@ func (s *Scheduler) PushReplace(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig,
 	if !oldCid.Defined() {
 		return ffs.EmptyJobID, fmt.Errorf("cid can't be undefined")
 	}
-	return s.push(iid, c, cfg, oldCid)
+	return s.push(iid, c, cfg, oldCid, true)
 }
 
-func (s *Scheduler) push(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, oldCid cid.Cid) (ffs.JobID, error) {
+func (s *Scheduler) push(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, oldCid cid.Cid, rehydrate bool) (ffs.JobID, error) {
 	if !c.Defined() {
 		return ffs.EmptyJobID, fmt.Errorf("cid can't be undefined")
 	}
@@ -40,6 +43,9 @@ func (s *Scheduler) push(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, oldCid
 	if err := cfg.Validate(); err != nil {
 		return ffs.EmptyJobID, fmt.Errorf("validating storage config: %s", err)
 	}
+	if cfg.Cold.Enabled && cfg.Cold.Encryption.Enabled && s.ks == nil {
+		return ffs.EmptyJobID, fmt.Errorf("cold storage encryption isn't supported")
+	}
 	jid := ffs.NewJobID()
 	j := ffs.StorageJob{
 		ID:        jid,
@@ -59,6 +65,7 @@ func (s *Scheduler) push(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, oldCid
 		Cid:         c,
 		Cfg:         cfg,
 		ReplacedCid: oldCid,
+		Rehydrate:   rehydrate,
 	}
 	if err := s.as.PutStorageAction(j.ID, aa); err != nil {
 		return ffs.EmptyJobID, fmt.Errorf("saving action for job: %s", err)
@@ -72,7 +79,7 @@ func (s *Scheduler) push(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, oldCid
 		return ffs.EmptyJobID, fmt.Errorf("enqueuing job: %s", err)
 	}
 	if jid := s.sjs.GetExecutingJob(iid, c); jid != nil {
-		s.l.Log(ctx, "Job %s is already being executed for the same data, this job will be queued until it finishes or is canceled.", jid)
+		s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventJobQueued}, "Job %s is already being executed for the same data, this job will be queued until it finishes or is canceled.", jid)
 	}
 
 	select {
@@ -80,15 +87,85 @@ func (s *Scheduler) push(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, oldCid
 	default:
 	}
 
-	s.l.Log(ctx, "Configuration saved successfully")
+	s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventJobQueued}, "Configuration saved successfully")
 	return jid, nil
 }
 
+// enqueueAction queues a Job which executes a maintenance action of the
+// stored data, such as a verification, described by what in Job logs. Jobs
+// are executed one at a time per Cid, so the action can safely update the
+// StorageInfo of the Cid. Queued Jobs for the Cid aren't canceled.
+func (s *Scheduler) enqueueAction(a astore.StorageAction, what string) (ffs.JobID, error) {
+	jid := ffs.NewJobID()
+	j := ffs.StorageJob{
+		ID:        jid,
+		APIID:     a.APIID,
+		Cid:       a.Cid,
+		Status:    ffs.Queued,
+		CreatedAt: time.Now().Unix(),
+	}
+
+	ctx := context.WithValue(context.Background(), ffs.CtxKeyJid, jid)
+	ctx = context.WithValue(ctx, ffs.CtxStorageCid, a.Cid)
+	ctx = context.WithValue(ctx, ffs.CtxAPIID, a.APIID)
+	s.l.Log(ctx, "Pushing new %s...", what)
+
+	if err := s.as.PutStorageAction(j.ID, a); err != nil {
+		return ffs.EmptyJobID, fmt.Errorf("saving action for job: %s", err)
+	}
+	if err := s.sjs.EnqueueKeep(j); err != nil {
+		return ffs.EmptyJobID, fmt.Errorf("enqueuing job: %s", err)
+	}
+
+	select {
+	case s.sd.evaluateQueue <- struct{}{}:
+	default:
+	}
+
+	s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventJobQueued}, "Queued %s successfully", what)
+	return jid, nil
+}
+
+// ActiveJob returns the last queued or executing Job for a Cid from iid.
+// When it finishes, every other Job for the Cid from iid has finished. If
+// there aren't active Jobs, it returns false.
+func (s *Scheduler) ActiveJob(iid ffs.APIID, c cid.Cid) (ffs.JobID, bool) {
+	jid := s.sjs.GetActiveJob(iid, c)
+	if jid == nil {
+		return ffs.EmptyJobID, false
+	}
+	return *jid, true
+}
+
+// Track updates the StorageConfig used by renewal and repair background crons
+// for a Cid from iid, without scheduling a new Job. If the StorageConfig isn't
+// repairable or renewable, the Cid is untracked.
+func (s *Scheduler) Track(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig) error {
+	if err := s.ts.Put(iid, c, cfg); err != nil {
+		return fmt.Errorf("saving repairable/renewable storage config: %s", err)
+	}
+	return nil
+}
+
+// TrackTxn is like Track, but writes in txn, which should be a transaction of
+// the root datastore of the datastore provided to New. This allows updating
+// the tracking state atomically with storage configs saved in other namespaces
+// of the root datastore.
+func (s *Scheduler) TrackTxn(txn datastore.Txn, iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig) error {
+	if err := s.ts.PutTxn(s.tds.WrapRootTxn(txn), iid, c, cfg); err != nil {
+		return fmt.Errorf("saving repairable/renewable storage config: %s", err)
+	}
+	return nil
+}
+
 // Untrack untracks a Cid from iid for renewal and repair background crons.
 func (s *Scheduler) Untrack(iid ffs.APIID, c cid.Cid) error {
 	if err := s.ts.Remove(iid, c); err != nil {
 		return fmt.Errorf("removing cid from action store: %s", err)
 	}
+	if err := s.rns.Remove(iid, c); err != nil {
+		return fmt.Errorf("removing renewal outcome: %s", err)
+	}
 	return nil
 }
 
@@ -206,6 +283,19 @@ func (s *Scheduler) StorageConfig(jid ffs.JobID) (ffs.StorageConfig, error) {
 // should be considered failed. If error is nil, it still can return []ffs.DealError
 // since some deals failing isn't necessarily a fatal Job config execution.
 func (s *Scheduler) executeStorage(ctx context.Context, a astore.StorageAction, job ffs.StorageJob, dealUpdates chan deals.StorageDealInfo) (ffs.StorageInfo, []ffs.DealError, error) {
+	if a.Verify != nil {
+		return s.executeVerify(ctx, a, job)
+	}
+	if len(a.RemoveDeals) > 0 {
+		return s.executeRemoveDeals(ctx, a)
+	}
+	if a.Evict {
+		return s.executeEvict(ctx, a)
+	}
+	if a.Lost {
+		return s.executeLost(ctx, a, job, dealUpdates)
+	}
+
 	ci, err := s.getRefreshedInfo(ctx, a.APIID, a.Cid)
 	if err != nil {
 		return ffs.StorageInfo{}, nil, fmt.Errorf("getting current cid info from store: %s", err)
@@ -219,13 +309,25 @@ func (s *Scheduler) executeStorage(ctx context.Context, a astore.StorageAction,
 
 	var hot ffs.HotInfo
 	if a.Cfg.Hot.Enabled {
-		s.l.Log(ctx, "Executing Hot-Storage configuration...")
-		hot, err = s.executeEnabledHotStorage(ctx, a.APIID, ci, a.Cfg.Hot, a.Cfg.Cold.Filecoin.Addr, a.ReplacedCid)
+		s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventHotStorage}, "Executing Hot-Storage configuration...")
+		keepEvicted, err := s.keepEvicted(ctx, a, ci)
 		if err != nil {
-			s.l.Log(ctx, "Enabled Hot-Storage excution failed.")
-			return ffs.StorageInfo{}, nil, fmt.Errorf("executing enabled hot-storage: %s", err)
+			return ffs.StorageInfo{}, nil, fmt.Errorf("evaluating evicted hot-storage: %s", err)
 		}
-		s.l.Log(ctx, "Hot-Storage configuration ran successfully.")
+		if keepEvicted {
+			hot = ci.Hot
+		} else {
+			hot, err = s.executeEnabledHotStorage(ctx, a.APIID, ci, a.Cfg.Hot, a.Cfg.Cold.Filecoin.Addr, a.ReplacedCid)
+			if err != nil {
+				s.l.LogEvent(ctx, ffs.LogEvent{Level: ffs.LogLevelError, Type: ffs.EventHotStorage}, "Enabled Hot-Storage excution failed.")
+				return ffs.StorageInfo{}, nil, fmt.Errorf("executing enabled hot-storage: %s", err)
+			}
+			if err := s.hs.SetCache(ctx, a.APIID, a.Cid, a.Cfg.Hot.Cache.Enabled, a.Cfg.Hot.Cache.TTL); err != nil {
+				return ffs.StorageInfo{}, nil, fmt.Errorf("setting hot-storage cache config: %s", err)
+			}
+		}
+		ci.Hot = hot
+		s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventHotStorage}, "Hot-Storage configuration ran successfully.")
 	}
 
 	// We want to avoid relying on Lotus working in online-mode.
@@ -240,21 +342,21 @@ func (s *Scheduler) executeStorage(ctx context.Context, a astore.StorageAction,
 		}
 	}
 
-	s.l.Log(ctx, "Executing Cold-Storage configuration...")
+	s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventColdStorage}, "Executing Cold-Storage configuration...")
 	cold, errors, err := s.executeColdStorage(ctx, ci, a.Cfg.Cold, dealUpdates)
 	if err != nil {
-		s.l.Log(ctx, "Cold-Storage execution failed.")
+		s.l.LogEvent(ctx, ffs.LogEvent{Level: ffs.LogLevelError, Type: ffs.EventColdStorage}, "Cold-Storage execution failed.")
 		return ffs.StorageInfo{}, errors, fmt.Errorf("executing cold-storage config: %s", err)
 	}
-	s.l.Log(ctx, "Cold-Storage configuration ran successfully.")
+	s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventColdStorage}, "Cold-Storage configuration ran successfully.")
 
 	if !a.Cfg.Hot.Enabled {
-		s.l.Log(ctx, "Executing Hot-Storage configuration...")
+		s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventHotStorage}, "Executing Hot-Storage configuration...")
 		if err := s.executeDisabledHotStorage(ctx, a.APIID, a.Cid); err != nil {
-			s.l.Log(ctx, "Disabled Hot-Storage execution failed.")
+			s.l.LogEvent(ctx, ffs.LogEvent{Level: ffs.LogLevelError, Type: ffs.EventHotStorage}, "Disabled Hot-Storage execution failed.")
 			return ffs.StorageInfo{}, nil, fmt.Errorf("executing disabled hot-storage: %s", err)
 		}
-		s.l.Log(ctx, "Hot-Storage configuration ran successfully.")
+		s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventHotStorage}, "Hot-Storage configuration ran successfully.")
 	}
 
 	return ffs.StorageInfo{
@@ -306,12 +408,14 @@ func (s *Scheduler) executeEnabledHotStorage(ctx context.Context, iid ffs.APIID,
 		size, err = s.hs.Replace(sctx, iid, replaceCid, curr.Cid)
 	}
 	if err != nil {
-		s.l.Log(ctx, "Direct fetching from IPFS wasn't possible.")
-		if !cfg.AllowUnfreeze || len(curr.Cold.Filecoin.Proposals) == 0 {
-			s.l.Log(ctx, "Unfreeze is disabled or active Filecoin deals are unavailable.")
+		s.l.LogEvent(ctx, ffs.LogEvent{Level: ffs.LogLevelWarn, Type: ffs.EventHotStorage}, "Direct fetching from IPFS wasn't possible.")
+		// Cached data is always allowed to be unfrozen, since that's
+		// how evicted data gets back to Hot Storage.
+		if (!cfg.AllowUnfreeze && !cfg.Cache.Enabled) || len(curr.Cold.Filecoin.Proposals) == 0 {
+			s.l.LogEvent(ctx, ffs.LogEvent{Level: ffs.LogLevelError, Type: ffs.EventHotStorage}, "Unfreeze is disabled or active Filecoin deals are unavailable.")
 			return ffs.HotInfo{}, fmt.Errorf("pinning cid in hot storage: %s", err)
 		}
-		s.l.Log(ctx, "Unfreezing from Filecoin...")
+		s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventUnfreezeStarted}, "Unfreezing from Filecoin...")
 		if len(curr.Cold.Filecoin.Proposals) == 0 {
 			return ffs.HotInfo{}, fmt.Errorf("no active deals to make retrieval possible")
 		}
@@ -328,8 +432,23 @@ func (s *Scheduler) executeEnabledHotStorage(ctx context.Context, iid ffs.APIID,
 		if err != nil {
 			return ffs.HotInfo{}, fmt.Errorf("unfreezing from Cold Storage: %s", err)
 		}
-		s.l.Log(ctx, "Unfrozen successfully from %s with cost %d attoFil, saving in Hot-Storage...", fi.RetrievedMiner, fi.FundsSpent)
-		size, err = s.hs.Pin(ctx, iid, curr.Cold.Filecoin.DataCid)
+		ev := ffs.LogEvent{
+			Type: ffs.EventUnfrozen,
+			Fields: map[string]string{
+				ffs.LogFieldMiner:  fi.RetrievedMiner,
+				ffs.LogFieldAmount: strconv.FormatUint(fi.FundsSpent, 10),
+			},
+		}
+		s.l.LogEvent(ctx, ev, "Unfrozen successfully from %s with cost %d attoFil, saving in Hot-Storage...", fi.RetrievedMiner, fi.FundsSpent)
+		pinCid := curr.Cold.Filecoin.DataCid
+		if curr.Cold.Filecoin.Encrypted {
+			s.l.Log(ctx, "Decrypting unfrozen data...")
+			if err := s.stageDecrypted(ctx, iid, curr.Cid, curr.Cold.Filecoin.DataCid); err != nil {
+				return ffs.HotInfo{}, fmt.Errorf("decrypting unfrozen data: %s", err)
+			}
+			pinCid = curr.Cid
+		}
+		size, err = s.hs.Pin(ctx, iid, pinCid)
 		if err != nil {
 			return ffs.HotInfo{}, fmt.Errorf("pinning unfrozen cid: %s", err)
 		}
@@ -372,6 +491,9 @@ func (s *Scheduler) getRefreshedHotInfo(ctx context.Context, iid ffs.APIID, c ci
 	if err != nil {
 		return ffs.HotInfo{}, err
 	}
+	if curr.Enabled {
+		curr.Evicted = false
+	}
 	return curr, nil
 }
 
@@ -380,7 +502,15 @@ func (s *Scheduler) getRefreshedColdInfo(ctx context.Context, curr ffs.ColdInfo)
 	for _, fp := range curr.Filecoin.Proposals {
 		_, err := s.cs.GetDealInfo(ctx, fp.DealID)
 		if err == ffs.ErrOnChainDealNotFound {
-			s.l.Log(ctx, "Detected that deal %d isn't active anymore, removing from active deals.", fp.DealID)
+			ev := ffs.LogEvent{
+				Level: ffs.LogLevelWarn,
+				Type:  ffs.EventDealStateChanged,
+				Fields: map[string]string{
+					ffs.LogFieldMiner:  fp.Miner,
+					ffs.LogFieldDealID: strconv.FormatUint(fp.DealID, 10),
+				},
+			}
+			s.l.LogEvent(ctx, ev, "Detected that deal %d isn't active anymore, removing from active deals.", fp.DealID)
 			continue
 		}
 		if err != nil {
@@ -399,6 +529,12 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 	}
 	curr.Cold.Enabled = true
 
+	// Existing deals were made with the data encrypted or not, so the
+	// encryption can't change until they expire.
+	if len(curr.Cold.Filecoin.Proposals) > 0 && curr.Cold.Filecoin.Encrypted != cfg.Encryption.Enabled {
+		return ffs.ColdInfo{}, nil, fmt.Errorf("encryption can't be changed while there are active deals")
+	}
+
 	// 1. If we recognize there were some unfinished started deals, then
 	// Powergate was closed while that was being executed. If that's the case
 	// we resume tracking those deals until they finish.
@@ -409,7 +545,7 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 	var allErrors []ffs.DealError
 	if len(sds) > 0 {
 		s.l.Log(ctx, "Resuming %d dettached executing deals...", len(sds))
-		okResumedDeals, failedResumedDeals := s.waitForDeals(ctx, curr.Cid, sds, dealUpdates)
+		okResumedDeals, failedResumedDeals := s.waitForDeals(ctx, curr.Cid, sds, cfg.Filecoin, dealUpdates)
 		s.l.Log(ctx, "A total of %d resumed deals finished successfully", len(okResumedDeals))
 		allErrors = append(allErrors, failedResumedDeals...)
 		// Append the resumed and confirmed deals to the current active proposals
@@ -426,12 +562,39 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 	if cfg.Filecoin.Renew.Enabled {
 		if curr.Hot.Enabled {
 			s.l.Log(ctx, "Checking deal renewals...")
-			newFilInfo, errors, err := s.cs.EnsureRenewals(ctx, curr.Cid, curr.Cold.Filecoin, cfg.Filecoin, s.dealFinalityTimeout, dealUpdates)
+			// Encrypted data is only prepared if renewals are due,
+			// since most evaluations don't renew deals.
+			renewCid := curr.Cid
+			if cfg.Encryption.Enabled {
+				renewCid = curr.Cold.Filecoin.DataCid
+				due, err := s.renewalDue(ctx, curr.Cold.Filecoin, cfg.Filecoin)
+				if err != nil {
+					s.saveRenewalOutcome(curr.APIID, curr.Cid, err, nil)
+					return ffs.ColdInfo{}, nil, fmt.Errorf("evaluating due renewals: %s", err)
+				}
+				if due {
+					if renewCid, err = s.coldDataCid(ctx, curr, cfg); err != nil {
+						s.saveRenewalOutcome(curr.APIID, curr.Cid, err, nil)
+						return ffs.ColdInfo{}, nil, err
+					}
+				}
+			}
+			newFilInfo, errors, err := s.cs.EnsureRenewals(ctx, renewCid, curr.Cold.Filecoin, cfg.Filecoin, s.dealFinalityTimeout, dealUpdates)
+			s.saveRenewalOutcome(curr.APIID, curr.Cid, err, errors)
 			if err != nil {
-				s.l.Log(ctx, "Deal renewal process couldn't be executed: %s", err)
+				s.l.LogEvent(ctx, ffs.LogEvent{Level: ffs.LogLevelError, Type: ffs.EventColdStorage, Fields: map[string]string{ffs.LogFieldError: err.Error()}}, "Deal renewal process couldn't be executed: %s", err)
 			} else {
 				for _, e := range errors {
-					s.l.Log(ctx, "Deal deal renewal errored. ProposalCid: %s, Miner: %s, Cause: %s", e.ProposalCid, e.Miner, e.Message)
+					ev := ffs.LogEvent{
+						Level: ffs.LogLevelError,
+						Type:  ffs.EventDealFailed,
+						Fields: map[string]string{
+							ffs.LogFieldMiner:       e.Miner,
+							ffs.LogFieldProposalCid: util.CidToString(e.ProposalCid),
+							ffs.LogFieldError:       e.Message,
+						},
+					}
+					s.l.LogEvent(ctx, ev, "Deal deal renewal errored. ProposalCid: %s, Miner: %s, Cause: %s", e.ProposalCid, e.Miner, e.Message)
 				}
 				numDeals := len(newFilInfo.Proposals) - len(curr.Cold.Filecoin.Proposals)
 				if numDeals > 0 {
@@ -450,6 +613,10 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 					log.Errorf("saving cid info to store: %s", err)
 				}
 			}
+		} else if curr.Hot.Evicted {
+			// Evicted data is unfrozen before if renewals are due,
+			// so there're no deals to be renewed.
+			s.l.Log(ctx, "Data is evicted from Hot-Storage and no deal renewals are due.")
 		} else {
 			// (**) Renewable note:
 			// This shouldn't happen since it would be an invalid Storage Config.
@@ -481,12 +648,36 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 		return curr.Cold, nil, nil
 	}
 
+	// Active deals of the same data made for other users are attached
+	// before making new deals, if enabled.
+	attached, attachedSize, err := s.attachDeals(ctx, curr, cfg)
+	if err != nil {
+		return ffs.ColdInfo{}, nil, fmt.Errorf("attaching deals of other users: %s", err)
+	}
+	if len(attached) > 0 {
+		curr.Cold.Filecoin.DataCid = curr.Cid
+		curr.Cold.Filecoin.Size = attachedSize
+		curr.Cold.Filecoin.Proposals = append(curr.Cold.Filecoin.Proposals, attached...)
+		if cfg.Filecoin.RepFactor-len(curr.Cold.Filecoin.Proposals) <= 0 {
+			s.l.Log(ctx, "The desired replication factor was reached attaching %d deals, avoiding making new deals.", len(attached))
+			return curr.Cold, allErrors, nil
+		}
+	}
+
 	// The answer is yes, calculate how many extra deals we need and create them.
 	deltaFilConfig := createDeltaFilConfig(cfg, curr.Cold.Filecoin)
+	currentMiners := make([]string, len(curr.Cold.Filecoin.Proposals))
+	for i, p := range curr.Cold.Filecoin.Proposals {
+		currentMiners[i] = p.Miner
+	}
 	s.l.Log(ctx, "Current replication factor is lower than desired, making %d new deals...", deltaFilConfig.RepFactor)
-	startedProposals, rejectedProposals, size, err := s.cs.Store(ctx, curr.Cid, deltaFilConfig)
+	dataCid, err := s.coldDataCid(ctx, curr, cfg)
+	if err != nil {
+		return ffs.ColdInfo{}, nil, err
+	}
+	startedProposals, rejectedProposals, size, err := s.cs.Store(ctx, dataCid, deltaFilConfig, currentMiners)
 	if err != nil {
-		s.l.Log(ctx, "Starting deals failed, with cause: %s", err)
+		s.l.LogEvent(ctx, ffs.LogEvent{Level: ffs.LogLevelError, Type: ffs.EventColdStorage, Fields: map[string]string{ffs.LogFieldError: err.Error()}}, "Starting deals failed, with cause: %s", err)
 		return ffs.ColdInfo{}, rejectedProposals, err
 	}
 	allErrors = append(allErrors, rejectedProposals...)
@@ -503,7 +694,7 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 	}
 
 	// Wait for started deals.
-	okDeals, failedDeals := s.waitForDeals(ctx, curr.Cid, startedProposals, dealUpdates)
+	okDeals, failedDeals := s.waitForDeals(ctx, curr.Cid, startedProposals, cfg.Filecoin, dealUpdates)
 	allErrors = append(allErrors, failedDeals...)
 	if err := s.sjs.RemoveStartedDeals(curr.APIID, curr.Cid); err != nil {
 		return ffs.ColdInfo{}, allErrors, fmt.Errorf("removing temporal started deals storage: %s", err)
@@ -519,14 +710,15 @@ func (s *Scheduler) executeColdStorage(ctx context.Context, curr ffs.StorageInfo
 	return ffs.ColdInfo{
 		Enabled: true,
 		Filecoin: ffs.FilInfo{
-			DataCid:   curr.Cid,
+			DataCid:   dataCid,
+			Encrypted: cfg.Encryption.Enabled,
 			Size:      uint64(size),
 			Proposals: append(okDeals, curr.Cold.Filecoin.Proposals...), // Append to any existing other proposals
 		},
 	}, allErrors, nil
 }
 
-func (s *Scheduler) waitForDeals(ctx context.Context, c cid.Cid, startedProposals []cid.Cid, dealUpdates chan deals.StorageDealInfo) ([]ffs.FilStorage, []ffs.DealError) {
+func (s *Scheduler) waitForDeals(ctx context.Context, c cid.Cid, startedProposals []cid.Cid, cfg ffs.FilConfig, dealUpdates chan deals.StorageDealInfo) ([]ffs.FilStorage, []ffs.DealError) {
 	s.l.Log(ctx, "Watching deals unfold...")
 
 	var failedDeals []ffs.DealError
@@ -553,6 +745,7 @@ func (s *Scheduler) waitForDeals(ctx context.Context, c cid.Cid, startedProposal
 				lock.Unlock()
 				return
 			}
+			res.FastRetrieval = cfg.FastRetrieval
 			lock.Lock()
 			okDeals = append(okDeals, res)
 			lock.Unlock()
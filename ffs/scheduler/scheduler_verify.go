@@ -0,0 +1,253 @@
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/ipfs/go-cid"
+	"github.com/ipld/go-ipld-prime/codec/dagjson"
+	basicnode "github.com/ipld/go-ipld-prime/node/basic"
+	"github.com/ipld/go-ipld-prime/traversal/selector"
+	"github.com/ipld/go-ipld-prime/traversal/selector/builder"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/astore"
+	"github.com/textileio/powergate/v2/ffs/scheduler/internal/cistore"
+)
+
+// PushVerify queues a Job which verifies the integrity of the stored data of a
+// Cid from iid. cfg is the current StorageConfig of the Cid, which is pushed
+// again to repair data that failed verification, if enabled. Queued Jobs for
+// the Cid, such as a pushed StorageConfig, aren't canceled by verifications.
+func (s *Scheduler) PushVerify(iid ffs.APIID, c cid.Cid, cfg ffs.StorageConfig, opts ffs.VerifyOptions) (ffs.JobID, error) {
+	if !c.Defined() {
+		return ffs.EmptyJobID, fmt.Errorf("cid can't be undefined")
+	}
+	if iid == ffs.EmptyInstanceID {
+		return ffs.EmptyJobID, fmt.Errorf("empty API ID")
+	}
+	if err := opts.Validate(); err != nil {
+		return ffs.EmptyJobID, fmt.Errorf("validating verify options: %s", err)
+	}
+	if _, err := s.cis.Get(iid, c); err == cistore.ErrNotFound {
+		return ffs.EmptyJobID, ErrNotFound
+	} else if err != nil {
+		return ffs.EmptyJobID, fmt.Errorf("getting storage info: %s", err)
+	}
+
+	aa := astore.StorageAction{
+		APIID:  iid,
+		Cid:    c,
+		Cfg:    cfg,
+		Verify: &opts,
+	}
+	return s.enqueueAction(aa, "verification")
+}
+
+// executeVerify verifies the stored data of a Cid, and returns its StorageInfo
+// with the results. If verification fails, the StorageInfo is saved and an
+// error is returned, so the Job is considered failed.
+func (s *Scheduler) executeVerify(ctx context.Context, a astore.StorageAction, job ffs.StorageJob) (ffs.StorageInfo, []ffs.DealError, error) {
+	info, err := s.getRefreshedInfo(ctx, a.APIID, a.Cid)
+	if err != nil {
+		return ffs.StorageInfo{}, nil, fmt.Errorf("getting current cid info from store: %s", err)
+	}
+	opts := *a.Verify
+
+	vi := ffs.VerifyInfo{JobID: job.ID}
+	if opts.Hot {
+		if info.Hot.Enabled {
+			hv, err := s.verifyHot(ctx, a.Cid)
+			if err != nil {
+				return ffs.StorageInfo{}, nil, err
+			}
+			vi.Hot = &hv
+		} else {
+			s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventVerification}, "Data isn't stored in Hot Storage, skipping its verification.")
+		}
+	}
+
+	var dealErrors []ffs.DealError
+	if opts.Cold {
+		sel, err := verifySelector(opts)
+		if err != nil {
+			return ffs.StorageInfo{}, nil, err
+		}
+		if len(info.Cold.Filecoin.Proposals) == 0 {
+			s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventVerification}, "There're no active deals, skipping their verification.")
+		}
+		for _, p := range info.Cold.Filecoin.Proposals {
+			if ctx.Err() != nil {
+				return ffs.StorageInfo{}, nil, fmt.Errorf("verification canceled")
+			}
+			dv := s.verifyDeal(ctx, info.Cold.Filecoin.DataCid, p, a.Cfg.Cold.Filecoin.Addr, opts.MaxPrice, sel)
+			if dv.Error != "" {
+				dealErrors = append(dealErrors, ffs.DealError{Miner: p.Miner, Message: dv.Error})
+			}
+			vi.Deals = append(vi.Deals, dv)
+		}
+	}
+	vi.Time = time.Now()
+	info.JobID = job.ID
+	info.Verification = vi
+
+	if vi.Healthy() {
+		s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventVerification}, "Verification finished, stored data is intact.")
+		return info, dealErrors, nil
+	}
+	if opts.Repair {
+		s.repairVerified(ctx, &info, a.Cfg)
+	}
+	if err := s.cis.Put(info); err != nil {
+		return ffs.StorageInfo{}, dealErrors, fmt.Errorf("saving verification results: %s", err)
+	}
+	return ffs.StorageInfo{}, dealErrors, fmt.Errorf("verification failed for %s", failedVerifications(vi))
+}
+
+// verifyHot walks the DAG of c in Hot Storage validating every block.
+func (s *Scheduler) verifyHot(ctx context.Context, c cid.Cid) (ffs.HotVerification, error) {
+	s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventVerification}, "Verifying Hot Storage data...")
+	hv, err := s.hs.Verify(ctx, c)
+	if err != nil {
+		return ffs.HotVerification{}, fmt.Errorf("verifying hot storage: %s", err)
+	}
+	if !hv.Healthy() {
+		ev := ffs.LogEvent{
+			Level:  ffs.LogLevelError,
+			Type:   ffs.EventVerification,
+			Fields: map[string]string{ffs.LogFieldError: hv.Error},
+		}
+		s.l.LogEvent(ctx, ev, "Hot Storage verification failed after validating %d blocks: %s", hv.Blocks, hv.Error)
+		return hv, nil
+	}
+	s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventVerification}, "Hot Storage data is intact, validated %d blocks of %s.", hv.Blocks, humanize.IBytes(hv.Size))
+	return hv, nil
+}
+
+// verifyDeal retrieves the data of a deal from its miner, restricted by the
+// dag-json encoded selector if not empty. The retrieval client validates every
+// retrieved block starting from the requested root, so a completed retrieval
+// means the miner holds the DAG of dataCid in the deal piece. The deal is only
+// considered corrupt if the retrieval completed with wrong data, since failing
+// to retrieve, e.g. if no miner offers the data within maxPrice, doesn't prove
+// the miner lost it.
+func (s *Scheduler) verifyDeal(ctx context.Context, dataCid cid.Cid, p ffs.FilStorage, waddr string, maxPrice uint64, selector string) ffs.DealVerification {
+	dv := ffs.DealVerification{DealID: p.DealID, Miner: p.Miner}
+	fields := map[string]string{
+		ffs.LogFieldMiner:  p.Miner,
+		ffs.LogFieldDealID: strconv.FormatUint(p.DealID, 10),
+	}
+	s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventVerification, Fields: fields}, "Verifying deal %d retrieving data from %s...", p.DealID, p.Miner)
+
+	var pieceCid *cid.Cid
+	if p.PieceCid.Defined() {
+		pieceCid = &p.PieceCid
+	}
+	fi, err := s.cs.Fetch(ctx, dataCid, pieceCid, waddr, []string{p.Miner}, maxPrice, selector)
+	if err != nil {
+		var corruptErr ffs.CorruptDataError
+		dv.Corrupt = errors.As(err, &corruptErr)
+		dv.Error = fmt.Sprintf("retrieving data: %s", err)
+		fields[ffs.LogFieldError] = dv.Error
+		if dv.Corrupt {
+			s.l.LogEvent(ctx, ffs.LogEvent{Level: ffs.LogLevelError, Type: ffs.EventVerification, Fields: fields}, "Deal %d verification failed: %s", p.DealID, dv.Error)
+		} else {
+			s.l.LogEvent(ctx, ffs.LogEvent{Level: ffs.LogLevelWarn, Type: ffs.EventVerification, Fields: fields}, "Deal %d couldn't be verified: %s", p.DealID, dv.Error)
+		}
+		return dv
+	}
+	dv.FundsSpent = fi.FundsSpent
+	fields[ffs.LogFieldAmount] = strconv.FormatUint(fi.FundsSpent, 10)
+	s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventVerification, Fields: fields}, "Deal %d is intact, retrieved with cost %d attoFil.", p.DealID, fi.FundsSpent)
+	return dv
+}
+
+// repairVerified repairs data which failed verification. Invalid blocks are
+// removed from Hot Storage and fetched again from the IPFS network, and corrupt
+// deals are removed from info, scheduling a Job with cfg which makes new deals
+// to replace them. Deals which couldn't be verified are kept.
+func (s *Scheduler) repairVerified(ctx context.Context, info *ffs.StorageInfo, cfg ffs.StorageConfig) {
+	vi := info.Verification
+	if vi.Hot != nil && !vi.Hot.Healthy() {
+		s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventVerification}, "Repairing Hot Storage data...")
+		if err := s.hs.Repair(ctx, info.Cid, vi.Hot.Invalid); err != nil {
+			ev := ffs.LogEvent{
+				Level:  ffs.LogLevelError,
+				Type:   ffs.EventVerification,
+				Fields: map[string]string{ffs.LogFieldError: err.Error()},
+			}
+			s.l.LogEvent(ctx, ev, "Repairing Hot Storage data failed: %s", err)
+		} else {
+			s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventVerification}, "Hot Storage data was repaired.")
+		}
+	}
+
+	failed := map[uint64]struct{}{}
+	for _, dv := range vi.Deals {
+		if dv.Corrupt {
+			failed[dv.DealID] = struct{}{}
+		}
+	}
+	if len(failed) == 0 || !cfg.Cold.Enabled {
+		return
+	}
+	proposals := make([]ffs.FilStorage, 0, len(info.Cold.Filecoin.Proposals))
+	for _, p := range info.Cold.Filecoin.Proposals {
+		if _, ok := failed[p.DealID]; !ok {
+			proposals = append(proposals, p)
+		}
+	}
+	info.Cold.Filecoin.Proposals = proposals
+	s.l.Log(ctx, "Removed %d corrupt deals from active deals.", len(failed))
+
+	jid, err := s.push(info.APIID, info.Cid, cfg, cid.Undef, false)
+	if err != nil {
+		s.l.LogEvent(ctx, ffs.LogEvent{Level: ffs.LogLevelError, Type: ffs.EventRepairScheduled, Fields: map[string]string{ffs.LogFieldError: err.Error()}}, "Scheduling deal repair errored: %s", err)
+		return
+	}
+	s.l.LogEvent(ctx, ffs.LogEvent{Type: ffs.EventRepairScheduled, Fields: map[string]string{ffs.LogFieldJobID: jid.String()}}, "Job %s was queued to replace corrupt deals.", jid)
+}
+
+// verifySelector returns the dag-json encoded selector of the data retrieved
+// from active deals with opts. In VerifySampled mode, the top opts.Depth levels
+// of the DAG are retrieved.
+func verifySelector(opts ffs.VerifyOptions) (string, error) {
+	if opts.Mode == ffs.VerifyFull {
+		return "", nil
+	}
+	ssb := builder.NewSelectorSpecBuilder(basicnode.Prototype.Any)
+	sel := ssb.ExploreRecursive(selector.RecursionLimitDepth(int64(opts.Depth)), ssb.ExploreAll(ssb.ExploreRecursiveEdge())).Node()
+	var buf bytes.Buffer
+	if err := dagjson.Encoder(sel, &buf); err != nil {
+		return "", fmt.Errorf("encoding verification selector: %s", err)
+	}
+	return buf.String(), nil
+}
+
+// failedVerifications describes the failed verifications of vi.
+func failedVerifications(vi ffs.VerifyInfo) string {
+	var failed []string
+	if vi.Hot != nil && !vi.Hot.Healthy() {
+		failed = append(failed, "hot storage")
+	}
+	var corrupt, unverified int
+	for _, dv := range vi.Deals {
+		if dv.Corrupt {
+			corrupt++
+		} else if dv.Error != "" {
+			unverified++
+		}
+	}
+	if corrupt > 0 {
+		failed = append(failed, fmt.Sprintf("%d of %d deals", corrupt, len(vi.Deals)))
+	}
+	if unverified > 0 {
+		failed = append(failed, fmt.Sprintf("%d of %d unverified deals", unverified, len(vi.Deals)))
+	}
+	return strings.Join(failed, " and ")
+}
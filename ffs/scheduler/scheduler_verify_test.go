@@ -0,0 +1,50 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+func TestVerifySelector(t *testing.T) {
+	t.Parallel()
+	sel, err := verifySelector(ffs.VerifyOptions{Mode: ffs.VerifyFull})
+	require.NoError(t, err)
+	require.Empty(t, sel)
+
+	sel, err = verifySelector(ffs.VerifyOptions{Mode: ffs.VerifySampled, Depth: 2})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"R":{"l":{"depth":2},":>":{"a":{">":{"@":{}}}}}}`, sel)
+}
+
+func TestFailedVerifications(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		vi   ffs.VerifyInfo
+		want string
+	}{
+		{
+			name: "hot storage",
+			vi:   ffs.VerifyInfo{Hot: &ffs.HotVerification{Error: "missing block"}},
+			want: "hot storage",
+		},
+		{
+			name: "corrupt and unverified deals",
+			vi: ffs.VerifyInfo{Deals: []ffs.DealVerification{
+				{DealID: 1},
+				{DealID: 2, Error: "wrong data", Corrupt: true},
+				{DealID: 3, Error: "no providers"},
+			}},
+			want: "1 of 3 deals and 1 of 3 unverified deals",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, failedVerifications(tt.vi))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package ffs
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/google/uuid"
@@ -234,6 +235,13 @@ func (s StorageConfig) WithColdAddr(addr string) StorageConfig {
 	return s
 }
 
+// WithColdFilDiversity specifies constraints on how replicas should be spread
+// between miners when making new deals.
+func (s StorageConfig) WithColdFilDiversity(diversity FilDiversity) StorageConfig {
+	s.Cold.Filecoin.Diversity = diversity
+	return s
+}
+
 // WithHotEnabled allows to enable/disable Hot storage usage.
 func (s StorageConfig) WithHotEnabled(enabled bool) StorageConfig {
 	s.Hot.Enabled = enabled
@@ -260,6 +268,21 @@ func (s StorageConfig) WithUnfreezeMaxPrice(maxPrice uint64) StorageConfig {
 	return s
 }
 
+// WithHotCache enables/disables using Hot Storage as a cache of Cold Storage,
+// evicting data that isn't accessed for ttl seconds.
+func (s StorageConfig) WithHotCache(enabled bool, ttl int64) StorageConfig {
+	s.Hot.Cache.Enabled = enabled
+	s.Hot.Cache.TTL = ttl
+	return s
+}
+
+// WithColdEncryption enables or disables the encryption of
+// data stored in Cold Storage.
+func (s StorageConfig) WithColdEncryption(enabled bool) StorageConfig {
+	s.Cold.Encryption.Enabled = enabled
+	return s
+}
+
 // Validate validates a StorageConfig.
 func (s StorageConfig) Validate() error {
 	if err := s.Hot.Validate(); err != nil {
@@ -273,6 +296,10 @@ func (s StorageConfig) Validate() error {
 	if s.Cold.Enabled && s.Cold.Filecoin.Renew.Enabled && !s.Hot.Enabled {
 		return fmt.Errorf("hot storage should be enabled to enable renewals")
 	}
+	// Evicted data is unfrozen from Cold Storage, so caching requires it.
+	if s.Hot.Enabled && s.Hot.Cache.Enabled && !s.Cold.Enabled {
+		return fmt.Errorf("cold storage should be enabled to enable hot storage caching")
+	}
 	return nil
 }
 
@@ -289,6 +316,9 @@ type HotConfig struct {
 	UnfreezeMaxPrice uint64
 	// Ipfs contains configuration related to storing Cid data in a IPFS node.
 	Ipfs IpfsConfig
+	// Cache contains configuration to use Hot Storage as a cache
+	// of data stored in Cold Storage.
+	Cache HotCacheConfig
 }
 
 // Validate validates a HotConfig.
@@ -299,6 +329,30 @@ func (hc HotConfig) Validate() error {
 	if err := hc.Ipfs.Validate(); err != nil {
 		return fmt.Errorf("invalid ipfs config: %s", err)
 	}
+	if err := hc.Cache.Validate(); err != nil {
+		return fmt.Errorf("invalid cache config: %s", err)
+	}
+	return nil
+}
+
+// HotCacheConfig is the desired caching behavior of a Cid in Hot Storage.
+// A cached Cid can be evicted from Hot Storage if it has healthy Filecoin
+// deals, and it's unfrozen again when its data is accessed.
+type HotCacheConfig struct {
+	// Enabled indicates that Hot Storage works as a cache of
+	// Cold Storage.
+	Enabled bool
+	// TTL is the amount of seconds without being accessed after which the
+	// Cid is evicted from Hot Storage. Zero means that the Cid is only
+	// evicted when the Hot Storage capacity budget is exceeded.
+	TTL int64
+}
+
+// Validate validates a HotCacheConfig.
+func (hcc HotCacheConfig) Validate() error {
+	if hcc.TTL < 0 {
+		return fmt.Errorf("ttl should be greater or equal to 0 seconds, got %d", hcc.TTL)
+	}
 	return nil
 }
 
@@ -326,6 +380,18 @@ type ColdConfig struct {
 	// Filecoin describes the desired Filecoin configuration for a Cid in the
 	// Filecoin network.
 	Filecoin FilConfig
+	// Encryption describes the desired encryption of data stored in
+	// the Filecoin network.
+	Encryption ColdEncryptionConfig
+}
+
+// ColdEncryptionConfig is the desired encryption of a Cid in cold storage.
+// Encrypted data is stored in Filecoin under a different Cid, but it's
+// transparently decrypted when unfrozen, so the original Cid is kept.
+type ColdEncryptionConfig struct {
+	// Enabled indicates that data is encrypted with a key of the
+	// APIID before making Filecoin deals.
+	Enabled bool
 }
 
 // Validate validates a ColdConfig.
@@ -375,6 +441,9 @@ type FilConfig struct {
 	DealStartOffset int64
 	// VerifiedDeal indicates if new deals should be marked as verified.
 	VerifiedDeal bool
+	// Diversity indicates constraints on how replicas should be spread
+	// between miners.
+	Diversity FilDiversity
 }
 
 // Validate returns a non-nil error if the configuration is invalid.
@@ -388,6 +457,45 @@ func (fc *FilConfig) Validate() error {
 	if err := fc.Renew.Validate(); err != nil {
 		return fmt.Errorf("invalid renew config: %s", err)
 	}
+	if err := fc.Diversity.Validate(); err != nil {
+		return fmt.Errorf("invalid diversity config: %s", err)
+	}
+	return nil
+}
+
+// FilDiversity contains constraints on how replicas of a Cid should be
+// spread between miners. A zero value means no constraints.
+type FilDiversity struct {
+	// MaxPerCountry is the maximum number of replicas stored by miners
+	// located in the same country. Zero means no limit.
+	MaxPerCountry int
+	// MaxPerContinent is the maximum number of replicas stored by miners
+	// located in the same continent. Zero means no limit.
+	MaxPerContinent int
+	// MinDistanceKm is the minimum distance in kilometers between the
+	// locations of any pair of miners storing replicas. Zero means no limit.
+	MinDistanceKm float64
+	// DistinctOperators indicates that miners sharing owner or worker
+	// addresses shouldn't store more than one replica.
+	DistinctOperators bool
+}
+
+// Enabled returns true if any diversity constraint is set.
+func (fd FilDiversity) Enabled() bool {
+	return fd.MaxPerCountry > 0 || fd.MaxPerContinent > 0 || fd.MinDistanceKm > 0 || fd.DistinctOperators
+}
+
+// Validate returns a non-nil error if the configuration is invalid.
+func (fd *FilDiversity) Validate() error {
+	if fd.MaxPerCountry < 0 {
+		return fmt.Errorf("max replicas per country can't be negative, got %d", fd.MaxPerCountry)
+	}
+	if fd.MaxPerContinent < 0 {
+		return fmt.Errorf("max replicas per continent can't be negative, got %d", fd.MaxPerContinent)
+	}
+	if fd.MinDistanceKm < 0 {
+		return fmt.Errorf("min distance can't be negative, got %f", fd.MinDistanceKm)
+	}
 	return nil
 }
 
@@ -436,6 +544,9 @@ type StorageInfo struct {
 	Hot HotInfo
 	// Cold contains cold storage information.
 	Cold ColdInfo
+	// Verification is the result of the last
+	// verification of the data.
+	Verification VerifyInfo
 }
 
 // HotInfo contains information about the current storage state
@@ -444,6 +555,9 @@ type HotInfo struct {
 	Enabled bool
 	Size    int
 	Ipfs    IpfsHotInfo
+	// Evicted indicates that the data was evicted from Hot Storage
+	// by caching, and will be unfrozen when accessed.
+	Evicted bool
 }
 
 // IpfsHotInfo contains information about the current storage state
@@ -464,6 +578,9 @@ type ColdInfo struct {
 type FilInfo struct {
 	// DataCid corresponds to the PayloadCid of the deal.
 	DataCid cid.Cid
+	// Encrypted indicates that DataCid is the Cid of the
+	// encrypted data.
+	Encrypted bool
 	// Size is the size of the Piece. Recall that this size
 	// is which is accounted for payment, and is usually
 	// greater than real data size since data is padded in
@@ -496,6 +613,264 @@ type FilStorage struct {
 	// EpochPrice is the price of attoFil per GiB
 	// per epoch paid in this deal.
 	EpochPrice uint64
+	// FastRetrieval indicates that the deal was made with
+	// fast retrieval enabled.
+	FastRetrieval bool
+}
+
+// ExpiringDeal is a Filecoin deal expiring within a forecast window.
+type ExpiringDeal struct {
+	FilStorage
+	// ExpirationEpoch is the epoch in which the deal expires.
+	ExpirationEpoch uint64
+}
+
+// ExpirationForecast describes the Filecoin deals of a stored Cid
+// expiring within a forecast window.
+type ExpirationForecast struct {
+	// APIID indicates from which instance this
+	// forecast belongs.
+	APIID APIID
+	// Cid of payload.
+	Cid cid.Cid
+	// RepFactor is the desired amount of active deals.
+	RepFactor int
+	// RenewEnabled indicates that deal-renewal is enabled for this Cid.
+	RenewEnabled bool
+	// RenewThreshold is the renew threshold in epochs of the Cid.
+	RenewThreshold int
+	// RenewHealthy indicates that deal-renewal is enabled, and the last
+	// deal renewal evaluation for the Cid didn't fail.
+	RenewHealthy bool
+	// ActiveDeals is the number of deals active at the forecast height.
+	ActiveDeals int
+	// ForecastActiveDeals is the number of deals still active at the
+	// end of the window if no deal gets renewed.
+	ForecastActiveDeals int
+	// Expiring contains the deals expiring within the window.
+	Expiring []ExpiringDeal
+}
+
+// AtRisk returns true if the active deals are forecast to drop below
+// RepFactor and deal-renewal can't be relied on to prevent it.
+func (ef ExpirationForecast) AtRisk() bool {
+	return ef.ForecastActiveDeals < ef.RepFactor && !ef.RenewHealthy
+}
+
+// Usage is the effective storage usage of a user, which attributes
+// data stored by multiple users in equal parts to each of them.
+type Usage struct {
+	// APIID indicates from which instance this
+	// usage belongs.
+	APIID APIID
+	// Cids is the number of Cids with storage information.
+	Cids int
+	// SharedCids is the number of Cids whose Hot Storage data or
+	// deals are shared with other users.
+	SharedCids int
+	// HotSize is the size in bytes of data in Hot Storage.
+	HotSize int64
+	// EffectiveHotSize is the size in bytes of data in Hot Storage
+	// attributed to the user.
+	EffectiveHotSize int64
+	// Deals is the number of active deals.
+	Deals int
+	// SharedDeals is the number of active deals shared with
+	// other users.
+	SharedDeals int
+	// ColdCost is the total cost in attoFIL of active deals.
+	ColdCost *big.Int
+	// EffectiveColdCost is the cost in attoFIL of active deals
+	// attributed to the user.
+	EffectiveColdCost *big.Int
+}
+
+// AuditDiscrepancyType is the type of a discrepancy between a deal
+// recorded in a StorageInfo and its on-chain state.
+type AuditDiscrepancyType int
+
+const (
+	// AuditUnspecified indicates a default or empty value.
+	AuditUnspecified AuditDiscrepancyType = iota
+	// AuditDealNotActive indicates the deal doesn't exist on-chain, expired,
+	// or was slashed. These deals are removed from the StorageInfo.
+	AuditDealNotActive
+	// AuditPieceCidMismatch indicates the on-chain deal is for a different
+	// PieceCid than the recorded one.
+	AuditPieceCidMismatch
+	// AuditMinerMismatch indicates the on-chain deal provider is different
+	// from the recorded miner.
+	AuditMinerMismatch
+	// AuditSectorNotSealed indicates the deal isn't included in a sealed
+	// sector yet.
+	AuditSectorNotSealed
+)
+
+// AuditDiscrepancyTypeStr maps AuditDiscrepancyType to describing string.
+var AuditDiscrepancyTypeStr = map[AuditDiscrepancyType]string{
+	AuditUnspecified:      "Unspecified",
+	AuditDealNotActive:    "DealNotActive",
+	AuditPieceCidMismatch: "PieceCidMismatch",
+	AuditMinerMismatch:    "MinerMismatch",
+	AuditSectorNotSealed:  "SectorNotSealed",
+}
+
+// AuditDiscrepancy is a discrepancy found auditing a recorded deal.
+type AuditDiscrepancy struct {
+	// Type is the discrepancy type.
+	Type AuditDiscrepancyType
+	// DealID is the deal id in the network.
+	DealID uint64
+	// Miner is the recorded miner of the deal.
+	Miner string
+	// Message describes the discrepancy.
+	Message string
+}
+
+// AuditReport is the result of reconciling the deals recorded in the
+// StorageInfo of a Cid with the Filecoin state.
+type AuditReport struct {
+	// APIID indicates from which instance this
+	// report belongs.
+	APIID APIID
+	// Cid of payload.
+	Cid cid.Cid
+	// Time is when the audit was executed.
+	Time time.Time
+	// Height is the chain height at the audit.
+	Height uint64
+	// AuditedDeals is the number of recorded deals audited.
+	AuditedDeals int
+	// ActiveDeals is the number of audited deals which are active on-chain.
+	ActiveDeals int
+	// Discrepancies are the discrepancies found.
+	Discrepancies []AuditDiscrepancy
+}
+
+// Healthy returns true if no discrepancies were found.
+func (ar AuditReport) Healthy() bool {
+	return len(ar.Discrepancies) == 0
+}
+
+// AuditSummary summarizes the health of a set of audit reports.
+type AuditSummary struct {
+	// LastAudit is the time of the most recent audit report.
+	LastAudit time.Time
+	// AuditedCids is the number of audited Cids.
+	AuditedCids int
+	// HealthyCids is the number of audited Cids without discrepancies.
+	HealthyCids int
+	// AuditedDeals is the number of audited deals.
+	AuditedDeals int
+	// ActiveDeals is the number of audited deals active on-chain.
+	ActiveDeals int
+	// Discrepancies is the number of discrepancies found by type.
+	Discrepancies map[AuditDiscrepancyType]int
+}
+
+// VerifyMode indicates which active deals are verified.
+type VerifyMode int
+
+const (
+	// VerifySampled verifies every active deal retrieving
+	// the top levels of the DAG.
+	VerifySampled VerifyMode = iota
+	// VerifyFull verifies every active deal retrieving
+	// the whole DAG.
+	VerifyFull
+)
+
+// VerifyOptions configures the verification of stored data.
+type VerifyOptions struct {
+	// Hot enables verifying the data in Hot Storage, walking
+	// its DAG and validating every block against its Cid.
+	Hot bool
+	// Cold enables verifying active deals, retrieving the data
+	// from their miners.
+	Cold bool
+	// Mode indicates how much data is retrieved from active deals.
+	Mode VerifyMode
+	// Depth is the number of DAG levels below the root retrieved
+	// from each active deal in VerifySampled mode.
+	Depth int
+	// MaxPrice is the maximum amount of attoFil to pay for
+	// each retrieval. Zero is no limit.
+	MaxPrice uint64
+	// Repair enables repairing data which failed verification.
+	Repair bool
+}
+
+// Validate validates a VerifyOptions.
+func (vo VerifyOptions) Validate() error {
+	if !vo.Hot && !vo.Cold {
+		return fmt.Errorf("hot or cold storage should be verified")
+	}
+	if vo.Mode != VerifySampled && vo.Mode != VerifyFull {
+		return fmt.Errorf("unknown verify mode %d", vo.Mode)
+	}
+	if vo.Cold && vo.Mode == VerifySampled && vo.Depth <= 0 {
+		return fmt.Errorf("depth should be positive")
+	}
+	return nil
+}
+
+// VerifyInfo is the result of verifying stored data.
+type VerifyInfo struct {
+	// JobID is the Job which verified the data.
+	JobID JobID
+	// Time is when the verification finished.
+	Time time.Time
+	// Hot is the result of verifying Hot Storage, if verified.
+	Hot *HotVerification
+	// Deals are the results of verifying active deals.
+	Deals []DealVerification
+}
+
+// Healthy returns true if every verification succeeded.
+func (vi VerifyInfo) Healthy() bool {
+	if vi.Hot != nil && !vi.Hot.Healthy() {
+		return false
+	}
+	for _, d := range vi.Deals {
+		if d.Error != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// HotVerification is the result of verifying data in Hot Storage.
+type HotVerification struct {
+	// Blocks is the number of validated blocks.
+	Blocks int
+	// Size is the total size of validated blocks.
+	Size uint64
+	// Invalid are blocks whose data doesn't match their Cid.
+	Invalid []cid.Cid
+	// Error is the cause of a failed verification.
+	Error string
+}
+
+// Healthy returns true if the whole DAG was validated.
+func (hv HotVerification) Healthy() bool {
+	return hv.Error == "" && len(hv.Invalid) == 0
+}
+
+// DealVerification is the result of verifying an active deal.
+type DealVerification struct {
+	// DealID is the id of the verified deal.
+	DealID uint64
+	// Miner is the miner storing the deal data.
+	Miner string
+	// FundsSpent is the amount of attoFil paid for the retrieval.
+	FundsSpent uint64
+	// Error is the cause of a failed verification.
+	Error string
+	// Corrupt indicates that the retrieval completed but the
+	// retrieved data didn't match the deal. If Error is set and
+	// Corrupt is false, the deal couldn't be verified, e.g. no
+	// miner offered the data or the transfer failed.
+	Corrupt bool
 }
 
 // JobLoggerCtxKey is a type to use in ctx values for CidLogger.
@@ -518,17 +893,155 @@ const (
 // JobLogger saves log information about a storage and retrieval tasks.
 type JobLogger interface {
 	Log(context.Context, string, ...interface{})
+	LogEvent(context.Context, LogEvent, string, ...interface{})
 	Watch(context.Context, chan<- LogEntry) error
 	GetByCid(context.Context, APIID, cid.Cid) ([]LogEntry, error)
 }
 
+// LogLevel is the severity of a log entry.
+type LogLevel int
+
+const (
+	// LogLevelInfo is an informational log entry. It's the default level.
+	LogLevelInfo LogLevel = iota
+	// LogLevelWarn is a log entry about a problem which doesn't
+	// necessarily make the execution fail.
+	LogLevelWarn
+	// LogLevelError is a log entry about a failure.
+	LogLevelError
+)
+
+// LogLevelStr maps LogLevel to describing string.
+var LogLevelStr = map[LogLevel]string{
+	LogLevelInfo:  "info",
+	LogLevelWarn:  "warn",
+	LogLevelError: "error",
+}
+
+// LogEventType is the kind of event described by a log entry.
+type LogEventType int
+
+const (
+	// EventUnspecified is a log entry without a particular event type.
+	EventUnspecified LogEventType = iota
+	// EventJobQueued indicates a Job was queued.
+	EventJobQueued
+	// EventJobExecuting indicates a Job started executing.
+	EventJobExecuting
+	// EventJobFailed indicates a Job execution failed.
+	EventJobFailed
+	// EventHotStorage indicates progress of Hot Storage execution.
+	EventHotStorage
+	// EventColdStorage indicates progress of Cold Storage execution.
+	EventColdStorage
+	// EventDealProposed indicates a deal was proposed to a miner.
+	EventDealProposed
+	// EventDealProposalFailed indicates a deal proposal couldn't be started.
+	EventDealProposalFailed
+	// EventDealAccepted indicates a miner accepted a deal proposal.
+	EventDealAccepted
+	// EventTransferStarted indicates the data transfer of a deal started.
+	EventTransferStarted
+	// EventSealing indicates a miner is sealing the data of a deal.
+	EventSealing
+	// EventDealStateChanged indicates any other deal state change.
+	EventDealStateChanged
+	// EventDealActive indicates a deal is active on-chain.
+	EventDealActive
+	// EventDealFailed indicates a deal failed.
+	EventDealFailed
+	// EventRenewalScheduled indicates a deal renewal evaluation was scheduled.
+	EventRenewalScheduled
+	// EventRepairScheduled indicates a repair evaluation was scheduled.
+	EventRepairScheduled
+	// EventUnfreezeStarted indicates data is being retrieved from Filecoin.
+	EventUnfreezeStarted
+	// EventUnfreezeProgress indicates progress of a retrieval from Filecoin.
+	EventUnfreezeProgress
+	// EventUnfrozen indicates data was retrieved from Filecoin.
+	EventUnfrozen
+	// EventExpiryForecast indicates the active deals of a Cid are
+	// forecast to drop below its RepFactor.
+	EventExpiryForecast
+	// EventHotEvicted indicates cached data was evicted from Hot Storage.
+	EventHotEvicted
+	// EventDealAttached indicates an active deal of the same data made
+	// for another user was attached instead of making a new deal.
+	EventDealAttached
+	// EventVerification indicates progress of a verification of stored data.
+	EventVerification
+	// EventMinerExcluded indicates a candidate miner wasn't selected for
+	// making a deal.
+	EventMinerExcluded
+)
+
+// LogEventTypeStr maps LogEventType to describing string.
+var LogEventTypeStr = map[LogEventType]string{
+	EventUnspecified:        "unspecified",
+	EventJobQueued:          "job-queued",
+	EventJobExecuting:       "job-executing",
+	EventJobFailed:          "job-failed",
+	EventHotStorage:         "hot-storage",
+	EventColdStorage:        "cold-storage",
+	EventDealProposed:       "deal-proposed",
+	EventDealProposalFailed: "deal-proposal-failed",
+	EventDealAccepted:       "deal-accepted",
+	EventTransferStarted:    "transfer-started",
+	EventSealing:            "sealing",
+	EventDealStateChanged:   "deal-state-changed",
+	EventDealActive:         "deal-active",
+	EventDealFailed:         "deal-failed",
+	EventRenewalScheduled:   "renewal-scheduled",
+	EventRepairScheduled:    "repair-scheduled",
+	EventUnfreezeStarted:    "unfreeze-started",
+	EventUnfreezeProgress:   "unfreeze-progress",
+	EventUnfrozen:           "unfrozen",
+	EventExpiryForecast:     "expiry-forecast",
+	EventHotEvicted:         "hot-evicted",
+	EventDealAttached:       "deal-attached",
+	EventVerification:       "verification",
+	EventMinerExcluded:      "miner-excluded",
+}
+
+// Keys of log entries fields.
+const (
+	// LogFieldMiner is the miner address related to the event.
+	LogFieldMiner = "miner"
+	// LogFieldProposalCid is the deal proposal cid related to the event.
+	LogFieldProposalCid = "proposal_cid"
+	// LogFieldDealID is the on-chain deal id related to the event.
+	LogFieldDealID = "deal_id"
+	// LogFieldAmount is an amount of attoFIL related to the event.
+	LogFieldAmount = "amount"
+	// LogFieldDealState is the deal state name related to the event.
+	LogFieldDealState = "deal_state"
+	// LogFieldJobID is a job id related to the event, which might be
+	// different from the job which generated the log entry.
+	LogFieldJobID = "job_id"
+	// LogFieldError is the error message related to the event.
+	LogFieldError = "error"
+	// LogFieldEpoch is a chain epoch related to the event.
+	LogFieldEpoch = "epoch"
+)
+
+// LogEvent is the machine-readable part of a log entry.
+type LogEvent struct {
+	Level  LogLevel
+	Type   LogEventType
+	Fields map[string]string
+}
+
 // LogEntry is a log entry from a Cid execution.
 type LogEntry struct {
-	APIID     APIID
-	Cid       cid.Cid
-	Timestamp time.Time
-	Jid       JobID
-	Msg       string
+	APIID       APIID
+	Cid         cid.Cid
+	RetrievalID RetrievalID
+	Timestamp   time.Time
+	Jid         JobID
+	Msg         string
+	Level       LogLevel
+	Type        LogEventType
+	Fields      map[string]string
 }
 
 // PaychDir specifies the direction of a payment channel.
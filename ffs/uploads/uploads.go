@@ -0,0 +1,438 @@
+package uploads
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logging "github.com/ipfs/go-log/v2"
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	"github.com/textileio/powergate/v2/ffs"
+)
+
+var (
+	log = logging.Logger("ffs-uploads")
+
+	// ErrNotFound indicates that the upload doesn't exist, or
+	// belongs to another instance.
+	ErrNotFound = errors.New("upload not found")
+	// ErrUnexpectedChunk indicates that a chunk was received
+	// out of order.
+	ErrUnexpectedChunk = errors.New("unexpected chunk index")
+	// ErrChecksumMismatch indicates that the data of a chunk doesn't
+	// match its checksum.
+	ErrChecksumMismatch = errors.New("chunk checksum mismatch")
+	// ErrSizeMismatch indicates that the received data size doesn't
+	// match the expected size of a finalized upload.
+	ErrSizeMismatch = errors.New("received size mismatch")
+	// ErrFinalizing indicates that the upload is being finalized.
+	ErrFinalizing = errors.New("upload is being finalized")
+)
+
+var defaultConfig = config{
+	ttl:             time.Hour * 24,
+	expiryFrequency: time.Hour,
+}
+
+type config struct {
+	ttl             time.Duration
+	expiryFrequency time.Duration
+}
+
+// Option configures a Store.
+type Option func(*config)
+
+// WithTTL configures how long an upload is kept since
+// its last received chunk.
+func WithTTL(ttl time.Duration) Option {
+	return func(c *config) {
+		c.ttl = ttl
+	}
+}
+
+// WithExpiryFrequency configures how often expired uploads are
+// deleted. Zero disables the background expiration.
+func WithExpiryFrequency(freq time.Duration) Option {
+	return func(c *config) {
+		c.expiryFrequency = freq
+	}
+}
+
+// Upload is a resumable upload session.
+type Upload struct {
+	ID    string
+	APIID ffs.APIID
+	// Chunks is the number of received chunks, which is also
+	// the index of the next expected chunk.
+	Chunks uint64
+	// Size is the number of received bytes.
+	Size      int64
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// Store receives data in numbered chunks into a file per upload, so
+// interrupted uploads can be resumed. Finalized uploads are staged in
+// hot storage.
+type Store struct {
+	ds   datastore.Datastore
+	path string
+	hs   ffs.HotStorage
+	cfg  config
+
+	// lock guards the datastore and the in-memory state of
+	// uploads, but not data files, which are written holding
+	// the lock of each upload.
+	lock        sync.Mutex
+	finalizing  map[string]struct{}
+	uploadLocks map[string]*uploadLock
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	finished chan struct{}
+}
+
+// New returns a new Store which saves upload sessions in ds, and received
+// data in path. If an expiry frequency is configured, a background worker
+// periodically deletes expired uploads.
+func New(ds datastore.Datastore, path string, hs ffs.HotStorage, opts ...Option) (*Store, error) {
+	cfg := defaultConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+	if err := os.MkdirAll(path, 0700); err != nil {
+		return nil, fmt.Errorf("creating uploads folder: %s", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Store{
+		ds:          ds,
+		path:        path,
+		hs:          hs,
+		cfg:         cfg,
+		finalizing:  map[string]struct{}{},
+		uploadLocks: map[string]*uploadLock{},
+		ctx:         ctx,
+		cancel:      cancel,
+		finished:    make(chan struct{}),
+	}
+	if cfg.expiryFrequency > 0 {
+		go s.expiryDaemon()
+	} else {
+		close(s.finished)
+	}
+	return s, nil
+}
+
+// Create creates a new upload owned by iid.
+func (s *Store) Create(iid ffs.APIID) (Upload, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := time.Now()
+	u := Upload{
+		ID:        uuid.New().String(),
+		APIID:     iid,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.cfg.ttl),
+	}
+	if err := s.save(u); err != nil {
+		return Upload{}, err
+	}
+	return u, nil
+}
+
+// Get returns the upload with the provided id owned by iid.
+func (s *Store) Get(iid ffs.APIID, id string) (Upload, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.get(iid, id)
+}
+
+// WriteChunk appends the data of the chunk with the provided index, which
+// should be the next expected one. The checksum is the SHA-256 digest of
+// data. Chunks which were already received are ignored, so retrying a chunk
+// is safe.
+func (s *Store) WriteChunk(iid ffs.APIID, id string, index uint64, data, checksum []byte) (Upload, error) {
+	unlock := s.lockUpload(id)
+	defer unlock()
+
+	u, err := s.writable(iid, id)
+	if err != nil {
+		return Upload{}, err
+	}
+	if index < u.Chunks {
+		return u, nil
+	}
+	if index > u.Chunks {
+		return Upload{}, ErrUnexpectedChunk
+	}
+	sum := sha256.Sum256(data)
+	if !bytes.Equal(sum[:], checksum) {
+		return Upload{}, ErrChecksumMismatch
+	}
+	if err := s.writeData(id, u.Size, data); err != nil {
+		return Upload{}, err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	// The upload might have been finalized or deleted
+	// while writing the data.
+	if _, err := s.get(iid, id); err != nil {
+		return Upload{}, err
+	}
+	if _, ok := s.finalizing[id]; ok {
+		return Upload{}, ErrFinalizing
+	}
+	u.Chunks++
+	u.Size += int64(len(data))
+	u.ExpiresAt = time.Now().Add(s.cfg.ttl)
+	if err := s.save(u); err != nil {
+		return Upload{}, err
+	}
+	return u, nil
+}
+
+// writable returns the upload with the provided id owned by iid, if it
+// isn't being finalized.
+func (s *Store) writable(iid ffs.APIID, id string) (Upload, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	u, err := s.get(iid, id)
+	if err != nil {
+		return Upload{}, err
+	}
+	if _, ok := s.finalizing[id]; ok {
+		return Upload{}, ErrFinalizing
+	}
+	return u, nil
+}
+
+// writeData writes data at offset in the data file of an upload.
+func (s *Store) writeData(id string, offset int64, data []byte) error {
+	f, err := os.OpenFile(s.dataPath(id), os.O_WRONLY|os.O_CREATE, 0600)
+	if err != nil {
+		return fmt.Errorf("opening upload data: %s", err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Errorf("closing upload data: %s", err)
+		}
+	}()
+	// Discard any data written after the last saved chunk,
+	// e.g: if the process crashed before saving the upload.
+	if err := f.Truncate(offset); err != nil {
+		return fmt.Errorf("truncating upload data: %s", err)
+	}
+	if _, err := f.WriteAt(data, offset); err != nil {
+		return fmt.Errorf("writing upload data: %s", err)
+	}
+	if err := f.Sync(); err != nil {
+		return fmt.Errorf("syncing upload data: %s", err)
+	}
+	return nil
+}
+
+// uploadLock serializes the chunk writes of an upload.
+type uploadLock struct {
+	sync.Mutex
+	refs int
+}
+
+// lockUpload locks the upload with the provided id for writing its data,
+// and returns a function to unlock it.
+func (s *Store) lockUpload(id string) func() {
+	s.lock.Lock()
+	ul, ok := s.uploadLocks[id]
+	if !ok {
+		ul = &uploadLock{}
+		s.uploadLocks[id] = ul
+	}
+	ul.refs++
+	s.lock.Unlock()
+
+	ul.Lock()
+	return func() {
+		ul.Unlock()
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		ul.refs--
+		if ul.refs == 0 {
+			delete(s.uploadLocks, id)
+		}
+	}
+}
+
+// Finalize stages the received data of the upload in hot storage as a UnixFS
+// DAG with the provided import options, and deletes the upload. The size is
+// the expected total size of the data, to detect incomplete uploads.
+func (s *Store) Finalize(ctx context.Context, iid ffs.APIID, id string, size int64, opts ...options.UnixfsAddOption) (cid.Cid, error) {
+	s.lock.Lock()
+	u, err := s.get(iid, id)
+	if err != nil {
+		s.lock.Unlock()
+		return cid.Undef, err
+	}
+	if _, ok := s.finalizing[id]; ok {
+		s.lock.Unlock()
+		return cid.Undef, ErrFinalizing
+	}
+	if u.Size != size {
+		s.lock.Unlock()
+		return cid.Undef, ErrSizeMismatch
+	}
+	s.finalizing[id] = struct{}{}
+	s.lock.Unlock()
+
+	c, err := s.stage(ctx, iid, id, size, opts)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.finalizing, id)
+	if err != nil {
+		return cid.Undef, err
+	}
+	if err := s.delete(id); err != nil {
+		log.Errorf("deleting finalized upload %s: %s", id, err)
+	}
+	return c, nil
+}
+
+func (s *Store) stage(ctx context.Context, iid ffs.APIID, id string, size int64, opts []options.UnixfsAddOption) (cid.Cid, error) {
+	f, err := os.OpenFile(s.dataPath(id), os.O_RDONLY|os.O_CREATE, 0600)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("opening upload data: %s", err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Errorf("closing upload data: %s", err)
+		}
+	}()
+	// Data after the last saved chunk, if any, isn't part of the upload.
+	c, err := s.hs.Stage(ctx, iid, io.LimitReader(f, size), opts...)
+	if err != nil {
+		return cid.Undef, fmt.Errorf("staging upload data in hot storage: %s", err)
+	}
+	return c, nil
+}
+
+// Expire deletes expired uploads, and returns the number
+// of deleted uploads.
+func (s *Store) Expire() (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	res, err := s.ds.Query(query.Query{})
+	if err != nil {
+		return 0, fmt.Errorf("querying uploads: %s", err)
+	}
+	defer func() { _ = res.Close() }()
+
+	now := time.Now()
+	var expired []string
+	for r := range res.Next() {
+		if r.Error != nil {
+			return 0, fmt.Errorf("iterating uploads: %s", r.Error)
+		}
+		var u Upload
+		if err := json.Unmarshal(r.Value, &u); err != nil {
+			return 0, fmt.Errorf("unmarshaling upload: %s", err)
+		}
+		if _, ok := s.finalizing[u.ID]; ok || now.Before(u.ExpiresAt) {
+			continue
+		}
+		if _, ok := s.uploadLocks[u.ID]; ok {
+			continue
+		}
+		expired = append(expired, u.ID)
+	}
+	for _, id := range expired {
+		if err := s.delete(id); err != nil {
+			return 0, err
+		}
+	}
+	return len(expired), nil
+}
+
+// Close closes the Store.
+func (s *Store) Close() error {
+	s.cancel()
+	<-s.finished
+	return nil
+}
+
+func (s *Store) expiryDaemon() {
+	defer close(s.finished)
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(s.cfg.expiryFrequency):
+			expired, err := s.Expire()
+			if err != nil {
+				log.Errorf("expiring uploads: %s", err)
+				continue
+			}
+			if expired > 0 {
+				log.Infof("deleted %d expired uploads", expired)
+			}
+		}
+	}
+}
+
+func (s *Store) get(iid ffs.APIID, id string) (Upload, error) {
+	buf, err := s.ds.Get(datastore.NewKey(id))
+	if err == datastore.ErrNotFound {
+		return Upload{}, ErrNotFound
+	}
+	if err != nil {
+		return Upload{}, fmt.Errorf("getting upload from datastore: %s", err)
+	}
+	var u Upload
+	if err := json.Unmarshal(buf, &u); err != nil {
+		return Upload{}, fmt.Errorf("unmarshaling upload: %s", err)
+	}
+	if u.APIID != iid {
+		return Upload{}, ErrNotFound
+	}
+	return u, nil
+}
+
+func (s *Store) save(u Upload) error {
+	buf, err := json.Marshal(u)
+	if err != nil {
+		return fmt.Errorf("marshaling upload: %s", err)
+	}
+	if err := s.ds.Put(datastore.NewKey(u.ID), buf); err != nil {
+		return fmt.Errorf("saving upload in datastore: %s", err)
+	}
+	return nil
+}
+
+func (s *Store) delete(id string) error {
+	if err := os.Remove(s.dataPath(id)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("removing upload data: %s", err)
+	}
+	if err := s.ds.Delete(datastore.NewKey(id)); err != nil {
+		return fmt.Errorf("deleting upload from datastore: %s", err)
+	}
+	return nil
+}
+
+func (s *Store) dataPath(id string) string {
+	return filepath.Join(s.path, id)
+}
@@ -0,0 +1,150 @@
+package uploads
+
+import (
+	"context"
+	"crypto/sha256"
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/tests"
+	"github.com/textileio/powergate/v2/util"
+)
+
+func TestUpload(t *testing.T) {
+	t.Parallel()
+	hs := &hotStorage{}
+	s := newStore(t, hs)
+	iid := ffs.NewAPIID()
+
+	u, err := s.Create(iid)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), u.Chunks)
+
+	_, err = s.Get(ffs.NewAPIID(), u.ID)
+	require.Equal(t, ErrNotFound, err)
+
+	chunks := [][]byte{[]byte("hello "), []byte("resumable "), []byte("world")}
+	_, err = s.WriteChunk(iid, u.ID, 1, chunks[1], checksum(chunks[1]))
+	require.Equal(t, ErrUnexpectedChunk, err)
+	_, err = s.WriteChunk(iid, u.ID, 0, chunks[0], checksum(chunks[1]))
+	require.Equal(t, ErrChecksumMismatch, err)
+
+	for i, chunk := range chunks[:2] {
+		u, err = s.WriteChunk(iid, u.ID, uint64(i), chunk, checksum(chunk))
+		require.NoError(t, err)
+	}
+	// Retrying a received chunk is a no-op.
+	u, err = s.WriteChunk(iid, u.ID, 1, chunks[1], checksum(chunks[1]))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), u.Chunks)
+	require.Equal(t, int64(16), u.Size)
+
+	_, err = s.Finalize(context.Background(), iid, u.ID, 21)
+	require.Equal(t, ErrSizeMismatch, err)
+
+	u, err = s.WriteChunk(iid, u.ID, 2, chunks[2], checksum(chunks[2]))
+	require.NoError(t, err)
+	c, err := s.Finalize(context.Background(), iid, u.ID, 21)
+	require.NoError(t, err)
+	require.Equal(t, hs.cid, c)
+	require.Equal(t, "hello resumable world", string(hs.data))
+
+	_, err = s.Get(iid, u.ID)
+	require.Equal(t, ErrNotFound, err)
+}
+
+func TestConcurrentChunks(t *testing.T) {
+	t.Parallel()
+	hs := &hotStorage{}
+	s := newStore(t, hs)
+	iid := ffs.NewAPIID()
+
+	// Concurrent retries of the same chunk are written once, while
+	// other uploads are written concurrently.
+	var ids []string
+	for i := 0; i < 3; i++ {
+		u, err := s.Create(iid)
+		require.NoError(t, err)
+		ids = append(ids, u.ID)
+	}
+	chunk := []byte("chunk")
+	var wg sync.WaitGroup
+	for _, id := range ids {
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func(id string) {
+				defer wg.Done()
+				_, err := s.WriteChunk(iid, id, 0, chunk, checksum(chunk))
+				require.NoError(t, err)
+			}(id)
+		}
+	}
+	wg.Wait()
+
+	for _, id := range ids {
+		u, err := s.Get(iid, id)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), u.Chunks)
+		require.Equal(t, int64(len(chunk)), u.Size)
+		_, err = s.Finalize(context.Background(), iid, id, u.Size)
+		require.NoError(t, err)
+		require.Equal(t, "chunk", string(hs.data))
+	}
+	require.Empty(t, s.uploadLocks)
+}
+
+func TestExpire(t *testing.T) {
+	t.Parallel()
+	s := newStore(t, &hotStorage{}, WithTTL(time.Millisecond*100))
+	iid := ffs.NewAPIID()
+
+	u1, err := s.Create(iid)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond * 200)
+	u2, err := s.Create(iid)
+	require.NoError(t, err)
+
+	expired, err := s.Expire()
+	require.NoError(t, err)
+	require.Equal(t, 1, expired)
+	_, err = s.Get(iid, u1.ID)
+	require.Equal(t, ErrNotFound, err)
+	_, err = s.Get(iid, u2.ID)
+	require.NoError(t, err)
+}
+
+func newStore(t *testing.T, hs ffs.HotStorage, opts ...Option) *Store {
+	s, err := New(tests.NewTxnDatastore(t), t.TempDir(), hs, append(opts, WithExpiryFrequency(0))...)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, s.Close()) })
+	return s
+}
+
+func checksum(data []byte) []byte {
+	sum := sha256.Sum256(data)
+	return sum[:]
+}
+
+type hotStorage struct {
+	ffs.HotStorage
+
+	data []byte
+	cid  cid.Cid
+}
+
+func (hs *hotStorage) Stage(ctx context.Context, iid ffs.APIID, r io.Reader, opts ...options.UnixfsAddOption) (cid.Cid, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return cid.Undef, err
+	}
+	hs.data = data
+	hs.cid, err = util.CidFromString("QmPewMLNYZS5ehyCRWnYpGMMvw5TJhLkHFkhYTNJBjnQZp")
+	return hs.cid, err
+}
@@ -0,0 +1,593 @@
+package gateway
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/powergate/v2/index/miner"
+	"github.com/textileio/powergate/v2/reputation"
+)
+
+const (
+	apiBasePath  = "/api/v1"
+	defaultLimit = 100
+	maxLimit     = 1000
+)
+
+type paramType string
+
+const (
+	paramString  paramType = "string"
+	paramInteger paramType = "integer"
+	paramNumber  paramType = "number"
+	paramBoolean paramType = "boolean"
+)
+
+// apiParam describes a filtering query parameter of an endpoint.
+type apiParam struct {
+	Name        string
+	Type        paramType
+	Description string
+}
+
+// apiEndpoint describes a paginated list endpoint of the REST API. Endpoints
+// are the single source for both the HTTP handlers and the OpenAPI spec.
+type apiEndpoint struct {
+	Path    string
+	Summary string
+	Params  []apiParam
+	// SortFields are the allowed values of the sort parameter,
+	// the first one is the default.
+	SortFields []string
+	// Item is a zero value of the listed items type.
+	Item interface{}
+
+	// version returns a value that changes every time the underlying
+	// indices are updated. It's used to generate ETags.
+	version func() string
+	// list returns the filtered items and when the data was last updated.
+	list func(p queryParams) ([]interface{}, *time.Time, error)
+	// less compares two items by a sort field.
+	less func(field string, a, b interface{}) bool
+}
+
+// apiList is the response of every list endpoint.
+type apiList struct {
+	LastUpdated *time.Time    `json:"lastUpdated,omitempty"`
+	Total       int           `json:"total"`
+	Limit       int           `json:"limit"`
+	Offset      int           `json:"offset"`
+	Items       []interface{} `json:"items"`
+}
+
+type apiError struct {
+	Error string `json:"error"`
+}
+
+type apiAsk struct {
+	Miner         string `json:"miner"`
+	Price         uint64 `json:"price"`
+	VerifiedPrice uint64 `json:"verifiedPrice"`
+	MinPieceSize  uint64 `json:"minPieceSize"`
+	MaxPieceSize  uint64 `json:"maxPieceSize"`
+	Timestamp     int64  `json:"timestamp"`
+	Expiry        int64  `json:"expiry"`
+}
+
+type apiMiner struct {
+	Address       string  `json:"address"`
+	Country       string  `json:"country"`
+	Latitude      float64 `json:"latitude"`
+	Longitude     float64 `json:"longitude"`
+	UserAgent     string  `json:"userAgent"`
+	Power         uint64  `json:"power"`
+	RelativePower float64 `json:"relativePower"`
+	SectorSize    uint64  `json:"sectorSize"`
+	SectorsLive   uint64  `json:"sectorsLive"`
+	SectorsActive uint64  `json:"sectorsActive"`
+	SectorsFaulty uint64  `json:"sectorsFaulty"`
+	Price         *uint64 `json:"price"`
+	VerifiedPrice *uint64 `json:"verifiedPrice"`
+	Score         *int    `json:"score"`
+}
+
+type apiFaults struct {
+	Miner  string  `json:"miner"`
+	Count  int     `json:"count"`
+	Epochs []int64 `json:"epochs"`
+}
+
+type apiReputation struct {
+	Miner string `json:"miner"`
+	Rank  int    `json:"rank"`
+	Score int    `json:"score"`
+}
+
+// registerAPI registers the REST API endpoints and its OpenAPI spec.
+func (g *Gateway) registerAPI(rg *gin.RouterGroup, basePath string) {
+	endpoints := g.apiEndpoints()
+	api := rg.Group(apiBasePath)
+	for _, ep := range endpoints {
+		api.GET(ep.Path, g.apiHandler(ep))
+	}
+	spec := openAPISpec(basePath+apiBasePath, endpoints)
+	api.GET("/openapi.json", func(c *gin.Context) {
+		c.JSON(http.StatusOK, spec)
+	})
+}
+
+func (g *Gateway) apiEndpoints() []apiEndpoint {
+	return []apiEndpoint{
+		{
+			Path:    "/asks",
+			Summary: "List storage asks of miners",
+			Params: []apiParam{
+				{Name: "miner", Type: paramString, Description: "Only include the ask of this miner"},
+				{Name: "maxPrice", Type: paramInteger, Description: "Maximum price in attoFIL per GiB per epoch"},
+				{Name: "maxVerifiedPrice", Type: paramInteger, Description: "Maximum verified price in attoFIL per GiB per epoch"},
+				{Name: "pieceSize", Type: paramInteger, Description: "Only include asks accepting this piece size"},
+			},
+			SortFields: []string{"miner", "price", "verifiedPrice", "minPieceSize", "maxPieceSize", "expiry"},
+			Item:       apiAsk{},
+			version:    g.asksVersion,
+			list:       g.listAsks,
+			less:       lessAsks,
+		},
+		{
+			Path:    "/miners",
+			Summary: "List miners with on-chain, location, price and reputation data",
+			Params: []apiParam{
+				{Name: "country", Type: paramString, Description: "Only include miners located in this ISO country code"},
+				{Name: "minPower", Type: paramInteger, Description: "Minimum miner power in bytes"},
+				{Name: "maxPrice", Type: paramInteger, Description: "Maximum ask price in attoFIL per GiB per epoch"},
+				{Name: "minScore", Type: paramInteger, Description: "Minimum reputation score"},
+				{Name: "hasAsk", Type: paramBoolean, Description: "Only include miners with (or without) an ask"},
+			},
+			SortFields: []string{"address", "power", "price", "score", "country"},
+			Item:       apiMiner{},
+			version:    g.minersVersion,
+			list:       g.listMiners,
+			less:       lessMiners,
+		},
+		{
+			Path:    "/faults",
+			Summary: "List miners faults history",
+			Params: []apiParam{
+				{Name: "miner", Type: paramString, Description: "Only include faults of this miner"},
+				{Name: "minFaults", Type: paramInteger, Description: "Minimum number of faults"},
+			},
+			SortFields: []string{"miner", "count"},
+			Item:       apiFaults{},
+			version:    g.faultsVersion,
+			list:       g.listFaults,
+			less:       lessFaults,
+		},
+		{
+			Path:    "/reputation",
+			Summary: "List miners reputation scores",
+			Params: []apiParam{
+				{Name: "minScore", Type: paramInteger, Description: "Minimum reputation score"},
+			},
+			SortFields: []string{"rank", "miner", "score"},
+			Item:       apiReputation{},
+			version:    g.reputationVersion,
+			list:       g.listReputation,
+			less:       lessReputation,
+		},
+	}
+}
+
+func (g *Gateway) apiHandler(ep apiEndpoint) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		p, err := parseQueryParams(c.Request.URL.Query(), ep)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, apiError{Error: err.Error()})
+			return
+		}
+
+		// ETags depend on the index version and the query, since
+		// different queries return different representations.
+		etag := makeETag(ep.Path, ep.version(), c.Request.URL.Query().Encode())
+		c.Header("ETag", etag)
+		c.Header("Cache-Control", "no-cache")
+		if matchesETag(c.GetHeader("If-None-Match"), etag) {
+			c.Status(http.StatusNotModified)
+			return
+		}
+
+		items, lastUpdated, err := ep.list(p)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, apiError{Error: err.Error()})
+			return
+		}
+		// Items are first ordered by the default sort field, which is unique,
+		// so ties in the requested sort field have a stable order between pages.
+		sort.Slice(items, func(i, j int) bool {
+			return ep.less(ep.SortFields[0], items[i], items[j])
+		})
+		sort.SliceStable(items, func(i, j int) bool {
+			if p.desc {
+				return ep.less(p.sort, items[j], items[i])
+			}
+			return ep.less(p.sort, items[i], items[j])
+		})
+
+		total := len(items)
+		start, end := p.offset, p.offset+p.limit
+		if start > total {
+			start = total
+		}
+		if end > total {
+			end = total
+		}
+		c.JSON(http.StatusOK, apiList{
+			LastUpdated: lastUpdated,
+			Total:       total,
+			Limit:       p.limit,
+			Offset:      p.offset,
+			Items:       append([]interface{}{}, items[start:end]...),
+		})
+	}
+}
+
+func (g *Gateway) asksVersion() string {
+	return g.askIndex.Get().LastUpdated.String()
+}
+
+func (g *Gateway) listAsks(p queryParams) ([]interface{}, *time.Time, error) {
+	index := g.askIndex.Get()
+	miner := p.str("miner")
+	maxPrice, hasMaxPrice := p.uint("maxPrice")
+	maxVerifiedPrice, hasMaxVerifiedPrice := p.uint("maxVerifiedPrice")
+	pieceSize, hasPieceSize := p.uint("pieceSize")
+
+	items := make([]interface{}, 0, len(index.Storage))
+	for _, a := range index.Storage {
+		if miner != "" && a.Miner != miner {
+			continue
+		}
+		if hasMaxPrice && a.Price > maxPrice {
+			continue
+		}
+		if hasMaxVerifiedPrice && a.VerifiedPrice > maxVerifiedPrice {
+			continue
+		}
+		if hasPieceSize && (pieceSize < a.MinPieceSize || pieceSize > a.MaxPieceSize) {
+			continue
+		}
+		items = append(items, apiAsk(a))
+	}
+	return items, &index.LastUpdated, nil
+}
+
+func lessAsks(field string, a, b interface{}) bool {
+	l, r := a.(apiAsk), b.(apiAsk)
+	switch field {
+	case "price":
+		return l.Price < r.Price
+	case "verifiedPrice":
+		return l.VerifiedPrice < r.VerifiedPrice
+	case "minPieceSize":
+		return l.MinPieceSize < r.MinPieceSize
+	case "maxPieceSize":
+		return l.MaxPieceSize < r.MaxPieceSize
+	case "expiry":
+		return l.Expiry < r.Expiry
+	default:
+		return l.Miner < r.Miner
+	}
+}
+
+func (g *Gateway) minersVersion() string {
+	index := g.minerIndex.Get()
+	return fmt.Sprintf("%d-%s-%s-%s", index.OnChain.LastUpdated, latestMetaUpdate(index.Meta.Info), g.asksVersion(), g.reputationVersion())
+}
+
+func (g *Gateway) listMiners(p queryParams) ([]interface{}, *time.Time, error) {
+	index := g.minerIndex.Get()
+	asks := g.askIndex.Get().Storage
+	scores, err := g.scores()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	country := p.str("country")
+	minPower, hasMinPower := p.uint("minPower")
+	maxPrice, hasMaxPrice := p.uint("maxPrice")
+	minScore, hasMinScore := p.uint("minScore")
+	hasAsk, filterHasAsk := p.boolean("hasAsk")
+
+	addrs := make(map[string]struct{}, len(index.OnChain.Miners))
+	for addr := range index.OnChain.Miners {
+		addrs[addr] = struct{}{}
+	}
+	for addr := range index.Meta.Info {
+		addrs[addr] = struct{}{}
+	}
+	items := make([]interface{}, 0, len(addrs))
+	for addr := range addrs {
+		meta := index.Meta.Info[addr]
+		chain := index.OnChain.Miners[addr]
+		m := apiMiner{
+			Address:       addr,
+			Country:       meta.Location.Country,
+			Latitude:      meta.Location.Latitude,
+			Longitude:     meta.Location.Longitude,
+			UserAgent:     meta.UserAgent,
+			Power:         chain.Power,
+			RelativePower: chain.RelativePower,
+			SectorSize:    chain.SectorSize,
+			SectorsLive:   chain.SectorsLive,
+			SectorsActive: chain.SectorsActive,
+			SectorsFaulty: chain.SectorsFaulty,
+		}
+		if a, ok := asks[addr]; ok {
+			price, verifiedPrice := a.Price, a.VerifiedPrice
+			m.Price = &price
+			m.VerifiedPrice = &verifiedPrice
+		}
+		if s, ok := scores[addr]; ok {
+			score := s.Score
+			m.Score = &score
+		}
+
+		if country != "" && !strings.EqualFold(m.Country, country) {
+			continue
+		}
+		if hasMinPower && m.Power < minPower {
+			continue
+		}
+		if hasMaxPrice && (m.Price == nil || *m.Price > maxPrice) {
+			continue
+		}
+		if hasMinScore && (m.Score == nil || *m.Score < int(minScore)) {
+			continue
+		}
+		if filterHasAsk && hasAsk != (m.Price != nil) {
+			continue
+		}
+		items = append(items, m)
+	}
+	lastUpdated := epochToTime(index.OnChain.LastUpdated)
+	return items, &lastUpdated, nil
+}
+
+func lessMiners(field string, a, b interface{}) bool {
+	l, r := a.(apiMiner), b.(apiMiner)
+	switch field {
+	case "power":
+		return l.Power < r.Power
+	case "price":
+		// Miners without an ask are always last.
+		if l.Price == nil || r.Price == nil {
+			return l.Price != nil && r.Price == nil
+		}
+		return *l.Price < *r.Price
+	case "score":
+		if l.Score == nil || r.Score == nil {
+			return l.Score == nil && r.Score != nil
+		}
+		return *l.Score < *r.Score
+	case "country":
+		return l.Country < r.Country
+	default:
+		return l.Address < r.Address
+	}
+}
+
+func (g *Gateway) faultsVersion() string {
+	return g.faultsIndex.Get().TipSetKey
+}
+
+func (g *Gateway) listFaults(p queryParams) ([]interface{}, *time.Time, error) {
+	index := g.faultsIndex.Get()
+	miner := p.str("miner")
+	minFaults, hasMinFaults := p.uint("minFaults")
+
+	items := make([]interface{}, 0, len(index.Miners))
+	for addr, f := range index.Miners {
+		if miner != "" && addr != miner {
+			continue
+		}
+		if hasMinFaults && uint64(len(f.Epochs)) < minFaults {
+			continue
+		}
+		items = append(items, apiFaults{
+			Miner:  addr,
+			Count:  len(f.Epochs),
+			Epochs: append([]int64{}, f.Epochs...),
+		})
+	}
+	return items, nil, nil
+}
+
+func lessFaults(field string, a, b interface{}) bool {
+	l, r := a.(apiFaults), b.(apiFaults)
+	if field == "count" {
+		return l.Count < r.Count
+	}
+	return l.Miner < r.Miner
+}
+
+func (g *Gateway) reputationVersion() string {
+	topMiners, err := g.reputationModule.GetTopMiners(math.MaxInt32)
+	if err != nil {
+		return ""
+	}
+	h := sha256.New()
+	for _, m := range topMiners {
+		_, _ = fmt.Fprintf(h, "%s:%d,", m.Addr, m.Score)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (g *Gateway) listReputation(p queryParams) ([]interface{}, *time.Time, error) {
+	topMiners, err := g.reputationModule.GetTopMiners(math.MaxInt32)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting reputation scores: %s", err)
+	}
+	minScore, hasMinScore := p.uint("minScore")
+
+	items := make([]interface{}, 0, len(topMiners))
+	for i, m := range topMiners {
+		if hasMinScore && m.Score < int(minScore) {
+			continue
+		}
+		items = append(items, apiReputation{Miner: m.Addr, Rank: i + 1, Score: m.Score})
+	}
+	return items, nil, nil
+}
+
+func lessReputation(field string, a, b interface{}) bool {
+	l, r := a.(apiReputation), b.(apiReputation)
+	switch field {
+	case "miner":
+		return l.Miner < r.Miner
+	case "score":
+		return l.Score < r.Score
+	default:
+		return l.Rank < r.Rank
+	}
+}
+
+func (g *Gateway) scores() (map[string]reputation.MinerScore, error) {
+	topMiners, err := g.reputationModule.GetTopMiners(math.MaxInt32)
+	if err != nil {
+		return nil, fmt.Errorf("getting reputation scores: %s", err)
+	}
+	res := make(map[string]reputation.MinerScore, len(topMiners))
+	for _, m := range topMiners {
+		res[m.Addr] = m
+	}
+	return res, nil
+}
+
+// queryParams are the validated query parameters of a request.
+type queryParams struct {
+	sort   string
+	desc   bool
+	limit  int
+	offset int
+	values map[string]interface{}
+}
+
+func (p queryParams) str(name string) string {
+	v, _ := p.values[name].(string)
+	return v
+}
+
+func (p queryParams) uint(name string) (uint64, bool) {
+	v, ok := p.values[name].(uint64)
+	return v, ok
+}
+
+func (p queryParams) boolean(name string) (bool, bool) {
+	v, ok := p.values[name].(bool)
+	return v, ok
+}
+
+func parseQueryParams(q url.Values, ep apiEndpoint) (queryParams, error) {
+	p := queryParams{
+		sort:   ep.SortFields[0],
+		limit:  defaultLimit,
+		values: make(map[string]interface{}),
+	}
+	declared := make(map[string]apiParam, len(ep.Params))
+	for _, param := range ep.Params {
+		declared[param.Name] = param
+	}
+	for name := range q {
+		v := q.Get(name)
+		switch name {
+		case "sort":
+			if !containsString(ep.SortFields, v) {
+				return queryParams{}, fmt.Errorf("invalid sort field %s, allowed values are: %s", v, strings.Join(ep.SortFields, ", "))
+			}
+			p.sort = v
+		case "order":
+			if v != "asc" && v != "desc" {
+				return queryParams{}, fmt.Errorf("invalid order %s, allowed values are: asc, desc", v)
+			}
+			p.desc = v == "desc"
+		case "limit":
+			limit, err := strconv.Atoi(v)
+			if err != nil || limit < 1 || limit > maxLimit {
+				return queryParams{}, fmt.Errorf("limit should be a number between 1 and %d", maxLimit)
+			}
+			p.limit = limit
+		case "offset":
+			offset, err := strconv.Atoi(v)
+			if err != nil || offset < 0 {
+				return queryParams{}, fmt.Errorf("offset should be a non-negative number")
+			}
+			p.offset = offset
+		default:
+			param, ok := declared[name]
+			if !ok {
+				return queryParams{}, fmt.Errorf("unknown query parameter %s", name)
+			}
+			val, err := parseParamValue(param.Type, v)
+			if err != nil {
+				return queryParams{}, fmt.Errorf("parsing %s: %s", name, err)
+			}
+			p.values[name] = val
+		}
+	}
+	return p, nil
+}
+
+func parseParamValue(typ paramType, v string) (interface{}, error) {
+	switch typ {
+	case paramInteger:
+		return strconv.ParseUint(v, 10, 64)
+	case paramNumber:
+		return strconv.ParseFloat(v, 64)
+	case paramBoolean:
+		return strconv.ParseBool(v)
+	default:
+		return v, nil
+	}
+}
+
+func makeETag(parts ...string) string {
+	h := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+	return `"` + hex.EncodeToString(h[:16]) + `"`
+}
+
+func matchesETag(ifNoneMatch, etag string) bool {
+	for _, t := range strings.Split(ifNoneMatch, ",") {
+		t = strings.TrimPrefix(strings.TrimSpace(t), "W/")
+		if t == etag || t == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+func latestMetaUpdate(info map[string]miner.Meta) string {
+	var latest time.Time
+	for _, m := range info {
+		if m.LastUpdated.After(latest) {
+			latest = m.LastUpdated
+		}
+	}
+	return latest.String()
+}
+
+func containsString(l []string, s string) bool {
+	for _, e := range l {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,258 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/index/ask"
+	"github.com/textileio/powergate/v2/index/faults"
+	"github.com/textileio/powergate/v2/index/miner"
+	"github.com/textileio/powergate/v2/reputation"
+)
+
+func TestMinersFilterSortPaginate(t *testing.T) {
+	t.Parallel()
+	router, _ := setupAPI()
+
+	var res struct {
+		Total int
+		Items []apiMiner
+	}
+	code := get(t, router, "/api/v1/miners?country=US&minPower=10&sort=price&limit=1", &res)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, 2, res.Total)
+	require.Len(t, res.Items, 1)
+	require.Equal(t, "f02", res.Items[0].Address)
+	require.Equal(t, uint64(50), *res.Items[0].Price)
+	require.Equal(t, 80, *res.Items[0].Score)
+
+	code = get(t, router, "/api/v1/miners?country=US&minPower=10&sort=price&limit=1&offset=1", &res)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "f01", res.Items[0].Address)
+
+	code = get(t, router, "/api/v1/miners?sort=power&order=desc", &res)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, 4, res.Total)
+	require.Equal(t, []string{"f03", "f01", "f02", "f04"}, minerAddrs(res.Items))
+
+	// Miners without an ask are last when sorting by price.
+	code = get(t, router, "/api/v1/miners?sort=price", &res)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "f04", res.Items[3].Address)
+	require.Nil(t, res.Items[3].Price)
+
+	code = get(t, router, "/api/v1/miners?hasAsk=false", &res)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, []string{"f04"}, minerAddrs(res.Items))
+}
+
+func TestAsks(t *testing.T) {
+	t.Parallel()
+	router, _ := setupAPI()
+
+	var res struct {
+		LastUpdated time.Time
+		Total       int
+		Items       []apiAsk
+	}
+	code := get(t, router, "/api/v1/asks?pieceSize=2048&sort=price&order=desc", &res)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, 2, res.Total)
+	require.Equal(t, "f01", res.Items[0].Miner)
+	require.Equal(t, "f02", res.Items[1].Miner)
+	require.False(t, res.LastUpdated.IsZero())
+}
+
+func TestFaultsAndReputation(t *testing.T) {
+	t.Parallel()
+	router, _ := setupAPI()
+
+	var fres struct {
+		Items []apiFaults
+	}
+	code := get(t, router, "/api/v1/faults?sort=count&order=desc", &fres)
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, fres.Items, 2)
+	require.Equal(t, "f02", fres.Items[0].Miner)
+	require.Equal(t, 2, fres.Items[0].Count)
+
+	var rres struct {
+		Items []apiReputation
+	}
+	code = get(t, router, "/api/v1/reputation?minScore=75", &rres)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, []apiReputation{{Miner: "f01", Rank: 1, Score: 90}, {Miner: "f02", Rank: 2, Score: 80}}, rres.Items)
+}
+
+func TestInvalidParams(t *testing.T) {
+	t.Parallel()
+	router, _ := setupAPI()
+
+	invalid := []string{
+		"/api/v1/miners?unknown=1",
+		"/api/v1/miners?sort=expiry",
+		"/api/v1/miners?order=up",
+		"/api/v1/miners?limit=0",
+		"/api/v1/miners?limit=100000",
+		"/api/v1/miners?offset=-1",
+		"/api/v1/miners?minPower=abc",
+		"/api/v1/asks?hasAsk=true",
+	}
+	for _, path := range invalid {
+		var res apiError
+		code := get(t, router, path, &res)
+		require.Equal(t, http.StatusBadRequest, code, path)
+		require.NotEmpty(t, res.Error)
+	}
+}
+
+func TestETag(t *testing.T) {
+	t.Parallel()
+	router, ai := setupAPI()
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/api/v1/asks", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	req := httptest.NewRequest("GET", "/api/v1/asks", nil)
+	req.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusNotModified, w.Code)
+
+	// A different query has a different representation.
+	req = httptest.NewRequest("GET", "/api/v1/asks?sort=price", nil)
+	req.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// An index update changes the ETag.
+	ai.index.LastUpdated = ai.index.LastUpdated.Add(time.Minute)
+	req = httptest.NewRequest("GET", "/api/v1/asks", nil)
+	req.Header.Set("If-None-Match", etag)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotEqual(t, etag, w.Header().Get("ETag"))
+}
+
+func TestOpenAPISpec(t *testing.T) {
+	t.Parallel()
+	router, _ := setupAPI()
+
+	var spec map[string]interface{}
+	code := get(t, router, "/api/v1/openapi.json", &spec)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "3.0.3", spec["openapi"])
+
+	paths := spec["paths"].(map[string]interface{})
+	for _, p := range []string{"/asks", "/miners", "/faults", "/reputation"} {
+		require.Contains(t, paths, p)
+	}
+	schemas := spec["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+	miner := schemas["Miner"].(map[string]interface{})["properties"].(map[string]interface{})
+	require.Equal(t, true, miner["price"].(map[string]interface{})["nullable"])
+	require.Equal(t, "integer", miner["power"].(map[string]interface{})["type"])
+	require.Contains(t, schemas, "MinerList")
+}
+
+func setupAPI() (*gin.Engine, *askIndex) {
+	gin.SetMode(gin.TestMode)
+	ai := &askIndex{index: ask.Index{
+		LastUpdated: time.Now(),
+		Storage: map[string]ask.StorageAsk{
+			"f01": {Miner: "f01", Price: 100, MinPieceSize: 256, MaxPieceSize: 4096},
+			"f02": {Miner: "f02", Price: 50, MinPieceSize: 256, MaxPieceSize: 4096},
+			"f03": {Miner: "f03", Price: 10, MinPieceSize: 4096, MaxPieceSize: 8192},
+		},
+	}}
+	mi := &minerIndex{index: miner.IndexSnapshot{
+		Meta: miner.MetaIndex{Info: map[string]miner.Meta{
+			"f01": {Location: miner.Location{Country: "US"}},
+			"f02": {Location: miner.Location{Country: "US"}},
+			"f03": {Location: miner.Location{Country: "CN"}},
+		}},
+		OnChain: miner.ChainIndex{LastUpdated: 100, Miners: map[string]miner.OnChainMinerData{
+			"f01": {Power: 20},
+			"f02": {Power: 10},
+			"f03": {Power: 30},
+			"f04": {Power: 0},
+		}},
+	}}
+	fi := &faultsIndex{index: faults.IndexSnapshot{
+		TipSetKey: "tsk",
+		Miners: map[string]faults.Faults{
+			"f01": {Epochs: []int64{10}},
+			"f02": {Epochs: []int64{20, 30}},
+		},
+	}}
+	rm := &reputationModule{scores: []reputation.MinerScore{
+		{Addr: "f01", Score: 90},
+		{Addr: "f02", Score: 80},
+		{Addr: "f03", Score: 70},
+	}}
+
+	g := NewGateway("", ai, mi, fi, rm, nil, nil)
+	router := gin.New()
+	g.registerAPI(router.Group(""), "")
+	return router, ai
+}
+
+func get(t *testing.T, router *gin.Engine, path string, res interface{}) int {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), res))
+	return w.Code
+}
+
+func minerAddrs(ms []apiMiner) []string {
+	res := make([]string, len(ms))
+	for i, m := range ms {
+		res[i] = m.Address
+	}
+	return res
+}
+
+type askIndex struct {
+	index ask.Index
+}
+
+func (ai *askIndex) Get() ask.Index                              { return ai.index }
+func (ai *askIndex) Query(q ask.Query) ([]ask.StorageAsk, error) { return nil, nil }
+func (ai *askIndex) Listen() <-chan struct{}                     { return make(chan struct{}) }
+func (ai *askIndex) Unregister(c chan struct{})                  {}
+
+type minerIndex struct {
+	index miner.IndexSnapshot
+}
+
+func (mi *minerIndex) Get() miner.IndexSnapshot   { return mi.index }
+func (mi *minerIndex) Listen() <-chan struct{}    { return make(chan struct{}) }
+func (mi *minerIndex) Unregister(c chan struct{}) {}
+
+type faultsIndex struct {
+	index faults.IndexSnapshot
+}
+
+func (fi *faultsIndex) Get() faults.IndexSnapshot  { return fi.index }
+func (fi *faultsIndex) Listen() <-chan struct{}    { return make(chan struct{}) }
+func (fi *faultsIndex) Unregister(c chan struct{}) {}
+
+type reputationModule struct {
+	scores []reputation.MinerScore
+}
+
+func (rm *reputationModule) GetTopMiners(n int) ([]reputation.MinerScore, error) {
+	if n > len(rm.scores) {
+		n = len(rm.scores)
+	}
+	return rm.scores[:n], nil
+}
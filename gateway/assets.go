@@ -12,7 +12,7 @@ var _Assets19d4daaf5fda2bea44d5a868092320a88976ec0f = "{{template \"header\" \"A
 var _Assetsff87a1af2b558b9c75d6a7149f7e7b3cc4566919 = "{{template \"header\" \"Reputation\"}}\n{{template \"menu\" .}}\n<div class=\".aligner-item\">\n    {{template \"table\" .}}\n</div>\n{{template \"footer\"}}"
 var _Assetsb289e24e7683deca2454781b8d3914d88103d97a = "package gateway\n\nimport (\n\t\"context\"\n\t\"fmt\"\n\t\"html/template\"\n\t\"io/ioutil\"\n\t\"net/http\"\n\t\"sort\"\n\t\"strconv\"\n\t\"strings\"\n\t\"time\"\n\n\t\"github.com/gin-contrib/location\"\n\t\"github.com/gin-contrib/static\"\n\t\"github.com/gin-gonic/gin\"\n\tlogger \"github.com/ipfs/go-log/v2\"\n\tassets \"github.com/jessevdk/go-assets\"\n\t\"github.com/rs/cors\"\n\tgincors \"github.com/rs/cors/wrapper/gin\"\n\taskRunner \"github.com/textileio/powergate/v2/index/ask/runner\"\n\tfaultsModule \"github.com/textileio/powergate/v2/index/faults/module\"\n\tminerModule \"github.com/textileio/powergate/v2/index/miner/module\"\n\t\"github.com/textileio/powergate/v2/reputation\"\n)\n\nconst numTopMiners = 100\n\nvar log = logger.Logger(\"gateway\")\n\n// fileSystem extends the binary asset file system with Exists,\n// enabling its use with the static middleware.\ntype fileSystem struct {\n\t*assets.FileSystem\n}\n\n// Exists returns whether or not the path exists in the binary assets.\nfunc (f *fileSystem) Exists(prefix, path string) bool {\n\tpth := strings.TrimPrefix(path, prefix)\n\tif pth == \"/\" {\n\t\treturn false\n\t}\n\t_, ok := f.Files[pth]\n\treturn ok\n}\n\n// Gateway provides HTTP-based access to Textile.\ntype Gateway struct {\n\taddr             string\n\tserver           *http.Server\n\taskIndex         *askRunner.Runner\n\tminerIndex       *minerModule.Index\n\tfaultsIndex      *faultsModule.Index\n\treputationModule *reputation.Module\n}\n\n// NewGateway returns a new gateway.\nfunc NewGateway(\n\taddr string,\n\taskIndex *askRunner.Runner,\n\tminerIndex *minerModule.Index,\n\tfaultsIndex *faultsModule.Index,\n\treputationModule *reputation.Module,\n) *Gateway {\n\treturn &Gateway{\n\t\taddr:             addr,\n\t\taskIndex:         askIndex,\n\t\tminerIndex:       minerIndex,\n\t\tfaultsIndex:      faultsIndex,\n\t\treputationModule: reputationModule,\n\t}\n}\n\n// Start the gateway.\nfunc (g *Gateway) Start(basePath string) {\n\tgin.SetMode(gin.ReleaseMode)\n\trouter := gin.Default()\n\trouter.Use(location.Default())\n\n\t// @todo: Config based headers\n\toptions := cors.Options{}\n\trouter.Use(gincors.New(options))\n\n\ttemp, err := loadTemplate()\n\tif err != nil {\n\t\tlog.Fatal(err)\n\t}\n\trouter.SetHTMLTemplate(temp)\n\n\trouter.Use(static.Serve(\"/wololo\", &fileSystem{Assets}))\n\trg := router.Group(\"/wololo\")\n\trg.GET(\"/asks\", g.asksHandler)\n\trg.GET(\"/miners\", g.minersHandler)\n\trg.GET(\"/faults\", g.faultsHandler)\n\trg.GET(\"/reputation\", g.reputationHandler)\n\n\trg.GET(\"/\", func(c *gin.Context) {\n\t\tc.Request.URL.Path = basePath + \"/asks\"\n\t\trouter.HandleContext(c)\n\t})\n\n\trouter.NoRoute(func(c *gin.Context) {\n\t\tg.render404(c)\n\t})\n\n\tg.server = &http.Server{\n\t\tAddr:    g.addr,\n\t\tHandler: router,\n\t}\n\n\terrc := make(chan error)\n\tgo func() {\n\t\terrc <- g.server.ListenAndServe()\n\t\tclose(errc)\n\t}()\n\tgo func() {\n\t\tfor err := range errc {\n\t\t\tif err != nil {\n\t\t\t\tif err != http.ErrServerClosed {\n\t\t\t\t\tlog.Errorf(\"gateway error: %s\", err)\n\t\t\t\t}\n\t\t\t\treturn\n\t\t\t}\n\t\t}\n\t\tlog.Info(\"gateway was shutdown\")\n\t}()\n\tlog.Infof(\"gateway listening at %s\", g.server.Addr)\n}\n\n// Addr returns the gateway's address.\nfunc (g *Gateway) Addr() string {\n\treturn g.server.Addr\n}\n\n// Stop the gateway.\nfunc (g *Gateway) Stop() error {\n\tctx, cancel := context.WithTimeout(context.Background(), time.Second)\n\tdefer cancel()\n\tif err := g.server.Shutdown(ctx); err != nil {\n\t\tlog.Errorf(\"error shutting down gateway: %s\", err)\n\t\treturn err\n\t}\n\treturn nil\n}\n\nfunc (g *Gateway) asksHandler(c *gin.Context) {\n\tmenuItems := makeMenuItems(0)\n\n\tindex := g.askIndex.Get()\n\n\tsubtitle := fmt.Sprintf(\"Last updated: %v, storage median price: %v\", timeToString(index.LastUpdated), index.StorageMedianPrice)\n\n\theaders := []string{\"Miner\", \"Price\", \"Min Piece Size\", \"Timestamp\", \"Expiry\"}\n\n\trows := make([][]interface{}, len(index.Storage))\n\ti := 0\n\tfor _, ask := range index.Storage {\n\t\trows[i] = []interface{}{\n\t\t\task.Miner,\n\t\t\task.Price,\n\t\t\task.MinPieceSize,\n\t\t\task.Timestamp,\n\t\t\task.Expiry,\n\t\t}\n\t\ti++\n\t}\n\n\tc.HTML(http.StatusOK, \"/public/html/asks.gohtml\", gin.H{\n\t\t\"MenuItems\": menuItems,\n\t\t\"Title\":     \"Available Asks\",\n\t\t\"Subtitle\":  subtitle,\n\t\t\"Headers\":   headers,\n\t\t\"Rows\":      rows,\n\t})\n}\n\nfunc (g *Gateway) minersHandler(c *gin.Context) {\n\tmenuItems := makeMenuItems(1)\n\n\tindex := g.minerIndex.Get()\n\n\tmetaSubtitle := fmt.Sprintf(\"%v miners online, %v miners offline\", index.Meta.Online, index.Meta.Offline)\n\tmetaHeaders := []string{\"Miner\", \"Location\", \"Online\", \"User Agent\", \"Updated\"}\n\tmetaRows := make([][]interface{}, len(index.Meta.Info))\n\ti := 0\n\tfor id, meta := range index.Meta.Info {\n\t\tmetaRows[i] = []interface{}{\n\t\t\tid,\n\t\t\tmeta.Location.Country,\n\t\t\tmeta.Online,\n\t\t\tmeta.UserAgent,\n\t\t\ttimeToString(meta.LastUpdated),\n\t\t}\n\t\ti++\n\t}\n\n\tchainSubtitle := fmt.Sprintf(\"Last updated %v\", timeToString(uint64ToTime(index.OnChain.LastUpdated)))\n\tchainHeaders := []string{\"Miner\", \"Power\", \"RelativePower\", \"SectorSize\", \"ActiveDeals\"}\n\tvar chainRows [][]interface{}\n\ti = 0\n\tfor id, onchainData := range index.OnChain.Miners {\n\t\tif onchainData.Power == 0 {\n\t\t\tcontinue\n\t\t}\n\t\tchainRows = append(chainRows, []interface{}{\n\t\t\tid,\n\t\t\tonchainData.Power,\n\t\t\tonchainData.RelativePower,\n\t\t\tonchainData.SectorSize,\n\t\t\tonchainData.ActiveDeals,\n\t\t})\n\t\ti++\n\t}\n\n\tsort.Slice(chainRows, func(i, j int) bool {\n\t\tl := chainRows[i][0].(string)\n\t\tr := chainRows[j][0].(string)\n\t\treturn index.OnChain.Miners[l].ActiveDeals >= index.OnChain.Miners[r].ActiveDeals\n\t})\n\n\tc.HTML(http.StatusOK, \"/public/html/miners.gohtml\", gin.H{\n\t\t\"MenuItems\": menuItems,\n\t\t\"MetaData\": gin.H{\n\t\t\t\"Title\":    \"Miner Metadata\",\n\t\t\t\"Subtitle\": metaSubtitle,\n\t\t\t\"Headers\":  metaHeaders,\n\t\t\t\"Rows\":     metaRows,\n\t\t},\n\t\t\"ChainData\": gin.H{\n\t\t\t\"Title\":    \"Miner On-Chain Data\",\n\t\t\t\"Subtitle\": chainSubtitle,\n\t\t\t\"Headers\":  chainHeaders,\n\t\t\t\"Rows\":     chainRows,\n\t\t},\n\t})\n}\n\nfunc (g *Gateway) faultsHandler(c *gin.Context) {\n\tmenuItems := makeMenuItems(2)\n\n\tindex := g.faultsIndex.Get()\n\n\tsubtitle := fmt.Sprintf(\"Current tip set key: %v\", index.TipSetKey)\n\n\theaders := []string{\"Miner\", \"Faults Epochs\"}\n\n\trows := make([][]interface{}, len(index.Miners))\n\ti := 0\n\tfor id, faults := range index.Miners {\n\t\tepochs := make([]string, len(faults.Epochs))\n\t\tfor j, epoch := range faults.Epochs {\n\t\t\tepochs[j] = strconv.FormatInt(epoch, 10)\n\t\t}\n\t\trows[i] = []interface{}{\n\t\t\tid,\n\t\t\tstrings.Join(epochs, \", \"),\n\t\t}\n\t\ti++\n\t}\n\n\tsort.Slice(rows, func(i, j int) bool {\n\t\tl := rows[i][0].(string)\n\t\tr := rows[j][0].(string)\n\t\treturn len(index.Miners[l].Epochs) >= len(index.Miners[r].Epochs)\n\t})\n\n\tc.HTML(http.StatusOK, \"/public/html/faults.gohtml\", gin.H{\n\t\t\"MenuItems\": menuItems,\n\t\t\"Title\":     \"Miner Faults\",\n\t\t\"Subtitle\":  subtitle,\n\t\t\"Headers\":   headers,\n\t\t\"Rows\":      rows,\n\t})\n}\n\nfunc (g *Gateway) reputationHandler(c *gin.Context) {\n\tmenuItems := makeMenuItems(3)\n\n\ttopMiners, err := g.reputationModule.GetTopMiners(numTopMiners)\n\tif err != nil {\n\t\tg.renderError(c, http.StatusInternalServerError, err)\n\t\treturn\n\t}\n\n\theaders := []string{\"Miner\", \"Score\"}\n\n\trows := make([][]interface{}, len(topMiners))\n\tfor i, minerScore := range topMiners {\n\t\trows[i] = []interface{}{\n\t\t\tminerScore.Addr,\n\t\t\tminerScore.Score,\n\t\t}\n\t}\n\n\tc.HTML(http.StatusOK, \"/public/html/reputation.gohtml\", gin.H{\n\t\t\"MenuItems\": menuItems,\n\t\t\"Title\":     fmt.Sprintf(\"Top %v Miners\", numTopMiners),\n\t\t\"Headers\":   headers,\n\t\t\"Rows\":      rows,\n\t})\n}\n\nfunc uint64ToTime(value int64) time.Time {\n\treturn time.Unix(value, 0)\n}\n\nfunc timeToString(t time.Time) string {\n\treturn t.Format(\"01/02/06 3:04 PM\")\n}\n\ntype menuItem struct {\n\tName     string\n\tPath     string\n\tSelected bool\n}\n\nfunc makeMenuItems(selectedIndex int) []menuItem {\n\tmenuItems := []menuItem{\n\t\t{\n\t\t\tName:     \"Asks\",\n\t\t\tPath:     \"asks\",\n\t\t\tSelected: false,\n\t\t},\n\t\t{\n\t\t\tName:     \"Miners\",\n\t\t\tPath:     \"miners\",\n\t\t\tSelected: false,\n\t\t},\n\t\t{\n\t\t\tName:     \"Faults\",\n\t\t\tPath:     \"faults\",\n\t\t\tSelected: false,\n\t\t},\n\t\t{\n\t\t\tName:     \"Reputation\",\n\t\t\tPath:     \"reputation\",\n\t\t\tSelected: false,\n\t\t},\n\t}\n\tmenuItems[selectedIndex].Selected = true\n\treturn menuItems\n}\n\n// render404 renders the 404 template.\nfunc (g *Gateway) render404(c *gin.Context) {\n\tc.HTML(http.StatusNotFound, \"/public/html/404.gohtml\", nil)\n}\n\n// renderError renders the error template.\nfunc (g *Gateway) renderError(c *gin.Context, code int, err error) {\n\tc.HTML(code, \"/public/html/error.gohtml\", gin.H{\n\t\t\"Code\":  code,\n\t\t\"Error\": formatError(err),\n\t})\n}\n\n// loadTemplate loads HTML templates.\nfunc loadTemplate() (*template.Template, error) {\n\tt := template.New(\"\")\n\tfor name, file := range Assets.Files {\n\t\tif file.IsDir() || !strings.HasSuffix(name, \".gohtml\") {\n\t\t\tcontinue\n\t\t}\n\t\th, err := ioutil.ReadAll(file)\n\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n\t\tt, err = t.New(name).Parse(string(h))\n\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n\t}\n\treturn t, nil\n}\n\n// formatError formats a go error for browser display.\nfunc formatError(err error) string {\n\twords := strings.SplitN(err.Error(), \" \", 2)\n\twords[0] = strings.Title(words[0])\n\treturn strings.Join(words, \" \") + \".\"\n}\n"
 var _Assets5e70439c4378bfd4d8fad0377484821d8d3176bb = "ASSET_DIRS = $(shell find ./public/ -type d)\nASSET_FILES = $(shell find ./public/ -type f -name '*')\n\nassets.go: ./public/ $(ASSET_DIRS) $(ASSET_FILES)\n\tgo-assets-builder . -p gateway -o assets.go"
-var _Assetsdde0973434b88ffc53b81b28400233e4fde8bb40 = "html {\n    box-sizing: border-box;\n    margin: 0;\n    padding: 0;\n    height: 100%;\n}\n\n*, *:before, *:after {\n    box-sizing: inherit;\n}\n\nbody {\n    margin: 0;\n    padding: 2em;\n    font-family: monospace, sans-serif;\n    color: #666666;\n    background-color: #222222;\n    height: 100%;\n}\n\n.logo {\n    position: absolute;\n}\n\n.title {\n    margin-bottom: 0.5em;\n    color: white;\n    font-weight: bold;\n}\n\n.subtitle {\n    font-size: 0.8em;\n    margin-bottom: 0.5em;\n}\n\n.navbar {\n    list-style: none;\n    text-align: center;\n    margin-bottom: 2em;\n    margin-top: 2em;\n    font-size: 0.8em;\n    text-transform: uppercase;\n}\n\n.navbar li {\n    display: inline;\n}\n\n.navbar a{\n    display: inline-block;\n    padding: 10px;\n}\n\n.navbar a.selected {\n    color: white;\n    font-weight: bold;\n}\n\na {\n    text-decoration: none;\n    color: #666666;\n}\n\na:hover {\n    text-decoration: underline;\n}\n\ntable {\n    border-collapse: collapse;\n    width: 100%;\n    margin-bottom: 1em;\n}\n\ntd, th {\n    border: 1px solid #666666;\n    padding: 8px;\n}\n\ntr:nth-child(odd){background-color: #252525;}\n\ntr:hover {background-color: rgb(49, 49, 49);}\n\nth {\n    padding-top: 12px;\n    padding-bottom: 12px;\n    text-align: left;\n    background-color: rgb(15, 15, 15);\n    color: white;\n    font-size: 0.8em;\n    text-transform: uppercase;\n}\n\n.aligner {\n    display: flex;\n    align-items: center;\n    justify-content: center;\n    flex-direction: column;\n    height: 100%;\n}\n\n.aligner-item {\n    max-width: 60%;\n}\n\n.aligner-item p {\n    text-align: center;\n    line-height: 1.5em;\n}\n\n.icon-big {\n    font-size: 4em;\n}\n"
+var _Assetsdde0973434b88ffc53b81b28400233e4fde8bb40 = "html {\n    box-sizing: border-box;\n    margin: 0;\n    padding: 0;\n    height: 100%;\n}\n\n*, *:before, *:after {\n    box-sizing: inherit;\n}\n\nbody {\n    margin: 0;\n    padding: 2em;\n    font-family: monospace, sans-serif;\n    color: #666666;\n    background-color: #222222;\n    height: 100%;\n}\n\n.logo {\n    position: absolute;\n}\n\n.title {\n    margin-bottom: 0.5em;\n    color: white;\n    font-weight: bold;\n}\n\n.subtitle {\n    font-size: 0.8em;\n    margin-bottom: 0.5em;\n}\n\n.navbar {\n    list-style: none;\n    text-align: center;\n    margin-bottom: 2em;\n    margin-top: 2em;\n    font-size: 0.8em;\n    text-transform: uppercase;\n}\n\n.navbar li {\n    display: inline;\n}\n\n.navbar a{\n    display: inline-block;\n    padding: 10px;\n}\n\n.navbar a.selected {\n    color: white;\n    font-weight: bold;\n}\n\na {\n    text-decoration: none;\n    color: #666666;\n}\n\na:hover {\n    text-decoration: underline;\n}\n\ntable {\n    border-collapse: collapse;\n    width: 100%;\n    margin-bottom: 1em;\n}\n\ntd, th {\n    border: 1px solid #666666;\n    padding: 8px;\n}\n\ntr:nth-child(odd){background-color: #252525;}\n\ntr:hover {background-color: rgb(49, 49, 49);}\n\nth {\n    padding-top: 12px;\n    padding-bottom: 12px;\n    text-align: left;\n    background-color: rgb(15, 15, 15);\n    color: white;\n    font-size: 0.8em;\n    text-transform: uppercase;\n}\n\n.aligner {\n    display: flex;\n    align-items: center;\n    justify-content: center;\n    flex-direction: column;\n    height: 100%;\n}\n\n.aligner-item {\n    max-width: 60%;\n}\n\n.aligner-item p {\n    text-align: center;\n    line-height: 1.5em;\n}\n\n.icon-big {\n    font-size: 4em;\n}\n\nform.inline {\n    display: inline-block;\n}\n\ninput, button {\n    font-family: monospace, sans-serif;\n    color: #666666;\n    background-color: #252525;\n    border: 1px solid #666666;\n    padding: 8px;\n}\n\nbutton {\n    cursor: pointer;\n}\n"
 var _Assets747353a94cf1b19b7a2e5c9fbc50b3ea0972f088 = "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n<svg width=\"1200px\" height=\"1400px\" viewBox=\"0 0 1200 1400\" version=\"1.1\" xmlns=\"http://www.w3.org/2000/svg\" xmlns:xlink=\"http://www.w3.org/1999/xlink\">\n    <!-- Generator: Sketch 52.6 (67491) - http://www.bohemiancoding.com/sketch -->\n    <title>Artboard</title>\n    <desc>Created with Sketch.</desc>\n    <defs>\n        <polygon id=\"path-1\" points=\"650 0 1212.91651 325 1212.91651 975 650 1300 87.0834875 975 87.0834875 325\"></polygon>\n    </defs>\n    <g id=\"Artboard\" stroke=\"none\" stroke-width=\"1\" fill=\"none\" fill-rule=\"evenodd\">\n        <g id=\"Group\" transform=\"translate(-100.000000, 0.000000)\">\n            <polygon id=\"Polygon\" fill=\"#FFCE00\" points=\"700 0 1306.21778 350 1306.21778 1050 700 1400 93.7822174 1050 93.7822174 350\"></polygon>\n            <g id=\"Textile_Icon_A_1200px-copy\" transform=\"translate(50.000000, 50.000000)\">\n                <mask id=\"mask-2\" fill=\"white\">\n                    <use xlink:href=\"#path-1\"></use>\n                </mask>\n                <use id=\"Mask\" fill=\"#FFB6D5\" xlink:href=\"#path-1\"></use>\n                <image mask=\"url(#mask-2)\" x=\"25\" y=\"25\" width=\"1250\" height=\"1250\" xlink:href=\"data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAABLAAAASwCAYAAADrIbPPAAAEGWlDQ1BrQ0dDb2xvclNwYWNlR2VuZXJpY1JHQgAAOI2NVV1oHFUUPrtzZyMkzlNsNIV0qD8NJQ2TVjShtLp/3d02bpZJNtoi6GT27s6Yyc44M7v9oU9FUHwx6psUxL+3gCAo9Q/bPrQvlQol2tQgKD60+INQ6Ium65k7M5lpurHeZe58853vnnvuuWfvBei5qliWkRQBFpquLRcy4nOHj4g9K5CEh6AXBqFXUR0rXalMAjZPC3e1W99Dwntf2dXd/p+tt0YdFSBxH2Kz5qgLiI8B8KdVy3YBevqRHz/qWh72Yui3MUDEL3q44WPXw3M+fo1pZuQs4tOIBVVTaoiXEI/MxfhGDPsxsNZfoE1q66ro5aJim3XdoLFw72H+n23BaIXzbcOnz5mfPoTvYVz7KzUl5+FRxEuqkp9G/Ajia219thzg25abkRE/BpDc3pqvphHvRFys2weqvp+krbWKIX7nhDbzLOItiM8358pTwdirqpPFnMF2xLc1WvLyOwTAibpbmvHHcvttU57y5+XqNZrLe3lE/Pq8eUj2fXKfOe3pfOjzhJYtB/yll5SDFcSDiH+hRkH25+L+sdxKEAMZahrlSX8ukqMOWy/jXW2m6M9LDBc31B9LFuv6gVKg/0Szi3KAr1kGq1GMjU/aLbnq6/lRxc4XfJ98hTargX++DbMJBSiYMIe9Ck1YAxFkKEAG3xbYaKmDDgYyFK0UGYpfoWYXG+fAPPI6tJnNwb7ClP7IyF+D+bjOtCpkhz6CFrIa/I6sFtNl8auFXGMTP34sNwI/JhkgEtmDz14ySfaRcTIBInmKPE32kxyyE2Tv+thKbEVePDfW/byMM1Kmm0XdObS7oGD/MypMXFPXrCwOtoYjyyn7BV29/MZfsVzpLDdRtuIZnbpXzvlf+ev8MvYr/Gqk4H/kV/G3csdazLuyTMPsbFhzd1UabQbjFvDRmcWJxR3zcfHkVw9GfpbJmeev9F08WW8uDkaslwX6avlWGU6NRKz0g/SHtCy9J30o/ca9zX3Kfc19zn3BXQKRO8ud477hLnAfc1/G9mrzGlrfexZ5GLdn6ZZrrEohI2wVHhZywjbhUWEy8icMCGNCUdiBlq3r+xafL549HQ5jH+an+1y+LlYBifuxAvRN/lVVVOlwlCkdVm9NOL5BE4wkQ2SMlDZU97hX86EilU/lUmkQUztTE6mx1EEPh7OmdqBtAvv8HdWpbrJS6tJj3n0CWdM6busNzRV3S9KTYhqvNiqWmuroiKgYhshMjmhTh9ptWhsF7970j/SbMrsPE1suR5z7DMC+P/Hs+y7ijrQAlhyAgccjbhjPygfeBTjzhNqy28EdkUh8C+DU9+z2v/oyeH791OncxHOs5y2AtTc7nb/f73TWPkD/qwBnjX8BoJ98VQNcC+8AAEAASURBVHgB7N1frK35edD3tWfGIdiezIzrkD92Ytdx4hInxcUE8schuUhCuUAqFCMBohcV0FbqBWq5rHrVXlaVWlypLW0vKiCxetM/Kokd6mlIADWE2K2iJKoU2ZEAVUXYE8PMeDznLPY+57znnH32Xnu971rv7/09z/P7cLP23utd7/v8Ps8qjb6cUXY7/4cAAQIECBAgQIAAAQIECBAgQIAAAQIECBAgQIBARIEPvHv/e7/zpf0rEWczEwECBAgQIECAAIFJ4LnpB68ECBAgQIDAWAJX8Wq/331u9/bu50WssXbvtAQIECBAgACBbAIX2QY2LwECBAgQIHC+wBSvLgPWt1zd7eJi9w92L+x+4rdfu/jy+Xd3BwIECBAgQIAAAQLrCghY63q6GwECBAgQCC/wbLyaBhaxJgmvBAgQIECAAAEC0QQErGgbMQ8BAgQIEGgocCheTY8UsSYJrwQIECBAgAABApEEBKxI2zALAQIECBBoKHAsXk2PFrEmCa8ECBAgQIAAAQJRBASsKJswBwECBAgQaCgwN15NI4hYk4RXAgQIECBAgACBCAICVoQtmIEAAQIECDQUWBqvplFErEnCKwECBAgQIECAQG8BAav3BjyfAAECBAg0FDg1Xk0jiViThFcCBAgQIECAAIGeAgJWT33PJkCAAAECDQXOjVfTaCLWJOGVAAECBAgQIECgl4CA1UvecwkQIECAQEOBteLVNKKINUl4JUCAAAECBAgQ6CEgYPVQ90wCBAgQINBQYO14NY0qYk0SXgkQIECAAAECBLYWELC2Fvc8AgQIECDQUKBVvJpGFrEmCa8ECBAgQIAAAQJbCghYW2p7FgECBAgQaCjQOl5No4tYk4RXAgQIECBAgACBrQQErK2kPYcAAQIECDQU2CpeTUcQsSYJrwQIECBAgAABAlsICFhbKHsGAQIECBBoKLB1vJqOImJNEl4JECBAgAABAgRaCwhYrYXdnwABAgQINBToFa+mI4lYk4RXAgQIECBAgACBlgICVktd9yZAgAABAg0Feser6Wgi1iThlQABAgQIECBAoJWAgNVK1n0JECBAgEBDgSjxajqiiDVJeCVAgAABAgQIEGghIGC1UHVPAgQIECDQUCBavJqOevk/VPzK7h27n/zt1y6+PP3NKwECBAgQIECAAIE1BASsNRTdgwABAgQIbCQQNV5NxxexJgmvBAgQIECAAAECawoIWGtquhcBAgQIEGgoED1eTUcXsSYJrwQIECBAgAABAmsJCFhrSboPAQIECBBoKJAlXk0EItYk4ZUAAQIECBAgQGANAQFrDUX3IECAAAECDQWyxauJQsSaJLwSIECAAAECBAicKyBgnSvo8wQIECBAoKFA1ng1kYhYk4RXAgQIECBAgACBcwQErHP0fJYAAQIECDQUyB6vJhoRa5LwSoAAAQIECBAgcKqAgHWqnM8RIECAAIGGAlXi1UQkYk0SXgkQIECAAAECBE4RELBOUfMZAgQIECDQUKBavJqoRKxJwisBAgQIECBAgMBSAQFrqZjrCRAgQIBAQ4Gq8WoiE7EmCa8ECBAgQIAAAQJLBASsJVquJUCAAAECDQWqx6uJTsSaJLwSIECAAAECBAjMFRCw5kq5jgABAgQINBQYJV5NhCLWJOGVAAECBAgQIEBgjoCANUfJNQQIECBAoKHAaPFqohSxJgmvBAgQIECAAAECxwQErGNC3idAgAABAg0FRo1XE6mINUl4JUCAAAECBAgQuEtAwLpLx3sECBAgQKChwOjxaqIVsSYJrwQIECBAgAABAocEBKxDMv5OgAABAgQaCohX13FFrOsefiNAgAABAgQIELguIGBd9/AbAQIECBBoLiBe3U4sYt3u4q8ECBAgQIAAAQK73XMQCBAgQIAAge0EHsWr/2O/333Ldk/N8aT9bvfx3dd3n/3Ol/av5JjYlAQIECBAgAABAlsJ+BdYW0l7DgECBAgML/BUvPrW4THuAPAvse7A8RYBAgQIECBAYFABAWvQxTs2AQIECGwrIF4t8xaxlnm5mgABAgQIECBQXcB/Qlh9w85HgAABAt0FPvji/l+5/E8Gr/6zQf/yauY2/OeEM6FcRoAAAQIECBAYRMC/wBpk0Y5JgAABAn0EruLV/fu7z4lXp/n7l1inufkUAQIECBAgQKCagIBVbaPOQ4AAAQJhBMSrdVYhYq3j6C4ECBAgQIAAgcwCAlbm7ZmdAAECBMIKiFfrrkbEWtfT3QgQIECAAAEC2QQErGwbMy8BAgQIhBcQr9qsSMRq4+quBAgQIECAAIEMAgJWhi2ZkQABAgTSCIhXbVclYrX1dXcCBAgQIECAQFQBASvqZsxFgAABAukExKttViZibePsKQQIECBAgACBSAICVqRtmIUAAQIE0gqIV9uuTsTa1tvTCBAgQIAAAQK9BQSs3hvwfAIECBBILyBe9VmhiNXH3VMJECBAgAABAj0EBKwe6p5JgAABAmUExKu+qxSx+vp7OgECBAgQIEBgK4HntnqQ5xAgQIAAgWoC4lX/je53u4/vvr777Adf3r/cfxoTECBAgAABAgQItBLwL7BaybovAQIECJQWEK9irffqX2I99w27n/jiVy6+Emsy0xAgQIAAAQIECKwhIGCtoegeBAgQIDCUgHgVc90iVsy9mIoAAQIECBAgsIaAgLWGonsQIECAwDAC4lXsVYtYsfdjOgIECBAgQIDAqQIC1qlyPkeAAAECwwmIVzlWLmLl2JMpCRAgQIAAAQJLBASsJVquJUCAAIFhBcSrXKsXsXLty7QECBAgQIAAgWMCAtYxIe8TIECAwPAC4lXOr4CIlXNvpiZAgAABAgQI3CYgYN2m4m8ECBAgQOCRgHiV+6sgYuXen+kJECBAgAABApOAgDVJeCVAgAABAs8IiFfPgCT9VcRKujhjEyBAgAABAgSeEhCwnsLwIwECBAgQmATEq0mixquIVWOPTkGAAAECBAiMKyBgjbt7JydAgACBAwLi1QGY5H8WsZIv0PgECBAgQIDA0AIC1tDrd3gCBAgQeFZAvHpWpNbvIlatfToNAQIECBAgMI6AgDXOrp2UAAECBI4IiFdHgIq8LWIVWaRjECBAgAABAkMJCFhDrdthCRAgQOCQgHh1SKbm30Wsmnt1KgIECBAgQKCugIBVd7dORoAAAQIzBcSrmVDFLhOxii3UcQgQIECAAIHSAgJW6fU6HAECBAgcExCvjgnVfl/Eqr1fpyNAgAABAgTqCAhYdXbpJAQIECCwUEC8WghW9HIRq+hiHYsAAQIECBAoJSBglVqnwxAgQIDAXAHxaq7UGNeJWGPs2SkJECBAgACBvAICVt7dmZwAAQIEThQQr06EK/4xEav4gh2PAAECBAgQSC3wXOrpDU+AAAECBBYKiFcLwQa6fL/bffz+W7vPfvDl/csDHdtRCRAgQIAAAQIpBPwLrBRrMiQBAgQIrCEgXq2hWP8el//D0d9/7ht2P/nFr1x8pf5pnZAAAQIECBAgkENAwMqxJ1MSIECAwJkC4tWZgIN9XMQabOGOS4AAAQIECIQXELDCr8iABAgQIHCugHh1ruCYnxexxty7UxMgQIAAAQIxBQSsmHsxFQECBAisJCBerQQ56G1ErEEX79gECBAgQIBAOAEBK9xKDESAAAECawmIV2tJjn0fEWvs/Ts9AQIECBAgEENAwIqxB1MQIECAwMoC4tXKoIPfTsQa/Avg+AQIECBAgEB3AQGr+woMQIAAAQJrC4hXa4u635WAiOV7QIAAAQIECBDoJyBg9bP3ZAIECBBoICBeNUB1y8cCItZjCj8QIECAAAECBDYVELA25fYwAgQIEGgpIF611HXvSUDEmiS8EiBAgAABAgS2ExCwtrP2JAIECBBoKCBeNcR16xsCItYNEn8gQIAAAQIECDQVELCa8ro5AQIECGwhIF5toewZzwqIWM+K+J0AAQIECBAg0E5AwGpn684ECBAgsIGAeLUBskccFBCxDtJ4gwABAgQIECCwqoCAtSqnmxEgQIDAlgLi1ZbannVIQMQ6JOPvBAgQIECAAIH1BASs9SzdiQABAgQ2FBCvNsT2qKMCItZRIhcQIECAAAECBM4SELDO4vNhAgQIEOghIF71UPfMYwIi1jEh7xMgQIAAAQIEThcQsE6380kCBAgQ6CAgXnVA98jZAiLWbCoXEiBAgAABAgQWCQhYi7hcTIAAAQI9BcSrnvqePVdAxJor5ToCBAgQIECAwHwBAWu+lSsJECBAoKOAeNUR36MXC4hYi8l8gAABAgQIECBwp4CAdSePNwkQIEAggoB4FWELZlgqIGItFXM9AQIECBAgQOCwgIB12MY7BAgQIBBAQLwKsAQjnCwgYp1M54MECBAgQIAAgWsCAtY1Dr8QIECAQCQB8SrSNsxyqoCIdaqczxEgQIAAAQIEnggIWE8s/ESAAAECgQTEq0DLMMrZAiLW2YRuQIAAAQIECAwuIGAN/gVwfAIECEQUEK8ibsVM5wqIWOcK+jwBAgQIECAwsoCANfL2nZ0AAQIBBcSrgEsx0moCItZqlG5EgAABAgQIDCYgYA22cMclQIBAZAHxKvJ2zLaWgIi1lqT7ECBAgAABAiMJCFgjbdtZCRAgEFhAvAq8HKOtLiBirU7qhgQIECBAgEBxAQGr+IIdjwABAhkExKsMWzLj2gIi1tqi7keAAAECBAhUFhCwKm/X2QgQIJBAQLxKsCQjNhMQsZrRujEBAgQIECBQTEDAKrZQxyFAgEAmgX/5xf1H7t3fvbrf774109xmJbCmgIi1pqZ7ESBAgAABAlUFBKyqm3UuAgQIBBcQr4IvyHibCohYm3J7GAECBAgQIJBQQMBKuDQjEyBAILuAeJV9g+ZvISBitVB1TwIECBAgQKCKgIBVZZPOQYAAgSQC4lWSRRmzi4CI1YXdQwkQIECAAIEEAgJWgiUZkQABAlUExKsqm3SOlgIiVktd9yZAgAABAgSyCghYWTdnbgIECCQTEK+SLcy4XQVErK78Hk6AAAECBAgEFBCwAi7FSAQIEKgmIF5V26jzbCEgYm2h7BkECBAgQIBAFgEBK8umzEmAAIGkAuJV0sUZO4SAiBViDYYgQIAAAQIEAggIWAGWYAQCBAhUFRCvqm7WubYUELG21PYsAgQIECBAIKqAgBV1M+YiQIBAcgHxKvkCjR9KQMQKtQ7DECBAgAABAh0EnuvwTI8kQIAAgeICj+LV5/b73bcWP6rjEdhEYL/b/YH7b+0++8GX9y9v8kAPIUCAAAECBAgEE/AvsIItxDgECBDILvBUvPq27GcxP4FoAv4lVrSNmIcAAQIECBDYSkDA2kracwgQIDCAgHg1wJIdsbuAiNV9BQYgQIAAAQIEOggIWB3QPZIAAQIVBcSrilt1pqgCIlbUzZiLAAECBAgQaCUgYLWSdV8CBAgMJCBeDbRsRw0jIGKFWYVBCBAgQIAAgQ0EBKwNkD2CAAEClQXEq8rbdbboAiJW9A2ZjwABAgQIEFhLQMBaS9J9CBAgMKCAeDXg0h05nICIFW4lBiJAgAABAgQaCAhYDVDdkgABAiMIiFcjbNkZswiIWFk2ZU4CBAgQIEDgVAEB61Q5nyNAgMDAAuLVwMt39LACIlbY1RiMAAECBAgQWEFAwFoB0S0IECAwkoB4NdK2nTWbgIiVbWPmJUCAAAECBOYKCFhzpVxHgAABAjvxypeAQAqBX37+G3Y/9cWvXHwlxbSGJECAAAECBAjMEBCwZiC5hAABAgR24pUvAYFcAiJWrn2ZlgABAgQIEDgiIGAdAfI2AQIECIhXvgMEkgqIWEkXZ2wCBAgQIEDgpoCAddPEXwgQIEDgKQH/2eBTGH4kkE9AxMq3MxMTIECAAAECtwgIWLeg+BMBAgQIPBQQr3wTCJQQELFKrNEhCBAgQIDA2AIC1tj7d3oCBAgcFBCvDtJ4g0BGAREr49bMTIAAAQIECDwWELAeU/iBAAECBCYB8WqS8EqglICIVWqdDkOAAAECBMYSELDG2rfTEiBA4KiAeHWUyAUEMguIWJm3Z3YCBAgQIDCwgIA18PIdnQABAs8KiFfPividQEkBEavkWh2KAAECBAjUFhCwau/X6QgQIDBbQLyaTeVCAhUERKwKW3QGAgQIECAwkICANdCyHZUAAQKHBMSrQzL+TqC0gIhVer0OR4AAAQIEagkIWLX26TQECBBYLCBeLSbzAQKVBESsStt0FgIECBAgUFhAwCq8XEcjQIDAMQHx6piQ9wkMISBiDbFmhyRAgAABArkFBKzc+zM9AQIEThYQr06m80ECFQVErIpbdSYCBAgQIFBIQMAqtExHIUCAwFwB8WqulOsIDCUgYg21boclQIAAAQK5BASsXPsyLQECBM4WEK/OJnQDApUFRKzK23U2AgQIECCQWEDASrw8oxMgQGCpgHi1VMz1BIYUELGGXLtDEyBAgACB2AICVuz9mI4AAQKrCYhXq1G6EYERBESsEbbsjAQIECBAIJGAgJVoWUYlQIDAqQLi1alyPkdgaAERa+j1OzwBAgQIEIglIGDF2odpCBAgsLqAeLU6qRsSGElAxBpp285KgAABAgQCCwhYgZdjNAIECJwrIF6dK+jzBAhcCohYvgYECBAgQIBAdwEBq/sKDECAAIE2AuJVG1d3JTCogIg16OIdmwABAgQIRBEQsKJswhwECBBYUUC8WhHTrQgQmARErEnCKwECBAgQILC5wHObP9EDCRAgQKCpgHjVlNfNCYws8AP33tp95kOv7F8aGcHZCRAgQIAAgT4C/gVWH3dPJUCAQBMB8aoJq5sSIHBd4Jff8bt2P/lbX7547fqf/UaAAAECBAgQaCcgYLWzdWcCBAhsKiBebcrtYQRGFxCxRv8GOD8BAgQIENhYQMDaGNzjCBAg0EJAvGqh6p4ECBwRELGOAHmbAAECBAgQWE9AwFrP0p0IECDQRUC86sLuoQQIPBQQsXwTCBAgQIAAgU0EBKxNmD2EAAECbQTEqzau7kqAwCIBEWsRl4sJECBAgACBUwQErFPUfIYAAQIBBMSrAEswAgECk4CINUl4JUCAAAECBJoICFhNWN2UAAECbQXEq7a+7k6AwEkCItZJbD5EgAABAgQIzBEQsOYouYYAAQKBBMSrQMswCgECzwqIWM+K+J0AAQIECBBYRUDAWoXRTQgQILCNgHi1jbOnECBwloCIdRafDxMgQIAAAQK3CQhYt6n4GwECBAIKiFcBl2IkAgQOCYhYh2T8nQABAgQIEDhJQMA6ic2HCBAgsK2AeLWtt6cRILCKgIi1CqObECBAgAABAlcCApbvAQECBIILiFfBF2Q8AgTuEhCx7tLxHgECBAgQIDBbQMCaTeVCAgQIbC8gXm1v7okECKwuIGKtTuqGBAgQIEBgPAEBa7ydOzEBAkkExKskizImAQJzBESsOUquIUCAAAECBA4KCFgHabxBgACBfgLiVT97TyZAoJmAiNWM1o0JECBAgEB9AQGr/o6dkACBZAIfenH/PW/f37263+++LdnoxiVAgMAxARHrmJD3CRAgQIAAgVsFBKxbWfyRAAECfQTEqz7unkqAwKYCItam3B5GgAABAgRqCAhYNfboFAQIFBAQrwos0REIEJgrIGLNlXIdAQIECBAg8EBAwPJFIECAQAAB8SrAEoxAgMDWAiLW1uKeR4AAAQIEEgsIWImXZ3QCBGoIiFc19ugUBAicJCBincTmQwQIECBAYDwBAWu8nTsxAQKBBMSrQMswCgECvQRErF7ynkuAAAECBBIJCFiJlmVUAgRqCYhXtfbpNAQInCUgYp3F58MECBAgQKC+gIBVf8dOSIBAQAHxKuBSjESAQG8BEav3BjyfAAECBAgEFhCwAi/HaAQI1BQQr2ru1akIEFhFQMRahdFNCBAgQIBAPQEBq95OnYgAgcAC4lXg5RiNAIEoAiJWlE2YgwABAgQIBBJ4LtAsRiFAgEBpAfGq9HodjgCB9QR+4Otf233mQ6/sX1rvlu5EgAABAgQIZBfwL7Cyb9D8BAikEBCvUqzJkAQIxBL4v97xu3Y/9Vtfvngt1limIUCAAAECBHoICFg91D2TAIGhBMSrodbtsAQIrCsgYq3r6W4ECBAgQCCtgICVdnUGJ0Agg4B4lWFLZiRAILiAiBV8QcYjQIAAAQJbCAhYWyh7BgECQwqIV0Ou3aEJEGgjIGK1cXVXAgQIECCQRkDASrMqgxIgkElg/9N//7v+h7/1e/7Lf/xP3/HuTHObdTyBN+/9069+4/PveXG8kztxNoGPX/zm//tH/qf/6C9f7H7xy9lmNy8BAgQIECBwvoCAdb6hOxAgQOCawFW82r+9f3W/273/2ht+IRBM4OKF13714tv//ffc/+3/8QPBRjMOgRsCF7/6f3/h3n/2qXsv7N75EyLWDR5/IECAAAEC5QWeK39CByRAgMCGAuLVhtgedZbAg3j1HZ/8yO7ijW8860Y+TGBTgf3vf3v3+s/vd594ZdPHehgBAgQIECDQXUDA6r4CAxAgUEVAvKqyyfrnuHjutc9fXMar/e7Nd9Y/rRPWExCx6u3UiQgQIECAwHEBAeu4kSsIECBwVEC8OkrkgiACD+LVBz75PeJVkIUY40QBEetEOB8jQIAAAQJpBQSstKszOAECUQTEqyibMMcxAfHqmJD3cwmIWLn2ZVoCBAgQIHCegIB1np9PEyAwuIB4NfgXINHxxatEyzLqAgERawGWSwkQIECAQGoBASv1+gxPgEBPAfGqp75nLxEQr5ZouTafgIiVb2cmJkCAAAECywUErOVmPkGAAIGdeOVLkEVAvMqyKXOeJyBinefn0wQIECBAIL6AgBV/RyYkQCCYwP7Tv/Lh/dv7V/e73fuDjWYcAtcExKtrHH4pLyBilV+xAxIgQIDA0AIC1tDrd3gCBJYKPIhXb93/nHi1VM71WwuIV1uLe14MARErxh5MQYAAAQIE1hcQsNY3dUcCBIoKiFdFF1vwWOJVwaU60gIBEWsBlksJECBAgEAaAQErzaoMSoBATwHxqqe+Zy8REK+WaLm2roCIVXe3TkaAAAECowoIWKNu3rkJEJgtIF7NpnJhZwHxqvMCPD6YwIOI9dn97hOvBBvMOAQIECBAgMAJAgLWCWg+QoDAOALi1Ti7zn5S8Sr7Bs3fRmD/8bd3r4tYbXDdlQABAgQIbCogYG3K7WEECGQSEK8ybWvsWcWrsffv9McERKxjQt4nQIAAAQIZBASsDFsyIwECmwuIV5uTe+CJAuLViXA+NpiAiDXYwh2XAAECBAoKCFgFl+pIBAicJyBenefn09sJiFfbWXtSBQERq8IWnYEAAQIExhUQsMbdvZMTIHCLgHh1C4o/hRQQr0KuxVDhBUSs8CsyIAECBAgQOCAgYB2A8WcCBMYTeBiv9q/ud7v3j3d6J84kIF5l2pZZ4wmIWPF2YiICBAgQIHBcQMA6buQKAgQGEHgSr/bvG+C4jphYQLxKvDyjBxIQsQItwygECBAgQGCWgIA1i8lFBAhUFhCvKm+31tnEq1r7dJreAiJW7w14PgECBAgQWCIgYC3Rci0BAuUExKtyKy17IPGq7GodrKuAiNWV38MJECBAgMACAQFrAZZLCRCoJSBe1dpn5dOIV5W362z9BUSs/jswAQECBAgQOC4gYB03cgUBAgUFxKuCSy16JPGq6GIdK5iAiBVsIcYhQIAAAQI3BASsGyT+QIBAdQHxqvqG65xPvKqzSyfJICBiZdiSGQkQIEBgXAEBa9zdOzmBIQXEqyHXnvLQ4lXKtRk6vYCIlX6FDkCAAAECZQUErLKrdTACBJ4VEK+eFfF7VAHxKupmzDWGgIg1xp6dkgABAgSyCQhY2TZmXgIEThIQr05i86EOAuJVB3SPJHBDQMS6QeIPBAgQIECgs4CA1XkBHk+AQHsB8aq9sSesIyBerePoLgTWERCx1nF0FwIECBAgsI6AgLWOo7sQIBBUQLwKuhhj3RAQr26Q+AOBAAIiVoAlGIEAAQIECDwQELB8EQgQKCsgXpVdbbmDiVflVupApQRErFLrdBgCBAgQSCsgYKVdncEJELhLQLy6S8d7kQTEq0jbMAuBQwIi1iEZfydAgAABAlsJCFhbSXsOAQKbCYhXm1F70JkC4tWZgD5OYFMBEWtTbg8jQIAAAQLPCAhYz4D4lQCB3ALiVe79jTS9eDXStp21joCIVWeXTkKAAAEC2QQErGwbMy8BAgcFxKuDNN4IJiBeBVuIcQgsEhCxFnG5mAABAgQIrCQgYK0E6TYECPQVEK/6+nv6fAHxar6VKwnEFRCx4u7GZAQIECBQVUDAqrpZ5yIwkIB4NdCykx9VvEq+QOMTuCYgYl3j8AsBAgQIEGgsIGA1BnZ7AgTaCohXbX3dfT0B8Wo9S3ciEEdAxIqzC5MQIECAQHUBAav6hp2PQGEB8arwcosdTbwqtlDHIXBNQMS6xuEXAgQIECDQSEDAagTrtgQItBUQr9r6uvt6AuLVepbuRCCugIgVdzcmI0CAAIEqAgJWlU06B4GBBMSrgZad/KjiVfIFGp/AIgERaxGXiwkQIECAwEIBAWshmMsJEOgrIF719ff0+QLi1XwrVxKoIzBFrB9/uc6ZnIQAAQIECMQQELBi7MEUBAjMEBCvZiC5JISAeBViDYYg0EngKmL9zs/vdyJWpwV4LAECBAgUFRCwii7WsQhUExCvqm207nnEq7q7dTIC8wVErPlWriRAgAABAvMEBKx5Tq4iQKCjgHjVEd+jFwmIV4u4XEyguICIVXzBjkeAAAECGwsIWBuDexwBAssExKtlXq7uJyBe9bP3ZAJxBUSsuLsxGQECBAhkExCwsm3MvAQGEhCvBlp28qOKV8kXaHwCTQVErKa8bk6AAAECwwgIWMOs2kEJ5BIQr3Lta+RpxauRt+/sBOYKiFhzpVxHgAABAgQOCQhYh2T8nQCBbgLiVTd6D14oIF4tBHM5gaEFRKyh1+/wBAgQIHC2gIB1NqEbECCwpoB4taame7UUuHjhtc9ffOCT37PfvfnOls9xbwIEKgmIWJW26SwECBAgsK2AgLWtt6cRIHCHgHh1B463Qgk8iFffIV6FWophCKQRELHSrMqgBAgQIBBKQMAKtQ7DEBhXQLwad/fZTi5eZduYeQlEFBCxIm7FTAQIECAQW0DAir0f0xEYQkC8GmLNJQ4pXpVYo0MQCCIgYgVZhDEIECBAIImAgJVkUcYkUFVAvKq62XrnEq/q7dSJCPQXELH678AEBAgQIJBFQMDKsilzEigoIF4VXGrRI4lXRRfrWARCCIhYIdZgCAIECBAILyBghV+RAQnUFBCvau614qnEq4pbdSYC0QRErGgbMQ8BAgQIxBMQsOLtxEQEyguIV+VXXOaAD+LV+//Ud+93b76zzKEchACBoAIiVtDFGIsAAQIEgggIWEEWYQwCowiIV6NsOv85H8erizfelf80TkCAQA4BESvHnkxJgAABAj0EBKwe6p5JYFAB8WrQxSc8tniVcGlGJlBGQMQqs0oHIUCAAIFVBQSsVTndjACBQwLi1SEZf48mIF5F24h5CIwoIGKNuHVnJkCAAIG7BQSsu328S4DACgLi1QqIbrGJgHi1CbOHECAwS0DEmsXkIgIECBAYRkDAGmbVDkqgj4B41cfdU5cLiFfLzXyCAIHWAg8i1mf3ux9/ufWT3J8AAQIECEQXELCib8h8BBILiFeJlzfY6OLVYAt3XAKpBPZ/4O3d74hYqXZmWAIECBBoISBgtVB1TwIEduKVL0EWAfEqy6bMSWBkARFr5O07OwECBAg8FBCwfBMIEFhdQLxandQNGwmIV41g3ZYAgQYCIlYDVLckQIAAgUQCAlaiZRmVQAYB8SrDlsx4JSBe+R4QIJBPQMTKtzMTEyBAgMBaAgLWWpLuQ4DAbv/XfvW792/tX93v9u/DQSCygHgVeTtmI0DgbgER624f7xIgQIBAVQEBq+pmnYvAxgIP4tX+3ufEq43hPW6xgHi1mMwHCBAIJyBihVuJgQgQIECguYCA1ZzYAwjUFxCv6u+4ygnFqyqbdA4CBHY7Ecu3gAABAgTGEhCwxtq30xJYXUC8Wp3UDRsJiFeNYN2WAIGOAiJWR3yPJkCAAIGNBQSsjcE9jkAlAfGq0jZrn0W8qr1fpyMwtoCINfb+nZ4AAQLjCAhY4+zaSQmsKiBercrpZg0FxKuGuG5NgEAQAREryCKMQYAAAQINBQSshrhuTaCqgHhVdbP1ziVe1dupExEgcEhAxDok4+8ECBAgUENAwKqxR6cgsJmAeLUZtQedKSBenQno4wQIJBQQsRIuzcgECBAgMFNAwJoJ5TICBC7/9x39tV/97v3+3uf2u/37eBCILCBeRd6O2QgQaCsgYrX1dXcCBAgQ6CUgYPWS91wCyQTEq2QLG3hc8Wrg5Ts6AQKPBEQsXwUCBAgQqCcgYNXbqRMRWF1AvFqd1A0bCYhXjWDdlgCBhAIiVsKlGZkAAQIE7hAQsO7A8RYBAv6zQd+BPALiVZ5dmZQAga0ERKytpD2HAAECBNoLCFjtjT2BQFoB//Iq7eqGG1y8Gm7lDkyAwGwBEWs2lQsJECBAILSAgBV6PYYj0E9AvOpn78nLBMSrZV6uJkBgRAERa8StOzMBAgSqCQhY1TbqPARWEBCvVkB0i00ExKtNmD2EAIESAiJWiTU6BAECBAYWELAGXr6jE7hNQLy6TcXfIgqIVxG3YiYCBGILiFix92M6AgQIELhLQMC6S8d7BAYTEK8GW3ji44pXiZdndAIEOguIWJ0X4PEECBAgcKKAgHUinI8RqCYgXlXbaN3ziFd1d+tkBAhsJSBibSXtOQQIECCwnoCAtZ6lOxFIKyBepV3dcIOLV8Ot3IEJEGgmIGI1o3VjAgQIEGgiIGA1YXVTAnkExKs8uxp9UvFq9G+A8xMgsL6AiLW+qTsSIECAQCsBAauVrPsSSCAgXiVYkhEfCIhXvggECBBoJSBitZJ1XwIECBBYV0DAWtfT3QikERCv0qxq+EHFq+G/AgAIEGguIGI1J/YAAgQIEDhbQMA6m9ANCOQTEK/y7WzUicWrUTfv3AQIbC8gYm1v7okECBAgsERAwFqi5VoCBQQexKvdvVf3u/37ChzHEQoLiFeFl+toBAgEFRCxgi7GWAQIECBwKSBg+RoQGEjgcbza7799oGM7akIB8Srh0oxMgEARARGryCIdgwABAuUEBKxyK3UgArcLiFe3u/hrPAHxKt5OTESAwGgCItZoG3deAgQIZBAQsDJsyYwEzhQQr84E9PHNBMSrzag9iAABAkcERKwjQN4mQIAAgY0FBKyNwT2OwNYC4tXW4p53qoB4daqczxEgQKCVgIjVStZ9CRAgQGC5gIC13MwnCKQREK/SrGr4QcWr4b8CAAgQCCsgYoVdjcEIECAwmICANdjCHXccAfFqnF1nP+nF87/zhYv3/6nv3l+88a7sZzE/AQIEagqIWDX36lQECBDIJSBg5dqXaQnMEhCvZjG5KIDAg3j1HZ/8sHgVYBlGIECAwJ0CItadPN4kQIAAgeYCAlZzYg8gsK2AeLWtt6edLiBenW7nkwQIEOgjIGL1cfdUAgQIELgSELB8DwgUEhCvCi2z+FHEq+ILdjwCBAoLiFiFl+toBAgQCC0gYIVej+EIzBcQr+ZbubKvgHjV19/TCRAgcL6AiHW+oTsQIECAwFIBAWupmOsJBBQQrwIuxUi3CohXt7L4IwECBBIKiFgJl2ZkAgQIpBYQsFKvz/AEdjvxyrcgi4B4lWVT5iRAgMBcARFrrpTrCBAgQOB8AQHrfEN3INBNQLzqRu/BCwXEq4VgLidAgEAaARErzaoMSoAAgeQCAlbyBRp/XAHxatzdZzu5eJVtY+YlQIDAUgERa6mY6wkQIEBguYCAtdzMJwh0FxCvuq/AADMFxKuZUC4jQIBAegERK/0KHYAAAQLBBQSs4AsyHoFnBcSrZ0X8HlVAvIq6GXMRIECglYCI1UrWfQkQIEBgtxOwfAsIJBIQrxIta/BRxavBvwCOT4DAwAIi1sDLd3QCBAg0FRCwmvK6OYH1BMSr9Szdqa2AeNXW190JECAQX0DEir8jExIgQCCfgICVb2cmHlBAvBpw6UmPLF4lXZyxCRAgsLqAiLU6qRsSIEBgcAEBa/AvgOPHFxCv4u/IhA8FxCvfBAIECBC4LiBiXffwGwECBAicIyBgnaPnswQaC4hXjYHdfjUB8Wo1SjciQIBAMYGriPXaZ/a7H3+52MEchwABAgQ2FhCwNgb3OAJzBcSruVKu6y0gXvXegOcTIEAgvMAPiFjhd2RAAgQIhBcQsMKvyIAjCohXI24955nFq5x7MzUBAgQ6CIhYHdA9kgABApUEBKxK23SWEgLiVYk1DnEI8WqINTskAQIE1hQQsdbUdC8CBAgMJiBgDbZwx40tIF7F3o/pngiIV08s/ESAAAECiwRErEVcLiZAgACBSUDAmiS8EugsIF51XoDHzxYQr2ZTuZAAAQIEbhcQsW538VcCBAgQuENAwLoDx1sEthIQr7aS9pxzBcSrcwV9ngABAgQeCYhYvgoECBAgsEhAwFrE5WIC6wuIV+ubumMbAfGqjau7EiBAYGABEWvg5Ts6AQIElgoIWEvFXE9gRQHxakVMt2oqIF415XVzAgQIjCwgYo28fWcnQIDAAgEBawGWSwmsKSBeranpXi0FxKuWuu5NgAABApcCIpavAQECBAgcFRCwjhK5gMD6AuLV+qbu2EZAvGrj6q4ECBAgcENAxLpB4g8ECBAg8LSAgPW0hp8JbCAgXm2A7BGrCIhXqzC6CQECBAjMFxCx5lu5kgABAsMJCFjDrdyBewqIVz31PXuJgHi1RMu1BAgQILCigIi1IqZbESBAoJKAgFVpm84SWkC8Cr0ewz0lIF49heFHAgQIEOghIGL1UPdMAgQIBBcQsIIvyHg1BPY//fnv2e/uvbrf77+9xomcoqqAeFV1s85FgACBdAIiVrqVGZgAAQJtBQSstr7uTmD3IF7de/tz4pUvQ3QB8Sr6hsxHgACB4QRErOFW7sAECBA4LCBgHbbxDoGzBcSrswndYCMB8WojaI8hQIAAgaUCItZSMdcTIECgqICAVXSxjtVfQLzqvwMTzBMQr+Y5uYoAAQIEugmIWN3oPZgAAQJxBASsOLswSSEB8arQMosfRbwqvmDHI0CAQB0BEavOLp2EAAECJwkIWCex+RCBwwLi1WEb78QSEK9i7cM0BAgQIHBUQMQ6SuQCAgQI1BUQsOru1sk6CIhXHdA98iQB8eokNh8iQIAAgf4CIlb/HZiAAAECXQQErC7sHlpRQLyquNWaZxKvau7VqQgQIDCQgIg10LIdlQABApOAgDVJeCVwhoB4dQaej24qIF5tyu1hBAgQINBOQMRqZ+vOBAgQCCkgYIVci6EyCYhXmbY19qzi1dj7d3oCBAgUFHgUsT7+UsGzORIBAgQIPCMgYD0D4lcCSwTEqyVaru0pIF711PdsAgQIEGgocBmx7n92vxOxGhq7NQECBEIICFgh1mCIjALiVcatjTmzeDXm3p2aAAECAwmIWAMt21EJEBhXQMAad/dOfoaAeHUGno9uKiBebcrtYQQIECDQT0DE6mfvyQQIENhEQMDahNlDKgmIV5W2Wfss4lXt/TodAQIECNwQELFukPgDAQIE6ggIWHV26SQbCIhXGyB7xCoC4tUqjG5CgAABAvkERKx8OzMxAQIEZgkIWLOYXERgtxOvfAuyCIhXWTZlTgIECBBoJCBiNYJ1WwIECPQUELB66nt2GgHxKs2qhh9UvBr+KwCAAAECBB4KiFi+CQQIECgmIGAVW6jjrC8gXq1v6o5tBC6e/+oXLr7jkx/eX7zxrjZPcFcCBAgQIJBKQMRKtS7DEiBA4G4BAetuH+8OLiBeDf4FSHT8h/HqT4pXiXZmVAIECBDYREDE2oTZQwgQINBeQMBqb+wJSQXEq6SLG3Bs8WrApTsyAQIECCwRELGWaLmWAAECQQUErKCLMVZfAfGqr7+nzxcQr+ZbuZIAAQIEhhYQsYZev8MTIFBBQMCqsEVnWFVAvFqV080aCohXDXHdmgABAgQqCohYFbfqTAQIDCMgYA2zagedIyBezVFyTQQB8SrCFsxAgAABAgkFRKyESzMyAQIErgQELN8DAo8ExCtfhSwC4lWWTZmTAAECBIIKiFhBF2MsAgQI3CUgYN2l471hBMSrYVad/qDiVfoVOgABAgQIxBAQsWLswRQECBCYLSBgzaZyYVUB8arqZuudS7yqt1MnIkCAAIGuAiJWV34PJ0CAwDIBAWuZl6uLCYhXxRZa+DjiVeHlOhoBAgQI9BQQsXrqezYBAgQWCAhYC7BcWktAvKq1z8qnEa8qb9fZCBAgQCCAgIgVYAlGIECAwDEBAeuYkPdLCohXJdda8lDiVcm1OhQBAgQIxBMQseLtxEQECBC4JiBgXePwywgC4tUIW65xRvGqxh6dggABAgTSCIhYaVZlUAIERhQQsEbc+sBnFq8GXn6yo4tXyRZmXAIECBCoIiBiVdmkcxAgUE5AwCq3Ugc6JCBeHZLx92gC4lW0jZiHAAECBAYTELEGW7jjEiCQQ0DAyrEnU54pIF6dCejjmwmIV5tRexABAgQIELhLQMS6S8d7BAgQ6CAgYHVA98htBcSrbb097XQB8ep0O58kQIAAAQINBESsBqhuSYAAgVMFBKxT5XwuhYB4lWJNhrwUEK98DQgQIECAQEgBESvkWgxFgMCIAgLWiFsf5Mzi1SCLLnBM8arAEh2BAAECBCoLXEWsz+x3H3+p8iGdjQABAtEFBKzoGzLfSQIP4tXbb7+63++//aQb+BCBjQQexqtPftf+4o13bfRIjyFAgAABAgSWC/xBEWs5mk8QIEBgTQEBa01N9woh8Dhe7fbfFmIgQxA4IPAkXr3+7gOX+DMBAgQIECAQR0DEirMLkxAgMKCAgDXg0isfWbyqvN1aZxOvau3TaQgQIEBgGAERa5hVOygBAtEEBKxoGzHPyQLi1cl0PrixgHi1MbjHESBAgACBdQVErHU93Y0AAQKzBASsWUwuii4gXkXfkPkmAfFqkvBKgAABAgRSC4hYqddneAIEMgoIWBm3ZuZrAuLVNQ6/BBYQrwIvx2gECBAgQGC5gIi13MwnCBAgcLKAgHUynQ9GEBCvImzBDHMExKs5Sq4hQIAAAQLpBESsdCszMAECWQUErKybM/dOvPIlyCIgXmXZlDkJECBAgMBJAiLWSWw+RIAAgWUCAtYyL1cHERCvgizCGEcFxKujRC4gQIAAAQIVBESsClt0BgIEQgsIWKHXY7jbBMSr21T8LaKAeBVxK2YiQIAAAQLNBESsZrRuTIAAgd3uAgKBbAL3vv9P/we7L/2jP5ttbvMOKHDxO/vdxT3/9+yAq8905Ddf+MbXP/HCL70z08xmHVPgXW+9tv/ef/Z5/3fqmOtPc+rLfx2w/7F7v/T5/+Q9//Ff+rX//+KfpRncoAQIEEgg4H8ISLAkI14X2O/2F/d2H/+rl6//9vV3/EaAAAECSwX2u+f/v+965y9/y9LPuZ4AAQIEnhG4/B9O/+c3/9z/+fzu3vN/7Hf/9YsX37X7oyLWM0Z+JUCAwBkC/hPCM/B8tI/Axe5i//zuV/785et/32cCTyVAgAABAgQIECDwlMCjePX9+1/78au/7ne7T3z1n+/+5ke/ef/up67yIwECBAicISBgnYHno/0EnkSs3X/XbwpPJkCAAAECBAgQGF7gYbz6hSleTR4i1iThlQABAusICFjrOLpLB4GHEesf/IXL/w5WxOrg75EECBAgQIAAgeEFnsSrH7vNQsS6TcXfCBAgcJqAgHWam08FERCxgizCGAQIECBAgACB0QSOxKuJQ8SaJLwSIEDgPAEB6zw/nw4gIGIFWIIRCBAgQIAAAQIjCcyMVxOJiDVJeCVAgMDpAgLW6XY+GUhAxAq0DKMQIECAAAECBCoLLIxXE4WINUl4JUCAwGkCAtZpbj4VUEDECrgUIxEgQIAAAQIEKgmcGK8mAhFrkvBKgACB5QIC1nIznwgsIGIFXo7RCBAgQIAAAQKZBc6MV9PRRaxJwisBAgSWCQhYy7xcnUBAxEqwJCMSIECAAAECBDIJrBSvpiOLWJOEVwIECMwXELDmW7kykYCIlWhZRiVAgAABAgQIRBZYOV5NRxWxJgmvBAgQmCcgYM1zclVCAREr4dKMTIAAAQIECBCIJNAoXk1HFLEmCa8ECBA4LiBgHTdyRWIBESvx8oxOgAABAgQIEOgp0DheTUcTsSYJrwQIELhbQMC628e7BQRErAJLdAQCBAgQIECAwJYCG8Wr6Ugi1iThlQABAocFBKzDNt4pJCBiFVqmoxAgQIAAAQIEWgpsHK+mo4hYk4RXAgQI3C4gYN3u4q8FBUSsgkt1JAIECBAgQIDAmgKd4tV0BBFrkvBKgACBmwIC1k0TfyksIGIVXq6jESBAgAABAgTOEegcr6bRRaxJwisBAgSuCwhY1z38NoCAiDXAkh2RAAECBAgQILBEIEi8mkYWsSYJrwQIEHgiIGA9sfDTQAIi1kDLdlQCBAgQIECAwF0CweLVNKqINUl4JUCAwEMBAcs3YVgBEWvY1Ts4AQIECBAgQOChQNB4Na1HxJokvBIgQGC3E7B8C4YWELGGXr/DEyBAgAABAiMLBI9X02pErEnCKwECowsIWKN/A5x/J2L5EhAgQIAAAQIEBhNIEq+mrYhYk4RXAgRGFhCwRt6+sz8WELEeU/iBAAECBAgQIFBbIFm8mpYhYk0SXgkQGFVAwBp18859Q0DEukHiDwQIECBAgACBWgJJ49W0BBFrkvBKgMCIAgLWiFt35oMCItZBGm8QIECAAAECBHILJI9XE76INUl4JUBgNAEBa7SNO+9RARHrKJELCBAgQIAAAQK5BIrEqwldxJokvBIgMJKAgDXStp11toCINZvKhQQIECBAgACB2ALF4tWELWJNEl4JEBhFQMAaZdPOuVhAxFpM5gMECBAgQIAAgVgCRePVhCxiTRJeCRAYQUDAGmHLzniygIh1Mp0PEiBAgAABAgT6ChSPVxOuiDVJeCVAoLqAgFV9w853toCIdTahGxAgQIAAAQIEthUYJF5NqCLWJOGVAIHKAgJW5e0622oCItZqlG5EgAABAgQIEGgrMFi8mjBFrEnCKwECVQUErKqbda7VBUSs1UndkAABAgQIECCwrsCg8WpCFLEmCa8ECFQUELAqbtWZmgmIWM1o3ZgAAQIECBAgcJ7A4PFqwhOxJgmvBAhUExCwqm3UeZoLiFjNiT2AAAECBAgQILBMQLy65iViXePwCwECRQQErCKLdIxtBUSsbb09jQABAgQIECBwUEC8upVGxLqVxR8JEEgsIGAlXp7R+wqIWH39PZ0AAQIECBAgsBOv7vwSiFh38niTAIFkAgJWsoUZN5aAiBVrH6YhQIAAAQIEBhIQr2YtW8SaxeQiAgQSCAhYCZZkxNgCT0Wsvxp7UtMRIECAAAECBIoIiFeLFiliLeJyMQECQQUErKCLMVYugUcR6y9e7HYiVq7VmZYAAQIECBDIJnAZr/6XN//sL3z//td+LNvoPecVsXrqezYBAmsICFhrKLoHgUsBEcvXgAABAgQIECDQWOBRvPq+/a+LVydQi1gnoPkIAQJhBASsMKswSAUBEavCFp2BAAECBAgQCCkgXq2yFhFrFUY3IUCgg4CA1QHdI2sLiFi19+t0BAgQIECAQAcB8WpVdBFrVU43I0BgIwEBayNojxlLQMQaa99OS4AAAQIECDQUEK+a4IpYTVjdlACBhgICVkNctx5bQMQae/9OT4AAAQIECKwgIF6tgHj4Fo8i1s9+9Jv37z58lXcIECAQQ0DAirEHUxQVELGKLtaxCBAgQIAAgfYC4lV748snXEasH/nqP9+JWJtoewgBAucICFjn6PksgRkCItYMJJcQIECAAAECBJ4WEK+e1mj+s4jVnNgDCBBYQUDAWgHRLQgcExCxjgl5nwABAgQIECDwSEC86vJVELG6sHsoAQILBASsBVguJXCOgIh1jp7PEiBAgAABAkMIiFdd1yxideX3cAIEjggIWEeAvE1gTQERa01N9yJAgAABAgRKCYhXIdYpYoVYgyEIELhFQMC6BcWfCLQUELFa6ro3AQIECBAgkFJAvAq1NhEr1DoMQ4DAIwEBy1eBQAcBEasDukcSIECAAAECMQXEq5B7EbFCrsVQBIYWELCGXr/D9xQQsXrqezYBAgQIECAQQkC8CrGGQ0OIWIdk/J0AgR4CAlYPdc8k8EhAxPJVIECAAAECBIYVEK9SrF7ESrEmQxIYQkDAGmLNDhlZQMSKvB2zESBAgAABAk0ExKsmrK1uKmK1knVfAgSWCAhYS7RcS6CRgIjVCNZtCRAgQIAAgXgC4lW8ncyYSMSageQSAgSaCghYTXndnMB8ARFrvpUrCRAgQIAAgaQC4lXSxT0cW8RKvT7DE0gvIGClX6EDVBIQsSpt01kIECBAgACBawLi1TWOrL+IWFk3Z24C+QUErPw7dIJiAiJWsYU6DgECBAgQILDbiVelvgUiVql1OgyBNAICVppVGXQkARFrpG07KwECBAgQKC4gXpVcsIhVcq0ORSC0gIAVej2GG1lAxBp5+85OgAABAgSKCIhXRRZ5+zFErNtd/JUAgTYCAlYbV3clsIqAiLUKo5sQIECAAAECPQTEqx7qmz9TxNqc3AMJDCsgYA27egfPIiBiZdmUOQkQIECAAIHHAuLVY4oRfhCxRtiyMxLoLyBg9d+BCQgcFRCxjhK5gAABAgQIEIgiIF5F2cSmc4hYm3J7GIEhBQSsIdfu0BkFRKyMWzMzAQIECBAYTEC8Gmzh148rYl338BsBAusKCFjrerobgaYCIlZTXjcnQIAAAQIEzhEQr87RK/NZEavMKh2EQDgBASvcSgxE4G4BEetuH+8SIECAAAECHQTEqw7ocR8pYsXdjckIZBYQsDJvz+zDCohYw67ewQkQIECAQDwB8SreTgJMJGIFWIIRCBQTELCKLdRxxhEQscbZtZMSIECAAIGwAuJV2NVEGEzEirAFMxCoIyBg1dmlkwwoIGINuHRHJkCAAAECUQTEqyibCD2HiBV6PYYjkEpAwEq1LsMSuCkgYt008RcCBAgQIECgsYB41Ri41u1FrFr7dBoCvQQErF7ynktgRQERa0VMtyJAgAABAgTuFhCv7vbx7q0CItatLP5IgMACAQFrAZZLCUQWeBKxLv7byHOajQABAgQIEEgsIF4lXl7/0UWs/jswAYHMAgJW5u2ZncAzAg8j1q/8O5evItYzNn4lQIAAAQIEzhQQr84E9PErARHL94AAgVMFBKxT5XyOQFABESvoYoxFgAABAgQyC4hXmbcXbnYRK9xKDEQghYCAlWJNhiSwTEDEWublagIECBAgQOAOAfHqDhxvnSogYp0q53MExhUQsMbdvZMXFxCxii/Y8QgQIECAwBYCD+PV3/6+/a//2BaP84yxBESssfbttATOFRCwzhX0eQKBBUSswMsxGgECBAgQiC7wJF794eijmi+vgIiVd3cmJ7C1gIC1tbjnEdhYQMTaGNzjCBAgQIBABQHxqsIW05xBxEqzKoMS6CogYHXl93AC2wiIWNs4ewoBAgQIECghIF6VWGO2Q4hY2TZmXgLbCwhY25t7IoEuAiJWF3YPJUCAAAECuQTEq1z7KjatiFVsoY5DYGUBAWtlULcjEFlAxIq8HbMRIECAAIHOAuKg67FTAABAAElEQVRV5wV4/JWAiOV7QIDAIQEB65CMvxMoKiBiFV2sYxEgQIAAgXMExKtz9Hx2ZQERa2VQtyNQREDAKrJIxyCwREDEWqLlWgIECBAgUFxAvCq+4JzHE7Fy7s3UBFoKCFgtdd2bQGABESvwcoxGgAABAgS2EhCvtpL2nBMERKwT0HyEQGEBAavwch2NwDEBEeuYkPcJECBAgEBhAfGq8HLrHE3EqrNLJyFwroCAda6gzxNILiBiJV+g8QkQIECAwCkC4tUpaj7TSUDE6gTvsQSCCQhYwRZiHAI9BESsHuqeSYAAAQIEOgmIV53gPfYcARHrHD2fJVBDQMCqsUenIHC2gIh1NqEbECBAgACB+ALiVfwdmfCggIh1kMYbBIYQELCGWLNDEpgnIGLNc3IVAQIECBBIKSBepVyboa8LiFjXPfxGYCQBAWukbTsrgRkCItYMJJcQIECAAIFsAuJVto2Z9w4BEesOHG8RKCwgYBVerqMROFVAxDpVzucIECBAgEBAAfEq4FKMdK7AFLE+8t79i+fey+cJEMghIGDl2JMpCWwuIGJtTu6BBAgQIEBgfQHxan1TdwwjcBWx3nh99zdFrDArMQiBpgICVlNeNyeQW0DEyr0/0xMgQIDA4ALi1eBfgDGO/yhi/ayINca+nXJsAQFr7P07PYGjAiLWUSIXECBAgACBeALiVbydmKiZwGXE+uHLf4klYjUTdmMCMQQErBh7MAWB0AIiVuj1GI4AAQIECFwXEK+ue/htCAERa4g1O+TgAgLW4F8AxycwV0DEmivlOgIECBAg0FFAvOqI79G9BUSs3hvwfAJtBQSstr7uTqCUgIhVap0OQ4AAAQLVBMSraht1nhMERKwT0HyEQBIBASvJooxJIIqAiBVlE+YgQIAAAQJPCYhXT2H4cXQBEWv0b4DzVxUQsKpu1rkINBQQsRriujUBAgQIEFgqIF4tFXP9AAIi1gBLdsThBASs4VbuwATWERCx1nF0FwIECBAgcJaAeHUWnw/XFhCxau/X6cYTELDG27kTE1hNQMRajdKNCBAgQIDAcgHxarmZTwwnIGINt3IHLiwgYBVerqMR2EJAxNpC2TMIECBAgMAzAuLVMyB+JXBYQMQ6bOMdApkEBKxM2zIrgaACIlbQxRiLAAECBGoKiFc19+pUTQVErKa8bk5gEwEBaxNmDyFQX0DEqr9jJyRAgACBAALiVYAlGCGrgIiVdXPmJvBQQMDyTSBAYDUBEWs1SjciQIAAAQI3BcSrmyb+QmChgIi1EMzlBAIJCFiBlmEUAhUERKwKW3QGAgQIEAgnIF6FW4mB8gqIWHl3Z/KxBQSssffv9ASaCDwVsf6bJg9wUwIECBAgMJKAeDXStp11IwERayNojyGwooCAtSKmWxEg8ETgUcT6dy9fRawnLH4iQIAAAQLLBMSrZV6uJrBAQMRagOVSAgEEBKwASzACgaoCIlbVzToXAQIECGwiIF5twuwhYwuIWGPv3+lzCQhYufZlWgLpBESsdCszMAECBAhEEBCvImzBDIMIiFiDLNox0wsIWOlX6AAE4guIWPF3ZEICBAgQCCQgXgVahlFGERCxRtm0c2YWELAyb8/sBBIJiFiJlmVUAgQIEOgncBmv/tc3/8wvft/+1/9wvyE8mcCYAiLWmHt36jwCAlaeXZmUQHoBESv9Ch2AAAECBFoKPIpXH93/xo+2fIx7EyBwWEDEOmzjHQK9BQSs3hvwfAKDCYhYgy3ccQkQIEBgnoB4Nc/JVQQ2EBCxNkD2CAInCAhYJ6D5CAEC5wmIWOf5+TQBAgQIFBMQr4ot1HEqCIhYFbboDNUEBKxqG3UeAkkERKwkizImAQIECLQVEK/a+ro7gTMERKwz8HyUQAMBAasBqlsSIDBPQMSa5+QqAgQIECgqIF4VXaxjVRIQsSpt01myCwhY2TdofgLJBUSs5As0PgECBAicJiBenebmUwQ6CIhYHdA9ksAtAgLWLSj+RIDAtgIi1rbenkaAAAECnQXEq84L8HgCywVErOVmPkFgbQEBa21R9yNA4CQBEeskNh8iQIAAgWwC4lW2jZmXwGMBEesxhR8IdBEQsLqweygBArcJiFi3qfgbAQIECJQREK/KrNJBxhUQscbdvZP3FxCw+u/ABAQIPCUgYj2F4UcCBAgQqCMgXtXZpZMMLyBiDf8VANBJQMDqBO+xBAgcFhCxDtt4hwABAgQSCohXCZdmZAJ3C4hYd/t4l0ALAQGrhap7EiBwtoCIdTahGxAgQIBABAHxKsIWzECgiYCI1YTVTQkcFBCwDtJ4gwCB3gIiVu8NeD4BAgQInCUgXp3F58MEMgiIWBm2ZMYqAgJWlU06B4GiAiJW0cU6FgECBKoLiFfVN+x8BB4LiFiPKfxAoKmAgNWU180JEFhDQMRaQ9E9CBAgQGAzAfFqM2oPIhBFQMSKsglzVBYQsCpv19kIFBIQsQot01EIECBQWUC8qrxdZyNwp4CIdSePNwmcLSBgnU3oBgQIbCUgYm0l7TkECBAgcJKAeHUSmw8RqCQgYlXaprNEExCwom3EPAQI3CkgYt3J400CBAgQ6CUgXvWS91wC4QRErHArMVARAQGryCIdg8BIAiLWSNt2VgIECCQQEK8SLMmIBLYVELG29fa0MQQErDH27JQEygmIWOVW6kAECBDIKSBe5dybqQlsICBibYDsEUMJCFhDrdthCdQSELFq7dNpCBAgkE5AvEq3MgMT2FpAxNpa3PMqCwhYlbfrbAQGEBCxBliyIxIgQCCigHgVcStmIhBSQMQKuRZDJRQQsBIuzcgECFwXELGue/iNAAECBBoLiFeNgd2eQD0BEaveTp1oewEBa3tzTyRAoIGAiNUA1S0JECBA4KaAeHXTxF8IEJglIGLNYnIRgYMCAtZBGm8QIJBNQMTKtjHzEiBAIJmAeJVsYcYlEE9AxIq3ExPlERCw8uzKpAQIzBAQsWYguYQAAQIElguIV8vNfIIAgVsFRKxbWfyRwFEBAesokQsIEMgmIGJl25h5CRAgEFxAvAq+IOMRyCcgYuXbmYn7CwhY/XdgAgIEGgiIWA1Q3ZIAAQIjCohXI27dmQlsIiBibcLsIYUEBKxCy3QUAgSuC4hY1z38RoAAAQILBcSrhWAuJ0BgqYCItVTM9SMLCFgjb9/ZCQwg8FTE+q8HOK4jEiBAgMBaAuLVWpLuQ4DAEYFHEevnPvLe/YtHLvU2gaEFBKyh1+/wBMYQeBSx/r3LVxFrjJU7JQECBM4TEK/O8/NpAgQWC1xGrB964/WdiLVYzgdGEhCwRtq2sxIYWEDEGnj5jk6AAIElAuLVEi3XEiCwooCItSKmW5UUELBKrtWhCBC4TUDEuk3F3wgQIEDgsYB49ZjCDwQI9BEQsfq4e2oOAQErx55MSYDASgIi1kqQbkOAAIFqAuJVtY06D4G0AiJW2tUZvLGAgNUY2O0JEIgnIGLF24mJCBAg0FVAvOrK7+EECNwUELFumvgLAQHLd4AAgSEFRKwh1+7QBAgQuCkgXt008RcCBEIIiFgh1mCIQAICVqBlGIUAgW0FRKxtvT2NAAEC4QQu49X/9uaf+cWP7n/jR8PNZiACBAhcCohYvgYEnggIWE8s/ESAwIACItaAS3dkAgQIXAk8ilffK175PhAgEFxAxAq+IONtJiBgbUbtQQQIRBUQsaJuxlwECBBoJCBeNYJ1WwIEWgmIWK1k3TeTgICVaVtmJUCgmYCI1YzWjQkQIBBLQLyKtQ/TECAwW0DEmk3lwqICAlbRxToWAQLLBUSs5WY+QYAAgVQC4lWqdRmWAIGbAiLWTRN/GUdAwBpn105KgMAMARFrBpJLCBAgkFFAvMq4NTMTIHCLgIh1C4o/DSEgYA2xZockQGCJgIi1RMu1BAgQSCAgXiVYkhEJEFgiIGIt0XJtFQEBq8omnYMAgVUFRKxVOd2MAAEC/QTEq372nkyAQFMBEaspr5sHFBCwAi7FSAQIxBAQsWLswRQECBA4WUC8OpnOBwkQyCEgYuXYkynXERCw1nF0FwIEigqIWEUX61gECNQXEK/q79gJCRB4ICBi+SKMIiBgjbJp5yRA4GQBEetkOh8kQIBAHwHxqo+7pxIg0E1AxOpG78EbCghYG2J7FAECeQVErLy7MzkBAoMJiFeDLdxxCRCYBESsScJrVQEBq+pmnYsAgdUFRKzVSd2QAAEC6wqIV+t6uhsBAukERKx0KzPwAgEBawGWSwkQICBi+Q4QIEAgqIB4FXQxxiJAYGsBEWtrcc/bSkDA2kracwgQKCMgYpVZpYMQIFBFQLyqsknnIEBgJQERayVItwklIGCFWodhCBDIIiBiZdmUOQkQKC8gXpVfsQMSIHCagIh1mptPxRUQsOLuxmQECAQXELGCL8h4BAjUFxCv6u/YCQkQOEtAxDqLz4eDCQhYwRZiHAIEcgmIWLn2ZVoCBAoJiFeFlukoBAi0FBCxWuq695YCAtaW2p5FgEBJARGr5FodigCByALiVeTtmI0AgYACIlbApRhpsYCAtZjMBwgQIHBTQMS6aeIvBAgQaCIgXjVhdVMCBOoLiFj1d1z9hAJW9Q07HwECmwmIWJtRexABAqMKiFejbt65CRBYSUDEWgnSbboICFhd2D2UAIGqAiJW1c06FwEC3QXEq+4rMAABAjUERKwaexzxFC+MeGhnJkCAQEuBq4j1wXfuf/Y3vutf/d7n/58XfrTls9ybAAECowj8S7/7a1/4/v/03/rQ5Xn/4Shnds6cAp/7zAfe2v1CztlNPY7AVcR6/fXdZz7y3v1P/eY/ufjqOCd30swCAlbm7ZmdAIGQAh98cf9v3L+3+/T9//wfv/XCf/jNf2//hW/8wZCDGooAAQKJBO49//zF/ve8932JRjbqoAL3X3rptwY9umPnE/jBN17f/dxlxPojIla+5Y04sf+EcMStOzMBAs0Epnh1+f+q9Y7dxW733Ke++IMXv+/Nv9fsgW5MgAABAgQIECBA4EQB/znhiXA+1kVAwOrC7qEECFQUuBavpgOKWJOEVwIECBAgQIAAgYACIlbApRjpVgEB61YWfyRAgMAygVvj1XQLEWuS8EqAAAECBAgQIBBQQMQKuBQj3RAQsG6Q+AMBAgSWCdwZr6ZbiViThFcCBAgQIECAAIGAAiJWwKUY6ZqAgHWNwy8ECBBYJjArXk23FLEmCa8ECBAgQIAAAQIBBUSsgEsx0mMBAesxhR8IECCwTGBRvJpuLWJNEl4JECBAgAABAgQCCohYAZdipAcCApYvAgECBE4QOCleTc8RsSYJrwQIECBAgAABAgEFRKyASzHSTsDyJSBAgMBCgbPi1fQsEWuS8EqAAAECBAgQIBBQQMQKuJTBRxKwBv8COD4BAssEPvDi/o/fv7f79OX/D/0dyz55y9Ui1i0o/kSAAAECBAgQIBBFQMSKsglzXAkIWL4HBAgQmClwFa/293Y/s0q8mp4pYk0SXgkQIECAAAECBAIKTBHrw+/Zf1PA8Yw0kICANdCyHZUAgdMFmsSraRwRa5LwSoAAAQIECBAgEFDgKmK99ebuZ0WsgMsZaCQBa6BlOyoBAqcJNI1X00gi1iThlQABAgQIECBAIKCAiBVwKYONJGANtnDHJUBgmcAm8WoaScSaJLwSIECAAAECBAgEFBCxAi5loJEErIGW7agECCwT2DReTaOJWJOEVwIECBAgQIAAgYACIlbApQwykoA1yKIdkwCBZQJd4tU04hSxPvbm353+5JUAAQIECBAgQIBAFAERK8omxppDwBpr305LgMAMga7xaprvKmL9lS/+0IWINYl4JUCAAAECBAgQCCQgYgVaxiCjCFiDLNoxCRCYJxAiXk2jThHr97/xy9OfvBIgQIAAAQIECBCIIiBiRdnEGHMIWGPs2SkJEJghECpeTfNeRaz/4ks/4F9iTSBeCRAgQIAAAQIEIgmIWJG2UXsWAav2fp2OAIGZAiHj1TT79C+x/OeEk4hXAgQIECBAgACBQAIiVqBlFB5FwCq8XEcjQGCeQOh4NR1BxJokvBIgQIAAAQIECAQUuIpYX3tz93Mffs/+mwKOZ6QCAgJWgSU6AgECpwukiFfT8USsScIrAQIECBAgQIBATIEfFLFiLqbCVAJWhS06AwECJwmkilfTCUWsScIrAQIECBAgQIBATAERK+Ze0k8lYKVfoQMQIHCKQMp4NR1UxJokvBIgQIAAAQIECMQUELFi7iX1VAJW6vUZngCBUwRSx6vpwCLWJOGVAAECBAgQIEAgpoCIFXMvaacSsNKuzuAECJwiUCJeTQcXsSYJrwQIECBAgAABAjEFRKyYe0k5lYCVcm2GJkDgFIGreHX/3u7Tl/8bUt5xyudDfkbECrkWQxEgQIAAAQIECDwWELEeU/jhHAEB6xw9nyVAII3AFK8uB34hzdBzBxWx5kq5jgABAgQIECBAoI+AiNXHvdRTBaxS63QYAgRuEygdr6YDi1iThFcCBAgQIECAAIGYAiJWzL2kmUrASrMqgxIgcIrAEPFqghGxJgmvBAgQIECAAAECMQVErJh7STGVgJViTYYkQOAUgaHi1QQkYk0SXgkQIECAAAECBGIKiFgx9xJ+KgEr/IoMSIDAKQJDxqsJSsSaJLwSIECAAAECBAjEFBCxYu4l9FQCVuj1GI4AgVMEho5XE5iINUl4JUCAAAECBAgQiCkgYsXcS9ipBKywqzEYAQKnCIhXT6mJWE9h+JEAAQIECBAgQCCggIgVcClRRxKwom7GXAQILBYQr24hE7FuQfEnAgQIECBAgACBQAIiVqBlRB5FwIq8HbMRIDBbQLy6g0rEugPHWwQIECBAgAABAgEERKwAS4g+goAVfUPmI0DgqIB4dZRotxOxZiC5hAABAgQIECBAoKOAiNURP8OjBawMWzIjAQIHBcSrgzQ33xCxbpr4CwECBAgQIECAQCQBESvSNoLNImAFW4hxCBCYLyBezbd6fKWI9ZjCDwQIECBAgAABAiEFRKyQa+k/lIDVfwcmIEDgBAHx6gS06SOPI9Ybf3f6k1cCBAgQIECAAAECgQRErEDLiDKKgBVlE+YgQGC2gHg1m+rwhQ8i1pd+6OJjItZhJO8QIECAAAECBAh0FBCxOuJHfLSAFXErZiJA4KCAeHWQZvkbItZyM58gQIAAAQIECBDYUkDE2lI7+LMErOALMh4BAk8ExKsnFqv9JGKtRulGBAgQIECAAAECTQRErCas+W4qYOXbmYkJDCkgXjVcu4jVENetCRAgQIAAAQIEVhAQsVZAzH4LASv7Bs1PYAAB8WqDJYtYGyB7BAECBAgQIECAwBkCItYZeBU+KmBV2KIzECgsIF5tuFwRa0NsjyJAgAABAgQIEDhBQMQ6Aa3KRwSsKpt0DgIFBcSrDksVsTqgeyQBAgQIECBAgMACARFrAValSwWsStt0FgKFBD7w7v2fuH9v9+nLI71Q6Fg5jiJi5diTKQkQIECAAAEC4wqIWAPuXsAacOmOTCC6wIN4dX/3M5dzile9lvUkYv2dXiN4LgECBAgQIECAAIE7BESsO3AqviVgVdyqMxFILCBeBVrew4j1wxcfe0PECrQWoxAgQIAAAQIECDwWELEeU9T/QcCqv2MnJJBGQLwKuCoRK+BSjESAAAECBAgQIPCUgIj1FEblHwWsytt1NgKJBMSrwMsSsQIvx2gECBAgQIAAAQKXAiLWAF8DAWuAJTsigegC4lX0DV3OJ2IlWJIRCRAgQIAAAQJDC4hYxdcvYBVfsOMRiC4gXkXf0FPziVhPYfiRAAECBAgQIEAgoICIFXApa40kYK0l6T4ECCwWEK8Wk/X/gIjVfwcmIECAAAECBAgQuEtAxLpLJ/F7Albi5RmdQGYB8Srx9kSsxMszOgECBAgQIEBgCAERq+CaBayCS3UkAtEFxKvoG5oxn4g1A8klBAgQIECAAAECHQVErI74LR4tYLVQdU8CBA4KiFcHafK9IWLl25mJCRAgQIAAAQJjCYhYhfYtYBVapqMQiC4gXkXf0AnziVgnoPkIAQIECBAgQIDAhgIi1obYLR8lYLXUdW8CBB4LiFePKer9IGLV26kTESBAgAABAgRqCYhYBfYpYBVYoiMQiC4gXkXf0ArziVgrILoFAQIECBAgQIBAQwERqyHuFrcWsLZQ9gwCAwuIVwMtX8QaaNmOSoAAAQIECBBIKSBipVzbw6EFrMTLMzqB6ALiVfQNNZhPxGqA6pYECBAgQIAAAQIrCohYK2JueSsBa0ttzyIwkIB4NdCynz2qiPWsiN8JECBAgAABAgRiCYhYsfYxaxoBaxaTiwgQWCIgXi3RKnqtiFV0sY5FgAABAgQIECgjIGIlW6WAlWxhxiUQXUC8ir6hDecTsTbE9igCBAgQIECAAIETBESsE9B6fUTA6iXvuQQKCohXBZd67pFErHMFfZ4AAQIECBAgQKCtgIjV1ne1uwtYq1G6EYGxBcSrsfd/5+lFrDt5vEmAAAECBAgQINBdQMTqvoLjAwhYx41cQYDAEQHx6giQt3c7Ecu3gAABAgQIECBAILaAiBV7PzsBK/iCjEcguoB4FX1DgeYTsQItwygECBAgQIAAAQK3CFxFrM98+D37b7rlPX/qLCBgdV6AxxPILCBeZd5ep9lFrE7wHkuAAAECBAgQIDBT4A+JWDOlNr5MwNoY3OMIVBEQr6psssM5RKwO6B5JgAABAgQIECCwQEDEWoC11aUC1lbSnkOgkIB4VWiZvY4iYvWS91wCBAgQIECAAIF5AiLWPKfNrhKwNqP2IAI1BMSrGnsMcQoRK8QaDEGAAAECBAgQIHBQQMQ6SLP9GwLW9uaeSCCtgHiVdnVxBxex4u7GZAQIECBAgAABAlcCIlaQ74GAFWQRxiAQXUC8ir6hxPOJWImXZ3QCBAgQIECAwBACIlaANQtYAZZgBALRBcSr6BsqMJ+IVWCJjkCAAAECBAgQKC0gYnVer4DVeQEeTyC6gHgVfUOF5hOxCi3TUQgQIECAAAECJQVErI5rFbA64ns0gegC4lX0DRWcT8QquFRHIkCAAAECBAiUEhCxOq1TwOoE77EEoguIV9E3VHg+Eavwch2NAAECBAgQIFBCQMTqsEYBqwO6RxKILiBeRd/QAPOJWAMs2REJECBAgAABAqkFRKyN1ydgbQzucQSiC4hX0Tc00HxXEetTX/rhi4+98XcGOrWjEiBAgAABAgQI5BEQsTbclYC1IbZHEYguIF5F39CY84lYY+7dqQkQIECAAAECSQRErI0WJWBtBO0xBKILiFfRNzT2fA8i1u9745fGVnB6AgQIECBAgACBoAIi1gaLEbA2QPYIAtEFxKvoGzLflcBz/9WXfuRCxPJlIECAAAECBAgQiCkgYjXei4DVGNjtCUQXuIxX/+b9+7ufuZzzheizmo+AiOU7QIAAAQIECBAgEFhAxGq4HAGrIa5bE4gu8Che/fTlnOJV9GWZ77GAiPWYwg8ECBAgQIAAAQLxBESsRjsRsBrBui2B6ALiVfQNme8uARHrLh3vESBAgAABAgQIdBYQsRosQMBqgOqWBKILiFfRN2S+OQIi1hwl1xAgQIAAAQIECHQSELFWhhewVgZ1OwLRBcSr6Bsy3xIBEWuJlmsJECBAgAABAgQ2FhCxVgQXsFbEdCsC0QXEq+gbMt8pAiLWKWo+Q4AAAQIECBAgsJGAiLUStIC1EqTbEIguIF5F35D5zhEQsc7R81kCBAgQIECAAIHGAiLWCsAC1gqIbkEguoB4FX1D5ltDQMRaQ9E9CBAgQIAAAQIEGgmIWGfCClhnAvo4gegC4lX0DZlvTQERa01N9yJAgAABAgQIEFhZQMQ6A1TAOgPPRwlEFxCvom/IfC0ERKwWqu5JgAABAgQIECCwkoCIdSKkgHUinI8RiC4gXkXfkPlaCohYLXXdmwABAgQIECBA4EwBEesEQAHrBDQfIRBdQLyKviHzbSEgYm2h7BkECBAgQIAAAQInCohYC+EErIVgLicQXUC8ir4h820pIGJtqe1ZBAgQIECAAAECCwVErAVgAtYCLJcSiC4gXkXfkPl6CIhYPdQ9kwABAgQIECBAYKaAiDUTSsCaCeUyAtEFxKvoGzJfTwERq6e+ZxMgQIAAAQIECBwRELGOAF29LWDNQHIJgegC4lX0DZkvgoCIFWELZiBAgAABAgQIEDggIGIdgJn+LGBNEl4JJBUQr5IuzthdBESsLuweSoAAAQIECBAgME9AxLrDScC6A8dbBKILiFfRN2S+iAIiVsStmIkAAQIECBAgQOCRwIOI9aFX9i8RuS4gYF338BuBNALiVZpVGTSggIgVcClGIkCAAAECBAgQmAT+0Ne/tvs5EWviePgqYF338BuBFALiVYo1GTK4gIgVfEHGI0CAAAECBAiMLSBiPbN/AesZEL8SiC4gXkXfkPkyCYhYmbZlVgIECBAgQIDAcAIi1lMrF7CewvAjgegC4lX0DZkvo4CIlXFrZiZAgAABAgQIDCMgYj1atYA1zHfeQbMLiFfZN2j+yAIiVuTtmI0AAQIECBAgMLyAiHX5FRCwhv//DgBkEBCvMmzJjNkFRKzsGzQ/AQIECBAgQKC0wPARS8Aq/f12uAoC4lWFLTpDFoEHEetjb/xSlnnNSYAAAQIECBAgMJTA0BFLwBrqu+6w2QTEq2wbM28Fgec+9aUfuRCxKqzSGQgQIECAAAECFQWGjVgCVsWvszOVEBCvSqzRIZIKiFhJF2dsAgQIECBAgMAYAkNGLAFrjC+3UyYTEK+SLcy4JQVErJJrdSgCBAgQIECAQBWB4SKWgFXlq+scZQTEqzKrdJACAiJWgSU6AgECBAgQIECgrsBQEUvAqvtFdrKEAuJVwqUZubyAiFV+xQ5IgAABAgQIEMgsMEzEErAyf03NXkpAvCq1TocpJiBiFVuo4xAgQIAAAQIEagkMEbEErFpfWqdJKiBeJV2csYcSELGGWrfDEiBAgAABAgSyCZSPWAJWtq+kecsJiFflVupAhQUeRqw3f7HwER2NAAECBAgQIEAgr0DpiCVg5f1imryAgHhVYImOMJzAc5/64icuPiZiDbd4ByZAgAABAgQI5BC4ilif+dAr+5dyjDt/SgFrvpUrCawqIF6tyulmBDYVELE25fYwAgQIECBAgACBZQJ/sGLEErCWfQlcTWAVAfFqFUY3IdBVQMTqyu/hBAgQIECAAAECdwuUi1gC1t0L9y6B1QXEq9VJ3ZBANwERqxu9BxMgQIAAAQIECBwXKBWxBKzjC3cFgdUExKvVKN2IQBgBESvMKgxCgAABAgQIECBwU6BMxBKwbi7XXwg0EfjOd+//5P37u5++vPkLTR7gpgQIdBMQsbrRezABAgQIECBAgMBxgRIRS8A6vmhXEDhb4Cpe7e/v/sbljcSrszXdgEBMAREr5l5MRYAAAQIECBAg8EAgfcQSsHyTCTQWEK8aA7s9gUACIlagZRiFAAECBAgQIEDgWYHUEUvAenadfiewooB4tSKmWxFIIiBiJVmUMQkQIECAAAECYwqkjVgC1phfWKfeQEC82gDZIwgEFRCxgi7GWAQIECBAgAABAlcCKSOWgOXLS6CBgHjVANUtCSQTELGSLcy4BAgQIECAAIGxBNJFLAFrrC+o024gIF5tgOwRBJIIiFhJFmVMAgQIECBAgMCYAqkiloA15pfUqRsJiFeNYN2WQGIBESvx8oxOgAABAgQIEKgvkCZiCVj1v4xOuJGAeLURtMcQSCggYiVcmpEJECBAgAABAuMIpIhYAtY4X0gnbSggXjXEdWsCRQRErCKLdAwCBAgQIECAQE2B8BFLwKr5xXOqDQXEqw2xPYpAcgERK/kCjU+AAAECBAgQqC0QOmIJWLW/fE7XWEC8agzs9gQKCohYBZfqSAQIECBAgACBOgJhI5aAVedL5iQbC4hXG4N7HIFCAiJWoWU6CgECBAgQIECgnkDIiCVg1fuiOdEGAuLVBsgeQaC4gIhVfMGOR4AAAQIECBDILRAuYglYub9Qpu8gIF51QPdIAkUFRKyii3UsAgQIECBAgEANgVARS8Cq8aVyio0ExKuNoD2GwEACItZAy3ZUAgQIECBAgEA+gTARS8DK9+UxcScB8aoTvMcSGEBAxBpgyY5IgAABAgQIEMgrECJiCVh5v0Am31BAvNoQ26MIDCogYg26eMcmQIAAAQIECOQQ6B6xBKwcXxRTdhQQrzriezSBwQRErMEW7rgECBAgQIAAgVwCXSOWgJXry2LajQXEq43BPY4AgZ2I5UtAgAABAgQIECAQWKBbxBKwAn8rjNZXQLzq6+/pBEYWELFG3r6zEyBAgAABAgTCC3SJWAJW+O+FAXsIiFc91D2TAIGnBUSspzX8TIAAAQIECBAgEExg84glYAX7Bhinv4B41X8HJiBA4KGAiOWbQIAAAQIECBAgEFhg04glYAX+JhhtewHxantzTyRA4G4BEetuH+8SIECAAAECBAh0FdgsYglYXffs4ZEExKtI2zALAQJPC4hYT2v4mQABAgQIECBAIJjAJhFLwAq2deP0ERCv+rh7KgEC8wVErPlWriRAgAABAgQIENhcoHnEErA236kHRhMQr6JtxDwECBwSELEOyfg7AQIECBAgQIBAAIGmEUvACrBhI/QTEK/62XsyAQKnCYhYp7n5FAECBAgQIECAwCYCzSKWgLXJ/jwkooB4FXErZiJAYI6AiDVHyTUECBAgQIAAAQKdBJpELAGr0zY9tq+AeNXX39MJEDhf4EHE+tde/9vn38kdCBAgQIAAAQIECKwusHrEErBW35EbRhcQr6JvyHwECMwVeO6v/PaPXohYc7lcR4AAAQIECBAgsK3AqhFLwNp2eZ7WWUC86rwAjydAYHUBEWt1UjckQIAAAQIECBBYT2C1iCVgrbcUdwouIF4FX5DxCBA4WUDEOpnOBwkQIECAAAECBNoLrBKxBKz2i/KEAALiVYAlGIEAgaYCIlZTXjcnQIAAAQIECBA4T+DsiCVgnbcAn04gIF4lWJIRCRBYRUDEWoXRTQgQIECAAAECBNoInBWxBKw2S3HXIALiVZBFGIMAgc0ERKzNqD2IAAECBAgQIEBgucDJEUvAWo7tE0kExKskizImAQKrC4hYq5O6IQECBAgQIECAwHoCJ0UsAWu9BbhTIAHxKtAyjEKAQBcBEasLu4cSIECAAAECBAjME1gcsQSsebCuSiQgXiVallEJEGgqIGI15XVzAgQIECBAgACB8wQWRSwB6zxsnw4mcBmvPrm/v/sbl2O9EGw04xAgQKCLgIjVhd1DCRAgQIAAAQIE5gnMjlgC1jxQVyUQeBSv/vrlqOJVgn0ZkQCB7QRErO2sPYkAAQIECBAgQGCxwKyIJWAtdvWBiALiVcStmIkAgUgCIlakbZiFAAECBAgQIEDgGYGjEUvAekbMr/kExKt8OzMxAQJ9BESsPu6eSoAAAQIECBAgMEvgzoglYM0ydFFUAfEq6mbMRYBAVAERK+pmzEWAAAECBAgQIHApcDBiCVi+H2kFxKu0qzM4AQKdBUSszgvweAIECBAgQIAAgbsEbo1YAtZdZN4LKyBehV2NwQgQSCIgYiVZlDEJECBAgAABAmMKXEWsz37olf1L0/H9b2ubJLymERCv0qzKoAQIBBe4iliv/eWP/vzuC7tvCT6q8Qjs9vvd/uLt+19HQSC8wP379y52O9/V8IsyIAECCQQ+du9r+//9I+/d/+u/+U8uvvov2ruXWG+3u6Dj//97etqjp7HQo0ZRSpVESQBP6TktHYiWIqVVEmdY204MCfeLA0fOTJQYU6uJ94FxoDBAZ06MA6kWUPASbj2GGMQeRtACKZxSaNOz3c973v/e797v//Jc1uW31vqQmL33//8861nr83uC5utLuf7vrf6LQDsC4lU7s7LT3e4X//0f+uwzz3z2WRYEogt829/4jx//qZ/9s98QfZ/2N7bAH37mcz/z0/tvffXVX//Nt48t4fTRBfZ/6vc+/uBf/bL/nhp9UPZHgEATAvv9/qd3r3/De/cv/uZn/I8QNjEym5wExCvvAQECBPII/OhH3vsNX//8j388z+pWJZBG4PNPPb3ff+SHvvbBc1/6v9KsaBUCBAgQIEAgssDj8Wrap4AVeVr2diMgXt1Q+IUAAQJZBESsLKwWTSxw9fR1xRKxEqtajgABAgQIxBO4H6+mHQpY8eZkR/cExKt7IP4kQIBAJgERKxOsZZMKiFhJOS1GgAABAgTCCRyLV9MmBaxwo7KhxwXEq8c1/E6AAIH8AiJWfmNP2C4gYm03tAIBAgQIEIgocCpeTXsVsCJOzJ4eCohXXgQCBAjUERCx6rh76jIBEWuZl6sJECBAgEB0gXPxatq7gBV9goPuT7wadPCOTYBAGAERK8wobOSMgIh1BsdXBAgQIECgIYFL8Wo6ioDV0EBH2ap4NcqknZMAgegCIlb0CdnfJCBieQ8IECBAgEDbAnPi1XRCAavtOXe3e/Gqu5E6EAECjQuIWI0PcJDti1iDDNoxCRAgQKA7gbnxajq4gNXd+Ns9kHjV7uzsnACBvgVErL7n28vpRKxeJukcBAgQIDCKwJJ4NZkIWKO8GcHPKV4FH5DtESAwvICINfwr0ASAiNXEmGySAAECBAjslsariUzA8uJUFxCvqo/ABggQIDBLQMSaxeSiygIiVuUBeDwBAgQIELggsCZeTUsKWBdgfZ1XQLzK62t1AgQIpBYQsVKLWi+HgIiVQ9WaBAgQIEBgu8DaeDU9WcDa7m+FlQLi1Uo4txEgQKCygIhVeQAeP0tAxJrF5CICBAgQIFBMYEu8mjYpYBUblQc9LiBePa7hdwIECLQnIGK1N7MRdyxijTh1ZyZAgACBiAJb49V0JgEr4mQ735N41fmAHY8AgWEERKxhRt30QUWspsdn8wQIECDQgcDDePXgmW/ev/ibn9lyHAFri557FwuIV4vJ3ECAAIHQAiJW6PHY3CMBEcurQIAAAQIE6gjcxKt3/cZvbd2BgLVV0P2zBcSr2VQuJECAQFMCDyPW1/74x5vatM0OJyBiDTdyByZAgACBygIp49V0FAGr8kBHebx4NcqknZMAgVEFfvSj7/2GrxexRh1/M+cWsZoZlY0SIECAQOMCqePVxCFgNf5StLB98aqFKdkjAQIEtguIWNsNrZBfQMTKb+wJBAgQIDC2QI54NYkKWGO/V9lPL15lJ/YAAgQIhBIQsUKNw2ZOCDwWsf7niUt8TIAAAQIECKwQyBWvpq0IWCsG4pZ5AuLVPCdXESBAoDcBEau3ifZ5nkcR6888eO5LRaw+R+xUBAgQIFBYIGe8mo4iYBUe6CiPE69GmbRzEiBA4LiAiHXcxaexBESsWPOwGwIECBBoVyB3vJpkBKx234+wOxevwo7GxggQIFBUQMQqyu1hKwVErJVwbiNAgAABAo8ESsSr6VECllcuqYB4lZTTYgQIEGheQMRqfoRDHEDEGmLMDkmAAAECGQRKxatp6wJWhgGOuqR4NerknZsAAQLnBUSs8z6+jSEgYsWYg10QIECAQDsCJePVpCJgtfNuhN6peBV6PDZHgACB6gIiVvUR2MAMARFrBpJLCBAgQIDAtcB1vPqp3YNnvnn/rt/4rVIgAlYp6Y6fI151PFxHI0CAQEIBESshpqWyCYhY2WgtTIAAAQKdCDyKV+8tGa8mOgGrkxeo1jHEq1rynkuAAIE2BUSsNuc22q5FrNEm7rwECBAgMFegVrya9idgzZ2S654QEK+eIPEBAQIECMwQELFmILmkuoCIVX0ENkCAAAECwQRqxquJQsAK9kK0sp3rePVtV6/ufuR6v69rZc/2SYAAAQJxBESsOLOwk9MCItZpG98QIECAwFgCtePVpC1gjfXOJTnto3j1w9eLiVdJRC1CgACBMQVErDHn3tqpRazWJma/BAgQIJBaIEK8ms4kYKWebOfriVedD9jxCBAgUFhAxCoM7nGrBESsVWxuIkCAAIEOBKLEq4lSwOrghSp1BPGqlLTnECBAYCwBEWusebd6WhGr1cnZNwECBAisFYgUr6YzCFhrJznYfeLVYAN3XAIECBQWELEKg3vcKgERaxWbmwgQIECgQYFo8WoiFLAafJFKb1m8Ki3ueQQIEBhTQMQac+6tnVrEam1i9kuAAAECSwUixqvpDALW0kkOdr14NdjAHZcAAQKVBUSsygPw+FkCItYsJhcRIECAQIMCUePVRClgNfhCldqyeFVK2nMIECBA4HEBEetxDb9HFRCxok7GvggQIEBgrUDkeDWdScBaO9nO7xOvOh+w4xEgQCC4gIgVfEC291BAxPIiECBAgEAvAtHj1eQsYPXytiU8h3iVENNSBAgQILBaQMRaTefGggIiVkFsjyJAgACBLAItxKvp4AJWlvG3u6h41e7s7JwAAQI9CohYPU61vzOJWP3N1IkIECAwikAr8Wqah4A1yls545zi1QwklxAgQIBAcQERqzi5B64QELFWoLmFAAECBKoKtBSvJigBq+rrEufh4lWcWdgJAQIECDwpIGI9aeKTeAIiVryZ2BEBAgQIHBdoLV5NpxCwjs9yqE/Fq6HG7bAECBBoVkDEanZ0Q21cxBpq3A5LgACBJgVajFcTtIDV5OuWbtPiVTpLKxEgQIBAfgERK7+xJ2wXELG2G1qBAAECBPIItBqvJg0BK8870cSq4lUTY7JJAgQIELgnIGLdA/FnSAERK+RYbIoAAQJDC7Qcr6bBCViDvr7i1aCDd2wCBAh0IiBidTLIzo8hYnU+YMcjQIBAQwKtx6uJWsBq6IVLtVXxKpWkdQgQIECgpoCIVVPfs+cKiFhzpVxHgAABArkEeohXk42AlesNCbqueBV0MLZFgAABAqsERKxVbG4qLCBiFQb3OAIECBC4EeglXk0HErBuxtr/L+JV/zN2QgIECIwoIGKNOPX2zixitTczOyZAgEDrAj3Fq2kWAlbrb+TM/YtXM6FcRoAAAQJNCohYTY5tuE2LWMON3IEJECBQTaC3eDVBCljVXqdyDxavyll7EgECBAjUExCx6tl78nwBEWu+lSsJECBAYJ1Aj/FqkhCw1r0Pzdz1KF79yPWGX9fMpm2UAAECBAisFBCxVsK5raiAiFWU28MIECAwlECv8WoaooDV8av8WLx6quNjOhoBAgQIELgjIGLd4fBHUAERK+hgbIsAAQINC/Qcr6axCFgNv5znti5endPxHQECBAj0LiBi9T7hPs4nYvUxR6cgQIBABIHe49VkLGBFeNMS70G8SgxqOQIECBBoUkDEanJsw21axBpu5A5MgACB5AIjxKsJTcBK/urUXVC8quvv6QQIECAQS0DEijUPuzkuIGIdd/EpAQIECFwWGCVeTRIC1uX3oZkrxKtmRmWjBAgQIFBQQMQqiO1RqwVErNV0biRAgMCwAiPFq2nIAlYnr7p41ckgHYMAAQIEsgiIWFlYLZpYQMRKDGo5AgQIdCwwWryaRilgdfBCi1cdDNERCBAgQCC7gIiVndgDEgiIWAkQLUGAAIHOBUaMV9NIBazGX2zxqvEB2j4BAgQIFBUQsYpye9hKARFrJZzbCBAgMIDAqPFqGq2A1fALLl41PDxbJ0CAAIFqAiJWNXoPXiBwiFj75978Pxbc5lICBAgQ6Fhg5Hg1jVXAavTlFq8aHZxtEyBAgEAIARErxBhs4oLAFLEefOTvPC9iXYDyNQECBAYQGD1eTSMWsBp80cWrBodmywQIECAQTkDECjcSGzoi8DBi/f0fErGO2PiIAAECowiIV69NWsBq7I0XrxobmO0SIECAQGgBESv0eGzukcDV6x48/UDE8j4QIEBgSAHx6nbsAtatRfjfxKvwI7JBAgQIEGhQQMRqcGgDblnEGnDojkyAwPAC4tXdV0DAuusR9i/xKuxobIwAAQIEOhAQsToY4gBHELEGGLIjEiBA4JGAePXkqyBgPWkS7hPxKtxIbIgAAQIEOhQQsTocaodHErE6HKojESBA4J6AeHUP5NGfAtZxlzCffvkbr/7K1au7H7ne0FNhNmUjBAgQIECgUwERq9PBdnYsEauzgToOAQIEHhMQrx7DuPergHUPJNKfU7zavbr74es9iVeRBmMvBAgQINC1gIjV9Xi7OZyI1c0oHYQAAQI3AuLVDcXRXwSsoyz1PxSv6s/ADggQIEBgXAERa9zZt3RyEauladkrAQIEzguIV+d9pm8FrMtGxa8Qr4qTeyABAgQIEHhCQMR6gsQHAQVErIBDsSUCBAgsFBCv5oEJWPOcil0lXhWj9iACBAgQIHBRQMS6SOSCAAIiVoAh2AIBAgRWCux3+/+2e/DMe/fv+o3fWrnEMLcJWIFGLV4FGoatECBAgACBRwIillehBQERq4Up2SMBAgTuCjyMV0898y3i1V2XU38JWKdkCn8uXhUG9zgCBAgQILBAQMRagOXSagIiVjV6DyZAgMBiAfFqMZn/DKzlZOnvEK/Sm1qRAAECBAikFhCxUotaL4eAiJVD1ZoECBBIKyBerfP0L7DWuSW7S7xKRmkhAgQIECCQXUDEyk7sAQkERKwEiJYgQIBAJgHxaj2sgLXebvOd4tVmQgsQIECAAIHiAiJWcXIPXCEgYq1AcwsBAgQyC4hX24AFrG1+q+8Wr1bTuZEAAQIECFQXELGqj8AGZgiIWDOQXEKAAIFCAuLVdmgBa7vh4hXEq8VkbiBAgAABAuEERKxwI7GhIwIi1hEUHxEgQKCwgHiVBlzASuM4exXxajaVCwkQIECAQHgBESv8iGzwWkDE8hoQIECgnoB4lc5ewEpneXEl8eoikQsIECBAgEBzAiJWcyMbcsMi1pBjd2gCBCoLiFdpByBgpfU8uZp4dZLGFwQIECBAoHkBEav5EQ5xABFriDE7JAECQQTEq/SDELDSmz6xonj1BIkPCBAgQIBAdwIiVncj7fJAIlaXY3UoAgSCCYhXeQYiYOVxvVlVvLqh8AsBAgQIEOheQMTqfsRdHFDE6mKMDkGAQFAB8SrfYASsfLY78SojrqUJECBAgEBQAREr6GBs646AiHWHwx8ECBBIIiBeJWE8uYiAdZJm2xfi1TY/dxMgQIAAgZYFpoj1zq/9if/S8hnsvX8BEav/GTshAQLlBMSr/NYCVgZj8SoDqiUJECBAgEBjAv/2o9/850SsxoY24HZFrAGH7sgECCQXEK+Skx5dUMA6yrL+Q/FqvZ07CRAgQIBAbwIiVm8T7fM8Ilafc3UqAgTKCIhXZZynpwhYCa3Fq4SYliJAgAABAp0IiFidDLLzY4hYnQ/Y8QgQyCIgXmVhPbmogHWSZtkX4tUyL1cTIECAAIGRBESskabd7llFrHZnZ+cECJQXEK/KmwtYCczFqwSIliBAgAABAp0LiFidD7iT44lYnQzSMQgQyCogXmXlPbm4gHWSZt4X4tU8J1cRIECAAAECu52I5S1oQUDEamFK9kiAQC0B8aqWvP8MrE3y4tUmPjcTIECAAIEhBUSsIcfe3KFFrOZGZsMECBQQEK8KIJ95hH+BdQbn3Ffi1Tkd3xEgQIAAAQLnBESsczq+iyIgYkWZhH0QIBBBQLyqPwUBa8UMxKsVaG4hQIAAAQIE7giIWHc4/BFUQMQKOhjbIkCgqIB4VZT75MMErJM0x78Qr467+JQAAQIECBBYLiBiLTdzR3kBEau8uScSIBBHQLyKMwsBa8EsxKsFWC4lQIAAAQIEZgmIWLOYXFRZQMSqPACPJ0CgioB4VYX95EMFrJM0d78Qr+56+IsAAQIECBBIJyBipbO0Uj4BESufrZUJEIgnIF7Fm4mANWMm4tUMJJcQIECAAAECmwRErE18bi4kIGIVgvYYAgSqCohXVflPPlzAOknz2hfi1QUgXxMgQIAAAQLJBESsZJQWyiggYmXEtTQBAtUFxKvqIzi5AQHrJM1uJ16dwfEVAQIECBAgkEVAxMrCatHEAiJWYlDLESAQQkC8CjGGk5sQsE7QiFcnYHxMgAABAgQIZBcQsbITe0ACgcci1n9PsJwlCBAgUFVAvKrKP+vhAtYRJvHqCIqPCBAgQIAAgaICIlZRbg9bKfAoYr1t/9ybRayVhm4jQKC+gHhVfwZzdiBg3VMSr+6B+JMAAQIECBCoJiBiVaP34AUCItYCLJcSIBBOQLwKN5KTGxKwHqMRrx7D8CsBAgQIECAQQkDECjEGm7ggIGJdAPI1AQIhBcSrkGM5uSkB6xHNW9949YHdq7sfvv7zqZNaviBAgAABAgQIVBAQsSqge+RiARFrMZkbCBCoKCBeVcRf+WgB6xpuildffHX3b65/Fa9WvkhuI0CAAAECBPIKiFh5fa2eRkDESuNoFQIE8gqIV3l9c60+fMASr3K9WtYlQIAAAQIEUguIWKlFrZdDQMTKoWpNAgRSCYhXqSTLrzN0wBKvyr9wnkiAAAECBAhsExCxtvm5u4yAiFXG2VMIEFgmIF4t84p29bABS7yK9iraDwECBAgQIDBXQMSaK+W6mgIiVk19zyZA4L6AeHVfpL2/hwxY4lV7L6odEyBAgAABAncFRKy7Hv6KKSBixZyLXREYTUC86mPiwwUs8aqPF9cpCBAgQIAAgd1OxPIWtCAgYrUwJXsk0K+AeNXPbIcKWOJVPy+ukxAgQIAAAQKvCYhY3oQWBESsFqZkjwT6ExCv+prpMAFLvOrrxXUaAgQIECBA4FZAxLq18FtcAREr7mzsjECPAuJVf1MdImCJV/29uE5EgAABAgQI3BUQse56+CumgIgVcy52RaA3AfGqt4m+dp7uA5Z41eeL61QECBAgQIDAkwIi1pMmPoknIGLFm4kdEehJQLzqaZp3z9J1wBKv7g7bXwQIECBAgED/AiJW/zPu4YQiVg9TdAYC8QTEq3gzSbmjbgOWeJXyNbEWAQIECBAg0JKAiNXStMbdq4g17uydnEAOgf1u9193Tz3zLft3/cZv5VjfmvUFugxY4lX9F8sOCBAgQIAAgboCIlZdf0+fJyBizXNyFQEC5wVei1e/733i1Xmn1r/tLmCJV62/kvZPgAABAgQIpBIQsVJJWiengIiVU9faBPoXeBivnnvWv7zqf9S7rgKWeDXAG+uIBAgQIECAwCIBEWsRl4srCYhYleA9lkDjAjfx6qs+/duNH8X2Zwh0E7DEqxnTdgkBAgQIECAwpICINeTYmzu0iNXcyGyYQFUB8aoqf5WHdxGwxKsq746HEiBAgAABAg0JiFgNDWvgrYpYAw/f0QksEBCvFmB1dGnzAUu86uhtdBQCBAgQIEAgq4CIlZXX4okERKxEkJYh0KmAeNXpYGccq+mAJV7NmLBLCBAgQIAAAQKPCYhYj2H4NayAiBV2NDZGoKqAeFWVv/rDmw1Y4lX1d8cGCBAgQIAAgUYFRKxGBzfYtkWswQbuuAQuCIhXF4AG+LrJgCVeDfBmOiIBAgQIECCQVUDEyspr8UQCIlYiSMsQaFxAvGp8gIm231zAEq8STd4yBAgQIECAwPACItbwr0ATACJWE2OySQLZBMSrbLTNLdxUwBKvmnu/bJgAAQIECBAILiBiBR+Q7T0UeBixPvpDz++fe/N/R0KAwDgC4tU4s55z0mYClng1Z5yuIUCAAAECBAgsFxCxlpu5o7zA1VMPXv9AxCoP74kEKgmIV5XgAz+2iYAlXgV+g2yNAAECBAgQ6EJAxOpijN0fQsTqfsQOSOChgHjlRTgmED5giVfHxuYzAgQIECBAgEB6ARErvakV0wuIWOlNrUggkoB4FWkasfYSOmCJV7FeFrshQIAAAQIE+hcQsfqfcQ8nFLF6a65YlQAAPxZJREFUmKIzEHhSQLx60sQntwJhA5Z4dTskvxEgQIAAAQIESgqIWCW1PWutgIi1Vs59BGIKiFcx5xJpVyEDlngV6RWxFwIECBAgQGBEARFrxKm3d2YRq72Z2TGBYwLi1TEVn90XCBewxKv7I/I3AQIECBAgQKCOgIhVx91TlwmIWMu8XE0gmoB4FW0icfcTKmCJV3FfFDsjQIAAAQIExhQQscace2unFrFam5j9EnhNQLzyJiwRCBOwxKslY3MtAQIECBAgQKCcgIhVztqT1guIWOvt3EmghoB4VUO97WeGCFjiVdsvkd0TIECAAAEC/QuIWP3PuIcTilg9TNEZRhAQr0aYcvozVg9Y4lX6oVqRAAECBAgQIJBDQMTKoWrN1AIiVmpR6xFIKyBepfUcabWqAUu8GulVc1YCBAgQIECgBwERq4cp9n8GEav/GTthmwLiVZtzi7LragFLvIryCtgHAQIECBAgQGCZgIi1zMvVdQRErDrunkrglIB4dUrG53MFqgQs8WrueFxHgAABAgQIEIgpIGLFnItd3RW4jVh/8KfvfuMvAgRKCohXJbX7fVbxgPXWZ6/+6hdf3f2ba9Kn+mV1MgIECBAgQIBA/wIiVv8z7uGEr0Wsv/22/XMiVg/zdIb2BMSr9mYWdcdFA9bDeHW1+9fXGOJV1DfCvggQIECAAAECCwRErAVYLq0mIGJVo/fgwQXEq8FfgMTHLxawxKvEk7McAQIECBAgQCCIgIgVZBC2cVZAxDrL40sCyQXEq+Skwy9YJGCJV8O/ZwAIECBAgACBzgVErM4H3MnxRKxOBukY4QXEq/AjanKD2QOWeNXke2HTBAgQIECAAIHFAiLWYjI3VBAQsSqge+RQAuLVUOMuetisAUu8KjpLDyNAgAABAgQIVBcQsaqPwAZmCIhYM5BcQmCFgHi1As0tswWyBSzxavYMXEiAAAECBAgQ6EpAxOpqnN0eRsTqdrQOVklAvKoEP9BjswQs8WqgN8hRCRAgQIAAAQJHBESsIyg+CidwG7He/NPhNmdDBBoSEK8aGlbDW00esMSrht8GWydAgAABAgQIJBQQsRJiWiqbwGsR64fetn9OxMqGbOGuBcSrrscb6nBJA5Z4FWq2NkOAAAECBAgQqC4gYlUfgQ3MEBCxZiC5hMARAfHqCIqPsgkkC1jiVbYZWZgAAQIECBAg0LSAiNX0+IbZvIg1zKgdNJGAeJUI0jKzBZIELPFqtrcLCRAgQIAAAQJDCohYQ469uUOLWM2NzIYrCYhXleAHf+zmgCVeDf4GOT4BAgQIECBAYKbAFLHe8TU/+Z9nXu4yAlUERKwq7B7akIB41dCwOtvqpoAlXnX2NjgOAQIECBAgQCCzwL/7B3/hz4tYmZEtv1lAxNpMaIFOBcSrTgfbyLFWByzxqpEJ2yYBAgQIECBAIJiAiBVsILZzVEDEOsriw4EFxKuBhx/k6KsClngVZHq2QYAAAQIECBBoVEDEanRwg21bxBps4I57UkC8Oknji4ICiwOWeFVwOh5FgAABAgQIEOhYQMTqeLgdHU3E6miYjrJKQLxaxeamDAKLApZ4lWECliRAgAABAgQIDCwgYg08/IaOLmI1NCxbTSogXiXltNhGgdkBS7zaKO12AgQIECBAgACBowIi1lEWHwYTELGCDcR2sguIV9mJPWChwKyAJV4tVHU5AQIECBAgQIDAIgERaxGXiysJiFiV4D22uIB4VZzcA2cIXAxY4tUMRZcQIECAAAECBAhsFhCxNhNaoICAiFUA2SOqCuz3u5/cPffst+y/6tO/XXUjHk7gnsDZgCVe3dPyJwECBAgQIECAQFYBESsrr8UTCYhYiSAtE07gYbx687PvE6/CjcaGrgVOBizxyvtBgAABAgQIECBQQ0DEqqHumUsFRKylYq6PLiBeRZ+Q/R0NWOKVF4MAAQIECBAgQKCmgIhVU9+z5wqIWHOlXBddQLyKPiH7mwSeCFjilReDAAECBAgQIEAggoCIFWEK9nBJQMS6JOT76ALiVfQJ2d9B4E7AEq8OLH4SIECAAAECBAhEEBCxIkzBHi4JiFiXhHwfVUC8ijoZ+zomcP2/HfO1//qON/ynv/Qr+y/76NVufydqHb73k0AUgZef/ord5x+8Psp27IPASYFf+Mgf+703PP27bzh5gS8IRBB45nW73R/5sgg7sQcCZwX+4b/8R5/4gW/5fX/57EW+JFBZYP/FVz+/+89/9z/uvv0/fGvlrXg8gYsC1/HqJ3Zvfvb9/gPbL1K5IIjATcC62r3rrV/cff5jV7urrwiyN9sgcFTgqb/1N3/x6ivf+qePfulDAoEErj78HZ+92u2eDbQlWyFwR2C/2//O7q9/z0v7F59/8c4X/iAQTOD6/8H66/v96/7Cq1evftdu9+p3Btue7RC4I7B/w//5+O7Lvv0b7nzoDwLBBPzLq2ADsZ1ZAjf/2mq/+2//76nd6999/f+Y/eSsO11EgAABAgQINCsgXjU7uuE2/jBeve6pb9p/6Ot+5sGH3v7d1/8Rrv9iOAQHJkCAQEIB8SohpqWKCtwErOmpIlZRew8jQIAAAQJVBMSrKuweukLgJl594O0/O92+3++vHkas/f6fr1jOLQQIEBheQLwa/hVoGuBOwJpOImI1PU+bJ0CAAAECZwXEq7M8vgwkcD9eHbb2MGJ98IXvua5ZItYBxU8CBAjMEBCvZiC5JLTAEwFr2q2IFXpmNkeAAAECBFYJiFer2NxUQeBUvDpsRcQ6SPhJgACBeQLi1TwnV8UWOBqwpi2LWLEHZ3cECBAgQGCJgHi1RMu1NQUuxavD3kSsg4SfBAgQOC8gXp338W07AicD1nQEEaudQdopAQIECBA4JSBenZLxeTSBufHqsG8R6yDhJwECBI4LiFfHXXzapsDZgDUdScRqc7B2TYAAAQIEJgHxynvQisDSeHU4l4h1kPCTAAECdwXEq7se/mpf4GLAmo4oYrU/aCcgQIAAgfEExKvxZt7qidfGq8N5RayDhJ8ECBB4TUC88ib0KDArYE0HF7F6HL8zESBAgECvAuJVr5Pt71xb49VBRMQ6SPhJgMDoAuLV6G9Av+efHbAmAhGr3xfByQgQIECgHwHxqp9Z9n6SVPHq4CRiHST8JEBgVAHxatTJj3HuRQFrIhGxxngxnJIAAQIE2hQQr9qc24i7Th2vDoYi1kHCTwIERhMQr0ab+HjnXRywJiIRa7wXxYkJECBAIL6AeBV/Rnb4mkCueHXwFbEOEn4SIDCKgHg1yqTHPueqgDWRiVhjvzhOT4AAAQKxBMSrWPOwm9MCuePV4cki1kHCTwIEehcQr3qfsPMdBFYHrGkBEevA6CcBAgQIEKgnIF7Vs/fkZQKl4tVhVyLWQcJPAgR6FRCvep2scx0T2BSwpgVFrGOsPiNAgAABAmUExKsyzp6yXaB0vDrsWMQ6SPhJgEBvAuJVbxN1nksCmwPW9AAR6xKz7wkQIECAQHoB8Sq9qRXzCNSKV4fTiFgHCT8JEOhFQLzqZZLOsUQgScCaHihiLWF3LQECBAgQ2CYgXm3zc3c5gdrx6nBSEesg4ScBAq0LiFetT9D+1wokC1jTBkSstWNwHwECBAgQmC8gXs23cmVdgSjx6qAgYh0k/CRAoFUB8arVydl3CoGkAWvakIiVYizWIECAAAECxwXEq+MuPo0nEC1eHYRErIOEnwQItCYgXrU2MftNLZA8YE0bFLFSj8l6BAgQIEBg+r9f97+z++vf89L+xedf5EEgskDUeHUwE7EOEn4SINCKgHjVyqTsM6dAloA1bVjEyjk2axMgQIDAaALi1WgTb/e80ePVQVbEOkj4SYBAdAHxKvqE7K+UQLaANR1AxCo1Rs8hQIAAgZ4FxKuep9vX2VqJVwd1Eesg4ScBAlEFxKuok7GvGgJZA9Z0IBGrxlg9kwABAgR6ERCveplk/+doLV4dJiJiHST8JEAgmoB4FW0i9lNbIHvAmg4oYtUes+cTIECAQIsC4lWLUxtzz63Gq8O0RKyDhJ8ECEQREK+iTMI+IgkUCVjTgUWsSGO3FwIECBCILiBeRZ+Q/R0EWo9XN+fY768efPCF79nt9//88JmfBAgQqCEgXtVQ98wWBIoFrAlDxGrhlbBHAgQIEKgtIF7VnoDnzxXoJV4dzutfYh0k/CRAoJaAeFVL3nNbECgasCYQEauF18IeCRAgQKCWgHhVS95zlwr0Fq8O5xexDhJ+EiBQWkC8Ki3uea0JFA9YE5CI1dprYr8ECBAgUEJAvCqh7BkpBHqNVwcbEesg4ScBAqUExKtS0p7TskCVgDWBiVgtvzb2ToAAAQKpBcSr1KLWyyXQe7w6uIlYBwk/CRDILSBe5Ra2fi8C1QLWBChi9fIaOQcBAgQIbBEQr7boubekwCjx6mAqYh0k/CRAIJeAeJVL1ro9ClQNWBOoiNXja+VMBAgQIDBXQLyaK+W62gKjxauDt4h1kPCTAIHUAuJValHr9S5QPWBNwCJW76+Z8xEgQIDAMQHx6piKzyIKjBqvDrMQsQ4SfhIgkEpAvEolaZ2RBEIErAlcxBrptXNWAgQIEBCvvAOtCIwerw5zErEOEn4SILBVQLzaKuj+UQXCBKxpACLWqK+hcxMgQGAsAfFqrHm3fFrx6u70RKy7Hv4iQGC5gHi13MwdBA4CoQLWtCkR6zAaPwkQIECgRwHxqsep9nkm8er4XEWs4y4+JUDgsoB4ddnIFQTOCYQLWNNmRaxzI/MdAQIECLQqMMWrBz/43S/tX3z+xVbPYN9jCIhX5+f8WMT6Z+ev9C0BAgReExCvvAkEtguEDFjTsUSs7cO1AgECBAjEETjEq6t3vE28ijMWOzkiIF4dQTny0aOI9b27/V7EOuLjIwIEbgXEq1sLvxHYIhA2YE2HErG2jNa9BAgQIBBFQLyKMgn7uCQgXl0Suvu9iHXXw18ECDwpIF49aeITAmsFQges6VAi1trRuo8AAQIEIgiIVxGmYA9zBMSrOUpPXiNiPWniEwIEXhO4jlc/sXvzs+/bf9Wnf5sJAQLbBcIHrOmIItb2QVuBAAECBMoLiFflzT1xnYB4tc7tcJeIdZDwkwCBg8CjePV+8eog4ieB7QJNBKzpmCLW9mFbgQABAgTKCYhX5aw9aZuAeLXN73C3iHWQ8JMAAfHKO0Agj0AzAWs6voiV5yWwKgECBAikFRCv0npaLZ+AeJXWVsRK62k1Ai0KiFctTs2eWxFoKmBNqCJWK6+WfRIgQGBMAfFqzLm3eGrxKs/URKw8rlYl0IKAeNXClOyxZYHmAtaELWK1/MrZOwECBPoVEK/6nW1vJ3sYrx48eM/+A2//2d7OFuE8IlaEKdgDgbIC4lVZb08bU6DJgDWNSsQa84V1agIECEQVEK+iTsa+7gvcxKsPvvBz97/zdzoBESudpZUIRBcQr6JPyP56EWg2YE0DELF6eQ2dgwABAm0LiFdtz2+k3YtXZactYpX19jQCNQTEqxrqnjmqQNMBaxqaiDXqq+vcBAgQiCEgXsWYg11cFhCvLhvluELEyqFqTQIxBMSrGHOwi3EEmg9Y06hErHFeWCclQIBAJAHxKtI07OWcgHh1Tif/dyJWfmNPIFBaQLwqLe55BHa7LgLWNEgRy+tMgAABAiUFxKuS2p61RUC82qKX7l4RK52llQjUFhCvak/A80cV6CZgTQMUsUZ9jZ2bAAECZQWu49XnHvzgd7909Y63vVj2yZ5GYJmAeLXMK/fVIlZuYesTyC8gXuU39gQCpwS6CljTIUWsU6P2OQECBAikEHgUrz4hXqXQtEZOAfEqp+76tUWs9XbuJFBbQLyqPQHPH12gu4A1DVTEGv21dn4CBAjkERCv8rhaNb2AeJXeNOWKIlZKTWsRKCMgXpVx9hQC5wS6DFjTgUWsc2P3HQECBAgsFRCvloq5vpaAeFVLftlzRaxlXq4mUFNAvKqp79kEbgW6DVjTEUWs20H7jQABAgTWC4hX6+3cWVZAvCrrvfVpItZWQfcTyC/wMF696Y3v23/Vp387/9M8gQCBcwJdB6zp4CLWufH7jgABAgQuCYhXl4R8H0VAvIoyiWX7ELGWebmaQEmBm3j11Z96peRzPYsAgeMC3Qes6dgi1vHh+5QAAQIEzguIV+d9fBtHQLyKM4s1OxGx1qi5h0BeAfEqr6/VCawRGCJgTTAi1prXwz0ECBAYV0C8Gnf2rZ1cvGptYsf3K2Idd/EpgRoC4lUNdc8kcFlgmIA1UYhYl18IVxAgQIDA9H9f7D/34Ae/+xNX73jbizwIRBa4jh6f3j948J79B1/4ucj7tLd5AiLWPCdXEcgpIF7l1LU2gW0CQwWsiUrE2vbCuJsAAQK9C4hXvU+4n/M9jFf7/TeJV/3MdDqJiNXXPJ2mLQHxqq152e14AsMFrGnEItZ4L7oTEyBAYI6AeDVHyTURBMSrCFPItwcRK5+tlQmcEhCvTsn4nEAcgSED1sQvYsV5Ce2EAAECEQTEqwhTsIc5AuLVHKX2rxGx2p+hE7QjIF61Mys7HVtg2IA1jV3EGvvld3oCBAgcBMSrg4Sf0QXEq+gTSrs/ESutp9UIHBMQr46p+IxATIGhA9Y0EhEr5otpVwQIECglIF6VkvacrQLi1VbBNu8Xsdqcm123ISBetTEnuyRwEBg+YE0QItbhdfCTAAECYwmIV2PNu+XTilctT2/73kWs7YZWIHBfQLy6L+JvAvEFBKxHMxKx4r+sdkiAAIGUAuJVSk1r5RQQr3LqtrO2iNXOrOw0voB4FX9GdkjgmICA9ZiKiPUYhl8JECDQsYB41fFwOzuaeNXZQDceR8TaCOh2AtcC4pXXgEC7AgLWvdmJWPdA/EmAAIHOBMSrzgba8XHEq46Hu+FoItYGPLcOLyBeDf8KAGhcQMA6MkAR6wiKjwgQINCBgHjVwRAHOYJ4NcigVx5TxFoJ57ahBcSrocfv8J0ICFgnBilinYDxMQECBBoVEK8aHdyA2xavBhz6iiOLWCvQ3DKsgHg17OgdvDMBAevMQEWsMzi+IkCAQEMC4lVDwxp8q+LV4C/AwuOLWAvBXD6kgHg15NgdulMBAevCYEWsC0C+JkCAQHAB8Sr4gGzvRkC8uqHwywKBm4i12//TBbe5lMAQAuLVEGN2yIEEBKwZwxaxZiC5hAABAgEFxKuAQ7GlowLi1VEWH84UeBixPvTC913/71cTsWaauax/AfGq/xk74XgCAtbMmYtYM6FcRoAAgSAC4lWQQdjGRQHx6iKRC2YIiFgzkFwyjIB4NcyoHXQwAQFrwcBFrAVYLiVAgEBFAfGqIr5HLxIQrxZxufiCgIh1AcjXQwiIV0OM2SEHFRCwFg5exFoI5nICBAgUFhCvCoN73GoB8Wo1nRvPCIhYZ3B81b2AeNX9iB1wcAEBa8ULIGKtQHMLAQIECgiIVwWQPSKJgHiVhNEiJwRErBMwPu5aQLzqerwOR+ChgIC18kUQsVbCuY0AAQKZBMSrTLCWTS4gXiUnteARgSliPfXhF7/Xf7D7ERwfdScgXnU3UgcicFRAwDrKMu9DEWuek6sIECCQW0C8yi1s/VQC4lUqSevMFRCx5kq5rlUB8arVydk3geUCAtZyszt3iFh3OPxBgACB4gJTvNr/wHe+dPWOt71Y/OEeSGCBgHi1AMulSQVErKScFgskIF4FGoatECggIGAlQBaxEiBaggABAisEDvFq9863v7DidrcQKCYgXhWj9qATAiLWCRgfNysgXjU7OhsnsFpAwFpNd/dGEeuuh78IECCQW0C8yi1s/VQC4lUqSetsFRCxtgq6P4qAeBVlEvZBoKyAgJXQW8RKiGkpAgQInBEQr87g+CqUgHgVahw2cy0gYnkNWhcQr1qfoP0TWC8gYK23O3qniHWUxYcECBBIJiBeJaO0UGYB8SozsOVXC4hYq+ncWFlAvKo8AI8nUFlAwMowABErA6olCRAgcC0gXnkNWhEQr1qZ1Lj7FLHGnX2rJxevWp2cfRNIJyBgpbO8s5KIdYfDHwQIENgsIF5tJrRAIQHxqhC0x2wWELE2E1qgkMB+t/vx3Zve+L79V3/qlUKP9BgCBAIKCFgZhyJiZcS1NAECQwmIV0ONu+nDildNj2/IzYtYQ469qUM/jFdf8sb3i1dNjc1mCWQRELCysN4uKmLdWviNAAECawTEqzVq7qkhIF7VUPfMFAIiVgpFa+QQEK9yqFqTQLsCAlaB2YlYBZA9ggCBLgXEqy7H2uWhxKsuxzrUoUSsocbdxGHFqybGZJMEigoIWIW4RaxC0B5DgEA3AuJVN6Ps/iDiVfcjHuaAItYwow5/UPEq/IhskEAVAQGrILuIVRDbowgQaFpAvGp6fENtXrwaatxDHFbEGmLMoQ8pXoUej80RqCogYBXmF7EKg3scAQLNCYhXzY1s2A2LV8OOvvuDi1jdjzjsAcWrsKOxMQIhBASsCmMQsSqgeyQBAk0IiFdNjMkmrwXEK69B7wIiVu8Tjnc+8SreTOyIQDQBAavSRESsSvAeS4BAWAHxKuxobOyegHh1D8Sf3QqIWN2ONtzBxKtwI7EhAiEFBKyKYxGxKuJ7NAECoQTEq1DjsJkzAuLVGRxfdSkgYnU51lCHEq9CjcNmCIQWELAqj0fEqjwAjydAoLqAeFV9BDYwU0C8mgnlsu4ERKzuRhrmQOJVmFHYCIEmBASsAGMSsQIMwRYIEKgiIF5VYffQFQLi1Qo0t3QlIGJ1Nc4QhxGvQozBJgg0JSBgBRmXiBVkELZBgEAxAfGqGLUHbRQQrzYCur0bARGrm1FWP4h4VX0ENkCgSQEBK9DYRKxAw7AVAgSyCohXWXktnlDgYby62r1n/8EXfi7hspYi0KyAiNXs6MJsXLwKMwobIdCcgIAVbGQiVrCB2A4BAskFxKvkpBbMJHATrz784s9neoRlCTQpIGI1ObYQmxavQozBJgg0KyBgBRydiBVwKLZEgEASAfEqCaNFCgiIVwWQPaJpARGr6fFV2bx4VYXdQwl0JSBgBR2niBV0MLZFgMBqAfFqNZ0bCwuIV4XBPa5ZARGr2dEV37h4VZzcAwl0KSBgBR6riBV4OLZGgMAiAfFqEZeLKwqIVxXxPbpJARGrybEV3bR4VZTbwwh0LSBgBR+viBV8QLZHgMBFAfHqIpELggiIV0EGYRvNCYhYzY2s2IbFq2LUHkRgCAEBq4Exi1gNDMkWCRA4KiBeHWXxYUAB8SrgUGypKQERq6lxFdmseFWE2UMIDCUgYDUybhGrkUHZJgECNwLi1Q2FX4ILiFfBB2R7zQiIWM2MKvtGxavsxB5AYEgBAauhsYtYDQ3LVgkMLiBeDf4CNHR88aqhYdlqEwIPI9Z+90+a2KxNZhEQr7KwWpQAgWsBAaux10DEamxgtktgQAHxasChN3pk8arRwdl2eIGnPvSO79uJWOHnlGOD4lUOVWsSIHAQELAOEg39FLEaGpatEhhMQLwabOANH1e8anh4tt6EgIjVxJiSblK8SsppMQIEjggIWEdQWvhIxGphSvZIYCwB8Wqsebd8WvGq5enZe0sCIlZL09q2V/Fqm5+7CRCYJyBgzXMKeZWIFXIsNkVgSAHxasixN3lo8arJsdl0wwIiVsPDm7l18WomlMsIENgsIGBtJqy7gIhV19/TCRDYXf/HnOw/t/+B73xp9863v8CDQGQB8SrydOytZwERq9/pilf9ztbJCEQUELAiTmXhnkSshWAuJ0AgmYB4lYzSQpkFxKvMwJYncEFAxLoA1ODX4lWDQ7NlAo0LCFiND/CwfRHrIOEnAQKlBMSrUtKes1VAvNoq6H4CaQRErDSOEVYRryJMwR4IjCcgYHU0cxGro2E6CoHgAuJV8AHZ3o2AeHVD4RcCIQRErBBj2LQJ8WoTn5sJENggIGBtwIt4q4gVcSr2RKAvAfGqr3n2fBrxqufpOlvLAiJWu9MTr9qdnZ0T6EFAwOphivfOIGLdA/EnAQLJBMSrZJQWyiwgXmUGtjyBjQIi1kbACreLVxXQPZIAgTsCAtYdjn7+ELH6maWTEIgiIF5FmYR9XBIQry4J+Z5ADAERK8Yc5uxCvJqj5BoCBHILCFi5hSuuL2JVxPdoAp0JiFedDbTj44hXHQ/X0boUELHij1W8ij8jOyQwioCA1fmkRazOB+x4BAoIiFcFkD0iiYB4lYTRIgSKC4hYxclnP1C8mk3lQgIECggIWAWQaz9CxKo9Ac8n0K6AeNXu7EbbuXg12sSdtzcBESveRMWreDOxIwKjCwhYg7wBItYgg3ZMAgkFxKuEmJbKKiBeZeW1OIFiAiJWMeqLDxKvLhK5gACBCgICVgX0Wo8UsWrJey6B9gTEq/ZmNuqOxatRJ+/cvQqIWPUnK17Vn4EdECBwXEDAOu7S7aciVrejdTACyQRei1ff9dLunW9/IdmiFiKQQUC8yoBqSQIBBESsekMQr+rZezIBApcFBKzLRt1dIWJ1N1IHIpBM4DZefZ14lUzVQjkExKscqtYkEEdAxCo/C/GqvLknEiCwTEDAWubVzdUiVjejdBACyQTEq2SUFsosIF5lBrY8gSACIla5QYhX5aw9iQCB9QIC1nq75u8UsZofoQMQSCYgXiWjtFBmAfEqM7DlCQQTELHyD0S8ym/sCQQIpBEQsNI4NruKiNXs6GycQDIB8SoZpYUyC4hXmYEtTyCogIiVbzDiVT5bKxMgkF5AwEpv2tyKIlZzI7NhAskExKtklBbKLCBeZQa2PIHgAiJW+gGJV+lNrUiAQF4BASuvbzOri1jNjMpGCSQTEK+SUVoos4B4lRnY8gQaERCx0g1KvEpnaSUCBMoJCFjlrMM/ScQKPyIbJJBMQLxKRmmhzALiVWZgyxNoTEDE2j4w8Wq7oRUIEKgjIGDVcQ/7VBEr7GhsjEAyAfEqGaWFMguIV5mBLU+gUQERa/3gruPVx3df8sb377/6U6+sX8WdBAgQqCMgYNVxD/1UESv0eGyOwCYB8WoTn5sLCohXBbE9ikCDAiLW8qE9ild/UbxabucOAgRiCAhYMeYQbhciVriR2BCBzQLi1WZCCxQSEK8KQXsMgcYFRKz5AxSv5lu5kgCBuAICVtzZVN+ZiFV9BDZAIJmAeJWM0kKZBcSrzMCWJ9CZgIh1eaDi1WUjVxAg0IaAgNXGnKrtUsSqRu/BBJIJiFfJKC2UWUC8ygxseQKdCohYpwcrXp228Q0BAu0JCFjtzaz4jkWs4uQeSCCZgHiVjNJCmQWu49Wn9le79+w//OLPZ36U5QkQ6FBAxHpyqOLVkyY+IUCgbQEBq+35Fdu9iFWM2oMIJBMQr5JRWiizwKN49U3iVWZoyxPoXEDEuh2weHVr4TcCBPoRELD6mWX2k4hY2Yk9gEAyAfEqGaWFMguIV5mBLU9gMAERa7cTrwZ76R2XwEACAtZAw05xVBErhaI1COQVEK/y+lo9nYB4lc7SSgQI3AqMHLHEq9v3wG8ECPQnIGD1N9PsJxKxshN7AIHVAuLVajo3FhYQrwqDexyBwQRei1gP/vFIxxavRpq2sxIYU0DAGnPum08tYm0mtACB5ALiVXJSC2YSEK8ywVqWAIE7Ak996IXv3+3HiFji1Z3R+4MAgU4FBKxOB1viWCJWCWXPIDBPQLya5+Sq+gLiVf0Z2AGBkQRGiFji1UhvtLMSGFtAwBp7/ptPL2JtJrQAgc0C4tVmQgsUEhCvCkF7DAECdwR6jlji1Z1R+4MAgc4FBKzOB1zieCJWCWXPIHBcQLw67uLTeALiVbyZ2BGBkQR6jFji1UhvsLMSIDAJCFjegyQCIlYSRosQWCQgXi3icnFFAfGqIr5HEyBwI9BTxBKvbsbqFwIEBhIQsAYadu6jili5ha1P4FZAvLq18FtsAfEq9nzsjsBoAj1ELPFqtLfWeQkQOAgIWAcJP5MIiFhJGC1C4KyAeHWWx5eBBMSrQMOwFQIEbgRajlji1c0Y/UKAwIACAtaAQ899ZBErt7D1RxYQr0aefltnF6/ampfdEhhNoMWIJV6N9pY6LwEC9wUErPsi/k4iIGIlYbQIgTsC4tUdDn8EFhCvAg/H1ggQuBFoKWKJVzdj8wsBAgMLCFgDDz/30UWs3MLWH0lAvBpp2m2fVbxqe352T2A0gRYilng12lvpvAQInBIQsE7J+DyJgIiVhNEigwuIV4O/AA0dX7xqaFi2SoDAjUDkiCVe3YzJLwQIENgJWF6C7AIiVnZiD+hYQLzqeLidHe1hvHrq6ffsP/ziz3d2NMchQGAAgYgRS7wa4MVzRAIEFgkIWIu4XLxWQMRaK+e+kQXEq5Gn39bZb+LVB57/hbZ2brcECBC4FYgUscSr27n4jQABAgcBAesg4Wd2ARErO7EHdCQgXnU0zM6PIl51PmDHIzCYQISIJV4N9tI5LgECswUErNlULkwhIGKlULRG7wLiVe8T7ud84lU/s3QSAgRuBWpGLPHqdg5+I0CAwH0BAeu+iL+zC4hY2Yk9oGEB8arh4Q22dfFqsIE7LoHBBGpELPFqsJfMcQkQWCwgYC0mc0MKARErhaI1ehMQr3qbaL/nEa/6na2TESBwK1AyYolXt+5+I0CAwCkBAeuUjM+zC4hY2Yk9oCEB8aqhYQ2+VfFq8BfA8QkMJlAiYolXg71UjkuAwGoBAWs1nRtTCIhYKRSt0bqAeNX6BMfZv3g1zqydlACBW4GcEethvHrjH3j//qs/9crtE/1GgAABAscEBKxjKj4rKiBiFeX2sGAC4lWwgdjOSQHx6iSNLwgQGEAgR8S6iVfP/+pnByB0RAIECGwWELA2E1oghYCIlULRGq0JiFetTWzc/YpX487eyQkQuBVIGbHEq1tXvxEgQGCugIA1V8p12QVErOzEHhBIQLwKNAxbOSsgXp3l8SUBAoMJpIhY4tVgL43jEiCQTEDASkZpoRQCIlYKRWtEFxCvok/I/g4C4tVBwk8CBAjcCmyJWOLVraPfCBAgsFRAwFoq5vrsAiJWdmIPqCggXlXE9+hFAuLVIi4XEyAwmMCaiCVeDfaSOC4BAskFBKzkpBZMISBipVC0RjQB8SraROznlIB4dUrG5wQIELgVWBKxxKtbN78RIEBgrYCAtVbOfdkFRKzsxB5QUOBhvPr+73xp986ve6HgYz2KwGIB8WoxmRsIEBhYYE7EEq8GfkEcnQCBpAICVlJOi6UWELFSi1qvhsBNvPr6t4tXNQbgmbMFxKvZVC4kQIDAjcC5iCVe3TD5hQABApsFBKzNhBbILSBi5Ra2fk4B8SqnrrVTCohXKTWtRYDAaALHIpZ4Ndpb4LwECOQWELByC1s/iYCIlYTRIqUF9g9+dz/9jw36l1el5T1voYB4tRDM5QQIEDgi8HjEEq+OAPmIAAECGwWu/3ur/yLQjsDV7l1v/fi3/71/8PLr3/JH29m1nY4q8Kaf/alf/8zzX//cqOd37jYEvuxLPv+73/j8575v/4Hnf6GNHdslAQIEYgtc/fu/9dd2b/mnP7p//lc/G3undkeAAIG2BASstuZlt9cCX/6mq6/cfWH3setf/zgQAgQIEFgvsN/vfvX6/3zjJ1/Z/+/1q7iTAAECBAgQIECAQH4BASu/sSdkEBCxMqBakgCBoQTEq6HG7bAECBAgQIAAgeYFBKzmRzjuAUSscWfv5AQIbBMQr7b5uZsAAQIECBAgQKC8gIBV3twTEwqIWAkxLUWAwBAC4tUQY3ZIAgQIECBAgEB3AgJWdyMd70Ai1ngzd2ICBNYJiFfr3NxFgAABAgQIECBQX0DAqj8DO0ggIGIlQLQEAQJdC4hXXY/X4QgQIECAAAEC3QsIWN2PeJwDiljjzNpJCRBYJiBeLfNyNQECBAgQIECAQDwBASveTOxog4CItQHPrQQIdCkgXnU5VociQIAAAQIECAwnIGANN/L+Dyxi9T9jJyRAYJ6AeDXPyVUECBAgQIAAAQLxBQSs+DOywxUCItYKNLcQINCVgHjV1TgdhgABAgQIECAwvICANfwr0C+AiNXvbJ2MAIHzAuLVeR/fEiBAgAABAgQItCcgYLU3MzteICBiLcByKQECXQiIV12M0SEIECBAgAABAgTuCQhY90D82Z+AiNXfTJ2IAIHjAuLVcRefEiBAgAABAgQItC8gYLU/QyeYISBizUByCQECTQuIV02Pz+YJECBAgAABAgQuCAhYF4B83Y+AiNXPLJ2EAIG7AuLVXQ9/ESBAgAABAgQI9CcgYPU3Uyc6IyBincHxFQECTQqIV02OzaYJECBAgAABAgQWCghYC8Fc3r6AiNX+DJ2AAIHXBMQrbwIBAgQIECBAgMAoAgLWKJN2zjsCItYdDn8QINCgwBSvdvvde15+Zf9Sg9u3ZQIECBAgQIAAAQKLBASsRVwu7klAxOppms5CYCwB8WqseTstAQIECBAgQIDA7vr/79Z/ERhYQMQaePiOTqBRAfGq0cHZNgECBAgQIECAwCaBB5vudjOBxgV+5TP7X9o9vXv39TF+pfGj2D4BAgMIiFcDDNkRCRAgQIAAAQIEjgr4F1hHWXw4msCjf4n1Y9fn/vLRzu68BAi0ISBetTEnuyRAgAABAgQIEMgjIGDlcbVqgwIiVoNDs2UCgwiIV4MM2jEJECBAgAABAgROCghYJ2l8MaLAW9509SevvrD72PXZ/UusEV8AZyYQUEC8CjgUWyJAgAABAgQIECguIGAVJ/fA6AIiVvQJ2R+BcQTEq3Fm7aQECBAgQIAAAQLnBQSs8z6+HVRAxBp08I5NIJCAeBVoGLZCgAABAgQIECBQXUDAqj4CG4gqIGJFnYx9EehfQLzqf8ZOSIAAAQIECBAgsExAwFrm5erBBESswQbuuAQCCIhXAYZgCwQIECBAgAABAuEEBKxwI7GhaAIiVrSJ2A+BfgXEq35n62QECBAgQIAAAQLbBASsbX7uHkRAxBpk0I5JoKKAeFUR36MJECBAgAABAgTCCwhY4Udkg1EERKwok7APAv0JiFf9zdSJCBAgQIAAAQIE0goIWGk9rda5gIjV+YAdj0AFAfGqArpHEiBAgAABAgQINCcgYDU3MhuuLSBi1Z6A5xPoR0C86meWTkKAAAECBAgQIJBXQMDK62v1TgVErE4H61gECgqIVwWxPYoAAQIECBAgQKB5AQGr+RE6QC0BEauWvOcSaF9AvGp/hk5AgAABAgQIECBQVkDAKuvtaZ0JiFidDdRxCBQQEK8KIHsEAQIECBAgQIBAdwICVncjdaDSAiJWaXHPI9CugHjV7uzsnAABAgQIECBAoK6AgFXX39M7ERCxOhmkYxDIKHAdr35tt99948uv7F/K+BhLEyBAgAABAgQIEOhSQMDqcqwOVUNAxKqh7pkE2hAQr9qYk10SIECAAAECBAjEFRCw4s7GzhoUELEaHJotE8gsIF5lBrY8AQIECBAgQIDAEAIC1hBjdsiSAiJWSW3PIhBbQLyKPR+7I0CAAAECBAgQaEdAwGpnVnbakICI1dCwbJVAJgHxKhOsZQkQIECAAAECBIYUELCGHLtDlxAQsUooewaBmALiVcy52BUBAgQIECBAgEC7AgJWu7Oz8wYERKwGhmSLBBILiFeJQS1HgAABAgQIECBA4FpAwPIaEMgsIGJlBrY8gUAC4lWgYdgKAQIECBAgQIBAVwICVlfjdJioAiJW1MnYF4F0AuJVOksrESBAgAABAgQIELgvIGDdF/E3gUwCIlYmWMsSCCAgXgUYgi0QIECAAAECBAh0LSBgdT1eh4smIGJFm4j9ENguIF5tN7QCAQIECBAgQIAAgUsCAtYlId8TSCwgYiUGtRyBigLiVUV8jyZAgAABAgQIEBhKQMAaatwOG0VAxIoyCfsgsF5AvFpv504CBAgQIECAAAECSwUErKVirieQSEDESgRpGQIVBMSrCugeSYAAAQIECBAgMLSAgDX0+B2+toCIVXsCnk9guYB4tdzMHQQIECBAgAABAgS2CghYWwXdT2CjgIi1EdDtBAoKiFcFsT2KAAECBAgQIECAwGMCAtZjGH4lUEtAxKol77kE5guIV/OtXEmAAAECBAgQIEAgtYCAlVrUegRWCohYK+HcRqCAgHhVANkjCBAgQIAAAQIECJwRELDO4PiKQGkBEau0uOcRuCwgXl02cgUBAgQIECBAgACB3AICVm5h6xNYKCBiLQRzOYGMAuJVRlxLEyBAgAABAgQIEFggIGAtwHIpgVICIlYpac8hcFpAvDpt4xsCBAgQIECAAAECpQUErNLinkdgpoCINRPKZQQyCIhXGVAtSYAAAQIECBAgQGCDgIC1Ac+tBHILiFi5ha1P4EkB8epJE58QIECAAAECBAgQqC0gYNWegOcTuCAgYl0A8jWBhALiVUJMSxEgQIAAAQIECBBIKCBgJcS0FIFcAiJWLlnrErgVEK9uLfxGgAABAgQIECBAIJqAgBVtIvZD4ISAiHUCxscEEgiIVwkQLUGAAAECBAgQIEAgo4CAlRHX0gRSC4hYqUWtR2C3E6+8BQQIECBAgAABAgTiCwhY8WdkhwTuCIhYdzj8QWCTgHi1ic/NBAgQIECAAAECBIoJCFjFqD2IQDoBESudpZXGFRCvxp29kxMgQIAAAQIECLQnIGC1NzM7JvBQQMTyIhBYLyBerbdzJwECBAgQIECAAIEaAgJWDXXPJJBIQMRKBGmZoQTEq6HG7bAECBAgQIAAAQKdCAhYnQzSMcYVELHGnb2TLxcQr5abuYMAAQIECBAgQIBABAEBK8IU7IHARgERayOg24cQEK+GGLNDEiBAgAABAgQIdCogYHU6WMcaT0DEGm/mTjxfQLyab+VKAgQIECBAgAABAhEFHkTclD0RILBc4OXP7P/v/undu6/v/JXld7uDQL8Cj+LVe15+Zf9Sv6d0MgIECBAgQIAAAQJ9C/gXWH3P1+kGFPAvsQYcuiOfFHgsXn3i5EW+IECAAAECBAgQIEAgvICAFX5ENkhguYCItdzMHf0JiFf9zdSJCBAgQIAAAQIExhUQsMadvZN3LiBidT5gxzsrIF6d5fElAQIECBAgQIAAgeYEBKzmRmbDBOYLiFjzrVzZj4B41c8snYQAAQIECBAgQIDAQUDAOkj4SaBTARGr08E61lEB8eooiw8JECBAgAABAgQINC8gYDU/QgcgcFlAxLps5Ir2BcSr9mfoBAQIECBAgAABAgROCQhYp2R8TqAzARGrs4E6zh0B8eoOhz8IECBAgAABAgQIdCcgYHU3UgcicFpAxDpt45t2BcSrdmdn5wQIECBAgAABAgTmCghYc6VcR6ATARGrk0E6xkMB8cqLQIAAAQIECBAgQGAMAQFrjDk7JYE7AiLWHQ5/NCogXjU6ONsmQIAAAQIECBAgsEJAwFqB5hYCPQiIWD1McdwziFfjzt7JCRAgQIAAAQIExhQQsMacu1MTeCggYnkRWhQQr1qcmj0TIECAAAECBAgQ2CbwYNvt7iZAoGWBlz+z/7/7p3fvvj7Dyy2fw97HERCvxpm1kxIgQIAAAQIECBB4XMC/wHpcw+8EBhV49C+xfuz6+G8ZlMCxGxAQrxoYki0SIECAAAECBAgQyCQgYGWCtSyB1gRErNYmNtZ+xaux5u20BAgQIECAAAECBO4LCFj3RfxNYGCBr3jT1Z949Qu7j10T+JdYA78H0Y4uXkWbiP0QIECAAAECBAgQKC8gYJU390QCoQVErNDjGW5z4tVwI3dgAgQIECBAgAABAkcFBKyjLD4kMLaAiDX2/KOcXryKMgn7IECAAAECBAgQIFBfQMCqPwM7IBBSQMQKOZZhNiVeDTNqByVAgAABAgQIECAwS0DAmsXkIgJjCohYY8699qnFq9oT8HwCBAgQIECAAAEC8QQErHgzsSMCoQRErFDj6H4z4lX3I3ZAAgQIECBAgAABAqsEBKxVbG4iMJaAiDXWvGudVryqJe+5BAgQIECAAAECBOILCFjxZ2SHBEIIiFghxtDtJsSrbkfrYAQIECBAgAABAgSSCAhYSRgtQmAMARFrjDmXPqV4VVrc8wgQIECAAAECBAi0JyBgtTczOyZQVUDEqsrf3cPFq+5G6kAECBAgQIAAAQIEsggIWFlYLUqgbwERq+/5ljqdeFVK2nMIECBAgAABAgQItC8gYLU/QycgUEVAxKrC3s1DxatuRukgBAgQIECAAAECBIoICFhFmD2EQJ8CIlafc819KvEqt7D1CRAgQIAAAQIECPQnIGD1N1MnIlBUQMQqyt38w8Sr5kfoAAQIECBAgAABAgSqCAhYVdg9lEBfAiJWX/PMdRrxKpesdQkQIECAAAECBAj0LyBg9T9jJyRQREDEKsLc7EPEq2ZHZ+MECBAgQIAAAQIEQggIWCHGYBME+hAQsfqYY+pTiFepRa1HgAABAgQIECBAYDwBAWu8mTsxgawCIlZW3uYWF6+aG5kNEyBAgAABAgQIEAgpIGCFHItNEWhbQMRqe36pdi9epZK0DgECBAgQIECAAAECApZ3gACBLAIiVhbWZhYVr5oZlY0SIECAAAECBAgQaEJAwGpiTDZJoE0BEavNuW3dtXi1VdD9BAgQIECAAAECBAjcFxCw7ov4mwCBpAIiVlLO8IuJV+FHZIMECBAgQIAAAQIEmhQQsJocm00TaEtAxGprXmt3K16tlXMfAQIECBAgQIAAAQKXBASsS0K+J0AgiYCIlYQx7CLiVdjR2BgBAgQIECBAgACBLgQErC7G6BAE2hAQsdqY09JdildLxVxPgAABAgQIECBAgMBSAQFrqZjrCRDYJCBibeILd7N4FW4kNkSAAAECBAgQIECgSwEBq8uxOhSB2AIiVuz5zN2deDVXynUECBAgQIAAAQIECGwVELC2CrqfAIFVAiLWKrYwN4lXYUZhIwQIECBAgAABAgSGEBCwhhizQxKIKSBixZzLpV2JV5eEfE+AAAECBAgQIECAQGoBASu1qPUIEFgkIGIt4qp/8X73qeuA9Y0vv7L/RP3N2AEBAgQIECBAgAABAqMICFijTNo5CQQWELECD+fxrYlXj2v4nQABAgQIECBAgACBggICVkFsjyJA4LSAiHXaJsQ34lWIMdgEAQIECBAgQIAAgVEFBKxRJ+/cBAIKiFgBhzJtSbwKOhjbIkCAAAECBAgQIDCOgIA1zqydlEATAiJWsDGJV8EGYjsECBAgQIAAAQIExhQQsMacu1MTCC0gYgUZj3gVZBC2QYAAAQIECBAgQICAgOUdIEAgpICIVXks4lXlAXg8AQIECBAgQIAAAQKPCwhYj2v4nQCBUAIiVqVxiFeV4D2WAAECBAgQIECAAIFTAgLWKRmfEyAQQkDEKjwG8aowuMcRIECAAAECBAgQIDBH4MGci1xDgACBWgKf/Mz+lx88vXv39fNfrrWHYZ57Ha9ev9+95+VX9p8Y5swOSoAAAQIECBAgQIBAEwL+BVYTY7JJAgT8S6zM78CjePVLr+x/IfOTLE+AAAECBAgQIECAAIHFAgLWYjI3ECBQS0DEyiQvXmWCtSwBAgQIECBAgAABAqkEBKxUktYhQKCIgIiVmFm8SgxqOQIECBAgQIAAAQIEcggIWDlUrUmAQFYBESsRr3iVCNIyBAgQIECAAAECBAjkFhCwcgtbnwCBLAIi1kZW8WojoNsJECBAgAABAgQIECgpIGCV1PYsAgSSCohYKznFq5VwbiNAgAABAgQIECBAoJaAgFVL3nMJEEgiIGItZBSvFoK5nAABAgQIECBAgACBCAICVoQp2AMBApsERKyZfOLVTCiXESBAgAABAgQIECAQTUDAijYR+yFAYJWAiHWBTby6AORrAgQIECBAgAABAgQiCwhYkadjbwQILBIQsU5wiVcnYHxMgAABAgQIECBAgEArAgJWK5OyTwIEZgmIWPeYxKt7IP4kQIAAAQIECBAgQKBFAQGrxanZMwECZwVErEc84tXZ98SXBAgQIECAAAECBAi0IyBgtTMrOyVAYIHA8BFLvFrwtriUAAECBAgQIECAAIHoAgJW9AnZHwECqwWGjVji1ep3xo0ECBAgQIAAAQIECMQUELBizsWuCBBIJDBcxBKvEr05liFAgAABAgQIECBAIJKAgBVpGvZCgEAWgWEilniV5f2xKAECBAgQIECAAAEC9QUErPozsAMCBAoIdB+xxKsCb5FHECBAgAABAgQIECBQS0DAqiXvuQQIFBfoNmKJV8XfJQ8kQIAAAQIECBAgQKCsgIBV1tvTCBCoLNBdxBKvKr9RHk+AAAECBAgQIECAQAkBAauEsmcQIBBKoJuIJV6Feq9shgABAgQIECBAgACBfAICVj5bKxMgEFig+YglXgV+u2yNAAECBAgQIECAAIHUAgJWalHrESDQjECzEUu8auYds1ECBAgQIECAAAECBNIICFhpHK1CgECjAs1FLPGq0TfNtgkQIECAAAECBAgQ2CIgYG3Rcy8BAl0ITBHr6gu7H7va7b4i9IHEq9DjsTkCBAgQIECAAAECBPIJPMi3tJUJECDQhsAnP7P/5Qev3737uuh/MuyOxauwo7ExAgQIECBAgAABAgTyC/gXWPmNPYEAgUYE3volV2999fO7j4X7l1jiVSNvkG0SIECAAAECBAgQIJBLQMDKJWtdAgSaFAgXscSrJt8jmyZAgAABAgQIECBAIK2AgJXW02oECHQgECZiiVcdvE2OQIAAAQIECBAgQIBACgEBK4WiNQgQ6E6gesQSr7p7pxyIAAECBAgQIECAAIH1AgLWejt3EiDQuUC1iCVedf5mOR4BAgQIECBAgAABAksFBKylYq4nQGAogeIRS7wa6v1yWAIECBAgQIAAAQIE5gkIWPOcXEWAwMACxSKWeDXwW+boBAgQIECAAAECBAicExCwzun4jgABAo8Eskcs8cq7RoAAAQIECBAgQIAAgZMCAtZJGl8QIEDgrkC2iCVe3YX2FwECBAgQIECAAAECBO4JCFj3QPxJgACBcwLJI5Z4dY7bdwQIECBAgAABAgQIEHgoIGB5EQgQILBQIFnEEq8WyrucAAECBAgQIECAAIFRBQSsUSfv3AQIbBLYHLHEq03+biZAgAABAgQIECBAYCwBAWuseTstAQIJBVZHLPEq4RQsRYAAAQIECBAgQIDACAIC1ghTdkYCBLIJLI5Y4lW2WViYAAECBAgQIECAAIF+BQSsfmfrZAQIFBKYHbHEq0IT8RgCBAgQIECAAAECBHoTELB6m6jzECBQReBixBKvqszFQwkQIECAAAECBAgQ6ENAwOpjjk5BgEAAgZMRS7wKMB1bIECAAAECBAgQIECgZQEBq+Xp2TsBAuEEnohY4lW4GdkQAQIECBAgQIAAAQIECBAgQGB4gSliveX3X/2/L3/26te+8o1XXzM8CAACBAgQIECAAAECBAgQIECAAIF4AlPEEq/izcWOCBAgQIAAAQIECBAgQIAAAQIECBAgQIAAAQIECBAgQIAAAQIECBAgQIAAAQIECBAgQIAAAQJjCfx/6JJc9YV91YIAAAAASUVORK5CYII=\"></image>\n            </g>\n        </g>\n    </g>\n</svg>"
 var _Assetsdd23384c8fb75cba24325da13db5e88f27edd970 = "{{template \"header\" \"Fault Index\"}}\n{{template \"menu\" .}}\n<div class=\".aligner-item\">\n    {{template \"table\" .}}\n</div>\n{{template \"footer\"}}\n"
 var _Assets5505a97055e70f2214132a49de39b49ea42721ef = "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x02\x00\x00\x00\x02\x00\b\x06\x00\x00\x00\xf4x\xd4\xfa\x00\x00 \x00IDATx\x9c\xed\x9dy\x94\x1ceٷ\xef\xc1]Q|U\x14\xdcP\x16C'd\xa7\x93\xccdB\b\x84\xa0\xa0`\x14\x905l\xb2\x88l\xa2\xa2\xaf\xbc\n\n*(*ۄ}O\b!d\x9f\xee\xec\xfb2[W\xf5\x84LE\x10\x97O\xc5\r\x95=\x132\xe9\x9e\xdf\xf7GgB\x92\xd9zy\xaa\xee\xaaz~\xd79\xd79\xfe#g\xd2]\xfd\xdcWwU=%B\b\xb1\x0e@\xf6\x81#\xcf\xc0\x956<%o\xd3\xfe{\b!\x84\x10\xe23p\xe4\xa7p\x05{\x98\x95_h\xff]\x84\x10B\b\xf1\x01dd\x12\x1c\xc9u\x1b\xfeo\x99GVN\xd0\xfe;\t!\x84\x10b\x00x\xb2/\\i\xefc\xf0\xef\xed6x\xb2\xaf\xf6\xdfM\b!\x84\x902\xc0\r\xb2\x0f\x1cYQ\xc2\xe0\xdfӌ\xac\ad\x1f\xed\u007f\a!\x84\x10B\x8a\x04\xae|\xbb\xec\xc1\xdf\xdd\xefi\xff{\b!\x84\x10\xd2\ah\x91\xe1pe\xbb\xc1\xe1_Б\x1d\xc8JR\xfb\xdfG\b!\x84\x90\xdd\x00d\x9f\x12\xcf\xf3\x97k;\xc0\xdb\x06\t!\x84\x10u\xe0ʌ\x00\x06\xff\u07bf\b\xcc\x06\xa4J\xfb\xdfN\b!\x84X\a2\xf2\xf5\xc0\a\u007f\xf7\v\x05/\xd5~\x1d\b!\x84\x10+@\x8b\x1c\fW\xb6\xa9\x0f\xff\xb7\xdc\x0eG\x0e\xd5~]\b!\x84\x90X\xb2s\xfb\xde\x17C0\xf0{;-\xf0on+L\b!\x84\x18\x04\x8eܥ>\xe0\x8b5+\xf7j\xbf^\x84\x10BH\xa4AV\xbe\fW\xf2\xeaC\xbd<O\xd1~\xfd\b!\x84\x90H\x81M\xf2Q\x04s[\x9f\xbf:\xb2\r\x9b\xe5cگ'!\x84\x10\x12jp\x83샬\xfcA}p\x9b6+\u007f\xe4\xf5\x01\x84\x10BH\x0f\xc0\x95\x1f\xab\x0fj\xff\xfd\xb9\xf6\xebL\b!\x84\x84\x02\xb4\xc8\xd1p\xa5#\x04\xc39\x18\v\x8f$\x9e\xa8\xfd\xba\x13B\b!*\xc0\x93}\xe1\xc8V\xa5!\f4\x8e\x04\x1a\xaa\v\xff[\xe7oh\xe7c\x87\t!\x84X\x03 Upd\x83\xda7\xf0\x96\x8f\x02\x8b\xd7\x00i\xaf\xe0\xe2u@\xcb\x01\x9a\xbf\bdp\x03\x1f;L\b!$\xc6\xc0\x95+\xf4\x06\xedہ\x15\xbfyk\xf0\xef\xed\x8a;\x00\xe7\x9dz!\xd0*\xdf\xd2~\u007f\b!\x84\x10\xa3\xc0\x95\xa1p\xe5M\xb5\xe1\xbanJ\xef\x83\u007fo\xd7^\xa8\xf9k@\aZe\xb8\xf6\xfbE\b!\x84TD\x80\x8f\xe9\xed\xd9\xe6O\x15?\xf8\xf7\xb6\xf9 \xbd\x10\xc8J;o\x1b$\x84\x10\x12I\x90\x95\xb9j\x034\xf3~`\xc9\xe2\xf2\x87\xff\xae\xeb\x03\x96\x02\x99\xfd4C \xa5\xfd>\x12B\b!E\x01W\xa6\xa8\rLW\x80U7V>\xf8\xf7v\xe5\xcf\x01\xa7J\xef\xdf\xe4\xca\x05\xda\xef+!\x84\x10\xd2#\xd8$\x9f\x85\xd6\xcf\xfd\x8e\x00\x1bN6?\xf8\xf7v\xfd)\x9a\xb7\rnCF\x0e\xd1~\x9f\t!\x84\x10\x11\t\xc1cz\x9b?\x01\xa4\xb3\xfe\x0f\xff]\xb6\x16\xae-\xd0\xfa\xf7\xf2\xb1Ä\x10B\xb4\x81+S\xd5\x06a\xe6}\xc0ҧ\x03\x1c\xfc{\xb9tn\xe1Z\x03\xad\u007f\u007fV\xee\xd7~\xff\t!\x84X\x06\\9i疶:\xc3o\xf5\xf7\xf4\x06\xffޮ\xbeN/\x02\\\xc9Ñ\xafh\x1f\x0f\x84\x10Bb\x0e\x9e\x93\x8f\xc0\xd5ܾ\xb7Z\u007f\xe0\xf7f\xc3Qz\xd7\a\xb8ҎM\xf2Q\xed\xe3\x83\x10BH\xcc\x00\xa4\nY٢\xf6M\xb7\xe5\x00`Q\xa3\xfe\x90\xef\xcfEM@\xcb\xc7\xf5~\x11\xc8\xca\x1f\x01n+L\b!\xc4\x00p\xe4&\xb5\x81\xe6\xbc\x03X\xf6\xa8\xfe`/\xd5e\xd3\x00\xe7]z!\xe0\xc8/\xb4\x8f\x1bB\b!\x11\x05\xadR\v\xcd\xed{\xd7^\xa6?\xc8+u͕\x9a\x11Ё\x8cL\xd0>\x8e\b!\x84D\x04l\x92\xf7i=\xa6\xb7\xd3\x11\xech\x18\xa0?\xb8M\xdb8D\xf3\xfa\x80\xad\xf8\xa7\xbcO\xfb\xb8\"\x84\x10\x12b\xe0\xc8\x12\xado\xac\xffZy \x8e\x9a\xf87\f\x1d\x93\xc7ks~\xab?\xb4M\xbbx5\xd0\xf21\xcd_\x04V\x03R\xa5}\x8c\x11B\b\t\x11p\xe5{Z\x83)\x97\xd9\a\x97^\xb0\x10\x89$vy\xe4\xd8\x1c^y:\x86\x11\x90\xf6\x80\xe5\xf7\x16\x1eM\xac\x15\x02Y\xb9N\xfbx#\x84\x10\xa2\fZe\x10\x1c٦1\x88:\x1d\xc1\x93\xbf\xb9d\x8f\xc1\xbf\xbb\xc9\xda\x1c^\x9d\x1d\xd3\bH{\xc0\xba\xb34O\v\xbc\x89gd\xb0\xf6\xf1G\b!$`vn߫\xf6\x98\u07bf.\xf9l\xaf\x83\u007fw\x87\x8d\xc9\xe3\x8d8\x9e\x0e\xd8ݖO\xeb\xfd\x1a\xe0\xf0\xb1Ä\x10b\rpe\xba\xd6\xc0y}\xc3\xfb\xf1\xf9\x13\x9f+j\xf8w9\xbc&\x87?>\xfa;\xfdA\xed\xa7K\x16\xebn+\xec\xca,\xed\xe3\x92\x10B\x88O\xc0\x91K\xe0\xeam\xdf\xfb\x9doN/i\xf0\xef\xf1K@u\x1e\xcf=\xfc\xbc\xfe\xa0\xf6ە\xbfҋ\x80\xac\xe4\xe1\xc8\xe5\xda\xc7)!\x84\x10C\xc0\x95\x83\xa0\xb4}o\xa7#X|ߩe\x0f\xfe\xbd#\xe0_O>\xa7?\xa4\x83p\xfd\x17u\xb7\x15n\x92\xcfj\x1f\xb7\x84\x10B\xca\x04\x90}\xe0\xca_\xb4\xbeQ\xbe\xb0\xec \f\xad\xdefd\xf8w9tt\x0e/\xc5\xf5\xee\x80nf\x81\xe6Oj\xfe\"\xf0\"\xaf\x0f \x84\x90\x88\x01G\xee\xd4\x1a\x1c\xed\r\xef\xc1W\xbe\xe2\x18\x1d\xfc\xbb;\xa2&\x8f\xff\xcez6\x04\x03: \x97\xce\x012\xef\xd5\v\x01W\xee\xd1>\x9e\t!\x84\xf4\x03\\\xf9*\\\xe9\xd0\x1a\x167]{\x87o\x83\u007fwG\xd5\xe6\xf0J\x9co\x11\xec\xc9U?Ҍ\x80\x1dp\xe4k\xda\xc77!\x84\x90\xbd@F\xef1\xbd\x9d\x8e\xa0q\xc6с\f\xfe\xdd=rl\x0e\xed\xf3B0\x98\x83\xb6a\x9c\xee\xf5\x01\xae\xec\xaf}\xbc\x13B\b\x11\x118Ҭ\xf5\xcd\xf0ŕ\ab\xd4Q/\x05>\xfcw\xbf0\xf0\xf5\xb9[\xf4\x87r\xd0.j\xd4\xdeVx\x13\xb7\x15&\x84\x10%\xe0ʏ\xb5\x06@G\xf3;0\xe5\xacUj\x83\u007f\xef_\x02^\x98\x1e\xf3}\x02zs\xd9\xe3\x80\xf3N\xbd\x10p\xe5gڟ\x03B\b\xb1\x06\xb8r,\xb2:\x8f\xe9\xedt\x04\xb7_\xff\x13\xf5\xa1\xdfS\x04\xfc\xfe\x11\v\xf6\t\xe8\xcd\xd5\xdfҌ\x80\xed\xc8\xc8$\xed\xcf\x05!\x84\xc4\x16d\xe4\xbdP<\xcf\xff܂\xc1ꃾ/\x87\x8e\xc9\xe3%\x9b\xee\x0e\xe8\xc9Ƅ\xde\xf5\x01YيM|\xec0!\x84\x18\x05\xae\xa4\xb4\xbe\xe1\xe5\x9b?\x84ϟ\xf0\x17\xf5\x01_\x8cG\x8c\xce\xe3\xff=f\xe9\xe9\x80.\x17\xaf\x06Z>\xa2\xf9\x8b\xc02\xed\xcf\v!\x84D\x1e\xb4ȷ\x90\x95\xbc\xd6b\xfe\xe6\x92;0i\xd2v\xf5\xc1^\x8a#\xabs\xf8\xcb4\xcb# \xed\x01+\xee\x02\x9c}\xf4B\xc0\x91\xefj\u007f~\b!$r\xa0Y\x92Z\x8f\xe9\x85#\xc0\xba)\xe8X\xe8\xe1\xe4\x13\xcc\xee\xe4\x17\x94G\x8e\xb5p\x9f\x80\xde\\{\xa1\xeec\x87\x1d\x19\xa3\xfdy\"\x84\x90У\xfd\x98^\xb4|\x1cH{\xc8\xd5{\x18{\xd4\x0e\xf5A^\x89#j\xf2xs~\b\x06pX\xd4\xdcVؑv@\xf6\xd1\xfe|\x11BH(\x81#\x8f\xa9-Й\xf7\x02KR@\xdaC\xc7\x02\x0f\x93OlW\x1f\xe0&\x1c^\x93\xc7\xebs,\xdc'\xa07\x97,\x022\xfbꅀ+3\xb4?g\x84\x10\x12\x1a\xe0\xc8\xf9pe\x87ڢ\xbc\xf2\xa7\xbb\x06\xc4\xf6\xf9[p\xcaI\xf1\x18\xfe]\x8e\xaa\xb5\xe9\x01BE\xba\xf2\x16\xcd\b\xc8\xc1\x91\x8b\xb4?w\x84\x10\xa2\x066\xc9\x00(\xdd\xd6\aG\x80\r'\xed1\x14\xda\xe7m\xc1\xb1\x13\xa3u\xc1_\xb1\x1e96\x87\u007f\xda\xf2(\xe1R\xdc\xf0U\xedm\x85\aj\u007f\x0e\t!$0\x00\xa9\x82+\xbfW\xfb\x06\xd6r\x00\x90v\xf7\x18\x04\xdb\xe6m\xc1\x91cs\xea\x83\xdaOGT\xe7\xf0\xaf'-\xdf'\xa0G\xddµ\x1fZ\xc7cV^\xc0\r\xbc>\x80\x10\x12s\x90\x95_\xa8-\xb4\xce;\x81\xa53\xba\r\x807\xe6n\xc1\x98\x88_\xf0W\xacC\xc6\xe4\xf1\xdb\a\u007f\x1f\x82\xa1\x1bB\x97>\x05dޣ\x17\x02\x8eܦ\xfd\xf9$\x84\x10\xe3 +_\x86+\xdb\xd5\x16\xd7\xd5\xdf\xefq\xd1o\x9f\xb7\x05GM\xe8P\x1f\xccA:\xac:\x8fg\x1fb\x04\xf4\xea\xea\xffӋ\x80£\xacO\xd1\xfe\xbc\x12BH\xc5\xc0\x95\x8fC\xf3<\u007fCM\xaf\v\xfd\xb6\xf9[0\xfe\x18\xbb\x86\u007f\x97#jr\xf8\xf7S<\x1dЧ\r\xb5z\xd7\a8ҎM\xf2I\xed\xcf/!\x84\x94\x05\\٠\xf6M\xaa\xe5#\xc0\xa2\x8d\xbd.\xee\x1d\v=\x1c\x17\xd3\v\xfeJ\x89\x00+\x1f%\\\x8a\x8b\x1a\x80\x96\x8f\xea\xfd\"\xe0HF\xfbsL\b!E\x83\x8c\xfcHm\xc1t\x05X~\u007f\x9f\x8bz\xc7B\x0f\xa7\x9d\xbcU}\x00\x87\xc1#\xc7\xe6\xf0*w\f\xec\xdf\xe5\x0f\xe9n+\xec\xcaO\xb4?ׄ\x10\xd2+h\x95\t\xaa\xdb\xf7\xae\xb9\xac߅\x9cÿ\xbb\xa3\xc6\xe5\xf0\xda\x1cF@Q\xae\xb9Jw[\xe1\xac\x1c\xa7\xfd9'\x84\x90] +\x1fDV\xf1<\u007fӐ\xa2\x16\xefm\xf3\xb7X\xff\xb3\u007fo\x8e\x1c\x9b\xc3\xd6y<\x1dP\xb4\x8d\xc3u\xf7\x0f\xf0\xe4Cڟ{B\x88\xe5\xc0\x95YJ\x8b \x90\xd9\x0fX\xbc\xbc\xa8\x05\xbb}\x9e\xbd\x17\xfc\x15눚<\xfe\xc1͂\x8aw\xf1J\xa0\xe5CZ\x11\x00\xb82_\xfb\xf3O\b\xb1\x10\xb8r%\\ɩ-~+n+z\xa1~c\xae}\xb7\xfa\x95\xebȚ\x1c~\xcb[\x04Ksŝz\x11PxT\xf65\xda\xeb\x01!\xc4\x02\x90\x95aм\xado\xfd\xd7JZ\x9c\xb7\xcd߂\xea\xf1vl\xf2c\xcaac\xf2x\xeeaF@ɮ;K\xf7\xb4\xc032R{} \x84\xc4\x10\xfcI\xde\rG^Q\xfb\xa6\xd3|H\xc9\vr\xbb\x05\xdb\xfb\xfa\xe5\xe0\xd1y\xbc4\x8b\x17\x06\x96e\xd3az\xbf\b8\xb2\r\u007f\x95\xf7h\xaf\x17\x84\x90\x98\x00W\xee\xd1[\xd0\xde\x05,\x9d_\xf2\"\xbc}~|\x1f\xec\x13\x94ës\xf8\xc7\f^\x13P\x96K\xeau\xb7\x15\xce\xcaC\xda\xeb\x06!$\xc2\xc0\x95)\xd0ܾw\xd5Me-\xbe\x1d\v=\x9c\x1a\xb3G\xfajy\xe4X>J\xb8\"W\xfe\\/\x02\n\xdb\n_\xa0\xbd\x8e\x10B\"\x04\x1c9\x14\x9a\xe7\xf97N*{\xc1\xcd\xd5{\x98|\"\x87\xbfI\x93\xb59\xbc1\x87\xb7\bV\xe4\x86\x13u\xaf\x0fh\x95\xcfi\xaf+\x84\x90\x90\x03G\xfb1\xbd\xe5/\xb2;\x16z\xa8\xb5\xe4\xa9~A;\xac:\x8f7\xe7+\fθ٬\xf8\xd8aW\xfe\nH\x95\xf6\x1aC\b\t\x19\xc8\xc8\xcdj\v\x93\xb3\x0f\xb0쉊\x16\xd67\xe7o\xc1\xc9'nS\x1f\x94qv\xe4\xd8\x1c^\xe6\xe9\x80\xca]6\x13pޮ\x19\x02\xbf\xd2^o\b!!\x00-r\x02\\ySm1ZsM\xc5\vj\xfb\xbc-8\xfe\xf87\xd5\a\xa4\r\x1eY\x9b\xc3_\xa6\xfdN\u007f\x88\xc6\xc1\xd5\xd7jF\xc0v\xb8r\x92\xf6\xfaC\bQ\x00\x199\x10\xaa\xe7\xf9\xab\x8d,\xa2\xdb\xe6oA5\u007f\xf6\x0f\xd4\x11\xd59\xbc0\x9dw\a\x18s\xa3\xe2c\x87\v\xd7\a|B{=\"\x84\x04\x04\\٨\xf6\xcd#\xf3?@\xda1\xb2p\xb6\xcfۂ\x11\xbc\xcf_\xc5!\xa3\xf3\xf8\x17\xb7\r6\xa8Sx\x84\xb5\xd6\xe7\xd2\x11G{]\"\x84\xf8\b\x1c\xb9\x0e\x9a\xdb\xf7.\xbf\xcf\u0602\xd9>\x8f\xdb\xfbj;tL\x1e\xbf{\xf8\xf9\x10\f\xcf\x18\xb9\xfcA\xbd\b\xc8J\x1e\x8eܠ\xbdN\x11B\f\x82\xac\x8c\x85+\xedJ\xdf,\x80u_\a\xd2m\xc6\x16\xc9\xed\v<|\x81\xe7\xfcC\xe1ȱ9\xfc\xed\t\xfe\x12`ܵ\x97j\x9e\x16\xd8\x06W\xc6k\xaf[\x84\x90\n@V>\bG^V\xfbF\xd1X\xdcczK\xb1c\xa1\x87\xe3\x8e\xe3\xf0\x0f\x93#k\xf8(a\xdfl\x1c\xa6\x17\x02\x8e\xb4c#\x1f;LH\xe4\x80+\v\xd4\x06\u007f\xe6\xbd\xc0\xa2\xf5\xc6\x17\xc3\x1d\v=L\x9a\xc8\xe1\x1fF\x87U\xe7\xb1u.#\xc0\x17\x17m\x002\xef\xd7\xfa5\x00pe\xb1\xf6zF\b)\x02\xb8r)\n[\x80\xea,\x16+\u007f\xe9\xcb\"ر\x80\xdb\xfb\x86\xddQ\xe3rxu6\xf7\t\xf0͕\xbfь\x80\x1dp\xe4r\xed\xf5\x8d\x10\xd2\x03pe(\x1c\xc5\xf3\xfc\xebO\xf1m\xe1۾\xc0\xe3&?\x111Y\x9b\xc3+\xdc,\xc8_ן\xa1{\xdb`FFh\xafw\x84\x10\x11\x81'\xefDV\xfe\xaa\xf6͠\xf9 _\x17\xbb\xed\v<\x8c\x19\xc7\xfb\xfc\xa3\xe4\xb0\xea<^\x9f\xc3\b\xf0\xdd\xe6\x835\u007f\x11x\x11\xab\xe4\xdd\xda\xeb\x1f!ւ\x8c<\xaa\xb6\x008\xef\x00\x96,\xf6u\x81k\x9f\xb7\x055\xdc\xe4'\x92\x0e\x1d\x93\xc7?f<\xab?$\xe3\xee⥀\xf3n\xcd\x10xB{\x1d$\xc4*\xd0*\xa7A\xf51\xbd?\xf2}ak\x9f\xb7\x05\xb5G\xf3>\xff(;\xbc:\x8f\xe7\xb8O@0\xae\xfa\x89^\x048\xd2\x01G\xce\xd2^\x17\t\x895xF\x0e\x86\xa3\xb9}\xefq\x81,f\xdb\x17l\xc18n\xf2\x13\v\x87U\xe7\xf9\xec\x80 \xdd\xf8y\xcd\xeb\x03\xb6\"+\x87i\xaf\x93\x84\xc4\x0e8\xb2E\xad\xf0[>\x12\xd8\x02\xb6c\xa1ǟ\xfdc\xe6\xb0\xea<\xde\xe0-\x82\xc1\xda\xf21\xcd_\x04\xfe\xa0\xbd^\x12\x12\v\xe0ʭp\xa5S\xedü\xf4\xa9\xc0\x16\xad\xed\v<L\xe2&?\xb1t\xf8\x98\x1c^\xe5\xdd\x01\xc1\xbatv\xe1Q\xdbZk\x87+\xb7k\xaf\x9f\x84D\x12\xb4\xca\xf1pd\x9bR\xc1\x03k\xae\x0et\xb1\xeaX\xe8\xe1\xab_\xe2}\xfeq6Y\xcb\bPq\xf5w4O\v\xbc\x89\xac\x9c\xa8\xbd\x9e\x12\x12\t\xb0I>\n\xcd\xc7\xf46&\x03_\xa0:\x16z\xf8\xe2\xe7y\x9f\xbf\r\x1eY\x9b\xe3\xe9\x00-\x1b\xc7\xe8\x85@V\xb6\u0093\x03\xb4\xd7WBB\v\\Y\xa3T\xe9@\xe6\x030\xf5\x98\xdeR\x87\xffx^\xedo\x95ê\xf3h\x9f\xdb\xf3\xf1@\xfd\xd6\x01Z\xfeG\xeb\xd7\x00\xc0\x91F\xedu\x96\x90P\x81\xac\xdc\x00\xd5\xc7\xf4>\xa4\xb2\x18\xbd9\u007f\v\x1f\xecc\xa9#j\xf2xi\x16\xf7\tPs٣z\x11\xe0J\x1eY\xb9I{\xdd%D\x15d\xa5\x06Z?\xf7\xbb\x02\xac\xbb@m\x01zs\xfe\x16L8f\xbb\xfa \xa2z&\xf9(a}\xd7^\xac\x19\x02\xed\xd8$\xe3\xb4\xd7aB\x02\x05\xcf\xca\xfb\xe1ʋj\x1f\xbc\xa6\x81\xca\vO\x1bv,\xf4\xd0>\xd7n\xb7.\xf9\xb9\xfaߠ\xed\xf6\xa9ˀ\x19\x1b\xf4\a\xa1\xed6\r\xd6\v\x01G^EF\xf6\xd3^\x97\t\xf1\x1d\xb8\xf2\x94\xde\a\xed\xdd\xc0\xe2\xd5\xea\xc3\x1f\xa96\xfd\x05O\xfb5h:T\xf5\x17\x98\xd0X\x97\x02\x06\x1d\vL[\xab\xff\xb7\xd8\xee\xe2\xb5@\xe6}z!\x90\x95\xb9\xda\xeb3!\xbe\x80\x8c\\\x05Gv\xa8}\xb8Vܡ\xbf\xc0p\xf8\x03\xe9\xcd@S\xa2\xf0\x9e0\x00\n\x010`<0h\"\xf0\xd8\x1a\xfd\xbf\x87\x02+\xa6\xeaE@\xe1\xb1\xc3\xdf\xd5^\xaf\t1\x026K\x02\x9a\xb7\xf5m8I\u007fAI{\x85\xc1o\xfd\xf0o\x03\x1ak\xdfz\u007f\x18\x00o\x05\xc0\x80\xf1\xc0\x90I\xc0SM\xfa\u007f\x13-\xb8~\xb2\xeec\x877\xc9\x11\xda\xeb7!e\x81\x8c\xbc\x03Y\xf9\xa3ZI7\u007fZ\u007f\x01\xe9\xd2\xfa\xc1\xef\x01\xa9\xcd@c\xf5\x9e\xef\x11\x03`\xcf\x00\xe8\xfa%`\x8e\xab\xffwѷl\xfe\xac\xe6/\x02\u007fCZޥ\xbd\x9e\x13R4\xc8ʽ\x8a\x1f\x18`I\xbd\xfe\xa2\xd1e\xaa\rH\x85\xe0\xefP}\r6\x03\x8d\xa3\xbb\xbfO\f\x80\xee\x010`|\u1680\xa7[\xf4\xff6\xfa\x96KҀ\xf36\xbd5͑\x87\xb5\xd7uB\xfa\x04\xaeL\x81+o\xaa}HV\xfdL\u007f\xa1\xd8]~\xf3\aқ\x81\x8d\x93z~\xbf\x18\x00=\a@\xd7逧\x9b\xf5\xff>\xba\xa7+o\x01\xdc*\xad\x10؎Mr\xa1\xf6:O\xc8\x1e\xc0\x95\x83t\x1f\xd3;A\u007fa\xe8&\xcf\xf9#\xdd\x06l8\xa1\xf7\xf7\x8e\x01\xd0{\x00\f\x18\x0f\f9\x9e\xbf\x04\x84Ս\xc7\xea>vx\x93|V{\xdd'D\xe0H\x8b҇\x00h\xf9\x90\xfeBЫ\xb6\x0f\u007f\x0fh\x1e\xde\xf7\xfb\xc7\x00\xe8;\x00v]\x13\x10\xfc\x16մH[\xf6\u05ca\x00\xc0\x91g\xb4\xd7\u007fb)p\xe4\x17p%\xafv\xf0/\x9b\xa9\xff\xe1\xefM\xeb\xbf\xf9o\x06\x9a\x86\xf4\xff\x1e2\x00\xfa\x0f\x80\x01\xe3\x81#\x8e\x03\x1e^\xa1\xff\xb7Ҟ]:K/\x02\\\xe9\x84#\xbfў\a\xc4\x12\xd0\"'\xe8=\xa6\xb7\nX\xf3\x1d\x84\xfa\xdb5\x87?\xd0txq\xef'\x03\xa0\xb8\x00\x180\x1e8b\"\xf0\xc0R\xfd\xbf\x97\xf6b\x1b\xb0\xfa\xfb\x855J'\x04\xdeD\x8b\x9c\xac=\x1fHL\x81+\xfbÑW\xd4J\xb7\xf1\xc8\x10|\xc8\xfbY\x00\xac\x1f\xfemom\xf2\xc3\x00(κTq\x01\xd0u:`\xc6F\xfd\xbf\x99\xf6mC\x0fw\xbc\x04\xf6%IڱY>\xa6=/H\x8c\x80#\x8b\xd5\x0e\xe8\xcc{\x80E!\xbf\x1a\xbak\x93\x1f\xab\x03\xa0\xad\xf0\x8c\x85R\xde[\x06@i\x010`<0\xf0Xn\x16\x14\t3@f_\xbd\x10\xc8\xcaJ\xed\xb9A\"\x0e\\\xf9!\\\xc5\xed{\x97?\x18\x82\x0fr?Z=\xf4\xbb^\x83\xcd@\xe3\x98\xd2\xdf_\x06@\xe9\x01\xd0uM\xc0\xccF\xfd\xbf\x9d\xf6\xef\xf2G\xf4\"\xc0\x91\x1c2\xf2\x13\xed9B\"\x06Z\xa5\x16\x8e\xb4+\x1d\xb4\xc0ڋ\xf4?\xb8\xc5h\xfd\xb7~\xaf0\xfc7\xf6q\xab\x1f\x03\xa0o\xebR\xa5\a\xc0\x80\xf1\xc0\xd0\xe3\xb9O@\x94\\\xf3\r\xbd\xdb\x06\x1dن\xac\x1c\xad=WH\xc8\xc1zy?\\\xf9\x93Z\xb16\x1d\xae\xffA-V\xdb\a\u007f\xdaC\xe1>\xff/\x96\xff~3\x00\xca\x0f\x80\xae͂\xe6e\xf5\xff\r\xb4x\x1b\a鬭\x05\xff\x86F\xf9\x80\xf6\x9c!!\x04\xae\xccP;0\x9d\xb7\x01\x8bW\xea\u007f8\x8b\xb6\r\xa1\xbe\x13!\xa8נ\xa7\xed}\x19\x00\xa5Y\x97*?\x00v\xed\x13\x90\xd1\xffw\xd0\xe2]\xbc\x1apީ\x19\x02\xb3\xb5\xe7\r\t\th\x95\x8b\xe1\xcav\xb5\x83qů\xf5?\x90\xa5j\xfd\xb7\xff\xcd@\xc31\x95\xbf\xf7\f\x80\xca\x03`\xc0x`\xf0\xf1\xc0\xe3k\xf5\xff-\xb44Wܮ\x19\x01\x1dp\xe4r\xed\xf9C\x94@V\x86\xc1U<Ͽ\xfek\xfa\x1f\xc0r\xe4\xf07w\x9b\x13\x03\xc0L\x00\f\x18\x0f\f\x9e\x04<\xb4\\\xff\xdfCKwݙ\xba\x8f\x1d~FFj\xcf#\x12\x10\xc8\xc8;\xe0\xc8f\xb5\xf2l9P\xff\x03W\xae\xd6\x0f\xff6\xa0\xe90s\xc7\x02\x03\xc0\\\x00\xec\xbaE\x90\x17\x06F\xd6\xe6OjE\x00\xe0\xcas\xc8\xc8;\xb4\xe7\x13\xf1\x11\xb8r\x0f\xb2\x8a\xdb\xf7.Y\xa0\xff!+ה\xed\xe7\xfd7\x03͟6{<0\x00\xcc\x06\xc0\x80\xf1@b\x02\xf0h\x94\xae\xa7\xa1{\xb8$\xa5\x19\x01\x9dp\xe5A\xed9E\f\x03GN\x83\xd6cz\x1d\x01V\xfd\x18\x91\x1d\x9e\xbc\xd5\x0fHo\x06\x1aG\x9a?6\x18\x00\xe6\x03`\xc0\xf8\xc2>\x01\x8f\xad\xd6\xff\xb7\xd1\xf2]y\x93\xe6\xb6\xc2\xdb\xe1\xc8Y\xdas\x8bT\b<9\x14\xae\xbc\xaaV\x94\x1b\xbe\xa0\xffA\xaaD\xeb\a\xff\xce\xd7`\xe3$\u007f\x8e\x0f\x06\x80?\x010`\xe7-\x82\xb3x: \xf2n\xf8\xa2\xee\xf5\x01\x199\\{\x8e\x912\x80#\x8dj\x83?\xb3\xaf\xfe\a\xa7b9\xfc\x91n\x03\x1a&\xfaw\x9c0\x00\xfc\v\x80\xae_\x02\xe6s\x9f\x80X\x98\xd9O+\x02\x00G\\\xedyF\x8a\x04\x8e\xdc\x04Grj\a˲\xe9\xfa\x1f\x96J\xe57\u007f \xdd\x06l\x1c\xef\xef\xb1\xc2\x00\xf07\x00\xba\"`6\xf7\t\x88\x85\xcbf\xe8E\x80+y8r\x8b\xf6|#\xbd\x80V9\x1e\xae\xd2cz]\x01\xd6|[\xff\x03bL\xdb\x03\xa0\r\xd8p\x92\xff\xc7\f\x03\xc0\xff\x00\x180\x1e\x18\xfay>@(N\xae\xfe\x1e\xe0\xaa]\x1f\xf0&Z\xe5\x8b\xda\xf3\x8e\xec\x04\x9e\x1c\x00G\xfe\xa16\xf8\x1bj\xf4?\x10&\xb5\xfeۿ\x8f\xe7\xfc\x19\x00ݭK\xf9\x1f\x00]\xd7\x04<\xb1A\xff\xdfK\xcd\xd90N+\x02\x00W\xfe\x8bV\xf9\x84\xf6\xfc\xb3\x1a\xb8\x92V;\x00\x9cw\x02\x8bb\xf6D2\xeb\xaf\xf8o\x03\x1aF\x04w\f1\x00\x82\v\x80\xae\xd3\x013\x18\x01\xb1rQ3\x90y\xaff\b,מ\x83ց\x8c|\a\xaet\xa8\xbd\xe9\xcb\xef\xd5?\xf0\x8dk\xf3\xe0\xf7\x80\xf43@\xd3\xc1\xc1^q\xcc\x00\b6\x00\x06\x8c\a\x06\x1e\x03ܿD\xff\xdfMͺ\xfc~\xcd\b\xd8\x01G~\xa0=\x17c\x0f\xb2R\x03\xcd\xed{\xd7^\xa8\u007f\xa0\xfb\xa1\xd5\xdf\xfa= \xbd\x19h\x1a\x10\xfc1\xc5\x00\b>\x00\x06\x8c/<@\xe8\x01F@,]{\x89\xeem\x83\xae\x1c\xa5='c\a\xd6\xc9\xff +\u007fP+\xbc\xc6\xc1\xfa\a\xb6/\xda\xfe\x93\xff\xceנ\xf1H\x9d\xe3\x8a\x01\xa0\x13\x00]\xa7\x03f6\xe8\xff\xfb\xa9?6\x0eӊ\x00\xc0\x95\xbfb\xa3|H{n\xc6\x02\xb8\xf2(\n[4꼙\x8b\x97\xea\x1f\xcc~h\xfd\xe0\xf7P8\xe7_\xab\xb7P0\x00\xf4\x02`\xc0x`\xf0q|\x94p\x9c]\xbc\xa2\xf0\xa8u\xbd\x10xB{~F\x16\xb8r!4\x1fӻ\xf2W\xfa\a\xb0oھ\xaf\xbf\a\xa46\x03\x1b?\xaf\xb980\x00Ҟn\x00\f\x18\x0f\f9\x1ex\xbaE\xffu\xa0\xfe\xb9\xf26\xa8\xdd6\xe8H\a\\\xb9T{\x9eF\x06\xb82\x10\x8e\xe6cz\xbf\xa2\u007f\xc0\xfa\xaa僿\xeb5X?Yw\xf83\x00\n֥t\x03`\xc0\xf8\xc2>\x01\xdc,(\xfe\xae?U\xef\xfa\x00G\xda\xe1\xc8\x10\xed\xf9\x1aZ\x90\x91\xf7\u0095g\xd4\x16\xe3\xe6\xcf\xe8\x1f\xa0\xbe\xcb\xe1_\xb8\xcf_\xf9\x9b?\x03\xe0-\xebR\xfa\x010`<0x\x120\xd7\xd5\u007f=\xa8\xff6\x1d\xaa\xf7\x99w\xe4Yl\x92\xf7i\xcf\xdb\xd0\x01W\x9eS{S\x96\xce\xd5?(\x03\xd1\xf6\x00P>\xe7\xcf\x00\xe8n]J\u007f\xf8\uf280\xe3\x80'\xd6\xeb\xbf&\xd4\u007f\x97\xcc\u05cc\x80\xbfk\xcf\xdb\xd0\x11x\x008U\xc0\xaa\xeba\xcdP\xb4\xfe\xa2\xbf\xcd@\x83\xd2\xd5\xfe\f\x80ޭK\xe9\x0f\xfe\xdd=\xe28\xee\x13`\x8dm\xc0\xaa\x1b\x83\u007f\xec0\x03\xa0;\x81\x06\xc0Ɖ!8\xf8\x02>\xd0\xd5\xff\x06M7\x03\x8d\n\xf7\xf93\x00\xfa\xb7.\xa5?\xf4\xf7vб\xc0\x03\xcb\xf4_\x1b\x1a\x9c\x1b\x02<-\xc8\x00\xe8N \x01\xd0\xf2a ݪ\u007f\xb0\x05\xa9\xf5\xdf\xfc\xdb\n;\xfci\x0f{\x06@\xcf֥\xf4\a~O\x0e<\x06\x98\xc5\a\b\xd9\xe53@\xcbG\x19\x00\x1a\xf8\x1e\x00˦\x85\xe0\x00\vX\xee\xed\x0f4\f\xd7\x1f\xf4\f\x80ޭK\xe9\x0f\xfb^\u007f\t\x98\bL\xe75\x01ֹ\xec\t\x06@\xd0\xf8\x12\x00\x8e\x00k.\xd7?\xa0\x82\xd6\ua87f\xdbk\x10\xd4S\xfd\x18\x00\xe5[\x97\xd2\x1f\xf4}9\xe4x>J\xd8V\xd7\\\xed\xcfm\x83\f\x80\xee\x18\x0f\x80\x861\xfa\a\x90\x8a\xdc\xe4\a\xa9\xcd\xc0\x86\x93\xf5\a<\x03\xa0\u007f\xebR\xfaC\xbe?\x87\x1e\xcf\x1d\x03mv\xe3X\x06\x80\xdf\x18\r\x80E\xb6~X-\x1f\xfc\xbb>\xb0\xe3\xf5\x87;\x03\xa08\xebR\xfa\x03\xbe\xa8\xd3\x01\xc7\x02\xf3-\xbb~\x88\xee\xa6kn[a\x06@w\x8c\x04\xc0\xf2{Bp\xa0(ɟ\xfdw\xfe\xec\u007f\x9c\xfe`g\x00\x14o]J\u007f\xb8\x17}:`\x12/\f\xb4\xdd\xe5\xf71\x00\xfc\xa0\xe2\x00H;\xfa\a\x87\xaa\xb6\a\xc0\xe6\xf0\x9f\xf3g\x00t\xb7.\xa5?\xd8K\x8a\x80\xe3\x81\xc7V\xeb\xbfnTQ\x97\x01`\x1a\x06@\x05Z\xff\xed_\xf1\x91\xbe\f\x80ʬK\xe9\x0f\xf5R=\xe28`\xda\x1a\xfd\u05ce*\xc9\x000\x0e\x03\xa0LS\xb6_\xf4\xd7\x064}F\xef!\x1f\f\x80ʬK\xe9\x0f\xf4rL\x1c\x03\xccب\xff\xfaQ\x05\x19\x00\xc6a\x00\x94\xaa\xed\xf7\xf8{\b\xed\x0e\u007f\f\x80\xe2\xadK\xe9\x0f\xf3r\x1dt,\xf0 w\f\xb4O\x06\x80q\x18\x00\xa5j\xfb\xf0o\x03\x1a\xaa\xf5\x878\x03\xa02\xebR\xfa\x83\xbc\x12\x87L\x02\xa6\xad\xd3\u007f\x1di\x802\x00\x8c\xc3\x00(V\xdb\u007f\xf2\xf7v^\xed?Q\u007f\x803\x00*\xb7.\xa5?\xc4+u\xf0q|\x94\xb0U2\x00\x8c\xc3\x00(\u0094\a\x0e\xff\xcd@cĿ\xf93\x00\u07b2.\xa5?\xc0M8h\"0ׂ5\x88\x82\x01\xe0\x03\f\x80~\xe4\xf0߹\xc3\xdfI\xfa\x83\x9b\x01`κ\x94\xfe\xf06\xe5\xd0で[\xf4_S\xea\xb3\f\x00\xe30\x00\xfa\x90\xc3\u007f\xe7\xf0\xff\x92\xfe\xd0f\x00\x98\xb5.\xa5?\xb8MG\x00\x9f\x1d\x10s\x19\x00\xc6a\x00\xf4&\xcf\xf9\x17.\xf83\xbc\x1fw\x18d\x00\xc4/\x00\x06\x8c\a\x8e\x98\b̉\xebzD\x19\x00>\xc0\x00\xe8E>\xd2\x17h\x1a\xa4?\xac\x19\x00\xfeX\x97\xd2\x1f\xd8~8\xf0X`:\xef\x0e\x88\xa7\f\x00\xe30\x00\xf6\xd6\xf6\xc1\xef\xa1p\x9f\xff\xc0hn\xf2\xc3\x00(κ\x94\xfe\xb0\xf6\xf3\x97\x80\a\x96\xea\xbf\xc6\u0530\f\x00\xe30\x00v\x97?\xfb\xef\x1a\xfe\xdaC\x9a\x01\xe0\xafu)\xfdA\xedw\x04\xf0\xd9\x011\x93\x01`\x1c\x06\xc0n.\n\xc1ߠm\xc30\xfd\x01\xcd\x00\xf0ߺ\x94\xfe\x90\x0e\"\x02f\xdb\xfa\x88\xf28\xca\x000\x0e\x03\xc0\xe3\xd5\xfei\xafp\xda#\x8a\x0f\xf6a\x00\x94g]J\u007f@\a\x15\x01\xb3\x9a\xf5_oj@\x06\x80q\xac\x0f\x00\x0e\xffx\xde\xea\xc7\x00\xe8ۺ\x94\xfep\x0eʡ\xc73\x02b!\x03\xc08V\a\x80\xf5O\xf4\xf3\n\xc3\u007f\xe3\x17\xf4\x872\x03 X\xebR\xfa\x839H\x87L\xe2-\x82\x91\x97\x01`\x1ck\x03 \xb5S\xed\xbfC\xf55\xd8\x1c\xcf\xfb\xfc\x19\x00\xfd[\x97\xd2\x1f\xcaA\xcb}\x02\".\x03\xc08\xf6\x06\x80\xed\xdf\xfcۀ\x86Z\xfda\xcc\x00б.\xa5?\x905\x1c|\x1cw\f\x8c\xac\f\x00\xe3X\x19\x00\xb6\x0f\xfft\x1b\xb0q\xbc\xfe f\x00\xe8Y\x97\xd2\x1f\xc6Z\x0e9\x1e\x98\xb6V\xff=\xa0%\xca\x000\x8eU\x01\x90j\xe3\xcf\xfe\xe96\xa01\xa6;\xfc1\x00\x8a\xb7.\xa5?\x885=b\"0c\x83\xfe\xfb@K\x90\x01`\x1c\xab\x02ࡕ\xc0\x91'\x03#m\xf6\x8b\xc0\x91\x93\xacv\xcdQ7\xe3\xe8\xa3;\xac\xf6\x98\xa3\xb6\xe1\xa4\xea\x17\xad6\xfb\x85\xfb\xf0\xcf'\x9f\xd3_\x97h\x912\x00\x8ccU\x00\xa4=\xe0\xe7ӀÏ\xd6\xff\x06B\xd5\\6\xfc&$\x92\xa0\xd6ډ\xfa\x9a{\xb0u\xd8)\x18^\x9d\xc3ߟ`\x04DC\x06\x80q\xac\v\x80\xb4\a\xdc\xf4(#\xc0b\x19\x00v[_s\x0f0`<\xb6\x0e;\x05\x89$0\xaav\a^\x9d\xfd[\xfdu\x89\xf6#\x03\xc08V\x06@\xda\x03~>]}\x10Q\x06\x00\r\xd2N,\xab\xbd{\xd7q\xd0\x15\x00\x89$0\xbc&\x8fms=\xfdu\x89\xf6!\x03\xc08\xd6\x06@\xda\x03n\x9b\xa3>\x8c(\x03\x80\x06\xe3ʣ\xa6\xa2s\xb7\xe3`\xf7\x00H$\x81a\xd5y\xbc1w\x8b\xfe\xbaD{\x91\x01`\x1c\xab\x03 \xed\x01\xbf\x99\xad>\x90(\x03\x80\xfak\xfd\xd8{\xbb\x1d\a{\a@\"\t\x8c\x1a\x97\xc3k\xb3\x19\x01\xe1\x94\x01`\x1c\xeb\x03 \xed\x01\xb7\xceT\x1fJ\x94\x01@\xfdq\xf9\xb8\xbb{<\x0ez\n\x80D\x12\x18=.\x87\xd7\xe70\x02\xc2'\x03\xc08\f\x80\x9d\u07b7X}0Q\x06\x005k\xebqw\xf4z\x1c\xf4\x16\x00\x89$0\xbc:\x87\xf6y\x9e\xfe\xbaDw\x93\x01`\x1c\x06\xc0nޓV\x1fN\x94\x01@\u0378\xb4v\xcfs\xfe\xa5\x04@\"\t\x8c\xa8\xc9\xe1Ϗ\xffN\u007f]\xa2;e\x00\x18\x87\x01\xb0\x97\xbfz\x8a\xb7\b\xc6\\\x06@\xfc]X}O\xbf\xc7A\u007f\x01\x90H\x16~\t\xf8\xfd\xa3\xcf\xeb\xafK\x14\f\x00\x1f`\x00\xf4\xe0-O\x00\x03\x18\x01q\x95\x01\x10g;\xb1\xb4vjQ\xc7A1\x01\xd0\x15\x01\xff\x9d\xf5\xac\xfe\xbad\xbd\f\x00\xe30\x00z\x91\xfb\x04\xc4V\x06@|M\xd5\xdc\xdd\xe7\xcf\xfe\xe5\x04@\"\t\f\x1b\x93\xc3+O\xf3\xc2@]\x19\x00\xc6a\x00\xf4b\xca\x03~\xf2\bp\xf8\x04\xf5\x81E\x19\x00\xb4\u007f\x17Tw\xbf\xd5\xcfT\x00$\x92\xc0ȱ9\xbc\xf24w\fԓ\x01`\x1c\x06@?\xde\xfc\x04\xaf\t\x88\x99\f\x80\xf89\xbf\xc4\xe1_N\x00$\x92\xc0\xe8q;x\x8b\xa0\x9a\f\x00\xe30\x00\x8a\xf0\xd6Y\xeaC\x8b2\x00hOvb\xdd\xd1ue\x1d\a\xe5\x04@\"Y\xd8,\xe8M\xde\"\xa8 \x03\xc08\f\x80\"\xbdo\x89\xfa\xe0\xa2\f\x00\xba\xa7\uec77\x15}\xce\xdfT\x00$\x92\x85g\al\xe5\xb6\xc1\x01\xcb\x000\x0e\x03\xa0\x04\xeb\x16\xaa\x0f/\xca\x00\xa0\x05\x17\xd5\xf6\xbc\xc3_\x10\x01\x90H\x02\xa3j\xf9(\xe1`e\x00\x18\x87\x01P\xa2\xb7\xf1\xd9\x01Q\x97\x01\x10}Sc+\x1b\xfe&\x02 \x91\x04\x92cs\xf8\xd3c\xdc,(\x18\x19\x00\xc6a\x00\x94\xe1\xed|\x8a`\x94e\x00D\xd9Nl\x18\xdf\xfb\xf6\xbeA\a@\"Y\xb8E\xf0\xd5ټ;\xc0\u007f\x19\x00\xc6a\x00\x94\xe9\xedsxw@De\x00D\xd5N\xa4\xc7\xf6\xbd\xbd\xafF\x00$\x92\xc0\xe0\xd1y\xfcu\x1a\u007f\t\xf0W\x06\x80q\x18\x00\x15\xf8\xe3\a\x81\x81Ǩ\x0f4\xca\x00\xb0\xc1٣K\xbf\xd5/\xa8\x00H$\x81#krxa:\xaf\t\xf0O\x06\x80q\x18\x00\x15z\xfd\x03@b\x82\xfaP\xa3\f\x808;\xaf\x8c\xfb\xfc\x83\x0e\x80D\xb2pa O\a\xf8%\x03\xc08\f\x00\x03\xfe\xf2I\xf5\xa1F\x19\x00q\xb5\xe9\x183\xe7\xfc\x83\b\x80D\xb2\xb0c\xe0\xf6\xf9\x9e\xfe\xba\x14;\x19\x00\xc6a\x00\x18rjJ}\xb0Q\x06@\xdc\\qT\x9d\xb1s\xfeA\x05@W\x04\xbc\xc1\x1d\x03\r\xcb\x000\x0e\x03\xc0\xa0\xb7\xf1\xee\x80(\xc8\x00\x88\x86\xa9\xb1\xfd?\xd27\xac\x01\x90H\x02c\xc6\xed\xe0\xb3\x03\x8c\xca\x000\x0e\x03\xc0\xb0w\xcdW\x1fp\x94\x01\x10uW\x8e\xab\xf3\xfd8\xf0;\x00\x12I Y\x9bÿg\xf2Q\xc2fd\x00\x18\x87\x01\xe0\x83S\xebՇ\x1ce\x00D\xd5\xd6\xe3\xca\xdf\xde7l\x01\x90H\x02#kr\xf8\xcfS\x8c\x80\xcae\x00\x18\x87\x01\xe0\x93w-\xe0>\x01!\x95\x01\x10^\xd3\x06v\xf8\v[\x00$\x92\xc0\xd019\xfc\xee\xe1\xe7\xf5ץH\xcb\x000\x0e\x03\xc0Goy\x02\xbcE0|2\x00\xc2h'\xe6\x8c1\u007f\xab_X\x02 \x91\x04\x86W\xe7\xf0\xfc#\x8c\x80\xf2e\x00\x18\x87\x01\xe0\xb3?~\x88\x11\x102\x19\x00a\xb3\x13s\xc7\xf8{\xc1_\x18\x02 \x91,\x9c\x0e\xf8\xef,^\x18X\x9e\f\x00\xe30\x00|6\xe5\x15\"\x80\xa7\x03B#\x03 \\.\xab\xf5\xefV\xbf\xb0\x05@\"Y\xb8E\x90\x8f\x12.G\x06\x80q\x18\x00\x01y\xebS\x8c\x80\x90\xc8\x00\b\x8f\xf55\xc1\u007f\xf3\xd7\x0e\x80D\xb2\xb0c\xe0\xeb\xdc'\xa0D\x19\x00\xc6a\x00\x04\xe8\xafg\xa9\x0f?\xca\x00\b\x8b\xf5\x01^\xf0\x17\xb6\x00H$\x811G\xed\xe0fA%\xc9\x000\x0e\x03 `\xefZ\xa0>\x00m\x97\x01\xa0m'\x1a&ܮ~\x1ch\a@\"Y\xd8'`\xdb<F@q2\x00\x8c\xc3\x00P\xf0\xfe%ꋟ\xcd2\x00t\r\xc3\xf0\x0fK\x00$\x92\x85k\x02^|\x92\xfb\x04\xf4/\x03\xc08\f\x00%\uf627\xbe\x00\xda*\x03@\xcfz\x9f\xb7\xf7\x8db\x00$\x92\xc0\x91c\xb9O@\xff2\x00\x8c\xc3\x00P\xf4\xd7O\xf3\xc2@\x06\x805\xd6\xd7\xe8\x9e\xf3\x0fs\x00$\x92\x85}\x02~\xcf}\x02\xfa\x90\x01`\x1c\x06\x80\xb2\xbc0\x90\x01\x10{;\xb1\xb4\xb6N\xfd}\x0f{\x00$\x92\xc0\x90\xd1y\xbc\xca\a\b\xf5\"\x03\xc08\f\x80\x10x\x037\x19\xda[r\x00\x00 \x00IDAT\vb\x00\xc4\xd5\xc2&?\x1a\xf7\xf9G1\x00\x12\xc9\xc2/\x01\xff|\xf29\xfdu)t2\x00\x8c\xc3\x00\b\x81]\x9b\x051\x02\x18\x001sn\xc0\xdb\xfb\xc6!\x00\x12\xc9\xc2>\x01|\x94\xf0\xde2\x00\x8c\xc3\x00\b\x91\xb7\xcc\x00\x06\xf0\x9a\x00\x06@<\f\xf2\xc1>q\v\x80D\x12\x18=\x8e;\x06\xee)\x03\xc08\f\x80\x9095\xa5\xbe0\xc6]\x06\x80\xff\xb6N\xfc\xb5\xfa\xfb\x1c\xf5\x00H$\x81\xe15yl\x9f\xef\xe9\xafK\xa1\x90\x01`\x1c\x06@\be\x040\x00\"\xec\xcaqw\x85\xf2\x9c\u007f\x14\x03 \x91,l\x16\xc4\xd3\x01\x1e\x18\x00>\xc0\x00\b\xa9w\xccW_ \xe3*\x03\xc0?S!\xbb\xd5/\x0e\x01\x90H\x16\xae\txa\xba\xed\x17\x062\x00\x8c\xc3\x00\b\xb1\xdc,\x88\x01\x10!W\x1eu\x97\xfa{\x1b\xd7\x00H$\v\x8f\x12\xfe\xfb\x136G\x00\x03\xc08\f\x80\x90{wZ}\xa1\x8c\x9b\f\x00\xf36M\xb8=\x12?\xfbG9\x00\x12\xc9\xc2>\x01\xff\x9ei\xeb\xb6\xc1\f\x00\xe30\x00\"\xe0-3\xc0[\x04\x19\x00au^ux\xb6\xf7\x8d{\x00$\x92\xc0\xb01\xb6\xee\x18\xc8\x000\x0e\x03 \"\xde\xf0 0\xf0\x18\xf5E3\x0e2\x00Lى٣\xc3{\x9f\u007f\\\x03 \x91\x04\x92cs\xf8\xfb\f\xdbN\a0\x00\x8c\xc3\x00\x88\x90?y\x98\xcf\x0e`\x00\x84\xc4N,\xae\x99\xaa\xfe^\xda\x1a\x00\x89d\xe1\x01B\xedV=J\x98\x01`\x1c\x06@\x84Ly\xc0\xeds\xd5\x17Ψ\xcb\x00\xa8܍\xe3\xa3w\xce?n\x01\x90H\x02ê\xf3h\xb7f\xb3 \x06\x80q\x18\x00\x11\xf47\xb3\xd5\x17\xcf(\xcb\x00\xa8\xcc\xf4\xd8h\u007f\xf3\x8fS\x00$\x92\x85\x1d\x03_\x9bmC\x040\x00\x8c\xc3\x00\x88\xa8\xb7\xcdQ_@\xa3*\x03\xa0|\x17\xc7d\xf8\xc7)\x00\n\x11\xb0Â͂\x18\x00\xc6a\x00DX\xde\"\xc8\x00\b\xccNd\x8f\r\xff\xf6\xbe\xb6\x06@\"Y\xd86\xf8\x8d9q\xfe%\x80\x01`\x1c\x06@Ľo\x89\xfaB\x1a5\x19\x00\xa5\xbb\xee\xe8;#\u007f\xce?\xee\x01\x90H\x02\xc3\xc6\xe4\xf1\xaf'\xe3\xbaO\x00\x03\xc08\f\x80\x18x\xebS\xbc;\x80\x01\xe0\x9b\v\"z\x9f\xbf\x8d\x01\x90H\x02#jrx>\x96\xfb\x040\x00\x8c\xc3\x00\x88\x89\xbf\x98\x01\x1c>A}Q\x8d\x82\f\x80R\x86\u007ft\xf6\xf6g\x00\xbc\xe5\xf0\xea\x1c^\x98\xfe;\xfduɨ\f\x00\xe30\x00b\xe4O\x1fS_T\xa3 \x03\xa0\x18;\x91\xaa\xa9S\u007f\xaf\x18\x00\xe5;\xac:\x8f\xad\xb1\xda'\x80\x01`\x1c\x06@\x8cLy;\u007f\t\xe0\xe9\x00\x06@e.\x1b\x1b\x8dG\xfa2\x00\xfavxu\x0e\xafΎ\xcb\xdd\x01\f\x00\xe30\x00b\xe8\xcdO\xa8/\xaea\x96\x01з\vc\xfc\xb3\xbfm\x01\x90H\x16n\x11\x8c\xc7>\x01\f\x00\xe30\x00bꯟV_`\xc3*\x03\xa0w\x97\xd5֩\xbf?\f\x00\xf3&ks\xd8\x1a\xf9\x1d\x03\x19\x00\xc6a\x00\xc4\xd8{\xb8O\x00\x03\xa0x7M\xfcu\xec\u007f\xf6\xb75\x00\x12I`xu\x1e\xeds=\xfdu\xa9l\x19\x00\xc6a\x00\xc4ܻS\xea\vm\xd8d\x00twŸ\xbb\xd4\xdf\x17\x06\x80\xff\x8e\xac\xc9Ex\xc7@\x06\x80q\x18\x00\x16x\xebS\xea\x8bm\x98d\x00\xecij\xac\x1d\xe7\xfc\x19\x00\x05G\xd5F\xf5Q\xc2\f\x00\xe30\x00,\xf1\x8ey\xea\vnXd\x00tى\x95\x16~\xf3\xb7=\x00\x12I`Xu\x0e\xffy*j;\x062\x00\x8c\xc3\x00\xb0H>J\x98\x01\xb0\x9b\xcbj\xe3\u007f\xab\x1f\x03\xa0w\x87\x8e\xc9㟑\xfa%\x80\x01`\x1c\x06\x80=\xe6\xea=\xfc\xee\xbb\xd7\x01\x87\xeb/\xbe\f\x00]O?\xf1\r\xe0\xa1\x15V\xfb\xe2\xed\xeb\xd5\xdf\am\x87\x8d\xc9\xe1oOD%\x02\x18\x00\xc6a\x00\xd8a\xbe\xdeÅ\xa7\xbf\x86\x05w}\x13\xb8\xedp\xab#\x80\x01\x00\x9cyr\xbb\xfa1\xa9\xed?\xa7?\xa7\xfe>\x84\xc1Q\xb5Qy\x940\x03\xc08\f\x80\xf8ۙ\xf2p\xcdy\xaf \x91D!\x00\\\x01\xee:\\}\x103\x00\x18\x00\x9a2\x00\xderxu\x1e\xdbB\u007f\x8b \x03\xc08\f\x80\xf8\xfb\x8d3_\xdb\xf5A\xdf\x15\x00\xae\x00\x0f\x1f\xa4>\x8c\x19\x00:2\x00\x18\x00{;\xac:\x8f7B\xbdY\x10\x03\xc08\f\x80\xf8ڙ\xf2\xf0ͳ^\xdd\xe3C\xbeG\x00\xb8\x02<\xf4Y\xf5\x81\xcc\x00\b^\x06\x00\x03\xa0'G\xd5\xe6\xf0jh\xb7\rf\x00\x18\x87\x01\x10O;S\x1e\xfe\xf7\xeb/u\xfb\x80w\v\x00W\x80\xfb>\xa7>\x94\x19\x00\xc1\xca\x00`\x00\xf4\xe6\xa8\xda\\H\x9f\x1d\xc0\x000\x0e\x03 \x9e^=\xe5\x95\x1e?\xdc=\x06\x80+\xc0\xd3\a\xa8\x0ff\x06@p2\x00\x18\x00}9<\x94\x8f\x12f\x00\x18\x87\x01\x10?\xbf~\xfak\xbd~\xb0{\r\x00W\x80\x99\x9fP\x1f\xce\f\x80`d\x000\x00\xfasDM\x0e\xff\xef\xb1ߩ\xbfOo\xc9\x000\x0e\x03 >v,\xf4pީo\xf4\xf9\xa1\xee3\x00\\\x01\xee?4\xf6\xb7\b2\x00\x18\x00H3\x00\x8aqxu\x0e\xbf{\xf8y\xf5\xf7\xaa \x03\xc08\f\x80x\x98\xab\xf7p\xc6\xe4\xad\xfd~\xa0\xfb\r\x00W\x80\xbb\x0f\x8bu\x040\x00\x18\x00H3\x00\x8auؘ\x1c\xfe=3\f\xdb\x063\x00\x8c\xc3\x00\x88\x87\xe7\x9e\xfazQ\x1f\xe6\xa2\x02\xc0\x11\xa0.\xbe\xfb\x040\x00\x18\x00H3\x00Jq\xd8\xe8\x1c^V\xdf,\x88\x01`\x1c\x06@\xb4\xedLy\xb8\xf8\x8c\xde\xcf\xf9\xefmQ\x01\xd0\xe5o\x12@\xe2h\xf5\x81\xcd\x000/\x03\x80\x01P\xaa#j\xf2xy\x96f\x040\x00\x8c\xc3\x00\x88\xae\xf9\x94\x87+{\xb9ڿ7K\n\x00W\x80\xa9\xf1;\x1d\xc0\x00`\x00 \xcd\x00(G\xdd[\x04\x19\x00\xc6a\x00D\xd3Δ\x87\xef_\xf8r\xc9\x1f\xe0\x92\x03\xc0\x15\xe0\x81CՇ6\x03\xc0\xac\f\x00\x06@\xb9&ks\xd86O\xe3=c\x00\x18\x87\x01\x10M\xbfuni\xdf\xfc\xbb,+\x00\\\x01\x9e\xfe\x98\xfa\xe0f\x00\x98\x93\x01\xc0\x00\xa8\xc4\xe1\xd5y\xbc1'\xe8_\x02\x18\x00\xc6a\x00D\xcb|\xca\xc3\xe5g\xbfZ\xf6\a\xb7\xec\x00p\x05\x98~\x90\xfa\xf0f\x00\x98\x91\x01\xc0\x00\xa8\xd4\xe4ؠ\x1f%\xcc\x000\x0e\x03 :v,,\xfd\x9c\xff\xdeV\x14\x00\xae\x00\x0f\x1f\xac>\xc0\x19\x00\x95\xcb\x00`\x00\x98096\x87?<\x1a\xd4>\x01\f\x00\xe30\x00\xa2a>\xe5\xe1\xbcӊ\xbbկ/+\x0e\x00W\x80G\xa2\xfdK\x00\x03\x80\x01\x804\x03\xc0\x94C\xc7\x04u\x8b \x03\xc08\f\x80h\xf8\xb5/\xf7\xbf\xc9O1\x1a\t\x80\xae\b\x88\xe8\xdd\x01\f\x00\x06\x00\xd2\f\x00\x93\x0e\x1e\x9dǟ\x1f\xf7{\xdb`\x06\x80q\x18\x00\xe1v\xc7B\x0fgL\xee{{\xdfR4\x16\x00\x8e\x00\xbfJ\x00\x03\x8fV\x1f\xe8\f\x80\xd2e\x000\x00L;\xb2&\x87\xbfN\xf73\x02\x18\x00\xc6a\x00\x84\xd7\\\xbd\x87+\xce)\xff\x82\xbf\x9e4\x16\x00]\xde:\x10Q\xdb,\x88\x01\xc0\x00@\x9a\x01\xe0\x87ɱ9\xbc:ۯ\xd3\x01\f\x00\xe30\x00\xc2\xeb5\xe7\x95~\x9f\u007f\u007f\x1a\x0f\x00W\x80{\x0fQ\x1f\xea\f\x80\xd2d\x000\x00\xfcrDM\x1e\xdb\xe7\xfb\xf1\x9e1\x00\x8c\xc3\x00\b\xa7W\x97y\x9f\u007f\u007f\xfa\x12\x00\xae\x00OF\xe7Q\xc2\f\x00\x06\x00\xd2\f\x00?\x1dQ\x93\xf3a\x9f\x00\x06\x80q\x18\x00\xe12\x9f\xf2p\xd5\x14\xb3?\xfb\xef\xaeo\x01\xe0\n\xf0H4n\x11d\x000\x00\x90f\x00\xf8\xed\xe8\xda\x1d\x86\xef\x0e`\x00\x18\x87\x01\x10\x1e\xf3\xf5\x1e\xbes\xbe\xf9\x9f\xfdw\xd7\xd7\x00p\x05x\xfc\xb3\xea\x03\x9e\x01п\f\x00\x06@\x10\x1e96\x87\x17\x8d=J\x98\x01`\x1c\x06@x4}\xc1_O\xfa\x1e\x00\xae\x003\xc2}:\x80\x01\xc0\x00@\x9a\x01\x10\x94#\xaaME\x00\x03\xc08\f\x00}\xf3\xf5\x1e\xce=\xb5\xf2M~\x8a1\x90\x00p\x05\x98\xf6\xe9\xd0\xee\x13\xc0\x00`\x00 \xcd\x00\bҡcrx\xf6\xa1Jw\fd\x00\x18\x87\x01\xa0\xeb\x9b\v\xb6`\xf2\x97\xda\x03\xfb \x06\x16\x00\xae\x14\x1e%\x1c\xc2[\x04\x19\x00\f\x00\xa4\x19\x00A;\xac:\x8f\xe7\x1e\xae$\x02\x18\x00\xc6a\x00\xe8\xb9c\xa1\x87sN1\xb7\xc9O1\x06\x1a\x00\xae\x146\vJ\xe8\x0f}\x06\xc0\x9e2\x00\x18\x00\x1a\x8e\xa8\xc9\xe1?O\x95{:\x80\x01`\x1c\x06\x80\x9e\x95<կ\\\x03\x0f\x00G\x80_\r\n\xd5\xe9\x00\x06\x00\x03\x00i\x06\x80\x96#ǖ{\x8b \x03\xc08\f\x80\xe0\xedLy\xb8\xaa§\xfa\x95k\xe0\x01\xd0\x15\x01\xf7\x1e\x12\x9a\b`\x000\x00\x90f\x00h\x9a\xac\xcd\xe1\xb5٥F\x00\x03\xc08\f\x80`ͧ<|\xf7\x02\u007fo\xf5\xebK\x95\x00\xe8\xf2\x81p\xec\x18\xc8\x00`\x00 \xcd\x00\xd0v\xf4\xb8\x1dx\xbd\xa4\b`\x00\x18\x87\x01\x10\x9c\x9d\xca\xc3?\x91T\x0e\x00W\nw\a0\x00\xd4e\x000\x00\xc2\xe0\x91csh\x9f[l\x040\x00\x8c\xc3\x00\b\xce+}\xdc\xe1\xafX\xd5\x03\xc0\x15`\xf6\x81\f\x00e\x19\x00\f\x80\xb08\xa2&\x8f\u007f>\xf9\\\x11\xef\x19\x03\xc08\f\x00\xffݱ\xd0\xc3\xe5\x01l\xf2S\x8c\xa1\b\x00W\x80\xc7>\xcb\x00P\x94\x01\xc0\x00\b\x93#k\x8a\xd9'\x80\x01`\x1c\x06\x80\xbf\xbe\xb9`K\xe0\xb7\xfa\xf5eh\x02\xc0\x95\xc25\x01\n\x17\x062\x00\x18\x00H3\x00\xc2\xe6\xb0\xea<\x9e\u007f\xa4\xaf\b`\x00\x18\x87\x01\xe0\x9f\xf9z/\xd0M~\x8a1T\x01\xe0\n\xf0@\xf0\x0f\x10b\x000\x00\x90f\x00\x84\xd1\xc1\xa3\xf3}<@\x88\x01`\x1c\x06\x80\u007f\x9e{Z0\xdb\xfb\x96b\xe8\x02\xc0\x95\xc2>\x01\x01\xee\x18\xc8\x00`\x00 \xcd\x00\b\xabës\xbd\\\x13\xc0\x000\x0e\x03\xc0\xbc\xf9\xfa\xf0\x9c\xf3\xdf\xdbP\x06\x80+\xc0\xaf\x06\"\xa8\b`\x000\x00\x90f\x00\x84\xd9dm\x0e/\xcf\xda\xfb\x97\x00\x06\x80q\x18\x00fͧ<|\xfb|\x9dM~\x8a1\xb4\x01\xe0\np\xcfa\f\x80\x80d\x000\x00\xc2\xee\xa8ڽw\fd\x00\x18\x87\x01`֫Bp\xab__\x86:\x00\\\x01\x9e\xf4\xffQ\xc2\f\x00\x06\x00\xd2\f\x80(8\xac:\x8f\xed\xf3\xbb\xde3\x06\x80q\x18\x00\xe6\xbc\xfa\xdc\xf0~\xf3\xef2\xf4\x01\xe0\n0\xe3\x93\f\x00\x9fe\x000\x00\xa2\xe2\x91c\xbbN\a0\x00\x8c\xc3\x00\xa8\xdc\\\xbd\x87k\xce\v\xff\xf0O$#\x12\x00\xae\x00\x8f\xfb\xb7m0\x03\x80\x01\xc0\x00\x88\x96\xc9\xda\x1c\xfe:}3\x03\xc04\f\x80\xca\f\xd3&?\xc5\x18\x99\x00pŷ͂\x18\x00\f\x00\x06@\xf4\xac>j+\x03\xc04\f\x80\xca<\xf3+\xe1\xd9\xe4\xa7\x18#\x15\x00\xae\x003?\xce\x00\xf0A\x06\x00\x03 j\x0e\xad\xde\xc6\x000\r\x03\xa0<\xf3)\x0f_\xfb\xf2V\xf5\x0fE\xa9F.\x00\\\x01\xee\xf9\x1cL\xde\"\xc8\x00`\x000\x00\xa2'\x03\xc0\a\x18\x00\xa5۱\xd0\xc3y!\xdc\xe4\xa7\x18#\x19\x00\xae\x14\xf6\t\x18h&\x02\x18\x00\f\x00\x06@\xf4d\x00\xf8\x00\x03\xa04\xf3)\x0fWL\x89\xc6\x05\u007f=\x19\xd9\x00p\x05\xf8\xcd #\xcf\x0e`\x000\x00\x18\x00ѓ\x01\xe0\x03\f\x80Ҽ\xec\xec\xe8\\\xf0ד\x91\x0e\x00W\x80\xc7\x0eb\x00\x18\x90\x01\xc0\x00\x88\x9a\f\x00\x1f`\x00\x14gg\xca\xc3U\x11\xb8Ͽ?#\x1f\x00\xae\x00\x0fUv\x8b \x03\x80\x01\xc0\x00\x88\x9e\f\x00\x1f`\x00\xf4o>\xe5\xe1\xda\v_V\xff\x00\x980\x16\x01\xe0\n\xf0ȡ\f\x80\nd\x000\x00\xa2&\x03\xc0\a\x18\x00}ۙ\xf2pE\x84\xee\xf3\xef\xcf\xd8\x04\x80+\xc0\xccO1\x00ʔ\x01\xc0\x00\x88\x9a\f\x00\x1f`\x00\xf4\xedE\xa7\xbf\xa6~\xe0\x9b4V\x01\xe0\n0\xfbc\f\x802d\x000\x00\xa2&\x03\xc0\a\x18\x00=\x9b\xab\xf70\xe5\xd4h\xde\xeaח\xb1\v\x00W\x80\xfb>W\xd2\xdd\x01\f\x00\x06\x00\x03 z2\x00|\x80\x01\xd0ݎ\x85\xd1\xdc\xe4\xa7\x18c\x19\x00\xae\x146\v*2\x02\x18\x00\f\x00\x06@\xf4d\x00\xf8\x00\x03\xa0\xbb\xe7\x9c\x12\xad\xed}K1\xb6\x01\xe0\np\xc7@\x06@\x912\x00\x18\x00Q\x93\x01\xe0\x03\f\x80=\xbd\xe0k\xf1\xfb\xd9\u007fwc\x1d\x00\xae\x00\xf7$\xfa\xfd%\x80\x01\xc0\x00`\x00DO\x06\x80\x0f0\x00\nv\xa6<\\\x19\x83\xfb\xfc\xfb3\xf6\x01\xe0\n0u@\x9f\x11\xc0\x00`\x000\x00\xa2'\x03\xc0\a\x18\x00\x85\xe1\xff\xbd\x98\xdc\xe7ߟV\x04\x80+\xc0\x83\x871\x00\xfa\x90\x01\xc0\x00\x88\x9a\f\x00\x1f`\x00x\xb8\xf4\xccx\xdd\xeaח\xd6\x04\x80+\xc0S=?J\x98\x01\xc0\x00`\x00DO\x06\x80\x0f\xd8\x1c\x00\x9d)\x0f\x97\x9ca\xcf\xf0O$-\v\x00W\x80\x99\x9fd\x00\xf4 \x03\x80\x01\x105\x19\x00>`k\x00\xe4\xeb=\\\x15\xe1\xa7\xfa\x95\xabu\x01\xe0\np\xff\x00\x06\xc0^2\x00\x18\x00Q\x93\x01\xe0\x036\x06@\xae\xde\xc3\xf9\xa7\xc5\xfbj\xff\u07b42\x00\\\xd9\xe3)\x82\f\x00\x06\x00\x03 z2\x00|\xc0\xc6\x00\x88\xeb&?\xc5hm\x00\xb8\x02<\xfa\x19\x06\xc0N\x19\x00\f\x80\xa8\xc9\x00\xf0\x01\x9b\x02 Wo\xf7\xf0O$-\x0f\x00W\x80\xa9\t,\x1b\xf9S\xf5\xf7A[\x06\x00\x03 j2\x00|\xc0\x96\x00\xc8\xd7{8?\xe6\x9b\xfc\x14\xa3\xf5\x01\xe0\n6M=\r\x83F\xe5\xd5\xdf\vM\xcf\xfa\xc2\xcb\xc0\xe9\xdf\x06\xbe\xf6-k\xddv\xf6\xffb\xd1}_\xa3\x11q\xc9\xfd\xa70\x00LcC\x00t\xa6<\\e\xc1&?\f\x80\xe2]s\xdf\xc5H$;\xd5\xdf\x0f-\xcf<\xb9\x1dx`9p\xf8\x84\xb2\x1e\xa9\x1c\vGOR?\x0ei\x802\x00\xba\x03\v\x02\xe0b\xcbn\xf5c\x00\x14\xa73m2\x06Z\x1a\x01\x85S\x00m;#\xe0h\xfda\xcc\x00\xa0~\xcb\x00\xe8\x0eb\x1c\x00\x9d)\xbb6\xf9a\x00\x94\xae\xf3\xf8)VF\xc0\x1e\xd7\x00ܽ\b\x18p\xb4\xfe@f\x00P?e\x00t\a1\r\x80Δ\x87k-\xd9ޗ\x01P\x99\x8d\x0fOQ\u007f_T\x03 \xed\x01\x0f\xaf\xd2\x1f\xc8\f\x00\xea\xa7\f\x80\xee \xa6\x01p\xc59<\xe7ߓ\f\x80\x9e}~\xee8\xf5\xf7F5\x00\xd2\x1e0\xdb\xd1\x1f\xca\f\x00\xea\x97\f\x80\xee \x86\x01\x10\xf7G\xfaV\"\x03\xa0w\x9f}\xfa\x18\xd8ra`\xaf\xb7\x01\u07bf̞k\x02\x18\x00v\xc9\x00\xe8\x0eb\x14\x00\x1d\v=\x9cs\xca\x1b\xea\x8bk\x98e\x00\xf4m\xd3#gYq\x8b`\x9f\xfb\x00LM\x03\x89c\xf4\a4\x03\x80\x9a\x94\x01\xd0\x1d\xc4$\x00r\xf5\x1eN;\xd9\xeeM~\x8a\x91\x01п\xeb\xee?\x1f\x03\x93\xf1\x8e\x80~7\x02\xbaoi\xfc#\x80\x01`\x97\f\x80\xee &\x01\xc0o\xfe\f\x00\x93\xae\xbd\xff\x92X\xdf\x1d\xd0o\x00\xa4ڀ{\x16\x01\x03\x8f\xd5\x1f\xd4\f\x00jB\x06@w\x10\xf1\x00\xe8Ly\xb8\xe8t\xde\xea\xc7\x000\xefһ\xae\xc4\xe0\xd1\xf1\xfc%\xa0譀\xef\xaaGl\u007f\t`\x00\xd8%\x03\xa0;\x88p\x00\xe4S\x1e.?\xfbU\xf5\xc54J2\x00Js\xed\xfd\xe7\xc7\U0009a012\x9e\x0505\x15\xcf\v\x03\x19\x00v\xc9\x00\xe8\x0e\"\x1a\x00\x9d)\x0f\xdf\xe5}\xfe\f\x80\x00lz\xe4L\xc4\xed\ue012\x1f\x06\xf4غ\xf8E\x00\x03\xc0.\x19\x00\xddAD\x03\xe0\xca)\xbcϟ\x01\x10\x9c\xcfϭQ\u007f\xefT\x03 \xd5\x06\xcch\xd0\x1f\xda\f\x00Z\xae\f\x80\xee b\x01\x90Oy\xb8\xec,\xfe\xec_\xae\f\x80\xf2m\x9b\xf9\x05\xf5\xf7O-\x00\xba\xbco)b\xb3m0\x03\xc0.\x19\x00\xddA\x84\x02\xa0c\xa1\x87\xcb\xcf\xe67\xffJd\x00T\xa6\xfb\xf8\xa9\xb1\xb8;\xa0\xec\x00H{\xc0\xbdK\xe2q:\x80\x01`\x97\f\x80\xee \"\x01\x90Oy8\xf7T\xde\xea\xc7\x00\xd0ם6\x19Q\xbf&\xa0\xa2\x00H{\xc0\xb4\xf5@b\x82\xfe\x10g\x00\xd0be\x00t\a\x11\t\x00n\xf2\xc3\x00\b\x93\xee\xb4ɑ\xbe;\xa0\xe2\x00H\xb5\x15\x9e\"8h\xa2\xfe g\x00\xd0bd\x00t\a!\x0f\x80\x1d\v=\x9c>\x99ß\x01\x10>\x17\xdd\xf9-\f\x1d\x93S\u007fOU\x02\xa0\xcb\xdb\xe7\x01G\x1c\xa7?\xcc\x19\x00\xb4?\x19\x00\xddA\x88\x03 W\xef\u16fcϟ\x01\x10bS\xb7_\x13\xc9͂\x8c\x05@\xda۹Y\xd0\x04\xfd\x81\xce\x00\xa0}\xc9\x00\xe8\x0eB\x1c\x00W\x9f\xcb\v\xfe\x18\x00\xe1w\xe3Cg\xab\xbf\xaf\xaa\x01\x90\xf6\x80'\x1b\xa3wa \x03\xc0.\x19\x00\xddAH\x03\xe0*\xde\xe7\xef\x8b\f\x00\u007f\xfc\xed\xac\x89\x88҅\x81\xc6\x03 \xed\x01\x0f,G\xa4n\x11d\x00\xd8%\x03\xa0;\bY\x00\xe4S\x1e\xae8\x87?\xfb3\x00\xa2\xa7\xfb\xf8)\xea\xef\xafj\x00\xa4\xbd\xc2-\x82ڃ\x9d\x01\x10O\x9b?[\xd9\xff\x9f\x01\xd0\x1d\x84(\x00r\xf5\x1e\xbeş\xfd}\x95\x01\xe0\xaf\xcf\xcc8Y\xfd=V\r\x80\xb4\a<\xb2:\x1a\xa7\x03\x18\x00ѱ\xe9\b \xddR\xd9\u007f\x83\x01\xd0\x1d\x84(\x00x\xc1\x1f\x03 \x0en\x99u,\xc2~:\xc0\xd7\x00H\xb5\x013\"pM\x00\x03 \x1a6\x1d\f\xa47\x01i\xb7\xb2\xff\x0e\x03\xa0;\bA\x00\xe4\xea=L\xe1&?\x81\xc8\x00\b\xc6gf\x9c\x10\xea}\x02|\r\x80.\xefY\x82P?J\x98\x01\x10~\x1b\x87\x00\xe9gv\x1eS\f\x00\xe3@9\x00\xde\\\xb0\x05_>\xb1]}A\xb4E\x06@p\xae\xbd\xff\x02\f\x1e\x1d\xce}\x02\x02\t\x80\xb4\aܹ\x00\x18\x14\xd2}\x02\x18\x00\xe1\xb6q\xd8n\xc3\xdfc\x00\xf8\x01\x14\x03`\xc7B\x0fg\u007f\x95\xdf\xfc\x19\x00\xf15}\xe7\xd58\"\x84\xbf\x04\x04\x16\x00i\x0f\xb8+\x15\xce͂\x18\x00\xe1\xb5頽\x86\xbf\xc7\x00\xf0\x03(\x06\x00\x9f\xea\xc7\x00\xb0\xc1Ew\\\x83A\xa3\xc2uM@\xa0\x01\x90j+<E0l\xa7\x03\x18\x00\xe14\xf3\x81^\xe6\n\x03\xc08P\b\x80Δ\x87+x\xc1\x1f\x03\xc0\"7>tV\xa8\xae\t\b4\x00\xba\xbc{Q\xb8.\fd\x00\x84ϖ\x8f\xa0p\xc1_O\xc7\x10\x03\xc08\b8\x00\xf2)\x0fל\xf7\xb2\xfa\x02h\xab\f\x00=\x9b\x1f934\x8f\x12V\t\x80\xb4W\xb80P{\xf03\x00\xc2i\xf3\x81@\xba\xb5\x8f\xe3\x87\x01`\x1c\x04\x18\x00\x9d\x1c\xfe\xea2\x00t}\xe6\xc9\x13Տ\x01\xd5\x00H{\x85[\x04ðc \x03 <6\u007f\xb2\x8fo\xfe]2\x00\x8c\x83\x00\x03\x80?\xfb\xeb\xcb\x00\xd0\xf7\xf9\xb9\xb5\xeaǁj\x00\xa4=\xe0\xf1u\xfa\x11\xc0\x00\b\x87M\x87\x16\x86{\xbf\xc7\r\x03\xc08\b \x00v,\xe4\x05\u007fa\x91\x01\x10\x0e[\xa7OV=\x1d\xa0\x1e\x00i\x0f\xb8{1p\xf8\x04\x06\x80\xcd6\x8cD\xf7\xab\xfd{\x93\x01`\x1c\xf8\x1c\x00o\xce\xdf\xc2[\xfdB$\x03 <6?r\x86څ\x81\xa1\b\x80\xb4\xb7s\xb3 \xa5\b`\x00\xe8Z\xd2\xf0\xf7\x18\x00~\x00\x1f\x03 W\xefq\x93\x9f\x90\xc9\x00\b\x97M\x8f\x9c\xae\xf2K@h\x02 \xed\x01\x0f.\xd7\xd9,\x88\x01\xa0gӡ%\x0e\u007f\x8f\x01\xe0\a\xf01\x00\xa6\x9c\xc2o\xfea\x93\x01\x10>\x17\xdd\xf1\xed\xc0w\f\fU\x00\xa4ڀ;\x17\x06\xbfY\x10\x03@\xc7\xe6O\xa2\xb8s\xfe{\xcb\x000\x0e|\b\x80\\=\x1f\xec\x13V\x19\x00\xe14u\xe75\x18<:\xb8\xd3\x01\xa1\n\x80.\xef\\\b\f\fp\xb3 \x06@\xf06\x1f\x88\xfe\xaf\xf6\xefM\x06\x80q`8\x00\xf2)>\xd27\xcc2\x00\xc2\xeb\xba\xfb\xcfàd0\x11\x10\xca\x00H{\x85\x1d\x03\x83\xda,\x88\x01\x10\xac-\x1fA\xdf\xf7\xf9\xf7'\x03\xc080\x1c\x00W\x9c\xc3o\xfea\x96\x01\x10n\u007f;k\xa2\xdd\x01\x90\xf6\x80\xa7\x1d\x06@\xdc\xcc|\xa0\x82o\xfe]2\x00\x8c\x03\x83\x01p\xe5\x14~\xf3\x0f\xbb\f\x80\xf0\xbb\xe5\xa9Iv\a@\xda\x03\x1e\\\xc9\x00\x88\x8b\xcd\au\xfb\xa2\xc8\x00\b\t0\x10\x00\xb9z\x0fW\xf3g\xffH\xc8\x00\x88\x86\x9b\xa6\u007f\xc5\xee\x00H{\xc0\xbdK\xe1\xebfA\f\x00\xff\xed\xf6H\xdfJd\x00\x18\a\x15\x06\xc0\x8ez\x97\x17\xfcEH\x06@tl\x9d>\x19\t\x9fn\x11\x8cD\x00\xa4=\xe0\x91\xd5\xfem\x16\xc4\x00\xf0\xd7\xc6!\x06\x87\xbf\xc7\x00\xf0\x03T\x18\x00SN\xf9\xaf\xfaP\xa3\f\x80\xb8\xbae\xd61\xf0#\x02\"\x13\x00\xa96\xe0\xf1\xf5\xf0\xe5Q\xc2\f\x00\xffl<\xd8\xf0\xf0\xf7\x18\x00~\x80\n\x03`X\xcdV\xf5\xa1F\x19\x00qv\xdd\xfd\xe7c\xf0(\xb3\xfb\x04D&\x00\xba\xbck!p\xc4$\x06@\x14l<\x02\x95_\xf0ד\f\x00\xe3\x80\x01`\x95\f\x80h\x9a\xba\xe3\x1a\f1\xb8YP\xe4\x02 \xed\x01\xb7\xcf\a\x06Md\x00\x84٦C`\xfe\x9b\u007f\x97\f\x00\xe3\x80\x01`\x95\f\x80\xe8\xba\xf8\xae\xab1h\x94\x99\xd3\x01\x91\f\x80\xb4W\xd8'\xc0Գ\x03\x18\x00fm\xf90\xfc\xf9\xe6\xdf%\x03\xc08`\x00X%\x03 ں\xd3N\x82\x89k\x02\"\x1b\x00\xa96\xe0\xd15f6\vb\x00\x98\xd3\xd9\x17\xe5m\xef[\x8a\f\x00\xe3\x80\x01`\x95\f\x80\xe8\xdb\xf2\xe8i\x15?@(\xb2\x01\xd0\xe5=K\x18\x00a\xb1\xa2\xed}K\x91\x01`\x1c0\x00\xac\x92\x01\x10\x0f\x9d\xc7N\xb3;\x00\xd2\x1e\xf0\xc02\x06\x80\xb6M\x87\x054\xfc=\x06\x80\x1f\x80\x01`\x95\f\x80\xf8\xf8l\x05\xdb\x06\xc7\"\x00\xd2\x1e0\xc7Aٛ\x051\x00*\xd3\xd7\v\xfez\x92\x01`\x1c0\x00\xac\x92\x01\x10/\x9f\x9f;\x16\xe5\\\x13\x10\x9b\x00H\xb7\x95\xbfY\x10\x03\xa0|\x9b>\x87\xe0\xbe\xf9w\xc9\x000\x0e\x18\x00V\xc9\x00\x88\x9f\r\x0f\x9d\x83A\xa3J{\x8a`|\x02`\xa7S\xd3(y\xb3 \x06@y6\x8c@\xb0\xdf\xfc\xbbd\x00\x18\a\f\x00\xabd\x00\xc4\xd3u\xf7\x9f\x8f#J\x88\x80\xd8\x05@W\x04\f<\x96\x01্\x83\x94\x86\xbf\xc7\x00\xf0\x030\x00\xac\x92\x01\x10_WL\xbd\xc2\xee\x00H{;\xf7\t(\xf2\x97\x00\x06@i\xb6\xec\xaf8\xfc=\x06\x80\x1f\x80\x01`\x95\f\x80x\xbb\ue04b\x8a:\x1d\x10\xdb\x00(%\x02\x18\x00\xc5\xdb\xf2\x11 ݪ\xfc\xde2\x00\x8c\x03\x06\x80U2\x00\xe2\xef\x9a{\xbf\xde\uf381\xb1\x0e\x80\xb4\aܽ\xa8\xff͂\x18\x00\xc5\xd9|\x00\x82\xbf\xe0\xaf'\x19\x00\xc6\x01\x03\xc0*\x19\x00v\xd8\xfc\xc8\x19\xe8\xeb\xee\x80\xd8\a@\xda\x03\x1eZ\xd5w\x040\x00\xfa\xb7\xf9\x00\xe8\u007f\xf3\xef\x92\x01`\x1c0\x00\xac\x92\x01`\x8f\xcfΚ\xd0k\x04X\x11\x00\xe96`f#\x03\xa0\\\x9b?\x0e\xa4\xb3!x\x1f\xbbd\x00\x18\a\f\x00\xabd\x00\xd8\xe5\x96Y\xc7Y\x1c\x00;}hEϛ\x051\x00z7\xd0\x1d\xfe\x8a\x95\x01`\x1c0\x00\xac\x92\x01`\x9f\r\x0f\x9d\xd7\xed\xd9\x01V\x05@\xda\x03\xee[\xd2\xfdt\x00\x03\xa0g\x1b\x87\x86p\xf8{\f\x00?\x00\x03\xc0*\x19\x00v\x9a\x9d\xf6\xe5=\"\xc0\xba\x00H{\x85\x1d\x03w\u007f\x940\x03\xa0\xbb\x8d\x87C\xf7V\xbf\xbed\x00\x18\a\f\x00\xabd\x00ث;m2\xba\xae\t\xb02\x00\xd2m\xc0\xfd\xcbފ\x00\x06\xc0\x9e6\u007f*\xc4\xc3\xdfc\x00\xf8\x01\x18\x00V\xc9\x00\xb0\xdb5\xf7^\x82A\xa3\xf2\x96\x06\xc0N\xef^T\xd81\x90\x01\xf0\x96\xcd\a\x87|\xf8{\f\x00?\x00\x03\xc0*\x19\x00t\xf9\xd4\xcbq\xf6\x97\xdf\b\xc1\x82\xae\xe8\u074b\x80\xea/\xa8\xbf\x17\xa1\xb0\xf9\xd3\x11\x18\xfe\x1e\x03\xc0\x0fPa\x00<rԓx`̜Hx\xf3Y\u007f\u008d\xdfx\xc9j7\xff\xdfu\xc0φ\xd9\xed\x9c)\xc0\x9a\xef[\xed\vO,\x00R\xda\v\xba\xb2\xb3V\xe9\x0f_m[>\x82p^\xf0\xc7\x00\b\x84J\x03\x00GԖ\xf7,n\ro_\x10\x82\x83Xٳ\xae\xd5\u007f\x1f4\xbd\xf4g@\xaaM\xff}дk\xf0\xdb\xfe:\xa4[\xf4\a\xb0\xfa\xf0wB\xf0>\x14+\x03\xc08\f\x00˴9\x00.\xfd\xb9\xddC/ն\xf3߿\xfbk\xd0f\xf1/\x01\x16\a@\xf3\xa7\x11\x9do\xfe]2\x00\x8c\xc3\x00\xb0L[\x03\xe0\xfc\xeb\xf5_{m{\x8b\x1fk\xa3\xc8\xd2\x00\x88\xc4\x05\u007f=\xc9\x000\x0e\x03\xc02m\f\x80\xcb\u007f\xa9\xff\xba\x87]+#\xc0\xc2\x00\x88\xcc\x05\u007f=\xc9\x000\x0e\x03\xc02m\v\x80+~e\xe9p\xdb͢\xff\xfdm\x96\xbdV\x96\x05@S\x02\xd1\x1d\xfe\x1e\x18\x00>\xc0\x00\xb0L\x9b\x02\xe0\xa2\x1b-\x1bh{\x99*c\xa0\x97\xf3\xff\x89\xac\x16\x05@\xe3PD{\xf8{`\x00\xf8\x00\x03\xc02m\t\x00\xdb\xcf\xf9W4\xc8\xf7\xbeP0\xaeZ\x12\x00\xa1|\xb0O92\x00\x8c\xc3\x00\xb0L\x1b\x02\xe0\xfc\xeb-\xfa\x16ۋ\x95\xfe\xfbS6\xdc\x1d`A\x00\xb4|\x1c\xf1\x18\xfe\x1e\x18\x00>\xc0\x00\xb0̸\a\xc09\xd7\x01\xf5\x9b\xf5_gMM\xc5O\xec#*\xe6\x01\xd0r\x00\x90Ά\xe0u6%\x03\xc08\f\x00ˌs\x00\\\xf8c\xfd\xd7W[\xd3\xdf\xdac\x1d\x011\x0e\x80\x96\x03\x80tk\b^c\x932\x00\x8c\xc3\x00\xb0̸\x06\xc0\x85?\xd1\u007fm5\xf5\xf5'\xfb\xb8\x9e\x0e\x88i\x00Dj{\xdfRd\x00\x18\x87\x01`\x99q\f\x80+\u007f\x1d\xf3o\xaaE\xe8\xf7\xbf?\x96\xafo\f\x03\xa0e\u007f\xc4\xef\x9b\u007f\x97\f\x00\xe30\x00,3n\x01p\xd9-1\x1dN%\x18Է\xf3ؽ\xce1\v\x80\xc6#\x10\xfd[\xfd\xfa\x92\x01`\x1c\x06\x80e\xc6)\x00.\xf9\xa9\xfe\xeb\xa9m\xe0C9N\xfb\x04\xc4(\x00\x1aG \xde\xc3\xdf\x03\x03\xc0\a\x18\x00\x96\x19\x97\x00\xb8\xf8&\xfd\xd7RS\xd5\r{\xe2\x12\x011\t\x80\xa6\xcf!\xfe\xc3\xdf\x03\x03\xc0\a\x18\x00\x96\x19\x87\x00\xe0#}C\xf0\xef\x8fC\x04\xc4 \x00\x9a\x0fE</\xf8\xebI\x06\x80q\x18\x00\x96\x19\xf5\x008\xe7\a1\x18<\x15\x18\xa6\xadz\xc3\xf4\xb7\x94e\xc4\x03\xa0\xe90\xd8\xf1ͿK\x06\x80q\x18\x00\x96\x19\xe5\x008\xe7\a\xfa\xaf\x9f\xaam@js\xc8n\xc9c\x00\xa8\xd8| \xec\xf9\xe6\xdf%\x03\xc08\f\x00ˌj\x00\\p\xbd\xfek\xa7mX\xbfm\x87\xf5\xef\xea\u05c8\x06@f_\xd87\xfc=0\x00|\x80\x01`\x99Q\f\x80+n\x8d\xf0\x901d\xe8\u007fn\x8f\xe2fA\x11\f\x80\x96\x0f\x03i7\x04\xaf\x9d\x86\f\x00\xe30\x00,3j\x01p\xc9OC>\xf8\x020\xe5!\x12?\xb5G\xee}\x8aX\x00Xu\xc1_O2\x00\x8cSq\x00d\x048q\xb8\xfe\xa0`\x00\x14g\x94\x02\xe0\x1b?\xd3\u007f\xbd\xb4\x8d\xca\xf0\xdf\xf5\xf7F\xe8o\x8dR\x004\r\x86]\x17\xfc\xed岙\x80S\xc5\x000M\xc5\x01\xd0冷\x01\x83\xc6\xe9\x0f\r\x06@\xdfF%\x00.\xbb9b\xc3\xc4\x0f#\xfa\xef\x8f\xcc\xfb\x16\x91\x00h:\x04H\xd9\xfaͿ\x15\xc8\xecg\xe6ud\x00t\xc7X\x00tY\xb7\xbf\xfe\xf0`\x00\xf4n\x14\x02\xe0\xf2_Dh\x88\xf8d\xaa\r\x91\r\x80\xb4\x87h\\\x13\x10\x81\x00h\x1c\nk\xbf\xf9o8\xd1\xeck\xc9\x00\xe8\x8e\xf1\x00(\xbc\xd0\xc07\x0f\xd3\x1f$\f\x80\xee\x86=\x00l\u007f\xa4\xef\xae\xc1\x1f\xe5\xe1\xdfe\xd8/\\\fy\x004\x0e\aR\x16\x0e\xffU7T\xfes?\x03\xa08|\t\x80\xddC\xe0\x98\xa4\xfePa\x00\xbce\x98\x03\xc0\xf6\xfb\xfc#\xff\xad\xbf'\xc3\x1c\x01!\x0e\x80\xe6\x83`\xdd7\xff%\xf5\x80\xeb\xc3\xe0g\x00\xf4\x8e\xaf\x01\xd0e\xfd{\x80\xc4Q\xfa\x03\x86\x01\x10\xde\x008\xeb\u007f\x81\xfa\xcd\xfa\xaf\x8f\xaaQ\xf8ټ\f\x19\x00\xa5\xd9\xf2\x01 \xed\x84\xe0\xf5\t\xcaͅ\xeb\x1c\xfc~]\x19\x00\xdd\t$\x00\xba\xfc\xe5\x81\f\x00m\xc3\x18\x00\x17\xdd\xc8\xe1\x1f\xd7\xe1\xdfe(# \x84\x01в?\xac\xba\xd5o\xfd\x19\xc1\xbd\xb6\f\x80\xee\x04\x1a\x00\xae\x14n\x1b<g \x03@˰\x05\x00\x9f\xea\x87p\xffLnҰEN\xc8\x02\xa0\xf9@ \xdd\x1a\x82\xd7%\x00W\xdc\xe9\xcfy~\x06@i\x04\x1e\x00\xbb[3\x86\x01\x10\xb4a\n\x80Ky\x9f\u007f\xfc\xce\xf9\xf7c\xa8B'D\x01\xd0\xf2)X\xf1\xcd\u007f\xf1J\xbdט\x01\xd0\x1d\xb8\xb2?\\\xf9\x8bڛ2\xf3\x03\f\x80 \rK\x00p{_\xc4\xe7j\xff\x12\r\xcd\xfb\x1e\x92\x00h>\x14Vl\xef\xdb8B\xf3u\xfe;6\xc9G\xb5\xe7mhAFFÕ\x0e\xa52\x03n\xf8\x14\x03 \b\xc3\x10\x00\x97\xfe,DC@K\xcb\xff\xfd\xa90\x9c\x0e\bA\x004\x8c\x8c\xff\xad~k/.\xac\xf1:\xafq\a\xb22V{\xbeF\x068\xf2]\xb8\xb2C\xe5\xcd\xca\b\xf0\xd5!\f\x00?\xd5\x0e\x80\x8bo\xd4\u007f\r4\x8d\xd5}\xfe\x06^\v\xd5\x10T\x0e\x80\x86#\x11\xeb[\xfd\x96=\x1c\xfcy\xfe.\x1d\xc9!+\xd7i\xcf\xd3\xc8\x02G\xd6\xc1\x95N\xb5\x0fǈ\x1a\x06\x80\x1fj\x06\xc0E\x1c\xfe\x1c\xfcazM\x14\x03\xa0\xe9\xb0\xf8~\xf3_\xd4\b8o\xd7{m\x1diО\x9f\xb1\x00\x19\xf94\\\xf9\x97\xda\x1b\xf9Ї\x18\x00\xa6\xd5\n\x803\xbf\xcf[\xfd\xac\xb9ڿD\xd5~\tP\n\x80\xe6O!\xb6\xe7\xfc7\x1e\xad7\xf8\xb3\xf2_4\xc8g\xb4\xe7f\xec\x80#\x9f\x87\xe6\xf5\x01\xdf>\x98\x01`J\x8d\x008\xe7\av\x0f\xff]\xb7\xfa\x85\xe0o\t\xab\xb6\x04@\xf3\xc7\x11˫\xfdW\u007fO\xef\xe7~W:\xe0ȗ\xb4\xe7d\xec\x81+7Ñ\x9cZ\b\x9c0\x82\x01P\xa9A\a\xc0\x857\xe8\xff\x9b\xd5\xe5\xf0/\xca\xc0# \xe0\x00h\xd9\x1f\xb1\xbb\xcf\u007f\xe9,\xf8\xba}o\xdf\xe6\xe0\xc8o\xb4\xe7\xa2u\xc0\x11O\xe9\r\a\x1a\xf6)\u007f[a\x06@\xb0\x01\xf0\xcd[\xf4\xff\xbd\xea\xf2'\xff\x92\f4\x02\x02\f\x80\xcc\a\x10\xafo\xfe\x9b\x81̾Z\x83\x1fp\xe59\xed9h5\xc8\xc8\xe1p\xe4\x15\xb5\x03\xe0\xce\xfd\x19\x00\xe5\x18T\x00\\v3\xcfw\x87\xe2V\xb7\b\x1a\xd8q\x13P\x004\u007f\x06\xb1\xda\xdb\u007f×\xf4\x06\xbf#o\xc0\x95\x81\xda\xf3\x8f\xec\x04\x8e|\rZ\xd7\ad\x04\xb8d\x00\x03\xa0\x14\x83\b\x00\xde\xe7\x0f\xde\xeaW\xa1\x81\x1c?\x01\x04@\xe3p\xc4\xe6V\xbf\x95?\xd7=\xcf\xdf*gk\xcf;\xd2\vpd&\\ɫ\x95\xe1\x84$\x03\xa0\x18\xfd\x0e\x80K,\xdf\xdb?큃ߐ\xbe\xff\x82\xe2s\x004\x0e\x8dǭ~KRZC\x1f\xc8J\x1e\x8e\xcc֞o\xa4\b\xe0\xc9;\xe1\xca\xf3j\a\xcb\xd2w1\x00\xfa\xd3\xcf\x00\xb8\xfc\x97\xfc\xe6\x9f\xf2\xc0\x000\xf9z\xfay\x8b\xa0\x8f\x01\xd0t(b\xf1Ϳ\xf9\x93z\xc3ߕ?!-\xefҞk\xa4D\xd0*\xa3\xe0\xca6\xb5\x03\xe7\xe7\x9f`\x00\xf4\xa6_\x01p\xc1\xf5\x1c\xfe\xdc\xe8'b\xaf\xabO\x01\xd04\x18HE\xfc\x82\xbfu\xe7hn\u07fb\x03\xaeTk\xcf1R!p\xe4\x12h^\x1fp\xd6 \x06\xc0\xde\xfa\x11\x00\xe7\xfc@\xffߥ*\a\u007f0\xaf\xb1\xe9\xff\xa6\x0f\x01\x10\xf5o\xfe+\xee\xd2<Ͽ\x03\x19\xb9B{n\x11\xc3 +K\xa0\xb9\xad\xf0\x981\f\x80.M\a\xc09\xff\xab\xffoҔ\xdf\xfa#\xac\xe1\x00h\xfe\b\"{\xab\xdf\xe25ZC\x1f;g\xc3\n\xed9E|\x04\x8d\xf2\x01h>vx\xee\xfb\x18\x00i\xcfl\x00\xf0j\u007fp\x93\x9f\x805z\xbc\x19\f\x80̾\x88\xec\xf6\xbeM\x035\x87\xff?\x90\x91\xfd\xb4\xe7\x13\t\b82\x0e\x9a\xdb\n\xaf\xbdD\xff\x03\xa7\xa9\xa9\x00\xb8\xf8&\xbb\x87\u007f\xaa\rHo\xe6\xf0\xd7z퍼\xee\x86\x02 \xaa\xdb\xfb\xae\xfd\xa6\xf6m}\x13\xb4\xe7\x11Q\x02\x19\xf9>\xb4\x1e;\xecT\x01\xcb\x1f\xd4\xff\x00jh\"\x00.\xf9\xa9\xfe\xbfC[n\xb4#\xe4V\x00\x00\x0f\x9aIDAT\xf2\xa3\xff\xfaW\xfc\xdf1\x10\x00M\x87!r\xc3\u007f\xf9#\xba\x8f\xe9u\xe4z\xed\xf9CB\x02\\iP*\xd0\xc2#+\x175\xe9\u007f \x83\xb4\xd2\x00\xb8\xecf\xfd\u007f\x83\xba\x16\xff\xf2\x11&+\x8e\x80\n\x03\xa0\xe90DꂿE-\x80\xf3n\x9d\xb5\xb6`\x8b\xf6\xbc!!\x04\x199\x10\x8e\xbc\xa8v`6\x8c\xd1\xffp\x06e%\x01p\xe5\xaf\xec\xfe\xd9?\xed\xf1\xdf\x1f6+\xfa%\xa6\x82\x00h:<Z\xb7\xfa5\x8c\xd5\x1c\xfc/\xa1U>\xa1=gH\xc8AVN\x84\xda\xf5\x01U\xc0\xeak\xf5?\xa8~[n\x00\\\xf8c\xbb\x87߮Ac\xf1k\x10VS^\x99\xc7f\x99\x01\xd08\x12\x91\xf9\xe6\xbf\xfa\a\xba\xe7\xf93\xf2e\xed\xb9B\"\x06\xb2\xf20\\\xa5\xc7\x0e\xbbU\xc0ҧ\xf5?\xb8~YN\x00\\\xf8c\xfd\xbf[[\xde\xee\x17n\xcbz\u007f\xca\b\x80\xa6\xc1\xd1\xd8\xdew\xe9\x1c(>\xa67\x0fW\x1eӞ#$\xe2\xc0U|\xecpf_ \xbdY\xff\x83l\xdaR\x03\xc0\xfaM~<p\xf0GE\x9f\x03 \xb3?\"\xf1\xcd?\xf3A\xad\xc1\x0f\xb8\xf2;\xed\xb9Ab\x046\xcb!p\xa4]\xed\x80\xde8Q\xff\x03m\xd2R\x02\xe0\xfc\x1f\x01\xf51\x8c\xa0\x92\xf4s/zjܒ~\t(!\x00\x9a\xf7\aҭ\xfa\xff\xbe\xbe\xdcp\xbc\xde\xe0wd\aZ\xe5s\xda\xf3\x82\xc4\x14d\xe4Lh^\x1f\xb02&\xb7\xbe\x15\x1b\x00\xe7_o\xf9\xe0\xdb9Hx\xab_\xf4,\xfa\xb8-2\x00\x9a\x0fD\xa8o\xf5[y\x8b\xf6y\xfes\xb5\xe7\x03\xb1\x048\xf244\xb7\x15^\x92\xd6\xff\xc0Wb1\x01pэ\xfa\u007f\xa7\xa6)\x0e\xff\xc8[\xd4\xdd\x01E\x04@\x98\x87\xff\x92\xc5ZC\x1fp\xa5\x13\x8e\xcc՞\a\xc4B\x90\x96w!+\u007fP;\xf8\x9b\x0f\xd2\xff\xf0\x97k\u007f\x01\xc0G\xfar\x93\x9f\xb8\xd8\xef\xfb\xd8O\x00\xb4|\x02\xa1\xfdٿ\xf9`\xbd៕?\xe3y>\xa6\x97(\x83\xcd2\x04\xaelW\xf9\x108\x02\xac\x9f\xac\xbf\x10\x94j_\x01\xc0\xe1\xbfS\xbe\x06\xb1\xb1\xcf㹏\x00h\x1a\x80P~\xf3_\u007f\x8a\xdecz\x1d\xe9@\xab\f\xd7^\xf7\t\xd9\x03d\xe5j8J\xdb\n\xbb\xfb\x00+\xa6\xea/\f\xc5\xda[\x00\x9c\xff#\xfd\xbfM]\x0e\xfeX\xda\xeb/\x01\xbd\x04@\xe3\xb0\xf0m\xf2\xb3\xfc\xde\xc2Z\xa3\xf3\xad\u007f\a\xb2r\xad\xf6:OH\x9f\xc0\x91%J\x1f\x90\x82\x8bW\xeb/\x14\xfd\xd9S\x00\\\xfa3\xfd\xbfKS\xde\xe3\x1f\u007fS=\xdd\xcd\xd1C\x004\rB\xa8n\xf5[\xbc\xb6\xb0e\xb9֚\xe6\xf01\xbd$B #\xfb\xc1U\xdcV\xb8q\xb0\xfe\xa2ї{\a\xc0%|\xa4/\x03\xc0\x12\xfb\v\x80\xe6\x83\x10\xaa\xe1\xdf8Lo\xf0g\xe5%<#\xff\xa3\xbd\x9e\x13R\x16\xd8$\xa3\xa1v}@\x15\xb0n\x8a\xfe\x02ғ\xbb\a\xc0y?\xb4{\xf8\xef\x1a\xfc\x16\xbf\x06\xb6\xb9\xc7vλ\x05@\xf3!\b\xcd\xc6_k\xcf\u05fb\xad/+ۑ\x95\xb1\xda\xeb7!F\x80+7\xc2Q\xdaVة\x02\x96=\xa6\xbf\xa0\xecnW\x00\x9c\xfb\u007f\xfa\u007f\x8b\xa6\xbc\xd5\xcf^w\x85\xdf\xce\x00h\n\xc97\xffe\xd3\x01G\xed<\u007f\x0eY\xb9E{\xbd&\xc4\x17\xa0\xf9\xd8\xe1\xcc\xfb\x80E\xcd\xfa\vL\xda+\x04\xc0\xb9?\xd4\xff;T\xe5\xb7~\xebM\xed\f\x80\x96\xfd\xa1?\xfc3@f?\xad\xc1\x0f\xf01\xbd\xc4\x06\xe0\xca\xc7\xe1\xc8kZ\x1f\xb4\xadk\xc6b\xe5/\xff\xa2\xeaƫ\xd6a\xe5/\xfe\xac\xfewh\xfa\xf7i\xcf\xf1\x9b?\x05\xd2\x0e\x90vu\xff\x86\x86Z\xbd\xc1\xefH;\x1a\xe5\x93\xda\xeb2!\x81\x82M\xf2y8:\xd7\a\xe4\x9d*\xdcz\xdd\xcdH$A\x03\xb7\x13?\xb8\xe8%\xe49\xfc\xa9\xb6\xab\xaf\xd5ܾw;Z\xe5\x8b\xda\xeb0!\xaa +\x0f\xa1\xf0\xe8\xca\xc0?\x84\x9dN\x15&OΆ`(\xda\xe3\x95g\xbf\x8aN\x0e\u007f\xaa\xe9\xd2yP|Lo'\xb2\xf2\xa8\xf6\xbaKH\xa8\x80+\xbfW\xfa@\xe2?\xab?\xaa>\x18m\xf0\xeas_\xd1_\xfc\xa9ݶ|Tk\xf0\x03\xae\xfcY{\x9d%$\xb4\xc0\x91C\x91\xd5y\xda`\xa7#Xr\xffWՇd\\\xbd\xf1\xb2\xff\xf0\x9b?\xd5s\xc3\x17\xf4\xb6\xef\xcdJ\aZd\x80\xf6\xfaJH$\x80+\xa7C\xe9\xb1\xc3y\xa7\n?\xbc\xfa>\xf5\x81\x19\x1f;\U00043bff\xc4\xe1Ou\\\xf9S\xdd\xc7\xf4\xb6\xca\xd9\xda\xeb)!\x91\x04\xaê\xe2c\x87'\x9d\xf0|\b\x06h\x94\xed\xc47\xcf\xe29\u007f\xaa\xe0\x92\xa5\x80\xf3\x0e\xad\xc1\x0f>\xa6\x97\x10\x03\xe0yy\x17\xb2\xf2\x82\xd6\a\xf9\x8f\xe9\x01!\x18\xa4\xd1\xf4\xd23_\xe3\xf0\xa7\xc1\xdbt\xa8\xde\xe0\xcfʿ\xb0Jޭ\xbdn\x12\x12+\x90\x91\x11P\xdaV\xb8\xd3\x11̼\xedb\xf5\x81\x1a%/8\xedu\xecX\x18\x82a@\xedq\xfd\x19\xba\xb7\xf55KR{\x9d$$\xd6\xc0\x95K\xe1\xea<v8\x9f\xa9\xc2U\x97\xccR\x1f\xae\xe1\xb6\x13\x17\x9d\xfe\x1ar\xf5!\x18\b\xd4\x0eWܡ9\xf8w #Wj\xaf\x8b\x84X\x05\x1cY\xa1\xf4\x81G{\xc3{Q3\xe1\xc5\x10\f۰ىo\x9e\xfd\xaa\xfe@\xa0v\xb8h#\x90y\xbf\xd6\xe0\a\\Y\xa3\xbd\x0e\x12b-\xc8\xca\a\xe1\xca\u007f\xb5\x16\x80֧G\x87`\xe8\x86\xc7\xef_\xf82\xcf\xf9\xd3`l\x1c\xae7\xf8\x1dy\r\x9e|H{\xfd#\x84\x88\b\x1c\x19\a\xa5\xeb\x03\xf2N\x15\xee\xfb\xe9\xf7Շ\xaf\xb6?\xb9\xec\xbf\x1c\xfe\xd4\u007f\xd7^\xa6\xfd\x98ޣ\xb5\xd7;BH\x0f\xc0\x91\xeb\xb4\x1e;\x9c\xcfT\xe1\xc2)K\xd5\a\xb1\x86\xdf\xe37\u007f\xea\xb7\xcb\x1f\x82\xe2\xf6\xbdyd\xe5z\xed\xf5\x8d\x10R\x04p\xc5QZ(\xf0ʺ\x0fahu\xbb\xfaP\x0e\xc6N|\xfbܗ\xf5\x87\x03\x8d\xb1.\xd0\xf2a\xad\xc1\x0f\xb8\xf2\x8c\xf6zF\b)\x114˧\xe0\xc8V\xad\x85cͣ_\b\xc1\x80\xf6\xff\x9b?\x9f\xeaG}s\xe3\x04\xbd\xed{\x1d\xe9\x80+\ai\xafc\x84\x90\n@VN\x84\xd6\xf5\x01\x99*\xdc\xf2\x83[\xd5\a\xb5\x1f\xdf\xfc\xbfq\xd6k\xd8\xc1[\xfd\xa8\x1f\xae\xfe\x81\xee\xf6\xbdY9Y{\xdd\"\x84\x18\x04\x8e\xd4A\xe9\xb1\xc3p\x05\xa7\x9c\xd2\x12\x82\xc1mf\xf8\x9f\xf5\x957\x90\xe7\xf0\xa7\xa6]\xfa\xb4\xd6\xd0\a\\\xe9\x84#\xf7j\xafS\x84\x10\x1fAV\xfe\xa8\xb5\xc8\xfck\xe5\x81!\x18\xe0\x95y\xee\xa9o\xe8\x0f\n\x1a?[\x0e\xd4\x1c\xfe/h\xafK\x84\x90\x80@F\x0e\x87\xe2\xb6©{NW\x1f\xe4\xe5x\xed\x05/s\x87?j\xd6\r_\xd4>\xcf?P{=\"\x84(\x00G\u0382\xe2c\x87\xaf\xbb\xeaA\xf5\xa1^\xac\xffw\xf1K\xfcٟ\x9as\xe5̀\xbb\x8f\xd6\xe0߁\xac\x9c\xab\xbd\xfe\x10BB\x00\xb2\xf2\xb8\xcaB\xe4\nv\xb4\xbc\x1d'|\xf1\xb7\xea\x03\xbe\xbfo\xfe\xbcϟ\x1aq\xc9\"\xc0y\x97\xce\xe0/\xf8\xa4\xf6zC\b\t\x19\xd8(\xef\x81+\xff\xd0Z\x98\x9e]0X}\xd0\xf7\xe4\xf7/|\x89ß\x9a\xb1)\xa19\xf8\xff\x8b\x8c\xbcW{\x9d!\x84\x84\x18\xdd\xc7\x0eW\xe1\xc9\xdb.Q\x1f\xfa]\xfe\xf0bn\xefK\r\xb8\xfel\xed\xed{\xf9\x98^BH\xf1\xa0U\xbe\x05\xc5\xc7\x0e_v\xe1|\xc5\xe1߉\xef\x9c\xff2\xcf\xf9\xd3\xca\\~\x8f\xde\xe0w$\aG\xae\xd5^G\b!\x11\x06\x8e,TY\xc0\\\xc1\xeb\x1bޏ\xf1\x13_\b|\xf8_t\xfa\xeb\xfaÃF\xd7\xc5k\x80\xcc~:\x83\xbf\xe0\"\xedu\x83\x10\x12\x13\xd0$\x1f\x86#\xedZ\vZffm`\x01p\xc6䭼Տ\x96o\xc3(\xbd\xc1\xef\xc8\x0e\xb8\xb2\xbf\xf6zA\b\x89!pe<\x14\x1f;|\xf7\x8d\xff\xe7\xeb7\xff\x8b\xcfx\r\x1d\vC0Dh\xf4\\s\x85\xe6\xcf\xfd\x1d\xd8$\xc7h\xaf\x0f\x84\x10\v\x80+?\x86Ҷ\u009dN\x15\xa6\x9c\xb9\xca\xf8\xf0\xbf\xe6\xbcW\xf8͟\x96\xee\xb2ǡ\xf8\x98\xdeN\xb8\xf23\xed\xf5\x80\x10b!pe\xbd\xd2\u0087\x17W}\f\xa3\x8ez\xd9H\x00|\xe7|>җ\x96\xe8\xa2F\xa0\xe5cZ\x83\x1fp\xa5I\xfb\xf3O\b\xb1\x1cd\xe4\xd3P\xdaM\xb0\xd3\x11\xac~\xf4Ċ\x86\xff\x8f.\xe1}\xfe\xb4D7\x1e\xa3\xb7}oV:\xd0 \x9f\xd1\xfe\xdc\x13B\xc8.\xd0*\x93\x91\xd5{\xec\xf0O\xbes\x17\x87?\xf5\xd7U7h?\xa6\xf7T\xed\xcf9!\x84\xf4\xca\xce\xc7\x0ek-\x92\xf8\xeaW3E\x9d\xf3\xff\xce\xf9\xdcޗ\x16\xe9\xd29\x80\xf3v\xb5c\x1a\x8eܧ\xfd\xb9&\x84\x90\xa2\x81+\xcfk-\x98\u007f[\xf6i\f\xa9\xde\xd6\xe7\x05\u007f\xeaC\x85F\xc0,\xd0\xfc)\xbd\xc1\xefʟ\x01\xa9\xd2\xfe,\x13BH\xc9\xc0\x91\x04\x14\xb7\x15^x\xf7\x99\xdd\x02\xe0\xe23^\xe3\xd5\xfe\xb4\u007f7\x9c\xa4\xf9s\xffv\xb4\xca \xed\xcf/!\x84T\f\\\xb9\x00\x8a\xdb\n\u007f\xe7\x9bӐHv\xe2\x8c\xc9[y\x9f?\xedە\xbf\xd6\u07be\xf7\x12\xed\xcf+!\x84\x18\a\xaeLW\xfaF\x85\xedM\xefC~qZ\u007f\xc0\xd0p\xbad1\x90\xf9\x80\xd67~ +3\xb5?\x9f\x84\x10\xe2+\xb8A\xf6\x81#\xaf\xa8-\xb4͟\xd6\x1f64\\6\u007fVo\xf0;Ҏ\xa7\xe4mڟKB\b\t\f\xb4\xca(d\xe5M\x9dE\xb7\nX{\x9e\xfe\u087a\xae\xbdH\xf7<\xbf+\xd5ڟCB\bQ\x03\xae|\x1b\xae\xe4t\x16\xe1*`\xc5T\xfdAD\x83u\xf9}Pܾ7\x0fW\xbe\xaf\xfd\xb9#\x84\x90\xd0\x00G\x96(-\xc8@\xcb\a\v\x8fp\xd5\x1eL\xd4_\x17\xad\x05Z>\xa25\xf8\x01GVj\u007f\xce\b!$\x94`\x93\xbc\x0f\x8eζ\xc2p\x04h\x1a\xa8?\xa4\xa8?6\x0e\xd6۾ב\x0e\xac\x97\xf7k\u007f\xbe\b!$\xf4\xa0E\x8e\x83\xd2\xf3\x05\xe0T\x01k\xae\xd6\x1fXԌ\xab\xbf\xab\xbb}\xaf#\x9f\xd7\xfe<\x11BH\xe4@VnBᑧ\n\x8bw\x15\xb0\xecQ\xfd\x01F\xcbs\xd9t\xdd\xed{\xb3r\x8b\xf6\xe7\x87\x10B\"\x0f\\iQ[\xc8[>Zx\xf4\xab\xf6@\xa3Ź\xa8\th>Po\xf0\xbbҪ\xfdy!\x84\x90X\x81\xcd\xf21\xb8Z\xb7\r\n\xd00F\u007f\xb8Ѿm\xa8\xd1;\xcf\xef\xcavd\xe4@\xed\xcf\t!\x84\xc4\x16\xb8r\n4\xaf\x0fX\xf5#\xfdAG\xf7tՍ\x9a\xe7\xf9w\xc0\x95ӵ?\x17\x84\x10b\rp\xe5~\xa5\x05\x1fp\xde\t,\x9d\xab?\xf8lw\xc9\x02 \xf3^\xad\xc1\x0f8\xf2\xb0\xf6\xe7\x80\x10B\xac\x04\"Up\xe5Oj\x03\xa0\xf9\x13@:\xab?\b\xad3[\xd8\xd2Y\xeb}\xcf\xca\v\xb8A\xf6\xd1>\xfe\t!\xc4z\xd0\"\a\xebm+,\xc0\xc6\xe3C0\x14-q×t\xcf\xf3;r\xa8\xf6\xf1N\b!d/\x90\x95\x8b\xa0\xf4\xd8a8U\xc0\xca[\xf5\ad\\]\xf1\x1b\xcd\xf3\xfc9\xb8\xf2\r\xed\xe3\x9b\x10BH?\xc0\x95YJ\x83\x02ȼ\x1fX\xb2T\u007f`\xc6\xc5\xc5ˀ\xcc\a\xb5\x06?\x90\x95\xb9\xda\xc73!\x84\x90\x12\xc0*y;\x1cyCmp4\x1f\xac?<\xa3n\xd3az\x83ߑ\x1d\xc8\xc8;\xb4\x8fcB\b!e\x82gd0\x1cٮ3D\xaa\x80ug\xe8\x0fҨ\xb9\xee\\\xdd\xc7\xf4fe\x98\xf6qK\b!\xc4\x10p\xe4:\x14\x1eŪ0T\xaa\x80\xe5\xf7\xeb\x0fְ\xbb\xfca(>\xa6\xb7\x13\x8e\\\xaf}\x9c\x12B\b\xf1\t\xb8\xb2\\i\xc0\x00-\x1f\xe6c\x87{r\xf1\xba\u0096\xcbZ\xef\x8b+k\xb4\x8fKB\b!\x01\x80g\xe5\xfdP\xdbMP\x80\xa6!\xfaC7,6\rӼ\xad\xaf\x03\x19\xd9O\xfbx$\x84\x10\x120\xc8\xcaX\xb8\x8a\xd7\a\xac\xfd\x86\xfe\x00\xd6r͕\xba\x8f\xe9u\xe5(\xed\xe3\x8f\x10B\x882p\xe5WJ\x83\bp\xde\x06,{B\u007f \a\xe5ҧ\n[)k\xbdޮܮ}\xbc\x11B\b\t\x11\x80T!+\xae\xda`j\xfeD\xe1Q\xb6\xda\x03\xda/\x175io\xdf\xdb\x06p\xfb^B\b!\xbd\x00O\x0eлmP\x80\x86j\xfdamڍG\xebn\u07fb\x85\x8f\xe9%\x84\x10R$p\xe5$h](\xe8V\x01\xab\u007f\xa8?\xb8+uՏ\xa1x[\xdf\x0e\xb8\xf2U\xed\xe3\x88\x10BHD\x81#\x0f*\r0 \xf3\x1e`\xe9|\xfdA^\xaaK\xea\x81̾Z\x83\x1fp\xe5Q\xed\xe3\x86\x10BH\f\xc0S\xf268\xf2/\xb5\x81\xd6\xfc\x19D\xe3\xb1í@\xf3!z\x83ߑ\x97\xb1Jޮ}\xbc\x10B\b\x89\x19\xc8\xc8!\xaa\x8f\x1d^\xff\xc5\x10\f\xf9^\\\xff\x15\xed\xed{\x0f\xd3>>\b!\x84\xc4\x1c8r\x1e\xb4\xb6\x15v\xaa\x80\x95\xbf\xd0\x1f\xf8]\xae\xfc\xb5\xe6\xe0\xef\x84#\x17i\x1f\x0f\x84\x10B,\x03\xae\xccV\x1a|\x85m\x855\x1f;\xbcx\xb9\xf6\xf6\xbd\v\xb4\xdf\u007fB\b!\x16\x03\xc8\xdb\xe1h\xdd- @\xd3!\xc1\x0f\xff\xa6\xcf\xe9\r~G:\xf8\x98^B\b!\xa1\x01\xad2\x1c\xaa\xdb\n_\xe0\xff\xe0_{\xa9\xde\xcf\xfdYَgd\xa4\xf6\xfbL\b!\x84\xf4\b\\\xb9F\xed۱[\x05\xac\x98j~\xf0/\xbf\xb7\xb0e\xb1ֿ++\xd7j\xbf\xaf\x84\x10BHQ\xc0\x95\xf5j\x03\xb3\xe5\x80\xc2#v+\x1d\xfc\x8b\xd6\x17\xb6(\xd6\x1b\xfcM\x80Ti\xbf\x97\x84\x10BHI\xa0Q>\x00W\xf1\xb6\xc1J\x1e;ܨ\xfa\x98\xde\xed|L/!\x84\x90ȃ\xac\x1c\r\xadm\x85\x9d*`\xf5\xb7\x8a\x1f\xfc\xab\xafռ\xado\a\\9V\xfb\xfd\"\x84\x10B\x8c\x02WnT\x1a\xac\x80\xf3n`٣\xbd\x0f\xfeeӀ\xcc\xfb\xb4\x06?\xe0\xcaϵ\xdf\x1fB\b!\xc47p\x83\xec\x03G\x9eU\x1b\xb4͟\x04\x165\xefv\x9e\xbf\x05h>Ho\xf0g\xe5\x0fxJަ\xfd\xbe\x10B\b!\x81\x80\xf5\xf2qh^\x1f\xb0q\"\xb0\xe1\x04\xdd\xf3\xfc\x1b\xe5\x13\xda\xef\x03!\x84\x10\xa2\x02\x1c\x99\fGrj\xdf\xc0\x837\x0fGN\xd3~\xdd\t!\x84\x90P\x00G\xee\t\xc1p\xf6\xdb\a\xb4_gB\b!$t\x00\xf26\xb8\xf2\xdf\x10\fjӾ\x04\xf01\xbd\x84\x10BH\x9f\xa0I>\a\xadm\x85M\xeaH\a6\xc9\x00\xedד\x10B\b\x89\x14p\xe5Bd\x95\x1e;\\\xb9\x97j\xbf~\x84\x10BH\xa4\x81#3C0Ћ\xfd\xd6?G\xfb\xf5\"\x84\x10Bb\x032\xf2\x0edC}Z\xa0\x03\x9e\xbcS\xfbu\"\x84\x10Bb\t\x9e\x91\x91!\v\x81\x0e\xb4IR\xfbu!\x84\x10B\xac\x00Y\xf9_\xf5៑\x1fi\xbf\x0e\x84\x10B\x88u@\xa4\nYY\xa60\xfc\xd7\xe0\x06\xd9G\xfb\xdfO\b!\x84X\r\xfe \xfb\xc1\t\xe4\xb4\xc0v\xfcI>\xa8\xfd\xef%\x84\x10B\xc8n\xa0U&\xa0\xf0H]\xb3\x83ߑ\x1cZe\x92\xf6\xbf\x8f\x10B\b!}\x80\xac\xdcbl\xf8g\xe5\xd7\xda\xff\x1eB\b!\x84\x14\xc9\xcem\x85\xb3\x15|\xeb\xf7\xb8}/!\x84\x10\x12Q\xe0\xca\xc7Qʶ\xc2YَV>\xa6\x97\x10B\b\x89\x05p\xe5\f\xb8}<v\xb8\xb0\xe5\xf0\x14\xed\xbf\x93\x10B\b!>\x00G\xee\xeb\xe1\xe7\xfe\x87\xb5\xff.B\b!\x84\xf8\f o\x87#\u007f\x81+\u007fEFޡ\xfd\xf7\x10B\x82\xe7\xff\x03#\x94\xff00\xd1\xddr\x00\x00\x00\x00IEND\xaeB`\x82"
@@ -26,8 +26,15 @@ var _Assetsd13d7a53abf40be3e0fd76e8a51bb4835b035bb0 = "\x89PNG\r\n\x1a\n\x00\x00
 var _Assets0d77b2008fc5e76d7d489317fc8f0df507d753c5 = "\x00\x00\x01\x00\x03\x00\x10\x10\x00\x00\x01\x00 \x00h\x04\x00\x006\x00\x00\x00  \x00\x00\x01\x00 \x00(\x11\x00\x00\x9e\x04\x00\x0000\x00\x00\x01\x00 \x00h&\x00\x00\xc6\x15\x00\x00(\x00\x00\x00\x10\x00\x00\x00 \x00\x00\x00\x01\x00 \x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xca\xff'.\xc8\xfe\xb5.\xc8\xfe\xb5\x00\xca\xff'\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcc\xff\x0f\x1b\xbd\xeb\x8dy\xbf\xfe\xf9ж\xff\xffж\xff\xffy\xbf\xfe\xf9\x17\xca\xfe\x8d\x00\xcc\xff\x0f\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\x02\x05\xcd\xfffl\x94\xb3\xe9\xe6KK\xff\xe8v\x97\xffԵ\xff\xffն\xff\xffն\xff\xff÷\xff\xff]\xc2\xfe\xe9\x05\xcd\xfff\x00\xff\xff\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xca\xff?\x1b\xa1\xfeϭ\xba\xff\xffͮ\xff\xff\x84i\xff\xffԵ\xff\xffԵ\xff\xffԶ\xff\xffԵ\xff\xffԵ\xff\xff[\xc3\xff\xff\xac\xba\xff\xffB\xc6\xfe\xcf\x00\xce\xff?\x00\x00\x00\x00\x00\x00\x00\x00\x1b\x8b\xfa\xedS3\xf4\xffׯ\xf4\xffΨ\xf4\xff</\xff\xff\x82q\xff\xff˷\xff\xffʶ\xfe\xffԵ\xfe\xffն\xff\xff\f\xcc\xff\xffN\xc4\xff\xffҶ\xff\xffR\xc4\xfe\xee\x00\x00\x00\x00\x00\x00\x00\x00t\x8f\xa9\xed\xff5)\xff\xff5)\xff\xf2<3\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xcd\xfe\xff\x85}\x8e\xffֲ\xf9\xff\x8bo\xff\xff\x04\xc4\xff\xff\x00\xce\xff\xffN\xc4\xff\xff`\xc1\xfe\xee\x00\x00\x00\x00\x00\x00\x00\x00b\xc2\xfe\xed\xecl\x85\xff\xff5)\xff\xf2<3\xff\x00\xce\xff\xff\x00\xcd\xfe\xff\x85}\x8e\xff\xff5)\xff\x8dm\xf9\xff@(\xff\xff\x06\xc2\xfc\xff\x02\xcc\xfc\xff\x02\xcc\xfc\xff\n\xcb\xfd\xee\x00\x00\x00\x00\x00\x00\x00\x00b\xc2\xfe\xedյ\xfe\xff\xecl\x85\xff\xf2<3\xff\x00\xcd\xff\xff#u\xfc\xff\xff5)\xff\xff5)\xffֲ\xf9\xffն\xff\xff\xfc<5\xff\xff5)\xff\xff5)\xffs\x8c\xa6\xee\x00\x00\x00\x00\x00\x00\x00\x00b\xc2\xfe\xedն\xff\xffյ\xfe\xff\xdfs\x8f\xff!u\xff\xffA'\xfc\xff\xff5)\xff\xff5)\xffֲ\xf9\xffն\xff\xff\xfc<5\xff\xff5)\xff\xedg}\xffb\xc0\xfc\xee\x00\x00\x00\x00\x00\x00\x00\x00b\xc2\xfe\xedն\xff\xffԵ\xff\xff\x85j\xfe\xff?'\xff\xffA'\xfc\xff\xff5)\xff\xff5)\xff\xa21\x94\xffB*\xff\xff\xf345\xff\xedg}\xffյ\xfd\xffb\xc1\xfe\xee\x00\x00\x00\x00\x00\x00\x00\x00b\xc2\xfe\xedԵ\xff\xff\x85j\xff\xff?'\xff\xff?'\xff\xffA'\xfc\xff\xff5)\xff\xff5)\xff\xfe5)\xff\x9e.\x94\xff\xe2f\x8a\xffյ\xfd\xffն\xff\xffb\xc1\xfe\xee\x00\x00\x00\x00\x00\x00\x00\x00R\xc4\xfe\xedԵ\xff\xffˬ\xff\xff˭\xff\xffY\xb9\xff\xff\x06\xc3\xfe\xff\u05ee\xf2\xff\u05ee\xf2\xffM*\xf2\xff\x84_\xf2\xffԵ\xfe\xffն\xff\xffԶ\xff\xffR\xc4\xfe\xee\x00\x00\x00\x00\x00\x00\x00\x00\x00\xca\xff?A\xc5\xfeϭ\xb9\xff\xffԵ\xff\xffӶ\xff\xffX\xc3\xff\xffն\xff\xffն\xff\xff}b\xff\xffԵ\xff\xffն\xff\xff\xad\xba\xff\xffA\xc5\xfe\xcf\x00\xca\xff?\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\x02\x05\xca\xfff^\xc2\xfe\xe9\xd9q\x8b\xff\xfa@<\xff\xfb@<\xff\xe9s\x90\xffԵ\xfe\xff÷\xff\xff^\xc2\xfe\xe9\x05\xca\xfff\x00\xff\xff\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcc\xff\x0f\x17\xc8\xfb\x8d\x90x\x88\xf9\xe8i\x80\xffе\xfd\xffx\xbf\xfe\xf9\x17\xca\xfe\x8d\x00\xcc\xff\x0f\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xd0\xff&.\xc7\xfe\xb4.\xc7\xfe\xb4\x00\xca\xff'\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00(\x00\x00\x00 \x00\x00\x00@\x00\x00\x00\x01\x00 \x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xca\xff'\x00\xcd\xfe\xb5\x00\xcd\xfe\xb5\x00\xca\xff'\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcc\xff\x0f\x00\xcc\xff\x8e\x0e\xcc\xfe\xf9x\xbf\xff\xffx\xbf\xff\xff\x0e\xcc\xfe\xf9\x00\xcc\xff\x8e\x00\xbf\xff\x10\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\x02\x00\xcd\xfff\x02\xcd\xfe\xe9W\xc3\xff\xffķ\xff\xffն\xff\xffն\xff\xffķ\xff\xffW\xc3\xff\xff\x02\xcd\xfe\xe9\x00\xcd\xfff\x00\xff\xff\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff?\x00\xcd\xfe\xcf?\xac\xd2\xff\xaf\xb8\xfd\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff\xaf\xba\xff\xff6\xc7\xff\xff\x00\xcd\xfe\xcf\x00\xce\xff?\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcf\xff \x00\xcf\xff\xaa!\xb9\xe2\xfe\xadel\xff\xfe5)\xff\xeedx\xffմ\xfd\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffԶ\xff\xff\x90\xbd\xff\xff\x1c\xca\xfe\xfe\x00\xcd\xfe\xab\x00\xcf\xff \x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xd0\xfe\v\x00\xcc\xfe\x84\n\xcc\xfd\xf6~\x92\xb2\xff\xeeMS\xff\xf7JM\xff\xf7JM\xff\xf7JM\xff\xe7y\x9c\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xff˶\xff\xffp\xc0\xff\xff\n\xcc\xfe\xf6\x00\xcc\xfe\x84\x00\xd0\xfe\v\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xff\x01\x00\xcd\xfe\\\x01\xcd\xfe\xe4N\xc4\xff\xff\xbf\xb8\xff\xffն\xff\xffŧ\xff\xff\x80d\xff\xffԵ\xff\xffն\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffQ\xc4\xff\xffͶ\xff\xff\xbf\xb8\xff\xffO\xc4\xff\xff\x01\xcd\xfe\xe4\x00\xcd\xfe\\\x00\x00\xff\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcb\xff6\x00\xcd\xfe\xc7\r\xa8\xff\xffKb\xff\xffն\xff\xffն\xff\xffն\xff\xffŧ\xff\xff?'\xff\xff\u007fd\xff\xffԵ\xff\xffն\xff\xffն\xff\xffԵ\xff\xffԶ\xff\xffն\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffն\xff\xff\x1a\xcb\xff\xff7\xc7\xff\xffͶ\xff\xffն\xff\xff\xa8\xba\xff\xff/\xc8\xff\xff\x00\xcd\xfe\xc7\x00\xcf\xff6\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfe\xdc$j\xff\xff>'\xff\xffX?\xff\xffն\xff\xffն\xff\xffն\xff\xffŧ\xff\xff?'\xff\xff?'\xff\xff\x80d\xff\xffԵ\xff\xffն\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffԶ\xff\xffԵ\xff\xffն\xff\xffն\xff\xff\x1a\xcb\xff\xff\x00\xce\xff\xff7\xc7\xff\xffͶ\xff\xffն\xff\xffԶ\xff\xff}\xbf\xff\xff\x00\xcd\xfe\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfe\xdcF=\xed\xffQ(\xeb\xffh>\xea\xff٩\xe9\xff٩\xe9\xff٩\xe9\xffȝ\xeb\xff:7\xff\xff:7\xff\xff:7\xff\xff{t\xff\xff\xc1\xb8\xff\xff\xc1\xb8\xff\xff\xc1\xb8\xff\xff\xc1\xb7\xfe\xffԵ\xfe\xffն\xff\xffն\xff\xffն\xff\xff\x19\xca\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff7\xc7\xff\xff̶\xff\xffն\xff\xff\xb9\xb9\xff\xff\x00\xcd\xfe\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfe\xdc\xdeHD\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xe5D>\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xcd\xfe\xff\x8cy\x89\xffׯ\xf4\xffն\xff\xffն\xff\xff\x8dq\xff\xff\b\xba\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff7\xc7\xff\xffͶ\xff\xff\xb9\xb9\xff\xff\x00\xcd\xfe\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfe\xdc\xd2j}\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xe5D>\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xcd\xfe\xff\x8cy\x89\xff\xff5)\xffׯ\xf4\xffն\xff\xff\x8cq\xff\xff?'\xff\xff\b\xba\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff7\xc7\xff\xff\xb1\xb9\xff\xff\x00\xcd\xfe\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfeܹ\xb7\xfd\xff\xeedw\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xe5D>\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xcd\xfe\xff\x8cy\x89\xff\xff5)\xff\xff5)\xffׯ\xf4\xff\x8cp\xff\xff?'\xff\xff?'\xff\xff\b\xba\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff&\xc9\xff\xff\x00\xcd\xfe\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfeܹ\xb9\xff\xffմ\xfc\xff\xeedx\xff\xff5)\xff\xff5)\xff\xff5)\xff\xe5D>\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xcd\xfe\xff\x8by\x8a\xff\xff5)\xff\xff5)\xff\xff5)\xff\x92m\xf4\xffB*\xff\xffB*\xff\xffB*\xff\xff\r\xb7\xfb\xff\x05\xca\xfa\xff\x05\xca\xfa\xff\x05\xca\xfa\xff\x05\xca\xfa\xff\x05\xca\xfa\xff\x04\xcb\xfb\xff\x00\xcd\xfe\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfeܹ\xb9\xff\xffն\xff\xffմ\xfc\xff\xeedx\xff\xff5)\xff\xff5)\xff\xe5D>\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xcd\xff\xff'r\xfa\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xffׯ\xf4\xffն\xff\xffն\xff\xffն\xff\xff\xfaDB\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xdeHD\xff\x00\xcd\xfe\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfeܹ\xb9\xff\xffն\xff\xffն\xff\xffմ\xfc\xff\xeedw\xff\xff5)\xff\xe5D>\xff\x00\xce\xff\xff\x00\xcd\xff\xff#q\xff\xffC'\xfa\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xffׯ\xf4\xffն\xff\xffն\xff\xffն\xff\xff\xfaDB\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xd5cp\xff\x00\xcd\xfe\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfeܹ\xb9\xff\xffն\xff\xffն\xff\xffն\xff\xffմ\xfd\xff\xeedx\xff\xe5D>\xff\x00\xcd\xff\xff#q\xff\xff?'\xff\xffC'\xfa\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xffׯ\xf4\xffն\xff\xffն\xff\xffն\xff\xff\xfaDB\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xf1[i\xff\xba\xb5\xf9\xff\x00\xcd\xfe\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfeܹ\xb9\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffմ\xfc\xff\xd5s\x8d\xff\"r\xff\xff?'\xff\xff?'\xff\xffC'\xfa\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xffׯ\xf4\xffն\xff\xffն\xff\xffն\xff\xff\xfaDB\xff\xff5)\xff\xff5)\xff\xff5)\xff\xf1[i\xffղ\xf9\xff\xb9\xb9\xff\xff\x00\xcd\xfe\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfeܹ\xb9\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xff\x82g\xfe\xff?'\xff\xff?'\xff\xff?'\xff\xffC'\xfa\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xa55\x95\xffF.\xff\xffF.\xff\xffF.\xff\xff\xe94B\xff\xff5)\xff\xff5)\xff\xf1[i\xffղ\xf9\xffն\xff\xff\xb9\xb9\xff\xff\x00\xcd\xfe\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfeܹ\xb9\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xff\x82g\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xffC'\xfa\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\x9e.\x95\xff?'\xff\xff?'\xff\xff\xe83C\xff\xff5)\xff\xf1[i\xffղ\xf9\xffն\xff\xffն\xff\xff\xb9\xb9\xff\xff\x00\xcd\xfe\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfeܹ\xb9\xff\xffն\xff\xffն\xff\xffԵ\xff\xff\x82g\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xffC'\xfa\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xfe5)\xff\x9e.\x95\xff?'\xff\xff\xe83C\xff\xf1[i\xffղ\xf9\xffն\xff\xffն\xff\xffն\xff\xff\xb9\xb9\xff\xff\x00\xcd\xfe\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfeܹ\xb9\xff\xffն\xff\xffԵ\xff\xff\x82g\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xffC'\xfa\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\x9e.\x96\xff\xdaZ\x83\xffղ\xf9\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff\xb9\xb9\xff\xff\x00\xcd\xfe\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfeܹ\xb9\xff\xffն\xff\xffӴ\xff\xff¤\xff\xffä\xff\xffä\xff\xff¤\xff\xffK\xb1\xff\xff\b\xb9\xff\xff\b\xb9\xff\xff\r\xb8\xfe\xff٦\xe5\xff٦\xe5\xff٦\xe5\xff٦\xe5\xff[.\xe6\xffU)\xe6\xffU)\xe6\xff\x82S\xe6\xffӲ\xfc\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff\xb9\xb9\xff\xff\x00\xcd\xfe\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfe\xdc}\xbf\xff\xffԶ\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffж\xff\xffC\xc5\xff\xff\x00\xce\xff\xff\x06\xcd\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffF-\xff\xff?'\xff\xffkQ\xff\xffѲ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffԶ\xff\xff}\xbf\xff\xff\x00\xcd\xfe\xdd\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff5\x00\xce\xfe\xc6.\xc8\xff\xff\xa7\xba\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffж\xff\xffC\xc5\xff\xff\x06\xcd\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffF-\xff\xffkQ\xff\xffѲ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff\xa7\xba\xff\xff.\xc8\xff\xff\x00\xce\xfe\xc6\x00\xcb\xff6\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xff\x01\x00\xcc\xff[\x01\xcd\xfe\xe3N\xc4\xff\xff\xbf\xb8\xff\xffԵ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffж\xff\xffI\xc4\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffsX\xff\xffѲ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff\xbf\xb8\xff\xffN\xc4\xff\xff\x01\xcd\xfe\xe3\x00\xcf\xff[\x00\x00\xff\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xd0\xfe\v\x00\xcc\xfe\x83\n\xcc\xfe\xf6o\xc1\xff\xffε\xfe\xff\xebm\x88\xff\xf7LP\xff\xf7LP\xff\xf3LP\xff\xf7LP\xff\xf7LP\xff\xf7LP\xff\xe9s\x90\xffҳ\xfe\xffն\xff\xffն\xff\xffն\xff\xffζ\xff\xffo\xc0\xff\xff\n\xcc\xfe\xf6\x00\xcc\xfe\x83\x00\xd0\xfe\v\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc7\xff \x00\xcd\xff\xaa\x1b\xca\xfe\xfe\x91\xb8\xf6\xff\xf3V`\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xf1[i\xffղ\xf9\xffն\xff\xffն\xff\xffԶ\xff\xff\x90\xbd\xff\xff\x1b\xca\xfe\xfe\x00\xcd\xff\xaa\x00\xc7\xff \x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcd\xff>\x00\xcd\xfe\xcf7\xc2\xf7\xff\xcc[a\xff\xff5)\xff\xff5)\xff\xf1[i\xffղ\xf9\xffն\xff\xffն\xff\xff\xae\xba\xff\xff6\xc7\xff\xff\x00\xcd\xfe\xcf\x00\xcd\xff>\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\x02\x00\xcf\xffe\x03\xcb\xfb\xe9h\x8e\xa6\xff\xdeev\xffղ\xf9\xffն\xff\xffķ\xff\xffV\xc3\xff\xff\x02\xcd\xfe\xe9\x00\xcd\xfff\x00\xff\xff\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcc\xff\x0f\x00\xcf\xfe\x8c\r\xcb\xfe\xf9w\xc0\xff\xffx\xbf\xff\xff\r\xcc\xfe\xf9\x00\xce\xfe\x8d\x00\xcc\xff\x0f\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xd0\xff&\x00\xcd\xfe\xb4\x00\xcd\xfe\xb4\x00\xd0\xff&\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00(\x00\x00\x000\x00\x00\x00`\x00\x00\x00\x01\x00 \x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcc\xff\x05\x00\xcd\xff4\x00\xcc\xff\xad\x00\xcd\xfe\xb5\x00\xcd\xff4\x00\xb6\xff\a\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcd\xff)\x00\xcd\xfe\x86\x00\xcd\xfe\xe1$\xc8\xfe\xfe'\xc8\xfe\xfe\x00\xcd\xfe\xe1\x00\xce\xfe\x8a\x00\xd0\xff,\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xd4\xff\x06\x00\xcd\xfev\x00\xce\xfe\xe1\x06\xcd\xfe\xfce\xc1\xff\xff\xbd\xb8\xff\xff\xc0\xb8\xff\xfff\xc1\xff\xff\t\xcd\xfe\xfc\x00\xce\xfe\xe5\x00\xcb\xfew\x00\xcc\xff\n\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc4\xfe\r\x00\xce\xff?\x00\xce\xff\xc2\v\xcc\xfe\xfe6\xc7\xff\xff\xa5\xba\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff\xa9\xb9\xff\xff9\xc6\xff\xff\v\xcc\xfe\xfe\x00\xcd\xfe\xc7\x00\xcc\xffB\x00\xc4\xfe\r\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcc\xff7\x00\xcd\xff\x9c\x00\xcd\xff\xf00\xc8\xff\xff\x84\xbe\xff\xffɶ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff̶\xff\xff\x84\xbe\xff\xff4\xc7\xff\xff\x00\xcd\xfe\xf3\x00\xcd\xff\x9c\x00\xcc\xff<\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xd2\xfe\x17\x00\xcb\xfe\x96\x00\xce\xfe\xec\x19\xbd\xe8\xfd\x93\x83\x9b\xffǳ\xf8\xffӶ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffӶ\xff\xffǷ\xff\xff\x86\xbd\xff\xff\x15\xca\xff\xfd\x00\xce\xfe\xed\x00\xce\xfe\x9e\x00\xc9\xff\x18\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xd4\xfe\x12\x00\xce\xffO\x00\xcd\xfd\xd8\x13\xc1\xee\xfeR\x9b\xb8\xff\xdaJG\xff\xfe5)\xff\xf2Yf\xffت\xec\xffյ\xfe\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffԶ\xff\xff\xb6\xb9\xff\xffK\xc5\xff\xff\x11\xcb\xfe\xfe\x00\xcd\xfe\xd8\x00\xcd\xfeW\x00\xcc\xff\x14\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcc\xff\x05\x00\xcd\xffM\x00\xcd\xfe\xae\x05\xca\xf9\xf9P\x9d\xbb\xff\xb0cj\xff\xfa7,\xff\xff5)\xff\xff5)\xff\xfe7,\xff\xeedx\xffت\xeb\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffѶ\xff\xff\x97\xbc\xff\xffB\xc6\xff\xff\x06\xcc\xff\xfa\x00\xcd\xfe\xb3\x00\xd0\xffM\x00\xda\xff\a\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcf\xff \x00\xcd\xfe\xae\x00\xcd\xfe\xf2\x1d\xc7\xfa\xfe\xa4\x87\xa7\xff\xe5ar\xff\xecVh\xff\xf1Yh\xff\xf1Zh\xff\xf1Zh\xff\xf1Zh\xff\xf1\\k\xff\xe5\u007f\xa5\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xff̶\xff\xffʷ\xff\xff\x97\xbc\xff\xff\x1f\xc8\xfe\xfe\x00\xcd\xfe\xf2\x00\xce\xff\xb2\x00\xcc\xff#\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcb\xfe\x19\x00\xce\xffs\x00\xce\xfe\xec\x16\xcb\xff\xffb\xc1\xff\xffǷ\xff\xffն\xff\xffն\xff\xff\xbb\x9d\xff\xffw\\\xff\xffˬ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffԵ\xff\xffO\xc4\xff\xff\xb2\xb9\xff\xffӶ\xff\xff˶\xff\xffb\xc1\xff\xff\x18\xca\xff\xff\x00\xce\xff\xf1\x00\xce\xffs\x00\xc8\xfe\x1c\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc6\xfe\x12\x00\xcb\xfe_\x00\xce\xfe\xbe\x04\xc0\xff\xfa-\xa2\xff\xff\xa1\xbb\xff\xffѶ\xff\xffն\xff\xffն\xff\xffն\xff\xff\xbb\x9d\xff\xffB*\xff\xff\x80d\xff\xffˬ\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffԵ\xff\xff.\xc8\xff\xff7\xc7\xff\xff\xb3\xb9\xff\xffն\xff\xffҶ\xff\xff\xa4\xba\xff\xffU\xc3\xff\xff\x10\xcc\xff\xfa\x00\xcd\xfe\xc3\x00\xcc\xffd\x00\xc6\xfe\x12\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xc9\xff0\x00\xce\xff\xc2\x00\xce\xfe\xf6\f\xab\xff\xff0L\xff\xfffS\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff\xbb\x9d\xff\xff?'\xff\xffB*\xff\xffv\\\xff\xffӴ\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffԶ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffԵ\xff\xffն\xff\xff-\xc8\xff\xff\x01\xcd\xff\xff!\xc9\xff\xff\xbc\xb8\xff\xffն\xff\xffն\xff\xff϶\xff\xff\xa5\xbb\xff\xff1\xc7\xff\xff\x00\xce\xfe\xf7\x00\xcd\xfe\xc3\x00\xcc\xff8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2\x05\xbd\xff\xff\"o\xff\xff=(\xff\xff?'\xff\xffhN\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff\xbb\x9d\xff\xff?'\xff\xff?'\xff\xff?'\xff\xffw\\\xff\xffˬ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffԵ\xff\xffԶ\xff\xffԵ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xff-\xc8\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff!\xc9\xff\xff\xb3\xb9\xff\xffӶ\xff\xffն\xff\xffն\xff\xffҶ\xff\xff~\xbe\xff\xff\x14\xcb\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2\x16\x90\xff\xff;/\xff\xff?'\xff\xff?'\xff\xffhN\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff\xbb\x9d\xff\xff?'\xff\xff?'\xff\xff?'\xff\xffB*\xff\xff\x80d\xff\xffˬ\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffԵ\xff\xffԵ\xff\xffԵ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xff-\xc8\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x01\xcd\xff\xff7\xc7\xff\xff\xb2\xb9\xff\xffն\xff\xffն\xff\xffն\xff\xffͶ\xff\xffN\xc5\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2#\x90\xf1\xffZ1\xdd\xff_)\xdc\xff_)\xdc\xff\x82J\xdb\xffܟ\xd9\xffܟ\xd9\xffܟ\xd9\xffܟ\xd9\xffܟ\xd9\xff\xbf\x8f\xdf\xff6C\xff\xff6C\xff\xff6C\xff\xff6C\xff\xff9F\xff\xffnx\xff\xff\xb2\xb9\xff\xff\xb2\xb9\xff\xff\xb2\xb9\xff\xff\xb2\xb9\xff\xff\xb2\xb9\xff\xff\xb3\xb7\xfd\xffԳ\xfc\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff+\xc6\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x01\xcd\xff\xff\"\xc9\xff\xff\xbb\xb8\xff\xffն\xff\xffն\xff\xffζ\xff\xffO\xc5\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2_\x94\xaf\xff\xf2<3\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xd5NK\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xcd\xfe\xff\x0e\xc4\xf2\xff\x90w\x85\xffت\xec\xffն\xff\xffն\xff\xffն\xff\xffί\xff\xff\x8er\xff\xff\x0e\xab\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\"\xc9\xff\xff\xb2\xb9\xff\xffӶ\xff\xffζ\xff\xffO\xc5\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2]\x98\xb4\xff\xf2=5\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xd5NK\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\n\xc7\xf6\xff\x8cy\x89\xff\xf5:1\xffت\xec\xffն\xff\xffն\xff\xffб\xff\xff\x8dq\xff\xffF.\xff\xff\x0e\xab\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x01\xcd\xff\xff7\xc7\xff\xff\xb3\xb9\xff\xffζ\xff\xffO\xc5\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2Q\xbd\xf2\xff\xe5br\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xd5NK\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xcd\xfe\xff\x93u\x84\xff\xf88.\xff\xff5)\xffت\xec\xffն\xff\xffն\xff\xff\x8dr\xff\xffD+\xff\xff?'\xff\xff\x0e\xab\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x01\xcd\xff\xff!\xc9\xff\xff\xb5\xb9\xff\xffO\xc5\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2O\xc5\xff\xff˳\xf9\xff\xef`r\xff\xfd8.\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xd5NK\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xcd\xfe\xff\x0e\xc4\xf2\xff\x91v\x85\xff\xff5)\xff\xff5)\xff\xff5)\xffت\xec\xffί\xff\xff\x8dq\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff\x0e\xab\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x1b\xca\xff\xff3\xc7\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2O\xc5\xff\xffʷ\xff\xff\u05ed\xf1\xff\xeedw\xff\xfd8.\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xd5NK\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\n\xc7\xf6\xff\x8cy\x89\xff\xf5:1\xff\xff5)\xff\xff5)\xff\xff5)\xffԦ\xec\xff\x8dq\xff\xffF.\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff\x0e\xab\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xcd\xff\xff\x01\xcd\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2O\xc5\xff\xffʷ\xff\xffյ\xfe\xff\u05ed\xf0\xff\xefas\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xd5NK\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xcd\xfe\xff\x91v\x85\xff\xf88.\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\x97k\xec\xffI1\xff\xffD,\xff\xffD,\xff\xffD,\xff\xffD,\xff\xff\x16\xa7\xf8\xff\b\xc7\xf7\xff\b\xc7\xf7\xff\b\xc7\xf7\xff\b\xc7\xf7\xff\b\xc7\xf7\xff\b\xc7\xf7\xff\b\xc7\xf7\xff\b\xc7\xf7\xff\b\xc8\xf7\xff\x03\xcb\xfb\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2O\xc5\xff\xffʷ\xff\xffն\xff\xffն\xff\xffղ\xf9\xff\xefas\xff\xfd8.\xff\xff5)\xff\xff5)\xff\xff5)\xff\xd5NK\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xcd\xff\xff\x03\xc4\xff\xff+o\xf7\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xffت\xec\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff\xf6OT\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xf69/\xff_\x94\xaf\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2O\xc5\xff\xffʷ\xff\xffն\xff\xffն\xff\xffն\xff\xff\u05ed\xf0\xff\xeedw\xff\xfd8.\xff\xff5)\xff\xff5)\xff\xd5NK\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\x02\xc7\xff\xff#r\xff\xffC.\xf7\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xffت\xec\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff\xf6OT\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xf6:0\xff^\x96\xb1\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2O\xc5\xff\xffʷ\xff\xffն\xff\xffն\xff\xffն\xff\xffյ\xfe\xff\u05ed\xf1\xff\xefar\xff\xff5)\xff\xff5)\xff\xd5NK\xff\x00\xce\xff\xff\x00\xce\xff\xff\x00\xcd\xff\xff%l\xff\xff=+\xff\xffE'\xf7\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xffت\xec\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff\xf6OT\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xeeQV\xffR\xb9\xec\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2O\xc5\xff\xffʷ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffղ\xf9\xff\xefas\xff\xfd8.\xff\xd5NK\xff\x00\xcd\xff\xff\x03\xc4\xff\xff$n\xff\xff?'\xff\xff?'\xff\xffE'\xf7\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xffت\xec\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff\xf6OT\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xfe6,\xff\xf4S[\xffЭ\xf0\xffO\xc5\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2O\xc5\xff\xffʷ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff\u05ed\xf1\xff\xeedw\xff\xd4QQ\xff\x02\xc7\xff\xff#r\xff\xff<-\xff\xff?'\xff\xff?'\xff\xffE'\xf7\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xffت\xec\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff\xf6OT\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xfe6+\xff\xf1[i\xff٥\xe3\xffζ\xff\xffO\xc5\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2O\xc5\xff\xffʷ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffյ\xfe\xff\u05ed\xf0\xff\xc6y\x96\xff$m\xff\xff=+\xff\xff?'\xff\xff?'\xff\xff?'\xff\xffE'\xf7\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xffت\xec\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff\xf6OT\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xf4PW\xffب\xe8\xffյ\xfe\xffζ\xff\xffO\xc5\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2O\xc5\xff\xffʷ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xff̭\xff\xff\u007fd\xfe\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xffE'\xf7\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xaf9\x8f\xffT3\xf5\xffK2\xff\xffK2\xff\xffK2\xff\xffK2\xff\xff\xd94U\xff\xff5)\xff\xff5)\xff\xff5)\xff\xfe6,\xff\xf3S]\xff\u05ec\xef\xffն\xff\xffն\xff\xffζ\xff\xffO\xc5\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2O\xc5\xff\xffʷ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffΰ\xff\xff\x82g\xff\xffD,\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xffE'\xf7\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xf84/\xff\x9e.\x96\xffG'\xf4\xff?'\xff\xff?'\xff\xff?'\xff\xff\xd62V\xff\xff5)\xff\xff5)\xff\xfe6+\xff\xf1[i\xff٥\xe3\xffն\xff\xffն\xff\xffն\xff\xffζ\xff\xffO\xc5\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2O\xc5\xff\xffʷ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xff~c\xff\xffB*\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xffE'\xf7\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xf84/\xff\xa3/\x90\xff?'\xff\xff?'\xff\xff?'\xff\xff\xd62V\xff\xff5)\xff\xff5)\xff\xf5PW\xffب\xe9\xffյ\xfe\xffն\xff\xffն\xff\xffն\xff\xffζ\xff\xffO\xc5\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2O\xc5\xff\xffʷ\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xff̭\xff\xff\u007fd\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xffE'\xf7\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xfe5)\xff\xa3/\x90\xffG'\xf4\xff?'\xff\xff\xd62V\xff\xfe6,\xff\xf4S\\\xff\u05ec\xf0\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffζ\xff\xffO\xc5\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2O\xc5\xff\xffʷ\xff\xffն\xff\xffն\xff\xffն\xff\xffΰ\xff\xff\x82g\xff\xffD,\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xffE'\xf7\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xf84/\xff\x9e.\x96\xffG'\xf5\xff\xd63X\xff\xf1[i\xff٥\xe3\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffζ\xff\xffO\xc5\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2O\xc5\xff\xffʷ\xff\xffն\xff\xffն\xff\xffԵ\xff\xff~c\xff\xffB*\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xff?'\xff\xffE'\xf7\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xf84/\xff\xa3/\x90\xff\xcdN\x85\xffب\xe8\xffյ\xfe\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffζ\xff\xffO\xc5\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2O\xc5\xff\xffʷ\xff\xffն\xff\xffն\xff\xffϰ\xff\xff\xb7\x99\xff\xff\xb7\x99\xff\xff\xb7\x99\xff\xff\xb7\x99\xff\xff\xb7\x99\xff\xff\xb5\x99\xff\xffH\xa5\xff\xff\x10\xac\xff\xff\r\xac\xff\xff\r\xac\xff\xff\r\xac\xff\xff\x16\xab\xfd\xffܜ\xd5\xffܜ\xd5\xffܜ\xd5\xffܜ\xd5\xffܜ\xd5\xffܜ\xd5\xffm3\xd6\xffc*\xd7\xffc*\xd7\xffc*\xd7\xffe,\xd7\xff\x88L\xd7\xffЬ\xf8\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffζ\xff\xffO\xc5\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2N\xc5\xff\xffʷ\xff\xffն\xff\xffն\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xff\xc1\xb7\xff\xffC\xc5\xff\xff\x03\xcd\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\t\xcc\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffK2\xff\xff?'\xff\xff?'\xff\xff@(\xff\xffkQ\xff\xff\xc1\xa3\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffͶ\xff\xffN\xc5\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xff\xc2\x0f\xcc\xff\xffp\xc0\xff\xffѶ\xff\xffն\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xffԶ\xff\xff\xc1\xb7\xff\xff:\xc6\xff\xff\x00\xce\xff\xff\x00\xce\xff\xff\t\xcc\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffK2\xff\xff?'\xff\xff?'\xff\xff^E\xff\xffŧ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffҶ\xff\xffw\xbf\xff\xff\x0f\xcc\xff\xff\x00\xcd\xff\xc8\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xca\xff,\x00\xcd\xff\xb6\x00\xcd\xfe\xf2'\xc9\xff\xff\x9a\xbc\xff\xffʷ\xff\xffն\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xffɶ\xff\xff:\xc6\xff\xff\x03\xcd\xff\xff\t\xcc\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffK2\xff\xffA(\xff\xffbI\xff\xffʬ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xff̶\xff\xff\x9a\xbc\xff\xff-\xc8\xff\xff\x00\xcd\xfe\xf3\x00\xcd\xff\xb6\x00\xcd\xff3\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xd2\xff\x11\x00\xce\xff^\x00\xcd\xfe\xbe\x0f\xcc\xff\xfaP\xc4\xff\xff\xa1\xbb\xff\xffѶ\xff\xffն\xff\xffԵ\xff\xffԵ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xff\xc1\xb7\xff\xffC\xc5\xff\xff\r\xcb\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffL3\xff\xffkQ\xff\xff\xc1\xa3\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffѶ\xff\xff\xa4\xba\xff\xffT\xc3\xff\xff\x0f\xcc\xff\xfa\x00\xce\xff\xc2\x00\xce\xffc\x00\xd2\xff\x11\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xd2\xff\x11\x00\xcd\xfeh\x00\xcd\xfe\xea\x0f\xcc\xff\xffZ\xc3\xff\xffŷ\xff\xffԵ\xff\xffԵ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xffԶ\xff\xff\xc0\xb7\xff\xffD\xc4\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffkQ\xff\xffŦ\xff\xffԵ\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffɶ\xff\xffZ\xc3\xff\xff\x11\xcb\xff\xff\x00\xce\xff\xf0\x00\xcd\xfeh\x00\xd6\xfe\x13\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xca\xfe\x1d\x00\xcd\xff\xa1\x00\xce\xfe\xeb\x1a\xca\xff\xfd\x89\xbd\xff\xffŷ\xff\xffԴ\xfc\xff\xe8v\x96\xff\xf1\\k\xff\xf2[i\xff\xf2[i\xff\xf2[i\xff\xe8[i\xff\xf2[i\xff\xf2[i\xff\xf2[i\xff\xf2[i\xff\xf1\\l\xff\xe7z\x9d\xffͮ\xfe\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffԶ\xff\xffŷ\xff\xff\x8d\xbd\xff\xff\x1d\xc9\xfe\xfe\x00\xce\xfe\xeb\x00\xcd\xfe\xa6\x00\xcd\xfe\x1f\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcc\xff\x05\x00\xcd\xffM\x00\xcd\xfe\xae\x04\xcc\xfe\xf9B\xc6\xff\xff\x92\xbd\xff\xff֤\xe2\xff\xf3V`\xff\xfe6*\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xfe6+\xff\xf1[i\xff٥\xe3\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffն\xff\xffѶ\xff\xff\x96\xbc\xff\xffB\xc6\xff\xff\x06\xcc\xfe\xf9\x00\xce\xff\xb2\x00\xcd\xffM\x00\xda\xff\a\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xbf\xff\f\x00\xcc\xffB\x00\xcc\xfe\xd4\n\xcc\xfe\xfe:\xc6\xfe\xff\xb8\xa8\xe2\xff\xf5OU\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xff5)\xff\xf5PV\xffب\xe9\xffյ\xfe\xffն\xff\xffն\xff\xffն\xff\xffԶ\xff\xff\xb3\xb9\xff\xff@\xc6\xff\xff\v\xcc\xfe\xfe\x00\xcd\xfe\xd4\x00\xce\xffJ\x00\xc4\xfe\r\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xce\xfe\x15\x00\xcd\xfe\x8a\x00\xcd\xfe\xe3\x13\xcb\xfe\xfcz\xb3\xe9\xff\xdeRU\xff\xfc6+\xff\xff5)\xff\xff5)\xff\xfe6,\xff\xf4S[\xff\u05ec\xf0\xffն\xff\xffն\xff\xffն\xff\xffӶ\xff\xff\xc0\xb8\xff\xff|\xbf\xff\xff\x13\xcb\xfe\xfc\x00\xce\xfe\xe5\x00\xce\xff\x92\x00\xce\xfe\x15\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xcf\xff6\x00\xcd\xfe\x9b\x00\xcd\xfe\xef4\xb8\xe3\xff\x9doz\xff\xf0=4\xff\xfe6+\xff\xf1[i\xff٥\xe3\xffն\xff\xffն\xff\xffն\xff\xff̶\xff\xff\x84\xbe\xff\xff3\xc7\xff\xff\x00\xcd\xfe\xf3\x00\xcd\xfe\x9b\x00\xcb\xff;\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xdf\xff\b\x00\xcc\xff2\x00\xcd\xfd\xbd\t\xc7\xf6\xfe4\xad\xd1\xff\xb9o\u007f\xff٨\xe8\xffյ\xfe\xffն\xff\xffն\xff\xff\xa4\xba\xff\xff-\xc8\xff\xff\a\xcc\xfe\xfe\x00\xce\xfe\xc1\x00\xcd\xff4\x00\xdf\xff\b\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xd4\xff\x06\x00\xcd\xffm\x00\xcd\xfe\xd7\x05\xcd\xfe\xfb]\xc2\xff\xff\xb4\xb9\xff\xff\xb7\xb8\xff\xff^\xc2\xff\xff\b\xcd\xfe\xfb\x00\xcd\xfe\xda\x00\xcd\xffm\x00\xc6\xfe\t\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xd2\xff(\x00\xcd\xfe\x85\x00\xce\xff\xe0#\xc8\xfe\xfe&\xc8\xfe\xfe\x00\xce\xff\xe0\x00\xcd\xfe\x8a\x00\xca\xff,\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\x03\x00\xcc\xff(\x00\xcd\xfe\xa5\x00\xcc\xff\xad\x00\xcc\xff(\x00\xbf\xff\x04\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"
 var _Assetsd02de8458478b9207bcc182c71f64095eebd83f2 = "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\xb4\x00\x00\x00\xb4\b\x06\x00\x00\x00=\xcd\x062\x00\x00\x1f\x04IDATx\x9c\xed\x9dytUս\xc7\u007fa\x16\x87\xd6\xf2\x04\x15Q\xb4*\x860\xc3E\xe6\x04\x01\x15q|\xd5\xda\xd6>\xeb\xf0lk\x9fU[\x87\xb6j-R-3\"\x88\x88L\x82 \x0e\xa0L\xb9\x99\x80$\x90\x84\xe4\xde{\xf6M\x80kU\xaa\x12\xb0\xab\xf5i\xab>[\x81\f\xf7\xf3\xfe8\xf7\x82\x81$\xe7\xdc\xe4\x9c{N\x92\xf3]뻖kI\x92}\xf7\xf9\xe4\x97\xdf\xfe\xed\xdf\xdeGē\xadB\x93T4Y\x83\x92\xf5\x84\xa4\xbf\xd3\xe3\xf1\xe4\xa9I\" \xbd\xd0d>J*Q\x12\x8d\xf9cB\xb2\x982\xb9\xd0\xe9\xf1y\xf2dZ\x94˕(\xf9\x18%4\xe0\xbf\x13\x96\x1b\x9c\x1e\xa7'O\x8d\n%\x93\xd1\xe4\xedF@>љ(\xb9\xde\xe9q{\xf2TG\x94H\xcf\x18\xc8_\xc5R\v\xb3@GQ\xf2/\x94d\x13\x96\xdeN\u007f\x0eOm\\\xec\x95^h\xf2+\x83\xf4¬\xff\x86&\x8fz\xf9\xb5'GD@~\x8c&!\x94\xd46\n\xaa\x96\x02%\xa3\xa1d\xbc\xfe\xdf\xc6`\x87)\x97\xbb\x9c\xfe|\x9eڀȗ\x0eh\x92\x8a\x12\xbf!\x98Z{\bv\x83\xfc'\xc1_\x01\xfe=\xb0\xfdO\x10\xfc\x0f\xfd\xff\x19}}X\xf2\xd1d\x00!\xe9\xe8\xf4\xe7\xf6\xd4\nE\xb9\\\x8a\x92\x17Q\xf2\x89!\x8c\xc13\xa0𗐽\x1d\xfc\x91\xba\xce.\x80\x82_\xeb`\x1bG\xebO\xd1d\x05\x15\xd2\xcf\xe9\xcf\xef\xa9\x15\x89\xb0܃\x92j\xe3\xa8,zj\xe1\xdf{2\xc8'y/\x14_\xad\u007f\x8d\xf1\xf7\xadA\x93\a\x9d\x9e\aO-X\x94\xc8)\x84\xe5F\x94\xe4\x19\x03\xd7\x11v\x0f\x83\xeds\xc0\x1f6\x01s\xdc\xe5\xb0}\x01\x94\\\x0eZ'3\x11\xbb\x90\xb0\xdcBH\xba:=?\x9eZ\x90\xd0\xe4b4ɋ\x95\xe1\x1a\x85\xac6\xd4\tvL\x87\xac\xdd\t\x80|\x82\xb3J\xf5_\x86P\x173P\xff\v%\x85\x94K\x9a\xd3\xf3\xe4\xc9\xe5\",\xbd\xd1\xe4q\x94\xfc\xdb\b\xacO\v\xba\xf3ʜ\xffa\xc8\xe8\xaf\xd8>\xb7\x92\x9a\xadM\x84\xf9Ĉ\xbd\xf3\x0es\xf9\xb5&\x87\t\xcbT\xc2\xd2\x1b$\xc5\xe9\xb9\xf3\xe4\"\x11\x92\xae\x84\xe4\xb7h\xf2.Jj\x1a\x03\xe9HYg6,\xbc\x83\x9b\xbf\x17\xa4\xff\x88\xa3\xa4\xfa`LF\x159\xb3+-\x00:\xa2WDr\xd7C\xd1-\xa0u6\xaa\x84\xd4\xc6\xc6\xfc$\xf9r\x9a\xd3\xf3\xe8\xc9a\x81\xb4\xa7T.AI\xb9QD\xac\t\xb5\xe3\x1f\x85\xdd\xf8\xf9]\x9bI\xf5Q\x8f\xa3\x94>\xff!\xb5\x99V@\x1ds\xdeR\b~\a\xb4vf\xca|\xef\x12\x92\xfe\xe4K\a\xa7\xe7Փ\x03B\x93\xa1(y!\x96\x936\nˡ\x9c\xde\xcc~|\x06\xbe\xb1\x9f7\x00\xb3\xee\xb1\xe3\xabȝcU\xa4\x8e;\x04\x05\x8fB\xe0\x023\xf9\xf5\xbf\t\xcbR\x94\x8ctz~=%I\xec\x97\xceh2;\x96'7\xdawQ\x15\xe8Ț\xb9\xf72d\xccW\xf4\xf5\xd56\ns<J\x0f\x1aYK\xd1s\x1fY\f\xf5^\xc8\n\xea\xf9\xb5q5$\x8a&_\xa3\xc9\">\x92.NϷ'\x9bD\x99tC\x93[QRa\x04r4ԅȆ\xab\xb9\xfdG\x05& >\xd9\x03GԒ5렵\xe9Gܹk\xa1x\x02\x84\xba\x9aY8\xfe\x99r\xf91J\xcerz\xfe=Y(\x94\x8cD\xc964\xf9\xda\x10\x82\xc0\xf9\xec\u007f\xedyn\xb8\xee\xd3&\xc1\x1c\x8f\xd4c3\xaa\b\xbd\xf0\xa1\xf5@\xfb#\xe0\x0f\xc2\xf6\xe7\xa0\xecBs\xd5\x10%\x85\x84d\xbc\xd3\xcf\xc1S3\x85\x92\v\xd0d\xa1QDF\x13\b\x9eE\xb4\xf0g\x1cZ\xbb\x87\x1b\xa6\x1cn\x06\xccǝ拲}^%Ֆ\x94\xf4\xeas\x05\x14\xfe\x1c\x82=\xcc\xec8FQ\xb2\x94\xa0\\\x84xe\xbe\x16%J\xa5\aJ\xa6\x11\x96w\ra\x0eu\x86]?$\x9a\xbb\x01\xf5\xe2\a\\3\xf9\x88%0\xc7=*\xbd\x9a\xec\xd9\am\x02:\x02\xfe}\x90\xb3\x11v\xfe\xc4L\x1a\x12E\x93\x0fP2\x1d%\xe7:\xfd\x9c<\x19\x887\xa4\x13\x9a\x8cEɇ\xc6\u007f\x8a\xdbC٥\x90\xb3\x81hf\x84O\xdfx\x97\xc9W[\x13\x99Ot__\x94\xddV\x97\xf4\xeas\xceF(\xed\vZ\a3e\xbeChr\x15\x11\xe9\xe4\xf4s\xf3T\x8fP2\x11%\x1b\f#\xb2\x12(M\x83\x1dOCV\x90hf\x04\xf5⇶\xc1\x1c\xf7\xc8q\xd5dͲ3Rǭ\xc1\xf6\x99P:\xd0L\x99/\x8a\x92\xcd(\x99\xec\xf4\xf3\xf3\x14\x13{\xa5\aaY\x89&_\x18\xe7\xca\xed\xa1\xf0\x17\x90\xb5K\xffS\xed\x8fph\xed{\x96\xa7\x19\r-\x14}\xa3k(Z`uI\xaf>\uf0ec\x12(\xf8\x95\xd92ߗ(y\x8dR9\xcf\xe9\xe7\xd9fED\xceF\xc9\xed(\xd9o\x18\x89B\xa7B\xf1d\xc8\xd9p\xec\xa1G3#\xec\u007fy\xbfe\v\xc0Dҏ\xdc9V\xf5~\x98p\xceF(\xbe\x16B\xa7\x9b\x89\xd8\a\xd0\xe4\x1eʥ\xa7\xd3ϷM\x89\x90܈\x92\x80\xa9\xf4b\xf7`\xc8[\x05~U\xe7A\xef[\xf6\x17n\xb86\xb90\xc7=:\xbd\x9a\x82g\x0f$\ah\u007f\x04\xfca\xc8[\x03\xbb\x87\x9bKC¢(\x97[\x9d~έZ \xedP2\x10%\x1b\x8d\x17|\xed \xd0\x13\xf2\u007fˉ\xfd\xc9\xd1\xcc\b\a\u05fcǤ+\x93\x91f4\x1e\xa9\xf3\xe6TR\xbd%YPG\xc0_\x01\xf9\u007f\x80\xc0\xf9掁)\xc9F\x93\xa1 \xed\x9c~\xfe\xadJ\xe8\xf5\xe4yh\xc7n!j$\xbd\xe8\x02;\xef\x81\x1c\xffI\x0f4\x9a\xa9G\xe6)\x93\x9d\x89\xcc'ڞ\xde\x0f\x13\xce\xce\xd1\xd7\x12\xc6i\x88~\xdbSX\x9eG\x93\x8b\x9d\xe6\xa0U\x88r\xb9\t%\x9f\x1aGe\x81\xddC \xab\xa8\xc1\a\xf9\xaf\xb7\xdf\xe1\xbak\xdc\x01s\xdciã\x84^\xf8 \xf9P\xfb#\xfa\xc2q\xf7\bs\xc7\xc0\x94\xfc\x13Mns\x9a\x87\x16)\x90vhr\x13J\xb2\x8dAn\x0f\xbb}\xb1\xe3O\xa1z\x1f\\43Bx\xc9\a\\}\x95\xb3iFC\x1e6\xba\x06\xff\xccd\x94\xf4\xea\xb3ҷ\xd1w\x8f4\x9b\x86\xe4\xa3\xc9\xf7yC\xda;\xcdI\x8b\x10\xbb\xa57\x9a\xec@o\xeb4^\xf4\xed\xf8#d\x955\xfa\xd0\xfe\xb2j\xbfka\xd6\x1de\xe8\xe8\x1a\xe7\"\xb5?\x02Y\x01\xd81\vB\x1dͤ!\xffF\x93R\xca\xe4R\xa7yq\xa5\x10I\xa1TΣ\\\xeeC\xc9\xdf\r!\x0e~\v\x8an\x80\xac\xc2F\x1fR43\xc2G\xab\xdf\xe7\xc6k\xbfv\x01\xb4&ҏX\xefG\xd2Jz\xf5\x82\xbd\v\x8a\xbe\a\xc13\x8d\xa3\xb5&\x9f\xa2\xe4ׄ\xe4|\xef\x18XL\x88\xa4\xa0\xe4\xe7\xe8m\x9d\x06\xb7\x10\xb5\x83\xe2I\x90\xb7\x96\x13\xcbp\xf5\xf9\xcb\r\xef\xb0\xf0\xe1Ox\xe0\xf6/\xac\xf7\x1d\x1f\xdb\xf2}\xa7\xfd\xe23>Y\xbd\xc79\xa0\xfd\x11\xf0\x97C\xeekP<\xc5\xdci\x19%\xfb\xd0\xe4\xc16\r5\x11\xe9\x84&\x03PRd*O\x0e\x9e\xa5o隹\xf3\"s\xdfq[\xfe\xb0\xf7B\xee:(\xba\xd6>\xa0\x1e\x98\r3\xd7\xc1V3\xf7{\xd8\xe9}z~\x1d\xecn\xae?D\x13\x85\x92am\xae?\x04%\x03\t\xcb\n\x94|f\x9c^t\x87\x82\x87 k\xa7\xb9\x87`+\xcc\x11\xc8]\xa3\xd7q\x8b'\xd9\a\xd2\xfd\xb3a\xc8\x14\x98\xb1\xd6a\xa0c\xce*\x81\x82\xdf\xe8\xb5}sՐ5\x84\xc5\xe74gI\x11!\xf95\x9a\xfc\x9f\xe1\x82O\x13(\xbaZ_\xac\x98\xba\x89\xe8\x04\xa0m\x81y3\x04\xce\xd2\xc7f7\xd0}ҡ\xdfDX\xb0\xd1y\xa0\xfd\x11\xfd\x19d\x05\xa0\xe8F3\x97NFQ\xf2\x15\x9a<\xee4o\xb6\x88\x88\x9cFXn1\x97^t\x86\x92\x11\xb0m\x11\xf8\x13\xc8%\xed\x8e\xccy+\xf4\xcb\x17\xe3\xe3L\x06\xd0}\xd2!m\x02<\xbd\n2\x9dN?\xe2\xde\x03\xdb^\xd2oT5w1N\x00Mn\xa3T\xcep\x9aCK\x84\xbe]]\x80\x89\xcb[\bt\x83\xed\xf3\f\xcbp\x8d\x02m\xc7C\xcc\u0382\xc09u7 \x92\x05t\x9ft\x184\x19\x9e]\xef\x02\x98\xbfᬀ~\x8dY\xe0,3P\u007f\x8d\x92\x124\x19\xe14\x8fM\x12\")\xb1봞AI\x95aj\x11\xec\x0e;\xef\x04\u007fy\xd3&\xd7\xce\x05`\xde+\xfam\xa2'\x8e;\x99@\xc7=\xf3U\xd8\xe2t\x05\xa4\x1e\x17\xfe\x1c\x02g\x9b\xd9q\xacF\xc9\x1c4\xb9\x98\xa9-\xa4?\x84R9\x03M\x1eAI\x04\xc32\\'\xbd\x9e\x9c\xfb*\t\xa5\x17I\x819\x02y\xab\xa1\xec\xa2\xfa\xc7\xee\x04о\xeb\xe1O\xaf8\x0f\xf0I\xde\x03\xb9\xafî\x9bͤ!Q\x94D\b\xcbc\x84\xe5\xdbN\xf3ڠȗ\x0e(\x19\x88&\u007f6Γc\xddpy/\x13o\xb4o2ȶ\xc0\xbc\x0fr6A蔆?\x83\x13@\xc7=\xefM\xfb~\x89\x9b\xebܵP\xd6\xcb\xec6\xfa\x87\x94\xc9H\xd7\xdd\xf6DXF\xa1d9J\x8e\x1a\xe7\xc9\x17\xc6\xda:\xb5\xe6M\\澦\xff2\x189/V\x9ak\xecs8\t\xf4\xe0\xc90}\x8d\xf3\xf06\xe8\n\xc8\xff=\x94]b\x06\xea*\x94\xac&(\x19Ns,\x14\xc9\xe9(Y\x1cK\xfa\r\xcap\x9d`\xe7]\xfa\r@\xcd\x05\xd1\xce4#\xe7m}k\xdd(\x1ft\x12\xe8x\xf5c\xee\x1b.\x80\xb7!\xef\x03\u007f(֦j\"\r\xd1\xef\x0fYMH\xbe\x95|\x90#\xf2\x9d\xd8e\xe0\xfb\x8cA\xee\x02%c\xf4\xdd5+&\xc9\xce4#o\xb5\xd9WE8\x0ft\x9ftH\x9b\b\xb3ܰ\xa3h\xe0\xdc7\xa1$\xc3\xdcmOJ\xdeG\x93[\t\xc9\u007f$\a\xe6\xa0LD\x93BS\xe9E\xe9e\xb1zr\xd0:\xe8l\x8b̛\xf5\v\x11\xcd\xf5\x06\xbb\x03\xe8>\xe90\xec:\x98\xfb\xa6\xf3\xd0\x1aZ\xc1\xb6%\xfa\x89{\xa3\xb9\r\xcbQ\x94\x14\x13\x92)\xf6\x03\xad\xe4\x8b\xc6#\xb2@\xf0\x1c\xfdı\x89\x06\"Ӷ\xb57c\xad~\x90\xd6\f\xc8n\x03:\xee\xe9k\xdcY\xd2;\xc9\x15\xfaK\x93\x02\xe7\x19\a\x0fM\xbev\x16\xe8\xd0)z=9'\xd3z\x90m\xdb\xce^\xa7On\"0\xbb\x11\xe8!S`\xbaKz?\xcc8;\v\n\u007f\x06\xc1\xd3\\\nt٥\x90\xb3Ն\x0foc5#;[_\x00&\n\xb3\x1b\x81\xee\x93\x0e\x97e\xc0b;\x9e\x81\x8d\xce\xc9\xd2o{r\x15\xd0%\x19\xe0\x0fX\xffam\xdd4Yi\xee\xf6Ζ\x04t\x9ft\x18r\r<\xb3\xdayP\x13\xb2\xa6\xf7^\xbb\x06\xe8\xe2\xeb\xb1<\x8a\xdaZ\x9a\xdb\x1a{\xd5C\x13av3\xd0}\xd2a\xc0\x95\xfa\xe6\x8b㠚\xf5>\xd8u[+\x05:3b\xe3\xa6ɾXo\xc6w\x9a\x0erK\x00:\xee\x19k\xdd_\xd2k\xfd@\xdb\x19\x997B\xd9w\x9b\x17\x99[\x12о\xeba\x9e˺\xf4\xda\f\xd0\xf1\xc8l\xe7\xb1)s\xfd\xbb\xad\a\xe8\xb8g\xba=R\xb76\xa0m/ͽjܛњ\x81v\xd3q\xae6\x05\xb4\x1d\x93\x95\x9div˵\xf5\x02\x1d\xf7\xc2M.\x80\xb7\xb5\x03\xbdu\xaf~\xc2y\xdaJ\xeb\xfd\xc7E0o\x18<w\xb6\xe5\xfe\xdfy?`\xf3\xf4C\xb6x돶\x90\xd9\u007f\x9a\xe5\xce\xf3\xcd\xe0㹅\xf6\xbfI\xa0M\x03\x9d\xb9\x0ff\xbd\xa6\x1f3\xba,\xc3\x06\xa7\xdb\xe2\x92\x01\x8f\x90\xe6\x8b\xda\xe4Z\xfa\xf9j,\xf7\xb2\xa1\xabxv\xf4fJ\x9f\xb7\xeb\xed\\\x1e\xd0ǡ\xfe\xc32\x1dj;\xfe\xd4\xda\xe0\x92\xfe\x0f;~ӒY\x0f\xf6\x1d\xe1\xc5!\xab\xa9\xed\x93\xc1Ӄ\xde&\xd5\r74\xb5j\xa0\xfd\x11=\xf5\x98\xb7\x1e\x06_\xe38\xac\xad\r\xe8\xc5CV\xf3\xf5eW\x11=\x064\x8cɨ\"wv\xa5\v`n\xad@\xc7\xfd\xf4*\xe8\u007f\xa5\xe3\xc0\xb6\x06\xa0\xfb\r\xaba\xe9З\x8f\x8d\xf9\x9b@\xa7\xfa\xf4K\xd7\xcb\x16%\xe1\xed\\m\x1a\xe8-{\xf4\xf4\xc3呺%\x00\xbdx\xc8j\x8e\xf6\x99\xd8 Щ>\xfd\xd2\xf5<'.]o3@\xfb#zN=\xe7u\x18t\xb5\xe3\xe0\xb6T\xa0\x97\x0f[EM\x9f+ꌹ>\xa0S}Q\x06\x8f\xac\xa58)o\xe7j\xab@\xfb#zN=m%\f\xbc\xcaqx[\x12Ѓ\x87\x1d\xe6\x85\xc1\xab\xeb\x1ds\xfd@\xeb\x1e8\xa2\x96\xecY\a\x1dJ?\xda\x02\xd0\xfe\x88\x9e~\xccZ\xa7\x9frv\x01\xc4-\x01\xe8\xa5\xc3V\xf1\xaf\xcb\ua7efƀN\xf5E\x19;\xbe\x8a\xd0b'Jzm\x05h\u007fDO?\xa6\xad\x80\x01\xee\x8a\xd4n\x03z\x90\xef(K\x86\xaejt̍\x03\xad;mx\x94\x1d\xf3*\xa9NjI\xaf-\x01\xed\x8f\xe8\xe9ǟ^qU\x9d\xda]@GY4X/\xcd5\x17\xe8T\x9f\xfe\x1eŜ\xa4\x96\xf4\xda\x1a\xd0q\xcfZ皅\xa2;\x80\x8e\xd2\xcfW\xcdҡ/\x1351f\xb3@\xa7\xfa\xf4\x92^\xe9\xf3\xc9*\xe9\xb5U\xa0\xe3\x91\xda\x05%=7\x00\xdd\xcfW͊!K\xeb\x94\xe6\xac\x02:\xd5\a#\xc7U\x93=;\x19o\xe7j\xab@\xfb#\xc7Kz\x0eW?\x9c\a:\xcaҡ/\x9b\x86\xb9)@\xa7\xfa\xa2\xf8\xc6\xd4P\xb2\xf0#\x0fh\xdb#\xf5\x13/\xc2@\xe7\xd2\x0f'\x81\x1e\xe4;¢\xc1\xabM\xa5\x19\xcd\x03\xfax\xfa\x917\xc7\xceޏ\xb6\x0et\x1c\xea\x85\x1b\x1dK?\x9c\x04zɐU\x86\v@+\x81N\xf5\xc1\xe8\x8cj\n\xe7\x1f\xf0\x80\xb6\xdd3\xd68R\xfdp\x02\xe8\xc1\xc3\x0e\xd7\xe9\xcdH&\xd0\xc7\"\xf5\xdcJ\xaa\xb7x@\xdb\xe7-{`\xea\xf2\xa4o\xbe8\x01\xf4\v\x83\x1b\xde4I\x06Щ>\xbd\xf7#\xd7\xf2\xde\x0f\x0f\xe8\x93=k]R\x17\x8a\xc9\x06z\xf9\xb0\xa6Gf+\x81N\xf5\xe9\x9b/\xdab+_\xe3\xec\x01}\xb2\xb7\xee\xd5[O\x93T\xa7N\x16\xd0\xfd\x86հx\xc8ɍFN\x02\x9d\xea\x83acj\xf0ϲ\xaa\xa4\xe7\x01]\xbf3\xf7\xc1\xfc\rI\x81:Y@'Z\x9aK\x16Щ\xbe(CG\xd7\x10\xb2$R{@7\x1e\xa9\xff\xb0L\xbf\x0e\xab\x05\x03=\xd8w\x84\xc5CVY:fk\x81>\x9e~4\xff8\x97\a\xb41Գ\xd6\xe9\xf7Q\xb4H\xa0\xa3\xbc\xd8\xc4\xd2\\\xb2\x81N\xf5\xe9ǹ\xb6ϫ\xf4\x80\xb6\xd3\xd1\xcc\b\x9fO]A\xcd\xc0\x8c\x16\a\xf4\x937\xef'z\xdf\f\xb0ص\xf7\xcddڵʶ_\xc2\xfcy\a\x9a\x18\xa9=\xa0\ra\xf6\xcf<\xc8S\xf7\xbf\xcd\xe1\xf9\x17\xc1\xd0\xd1-\n\xe8\x85\x0f}b˼\xd4n\x8d\xf0Խ\xff\xb0mܣӫɛ۔H\xed\x01ݨw̫d\xc0\x88Z~q\xf7\x16\x0e\xef\xee\n˺à1\x1e\xd06\x03\xad\x1f\xe7j\xcaB\xd1\x03\xbaAo\x9b[I\xfa\x15U\xa4\xfaЁ.=\x05B\x02s{Z\n\xb5\at\xc3\x1e8\xa2\x16\xff\xccD\x8esy@\x9f\xe4hf\x04\xff\xac\x83\xf8\xc6\xd4\x1c\x9b\xd8c@+\x81P\n\xbc|\x16\f\xb1\x06j\x0f\xe8\xc6#\xf5\x88\xb1\xd5\xec^h\xf68\x97\a\xf4I0\xe7ͭd\xdc\xf8\xaa:\x13[\ah}\x82`\xd1\xd90\xa4\xf99\xb5\a\xb4\xb1\xd3|Q\xb6ϭ\xa4ʰ\xf7\xc3\x03\xba\x8e\xb7\xcc8t,\xcdh\x14h%\x10L\x81\x17z4\x1bj\x0fhs\x1e7\xbe\xca\xc4q.\x0f\xe8c\xde>\xaf\x92!\xa3j\xeb\x9d\xccz\x81\x8e{\xc1\xb9\xcdʩ=\xa0ͻ\xaf/J\xf1\x82\x8f\x1aɩ=\xa0\x89fF(\x98_\xc9\x15\x13\x8e68\x91\x8d\x02\x1dL\xd1ӏ&\x96\xf4<\xa0\x13\xf3\x88q\xd5\xe46x\x9c\xab\x8d\x03\x1d͌\xb0y\xfa!\x06\x8c\xa8?2\x9b\x02:\x9eS\xaf=\x13\x06'\x0e\xb5\at\xa2\x8e2hd-E\xcf}\xe4\x01}\"\xcc\xf9\xcf\x1e`L\xc6\xc99s\xc2@ǫ\x1f\xb3\u0383\x81\x89\xa5\x1f\x1e\xd0M\U000e0475\xec8\xa9\xf7\xa3\x8d\x02\x1d͌\x903\xbb\x92Q\xe9զ&\xcf\x14\xd0\xf1\xf4ci\xf7\x84\x16\x8a\x1e\xd0M\x8f\xd4\xe3\xc6WQT\xe7.\xbd6\bt<2\x9b\x859!\xa0\xe3\xe9\xc7s瘆\xda\x03\xbay\xee닒;'~CS\x1b\x03:\x9a\x19a\xd3\xf4C\xa6Ҍ&\x03]\xa7\xa4g\x9c~x@7ߣҫcW\xf9\xb61\xa0\v\xe6W\x1a.\x00-\x01:\xee\x97z\x18\xe6\xd4\x1e\xd0\xd68\xcd\x17\xa5b\xc9_\xda\x0e\xd0\xdb\xe75^\x9a\xb3\x05\xe8P\n<۳\xd1\xf4\xc3\x03\xda:\x8fJ\xafb\xf7\ua7f6n\xa0\xa3\x99\xfa\x0e`C\x9b&\xb6\x02\xadDohZ\xda\x1d\x06\x8d\xf5\x80\xb6\xddQV\xcf\xfee\xeb\x05:ޛQ\xdfvvҀ\x8e\xe7\xd4\vΩwG\xd1\x03\xda\x03ڴ\xfd3\x0f\x9e\xd4h\xe4\b\xd0q\xa8\x97u?iG\xd1\x03\xda\x03ڔ\xb7ͭ\xac\xd3\x02\xea8\xd0q?wn\x9d\x85\xa2\a\xb4\a\xb4a\x9a\xb1}^%㚙f\xd8\x06t0E\x87z\xe8\x18\x0fh\x0fhc\x983g\x1a\xf7f8\nt\xdck\xbf\x03\x83\xc7x@{@7\f\xf36\v\x16\x80I\x03:\x98\x02s{R2\xfc!\x0fh\x0f\xe8\x93a\xf6\xcf<hYΜ\x14\xa0\x95@(\x85\xf7\x97\x8fd\xf8\xd8/\xed\x01\xfa'\xfb\xe0\x96\a,w\xf4\x96\ax\xe7\xc9;\xc8Y\xf6\x9f\xae\xf3\x81\xec\xef\xb6|\xa0\v\xe7\x1f`L\x86\xf9\xde\f\xd7\x00\xad\x84\xdaP\no/\xbc\x8b\x11\xe9\xd6G\xbc\x85\x0f}\x02O,\xb1\xfe\xcd_\x97\xa5Ì\xf3l\x99\x0f[\xdcR\x80\x8efFx뙏\x19kAi\xce)\xa0QBM\xb0\x03%\xaf\\Ũ\x8cϬ\azs\x05<\xb6\xd8\x03\xda\xed@\xc7s\xe6\xe6\xec\x00\xba\x05踳\x96\xfc\x88\xa1c\xbe\xb2\x16\xe8\xf8\x9c=\xb5\x02R\x9b\u007f\xf3\xa8\a\xb4M@\x17<{\x80\x8c&\xf6f\xb8\x15\xe8\xea`G6>\u007f\x17#-\x8a\xd4u\x80\u07ba\x17\x1e{\x01\xfaZ\x00\xb5\a\xb4u@G3#\xe4\xceiZלہF\tQ-\x85]\xab\xa6p\xf9\xb8ϭ\x05:\x0e\xf5S+=\xa0\xdd\x02t43B\xd6,k\xb6\xb3\xdd\n4J\xa8\t\xb5g\xeb\xe2\xffjv\xf5\xa3\xc1\xb2\xddc\x8b\x9b\xf7\xe6/\x0fhk\x80Κ}\x90\x91\xe3\xec\xabf\xb8\x05h\x94\xbeP\xcc_y\x13#\x9bQ\xfdh\x10\xe8Maxr\xa9\a\xb4S@G3\xf5\xd2\\\"ǦZ:\xd0(=\xfdؼ\xe8'\fkb\xa46\xdcXyr)\xf4o\u0085\xeen\x05:p\x1e\x94\xa6\xba\x1b\xe8\xf8U\x03\xc9N3\xdc\x004JO?\xb2_\xba\x95\x11\xe9\x89\xe7Ԇ@o\xae\x80߽\xd0:\x80.\xbb\x10r_s\xf7\x89\x95x\xce<t\x94=;\x80-\x01\xe8\xb8\vVܘ\xf0B\xd1\xd4\xd6w\xe6>}\xa1\x98H\xf5\xc3m@\aΆ\x9c\xb7q\xf5\x99\xc28\xccv\xf4f\xb4D\xa0kB\xed\xc9y\xe9\xfb\x8c\xca0\x9fS\x9b\xee\xe5غW_(\xf6\x9b\xd4\xf2\x80\x0e\xf4\x86\xbcU1n\\\nt<\xcdp:2\xbb\th\x94\x9eS\xef|\xf9:\x86\x8f\xfd\xc2Z\xa0\xe3\xe9\xc7\xd4e-\v\xe8@o\xc8\xd9\xfc\x8d\xbf\xea.\x05\xbap\xfe\x01Gsf\xb7\x02\x8d\xd2#\xf5\x9b\xf3\xefe\xf88c\xa8\x13\xee\xb6\xcbܧGj\xa3\xde\x0f7\x00\x1d8\xfb\x1b\x919\xe2N\xa0\xa3Eד\x95\xc0\x8dFm\x11h\x94P\x13lO\xe8\xb5\f\xc3ޏ&\xb5\x8fn\xae\xd0\x1b\x9a\xdc\ftم\xc7s\xe6:\xe3w\x19л_\xf9\x01#\xd3\xdd\x13\x99\xdd\nt\xdcyKofD\xfa?\xad\x05:\xee?,o\xf8\x1d\x8dN\x02\x1d\xe8\x05\xb9\xaf70n\x97\x01\xfd\xf6\xa2\xff\"\u0557\xbc-\xed\x96\x0etM\xb0\x03\x9b\x9eo\xb8\xf5\xb4Y@o*o\xb8\xa4\xe7\x14\xd0\xc1\xb3!ou=\x91\xd9\x03\xbaU\x00\x1dw\xc1\x8a\x1b\xf1ճ\xf9bɉ\x95\xa7W\xbb\x03\xe8\xc09\xb14\xa3\xb1\xf1z@\xb7\n\xa0kC\xed\xc8Z\xf2Ó\xeaԖ\x1d\xc1zl1\xa4Mt\x0e\xe8\xb2\xf3\r\"\xb3\at\xab\x02Z\x87:\x85ҵWq\xf97\xaa\x1f\x96\x01\xbde\x8f\xfe\xdes'\x80\x0e\xf4\x84\xec\\\x130{@\xb7*\xa0u\xa8\xdb\xf1ւ\xff>vH\xc0\xf2C\xb2\xf1\xe3\\\xc9\x02:\xd8\x1d\xb6-3\t\xb3\at\xab\x03:\x0eu\xfeʛ\x18\x9d\xf1\xa9\xf5@o\xae\x80'^L\x0e\xd0e\x17Ū\x19\x89\x9c1u\x19Л\x17\xdd\xc6 \xdf\x11\x06\xf9\x8e&쁾*\x06\x8c\xa8\xb5\xc5\xf7\xff\xf7\x16\x0e\x17\x9d\x06e\xed\xadw\xb0\x03h\x9d-\xf7\xf6e?b\xd5\x13\x89\xbeZ\xd8$4S\x97\xc3܋\xec\x839\xd0\x13r\xd77mln\x02\xfa\xaf\v\x06\x92;`*\xb9\xfd\x9fJ\xd8\xd9\x13_\"s\xc6![\x1c|b=5w\x0e\x83;\xfa[\xeb\x9f\x0e\x83\xf5O\xc2\xf6\x05\x96\xbb&w\x11\x9f\xbdY\xa6\xef\xfeY\r\xf5\x96=\x90\xf7\x9f6\xc1\xdc\v\xf2^I02\xbb\x14h\xe6w\x87>\xe3\x9a\xd6t>\xe5\xa76D\xa3\x98g\xbdZw\x95o\x85\xfbNЫ\a[\xf7\xda3\xe6\xcc}umu\x94\xae\x0f\x9c\xe6:\xd8\x03r66\x11f\x0fh\xe7\x80N\x9b\b\x0f\xce\xd5sR\xbb@\xfe\xe6C\xb6\x1cj\x1b\x80\x0e\x9e\x03y+\x9a\x01\xb3\a\xb43@\xf7\x9b\xa8\xe7\xa0I\x81\xd9.\xa8-\x06:\xd0\vr\xb2\x9b\t\xb3\at\xf2\x81N\x9b\x00\xbfyޞ4\xc3\x10X+\xa1\xb6\x10\xe8@\xafئ\x89\x8d\xbfh\x1e\xd06\x00\xddo\xa2\xde\x1b\xb1\xa9\xdc\x01\x98O\xf8w͆\xda\"\xa0\x03\xe7C\xee:\xac\xbb\xe4\xde\x03:9@\xa7M\x80\x87\xe6\xdb3\xb6\xa6\x00\xdal\xa8-\x00:pn#]s\xad\x05\xe8%ݠ\u007fb\xaf\x16v=\xd0\xfd'\xc1o\x179\x1b\x99\x1b\xfa\xba&C\xddL\xa0\x03\x17@\xdeJ\xac\u007f㙂\xe2\xeb\\\x04t0\x05^=\x03F\x0fo\x1d@\xa7M\x84i+\xed/\xcd5\xe7k\x9b\xf4\xf5\xcd\x00:\xd0\v\xb2\xcc\xf6f$\xe0\xecm\xb0{8h\x1d\\\x04t\xdcE\xed\xe1\xd1\xde0\xea\xf2\x96\vt\xda\x04\xb8\u007f\xb6\xcd\xd5\f\vr\xe1&A\xddD\xa0\x83\xdd o\xb9\xb5%\xc4\xec\xedP\xf00\x04\xcfh\xf8\xe7:\x0e\xb4\x12\xfd\xfd~\x9b\xbb\xc2}\x17CZ\xfd\xef\xf8s-\xd0\xfd'\xe9=\x0fv\xa6\x19\x99V\u007f?\vz&\f\x17\x80\x1b,\xf8%\x8c{\x0f\xe4?\x01e\x97\x82\x96\xd2\xf8\xcfN\x12\xd0ףDC\x93\x1a\xc3\xc9x\xfdt\xb8~`\xe3`\xbb\x05贉z\xcelu\x9aQ'*[\xfc\xe7:a\xa8\x13\x04:p\xbe\x85\v\xc0r\xc8[\v\xbb\a\x9b\xf9\xd95(\xa9\xa0\\\xbeo;\xd0\"\"\xec\x95\x1e(\xb9\x1b%\xef\xa3$\xda\xe8\xe0\x8a\xdb\xc3³`\xd2P\xf7\x02\xddo\xa2\xbe\x9d\xed\xea4\xc3\n\xa8\x13\x00\xfa\x18\xcc\x16\x8c;g#\x14]\x0f\xa1\xd3\xcd\xfc\xec\x8f\b\xc9/PrnR`\xae\x03\xf6.9\x13%o\x98\x9a\xa0\xdd\xed\xe07\x17\xb8\x0f\xe8\xbeW\xc0\xef\x16\xd97\x06+\xd3\fS\xbf8\x16\x00\x1d\xec\x16K3,\x18W\xc1#\x10\xeaj\xf6\xafB&e\xd2-\xe9 \x9f\x04vP&\xa1d=J\xaa\r\a\xbd\xe1T\xb8\xa7\xcf\xf14\xc4I\xa0\xfbM\x82_\xcfk\x199\xb3\xe9\x9fg\xb0\xebh\x04t\xa0\x97\xbe\x00lVd\xde\x03;fBi?c\x88\xf5\xd4u\x13a\xb9\xc6i\x8e눐t%,\x19(\xd9o\xf8!\xcaR`\xfd\xa9p\xcd`\xe7\x80N\x8bu\xcdm\xd9\xe3\x10\\6B\x9d\xe8\xe9\xeac0_\xa0\x97\xe6\x9a3\xee\xdc7t\x90\xb5Nf`>\x88\x92Ʉ\xa4\xab\xd3\xfc6(ʤ\x1bay\x1aM>@I\xada\x1a\xb2\xd2\x17;\x15lCͷ!\xa0\xd3&\xc0#\xcf\xc1f\x17m\x9a$e\f\x8d\x00\x1d8\xb7\x19\x9b&{\xf5\xab\xbdv\xfe\xc4Lz\x11Eɇh2\x8bb\xe9\xee4\xaf\xa6\x04\x92\x82&\x03\xd0d\x91a5D\x8b\xfd\x99+\xb8\x1f\xfc\x16\x03V\x1f\xd0\xfd&\xc1\xe3-\xa44\xd7\xecq\x9c\bg\x03@7w\x01X\xf00\x94\xf5֟e\xe30נd9\x9a\f\xe0\ri\xef4\xa7M\x12\x9a\x8c@I\x18%G\r\xff\x04\x05z\xc0\xb6%\xe0\xd7\xec\x01\xba\xef\x15\xf0г6w\xcd9\x1c\x9d\xeb\x1dS#@\az\xc5\x1a\x8d\x12\xfd\xfe\n\xb6\xad\xd0#\xbb\xf1b\xef(J\"\x84d\xbc\xd3<Z\"\xf6ș(\xb9\x1bM\x94\xe1\x87\x0f\x9d\x02\xc5\x13bM\xe3\xcd\x04\xef\x9b@\xf7\x9b\x04\x0f?\a\x1b\xc3I\x00\xc7->1R\x9f\x00\xf4\xb1\x16\xd0DƾW\xff\x9a\xe2+\xcdU/²\x17%\xf7\xba\xa2za\x87P2\xcd0\xb7V\xa2\xef\"\x15\xddd\r\xd0i\x13\xf4\xcb\xc1\xed\x02\xc7\ri\x86\xa9_\xb8o\x00\x1d<'֜\x9f\xe0\xf7\xda\xf5C\xd0ڙ\x89\xcaQ\xc22\xdbiޒ\"4\x19\x81&\x1b0\xdaFW\xa2\xbf\u007f#\xffI\xc8*i\x1aЃ\xae\x86_͵>gv\xaa\x92\xd1ܱ\xee\xba-v\xd7\xdc\n\xf3c\xcf\n\xc0\x8e?\xeay\xb21\xc8_\xa2d3J\xd2\x11Iq\x9a\xb5\xa4)V\xe6\x1bm*\r\xd1:铹m\x01\t\xfdy\x9c\xf7\xa6~\x19xk*\xcd5k\xbc{!\xff\xb7\t\x1chݧ\xafi\xca.2W\x86S\xf2\x0e\xe52\x9e\x1c9\xd5i\xbe\x1c\x13oH{4y\x14%\u007f!\xdcx*\x12\xd5ڳ\xff\xad[x\xe6\x81\xdd\xdc\xf3\x83\u007fr\xc7-_5\xea;\xbf\xf7%w\xdc\xfc\u007f\x86\xff.Q?p\xfb\x17\xbc\xb7b\xbf\xbbӌ\x86\x00\xf5\xef5\x01s\x85^\x86\xdbus\xfdm\x9d'\xfb#B\xf2\xfb\x16[\xb9\xb0Z )\x84\xe5\x12\x94<\x81&\x9f\x19M\xe0';\xcef\xf5\xec\xfb\xb8|\\\xd3\xdf\x05\xd8T\x8f\x1cWM挃D[\x1c\xcc&\x9dU\xaaד\x83=̀\xfcO\x94<EH.c\xaa\xb4s\x9a#W*\x06v9J\xaa0j|R\xc2/\xefYπ\x11G\x92\x00s\x94\xfe\x97GY\xf3\xe4_[)\xcc\xe5\xb0m1\x84:\x1b/\xf6\xf4g\xf3\x1e\x15\xd2\xcfi^Z\x84@:\xa0\xe4v²\xcb\b\xe8\xaa@Gv\xac\x9c\xc2\xcf\xee\xdc\xca\xc0\x91\x87m\x03zLF\x15\x1b\x9f9\xd4\na\x0eö\xa5z\xa9T\xebh\xa6\fW\x8a\x92\xbb\tIG\xa79iq\"_\xba\xa0\xc9\x1dh\xf2\xb9\xd1D\x1f-\xebĎ\x95S\x18f\xf2MS\x89z\xeb\x8cCԶ:\x98\x03P2\x11B\xa6\xfa.\xbeDɽ\x94\xc8)Ns\xd1\xe2E\x99\\JXV\xa0\xc9ߌҐ\xaf\x8aOg\xce\xe33\x18\u007f\xe5AKҌQ\xe9\xd5l\xfa\xd3!\x17\xc0g\xa1\xb3w@\xc1\xa3\x10\xfc\xb6\x99\xf4\xe2\x13\x94\xac\xa5\\Ҝ\xe6\xa0U\x89|\xe9@\xb9\x8c!,9\xc6iH\a\xde\xdbҏ\xc7\x1fXF\xff\x11G\x9b\f\xf4\xb015\xbc1\xed\xe3V\x94fT\xc0\x8e\x19P\x96j\xb6zQHHƃtp\xfa\xf9\xb7j\xa1\xc9mh\xf2\xaeQ\xb4\x8ejBњ\x89\\\u007fCE\xc2`\x0f\x1fS\xc3[O\xb7\x16\x98+ g\x13\x94\xa4\x9b\x818\x8a\xde\x02|\xb7\xd3ϹM\t%\x17\xa0Ƀ(\xa94zH_\xec:\x93מ\xfd)\x93\xa7\xfc\xd9\\i.\xbd\x9a\xad\xad\xa54\x97\xe3\u05f7\xab\x83g\x9a\x81\xf9\xafh\xf2(!\xf9\xae\xd3Ϸ͊\x12\xe9IX\xdeF\xc9a\xa3\x88]\x1d\xec\xc0\xf4\xdf\xcee\xc8\xe8\xafH\xf5E녹\xff嵬\x9b\xda\xd2Ks\xfb\xc0\xaf\xe9-\x03\x9a\xa92\xdc\x114\xc9A\xc9\x05N?OO1\xa1\xc9U(\xd9h&\r)_?\x9cG\xfegu\f\xec\xe30gL\xa8bˌ\x96^\x9a\xd3`\xfb\x1c(5u\xba:JX2\xd1\xe4:\xa7\x9f\x9f\xa7zDDN#,7\xa0\xe4\x90\xd1\xc3<\\z\n\x81\xd7\xc61\xe5\xbaȱȼe\xc6!j\xb6:\rd3\x9c\x93\t%# \xd4\xc5L\x19\xeeo(\xf9\x01\xa5r\x86\xd3\xcf͓\x81(\x97\x9eh\xb2\x10M*\x8d\"\xf6\xbfKNe\xf1\x1f\x1fc\xdd\xd3\xc5-72\xe7dC\xe1}\x10<\xcdLz\xf11\x9a\xbc\xe8\xa5\x17-P\x94K\x1aaYi&\r\x89\x06z\xe9G\x89\xfc\xcay@M\xbbB\xbf& \xd0\xdb\xcc\xf1\xa7(J֡d \xb4\xa1\xb6\xce\xd6(\x94L@I\x19f\x8e\x81\x95]\x1c;\x06\x16r\x01\xb0\rY\xe9W\r\x94^f&O\xaeB\x89FH\xa68\xfd\x1c<Y(\xf6J\x0f4\xf9\x99\x99\xfa5\xa1S\xa1\xe8Z\x1b\xeeA\xb6\xc0\xb9\xeb\xf5\x93<fn!\xd2\xe4\x034\xb9\x0f'n!\xf2\x94\x1c\x11\x92\xae\x84d\x99\x89Ȧ7\xeb\xec\xbc\xcby\x88\xe3.\xbc\x0f4\x13\v>ݯ\x92/\xa79=ߞ\x92$4\x19\x8b&k\xd0\xe4\xb0q\x1a\xd2\x1b\xf2\u007f\x0f\xfe\xa03\xe9ŎiPv\x89\x19\x88\x8f\xa0\xc9\xebhr\x85\xd3\xf3\xeb\xc9\x01\x91/](\x93a(y\xc78Zw\xd0\xef\xaf\xc8[\x9e<\x98\xf3^\x89\xddw\xd1\xd1xѧ\xc9\a\x94\xcb\x18\xaf\x1bΓP*g\x10\x92\xe9(9\x80щt\xad3\xec\xbc\rr\xb6\x80߆\xb3\x8a\xfe=z=y\xe7\x9d\xfa\x95\x0eF\x95\v\xbd49\x87\x90|\xcb\xe9y\xf4\xe4\"\x81\xa4\xa0\xa4/\x9a\xcc$,G\f\xa2!\x04\u0381\xc2{\xb0\xb6̷Gϓ\x03=͔\xe1\xaa\xd0\xe4Y\x94\xf4\x05\xef\xf8\x93\xa7F\x14\xab_\x97\xa1L\xe4סSa\xfbB\x9aW\xe6\xd3`ۋ\x8d\xbf\x9e\xe1\x9by\xb2\x92r4\x19\xea\xf4<yjA\"\"\xa7\xa1\xe4v\x94\x14\x19\xe7ם\xa1d\x1cl{\x81\xc4\xee竀mˠ\xf8\n3\xe9\x05(\t\xa0\xe4no\xbb\xdaS\x93E\x89\x9c\x82&\x8f\x18\xe7\xd61\xb0\x8b\xae3\x0f\xf4\xae\x9b\xf5\xbe\vs\xbb|O\x12\x92\xaem\xea\xf2\x16O\xf6\tM\x06\xa0o\x1d\xff\xc30\x92\x06\xbaA\xfe\xef k\xe7\xc9\x10g\x15\xebm\x9d\x81s\xccD\xe4\xcfQ\xb2\x01%Ü\xfe\xfc\x9eZ\xa1ȗ.\x94\xcbp\x94\x14\x18\xa7!\x1d\xa1\xec|\xfd&{\xff\x1e\xf0\xef\x85\xed\xf3\x8f\x97\xe1\f\xbf^J\t\xcbh>\x92.N\u007fnOm@(\xb9\x17%\u007f6NER\xa0x\"\x14M1~\x8d\x99\xee\xf7\t˃N\u007f>OmP\x84\xe5\x124\x99\x8a~2\xda\f\xac\x8d\xf9S4y\x06MR\xbdn8O\x8e\x8a\x90\x9c\x8f&\x85\xe8\xdd|\x86\xb7=\x9d\xb0\xd8;\x8a\x92\x00\x9a\\\xec\xf4\xe7\xf0䩎\xd0\xe4V\x94\xe4%\x00t\x01\x9a\xfc\xd8\xe9q{\xf2Ԡ\xd8/\x9d\xd1\xe4\xfb(\xf9{\xa3酒\xdb\xc9\xf7\x16|\x9eZ\x88\xa8\x90\vQ\xb2\x1au춧(J\xfe\x97\xb0\xbcN\xb9\\\xea\xf4\xf8<yJX )\x04e8ay\v%\x99\x84e\x94\xb7\xe0\xb3W\xff\x0fe\x94v,'\x16\xaf\x19\x00\x00\x00\x00IEND\xaeB`\x82"
 
+var _Assetsa6ba389c811c864717262cad6a3b3c578de91be9 = "{{template \"dashboardHeader\" .}}\n{{template \"dashboardMenu\" .}}\n<div class=\".aligner-item\">\n    {{template \"table\" .}}\n</div>\n{{template \"footer\"}}\n"
+var _Assets61945eca059109a7567cc6ee9e6430ca1c9ceb91 = "{{template \"dashboardHeader\" .}}\n{{template \"dashboardMenu\" .}}\n<div class=\".aligner-item\">\n    <div class=\"title\">{{.Title}}</div>\n    <div class=\"subtitle\">{{.Subtitle}}</div>\n    <table id=\"jobs\">\n        <tr>\n        {{range $header := .Headers}}\n            <th>{{$header}}</th>\n        {{end}}\n        </tr>\n    {{range $job := .Jobs}}\n        <tr id=\"job-{{$job.ID}}\">\n            <td>{{$job.ID}}</td>\n            <td><a href=\"dashboard/logs?cid={{$job.Cid}}\">{{$job.Cid}}</a></td>\n            <td>{{$job.Status}}</td>\n            <td>{{$job.CreatedAt}}</td>\n            <td>{{$job.Deals}}</td>\n            <td>{{$job.Error}}</td>\n        </tr>\n    {{end}}\n    </table>\n</div>\n<script>\n    (function () {\n        var table = document.getElementById(\"jobs\");\n        var events = new EventSource(\"dashboard/jobs/events\");\n        events.addEventListener(\"job\", function (e) {\n            var job = JSON.parse(e.data);\n            var row = document.getElementById(\"job-\" + job.id);\n            if (!row) {\n                row = table.insertRow(1);\n                row.id = \"job-\" + job.id;\n            }\n            while (row.firstChild) {\n                row.removeChild(row.firstChild);\n            }\n            [job.id, job.cid, job.status, job.createdAt, job.deals, job.error].forEach(function (value, i) {\n                var cell = row.insertCell();\n                if (i === 1) {\n                    var link = document.createElement(\"a\");\n                    link.href = \"dashboard/logs?cid=\" + encodeURIComponent(value);\n                    link.textContent = value;\n                    cell.appendChild(link);\n                } else {\n                    cell.textContent = value;\n                }\n            });\n        });\n    })();\n</script>\n{{template \"footer\"}}\n"
+var _Assets6e15aa7e1a2624709b3d1e78fc0664f9653cef47 = "{{define \"dashboardHeader\"}}\n<!doctype html>\n    <html lang=\"en\">\n        <head>\n            <meta charset=\"utf-8\">\n            <base href=\"{{.BasePath}}\">\n            <title>Dashboard</title>\n            <link rel=\"apple-touch-icon\" sizes=\"180x180\" href=\"public/img/apple-touch-icon.png\">\n            <link rel=\"icon\" type=\"image/png\" sizes=\"32x32\" href=\"public/img/favicon-32x32.png\">\n            <link rel=\"icon\" type=\"image/png\" sizes=\"16x16\" href=\"public/img/favicon-16x16.png\">\n            <link rel=\"manifest\" href=\"public/img/site.webmanifest\">\n            <link rel=\"stylesheet\" href=\"public/css/all.min.css\">\n            <link rel=\"stylesheet\" href=\"public/css/style.css\">\n        </head>\n        <body>\n            <div class=\"logo\">\n                <img src=\"public/img/hex.svg\" height=\"40\" alt=\"textile\"/>\n            </div>\n{{end}}\n\n{{define \"dashboardMenu\"}}\n            <ul class=\"navbar\">\n            {{range .MenuItems}}\n                {{if .Selected}}\n                    <li><a class=\"selected\" href=\"{{.Path}}\">{{.Name}}</a></li>\n                {{else}}\n                    <li><a href=\"{{.Path}}\">{{.Name}}</a></li>\n                {{end}}\n            {{end}}\n                <li>\n                    <form class=\"inline\" method=\"post\" action=\"dashboard/logout\">\n                        <button type=\"submit\">Logout</button>\n                    </form>\n                </li>\n            </ul>\n            <div class=\"subtitle\">User: {{.UserID}}</div>\n{{end}}\n"
+var _Assetse2216398c78f690d5313c7d076bf69ca7635b65e = "{{template \"dashboardHeader\" .}}\n<div class=\"aligner\">\n    <div class=\"aligner-item\">\n        <div class=\"title\">User Dashboard</div>\n        {{if .Error}}<p>{{.Error}}.</p>{{end}}\n        <form method=\"post\" action=\"dashboard/login\">\n            <input type=\"password\" name=\"token\" placeholder=\"Auth token\" autocomplete=\"off\" required>\n            <button type=\"submit\">Login</button>\n        </form>\n    </div>\n</div>\n{{template \"footer\"}}\n"
+var _Assetsff724bab7cd2dc12ed773a0340fd352cfa7e185d = "{{template \"dashboardHeader\" .}}\n{{template \"dashboardMenu\" .}}\n<div class=\".aligner-item\">\n    <div class=\"title\">{{.Title}}</div>\n    <form method=\"get\" action=\"dashboard/logs\">\n        <input type=\"text\" name=\"cid\" placeholder=\"Cid\" value=\"{{.Cid}}\" size=\"64\" required>\n        <button type=\"submit\">Watch</button>\n    </form>\n    <table id=\"logs\">\n        <tr>\n            <th>Time</th>\n            <th>Job ID</th>\n            <th>Message</th>\n        </tr>\n    </table>\n</div>\n{{if .Cid}}\n<script>\n    (function () {\n        var table = document.getElementById(\"logs\");\n        var events = new EventSource(\"dashboard/logs/events?cid=\" + encodeURIComponent(\"{{.Cid}}\"));\n        events.addEventListener(\"log\", function (e) {\n            var entry = JSON.parse(e.data);\n            var row = table.insertRow();\n            [entry.time, entry.jobId, entry.msg].forEach(function (value) {\n                row.insertCell().textContent = value;\n            });\n        });\n        events.addEventListener(\"failure\", function (e) {\n            table.insertRow().insertCell().textContent = \"Error: \" + e.data;\n            events.close();\n        });\n    })();\n</script>\n{{end}}\n{{template \"footer\"}}\n"
+var _Assetsdd68b7b061a6b9857f0350942180a433fe48ccfa = "{{template \"dashboardHeader\" .}}\n{{template \"dashboardMenu\" .}}\n<div class=\".aligner-item\">\n    <div class=\"title\">{{.Storage.Title}}</div>\n    <div class=\"subtitle\">{{.Storage.Subtitle}}</div>\n    <table>\n        <tr>\n        {{range $header := .Storage.Headers}}\n            <th>{{$header}}</th>\n        {{end}}\n        </tr>\n    {{range $row := .Storage.Rows}}\n        <tr>\n        {{range $i, $value := $row}}\n            {{if eq $i 0}}\n            <td><a href=\"dashboard/logs?cid={{$value}}\">{{$value}}</a></td>\n            {{else}}\n            <td>{{$value}}</td>\n            {{end}}\n        {{end}}\n        </tr>\n    {{end}}\n    </table>\n</div>\n<div class=\".aligner-item\">\n    {{template \"table\" .Wallet}}\n</div>\n{{template \"footer\"}}\n"
+
 // Assets returns go-assets FileSystem
-var Assets = assets.NewFileSystem(map[string][]string{"/": []string{"gateway.go", "Makefile"}, "/public": []string{}, "/public/css": []string{"all.min.css", "style.css"}, "/public/html": []string{"error.gohtml", "miners.gohtml", "faults.gohtml", "index.gohtml", "asks.gohtml", "reputation.gohtml", "404.gohtml"}, "/public/img": []string{"favicon-16x16.png", "hex.svg", "favicon.ico", "android-chrome-192x192.png", "apple-touch-icon.png", "android-chrome-512x512.png", "site.webmanifest", "favicon-32x32.png"}}, map[string]*assets.File{
+var Assets = assets.NewFileSystem(map[string][]string{"/": []string{"gateway.go", "Makefile"}, "/public": []string{}, "/public/css": []string{"all.min.css", "style.css"}, "/public/html": []string{"error.gohtml", "miners.gohtml", "faults.gohtml", "index.gohtml", "asks.gohtml", "reputation.gohtml", "404.gohtml", "dashboard_deals.gohtml", "dashboard_jobs.gohtml", "dashboard_layout.gohtml", "dashboard_login.gohtml", "dashboard_logs.gohtml", "dashboard_storage.gohtml"}, "/public/img": []string{"favicon-16x16.png", "hex.svg", "favicon.ico", "android-chrome-192x192.png", "apple-touch-icon.png", "android-chrome-512x512.png", "site.webmanifest", "favicon-32x32.png"}}, map[string]*assets.File{
 	"/public/img/site.webmanifest": &assets.File{
 		Path:     "/public/img/site.webmanifest",
 		FileMode: 0x1ed,
@@ -91,7 +98,7 @@ var Assets = assets.NewFileSystem(map[string][]string{"/": []string{"gateway.go"
 	}, "/public/css/style.css": &assets.File{
 		Path:     "/public/css/style.css",
 		FileMode: 0x1a4,
-		Mtime:    time.Unix(1591014936, 1591014936051787332),
+		Mtime:    time.Unix(1792399062, 1792399062918847001),
 		Data:     []byte(_Assetsdde0973434b88ffc53b81b28400233e4fde8bb40),
 	}, "/public/img/hex.svg": &assets.File{
 		Path:     "/public/img/hex.svg",
@@ -148,4 +155,34 @@ var Assets = assets.NewFileSystem(map[string][]string{"/": []string{"gateway.go"
 		FileMode: 0x1a4,
 		Mtime:    time.Unix(1591014936, 1591014936052044627),
 		Data:     []byte(_Assets4bf0d0667fe4719e513a3edfeb3a25a2377cf2b7),
+	}, "/public/html/dashboard_deals.gohtml": &assets.File{
+		Path:     "/public/html/dashboard_deals.gohtml",
+		FileMode: 0x1a4,
+		Mtime:    time.Unix(1792399056, 1792399056429875269),
+		Data:     []byte(_Assetsa6ba389c811c864717262cad6a3b3c578de91be9),
+	}, "/public/html/dashboard_jobs.gohtml": &assets.File{
+		Path:     "/public/html/dashboard_jobs.gohtml",
+		FileMode: 0x1a4,
+		Mtime:    time.Unix(1792399056, 1792399056429875269),
+		Data:     []byte(_Assets61945eca059109a7567cc6ee9e6430ca1c9ceb91),
+	}, "/public/html/dashboard_layout.gohtml": &assets.File{
+		Path:     "/public/html/dashboard_layout.gohtml",
+		FileMode: 0x1a4,
+		Mtime:    time.Unix(1792399056, 1792399056429875269),
+		Data:     []byte(_Assets6e15aa7e1a2624709b3d1e78fc0664f9653cef47),
+	}, "/public/html/dashboard_login.gohtml": &assets.File{
+		Path:     "/public/html/dashboard_login.gohtml",
+		FileMode: 0x1a4,
+		Mtime:    time.Unix(1792399056, 1792399056429875269),
+		Data:     []byte(_Assetse2216398c78f690d5313c7d076bf69ca7635b65e),
+	}, "/public/html/dashboard_logs.gohtml": &assets.File{
+		Path:     "/public/html/dashboard_logs.gohtml",
+		FileMode: 0x1a4,
+		Mtime:    time.Unix(1792399062, 1792399062918847001),
+		Data:     []byte(_Assetsff724bab7cd2dc12ed773a0340fd352cfa7e185d),
+	}, "/public/html/dashboard_storage.gohtml": &assets.File{
+		Path:     "/public/html/dashboard_storage.gohtml",
+		FileMode: 0x1a4,
+		Mtime:    time.Unix(1792399056, 1792399056429875269),
+		Data:     []byte(_Assetsdd68b7b061a6b9857f0350942180a433fe48ccfa),
 	}}, "")
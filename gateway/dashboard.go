@@ -0,0 +1,372 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ipfs/go-cid"
+	"github.com/textileio/powergate/v2/deals"
+	"github.com/textileio/powergate/v2/ffs"
+	"github.com/textileio/powergate/v2/ffs/api"
+	"github.com/textileio/powergate/v2/ffs/manager"
+)
+
+const (
+	tokenCookie       = "powergate_token"
+	tokenCookieMaxAge = 60 * 60 * 12
+	numDashboardJobs  = 50
+	dashboardAPIKey   = "api"
+)
+
+// UserAPIs resolves user API instances from auth tokens.
+type UserAPIs interface {
+	GetByAuthToken(token string) (*api.API, error)
+}
+
+type jobView struct {
+	ID        string `json:"id"`
+	Cid       string `json:"cid"`
+	Status    string `json:"status"`
+	CreatedAt string `json:"createdAt"`
+	Deals     string `json:"deals"`
+	Error     string `json:"error"`
+}
+
+type logView struct {
+	Time  string `json:"time"`
+	JobID string `json:"jobId"`
+	Msg   string `json:"msg"`
+}
+
+// registerDashboard registers the token-authenticated user dashboard.
+func (g *Gateway) registerDashboard(rg *gin.RouterGroup) {
+	rg.POST("/dashboard/login", g.dashboardLoginHandler)
+	rg.POST("/dashboard/logout", g.dashboardLogoutHandler)
+
+	dg := rg.Group("/dashboard", g.dashboardAuth)
+	dg.GET("", g.dashboardStorageHandler)
+	dg.GET("/deals", g.dashboardDealsHandler)
+	dg.GET("/jobs", g.dashboardJobsHandler)
+	dg.GET("/jobs/events", g.dashboardJobsEventsHandler)
+	dg.GET("/logs", g.dashboardLogsHandler)
+	dg.GET("/logs/events", g.dashboardLogsEventsHandler)
+}
+
+// dashboardAuth resolves the user API from the auth token provided in the
+// session cookie or Authorization header. Unauthenticated page requests
+// are shown the login page.
+func (g *Gateway) dashboardAuth(c *gin.Context) {
+	token, _ := c.Cookie(tokenCookie)
+	if auth := c.GetHeader("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+		token = strings.TrimPrefix(auth, "Bearer ")
+	}
+	if token == "" {
+		g.renderLogin(c, http.StatusOK, "")
+		return
+	}
+	i, err := g.users.GetByAuthToken(token)
+	if err == manager.ErrAuthTokenNotFound {
+		g.renderLogin(c, http.StatusUnauthorized, "Invalid auth token")
+		return
+	}
+	if err != nil {
+		g.renderError(c, http.StatusInternalServerError, fmt.Errorf("getting user: %s", err))
+		c.Abort()
+		return
+	}
+	c.Set(dashboardAPIKey, i)
+	c.Next()
+}
+
+func (g *Gateway) dashboardLoginHandler(c *gin.Context) {
+	token := strings.TrimSpace(c.PostForm("token"))
+	if _, err := g.users.GetByAuthToken(token); err != nil {
+		g.renderLogin(c, http.StatusUnauthorized, "Invalid auth token")
+		return
+	}
+	c.SetSameSite(http.SameSiteStrictMode)
+	c.SetCookie(tokenCookie, token, tokenCookieMaxAge, g.dashboardPath(), "", c.Request.TLS != nil, true)
+	c.Redirect(http.StatusSeeOther, g.dashboardPath())
+}
+
+func (g *Gateway) dashboardLogoutHandler(c *gin.Context) {
+	c.SetSameSite(http.SameSiteStrictMode)
+	c.SetCookie(tokenCookie, "", -1, g.dashboardPath(), "", c.Request.TLS != nil, true)
+	c.Redirect(http.StatusSeeOther, g.dashboardPath())
+}
+
+func (g *Gateway) dashboardStorageHandler(c *gin.Context) {
+	i := userAPI(c)
+
+	infos, err := i.ListStorageInfo()
+	if err != nil {
+		g.renderError(c, http.StatusInternalServerError, fmt.Errorf("listing storage info: %s", err))
+		return
+	}
+	sort.Slice(infos, func(a, b int) bool { return infos[a].Created.After(infos[b].Created) })
+	storageRows := make([][]interface{}, len(infos))
+	for j, info := range infos {
+		hot := "disabled"
+		if info.Hot.Enabled {
+			hot = fmt.Sprintf("enabled (%d bytes)", info.Hot.Size)
+		}
+		cold := "disabled"
+		if info.Cold.Enabled {
+			cold = fmt.Sprintf("enabled (%d deals, piece size %d)", len(info.Cold.Filecoin.Proposals), info.Cold.Filecoin.Size)
+		}
+		storageRows[j] = []interface{}{
+			info.Cid.String(),
+			timeToString(info.Created),
+			hot,
+			cold,
+			string(info.JobID),
+		}
+	}
+
+	addrs := i.Addrs()
+	walletRows := make([][]interface{}, len(addrs))
+	for j, a := range addrs {
+		balance := "unavailable"
+		ctx, cancel := context.WithTimeout(c.Request.Context(), time.Second*10)
+		bal, err := g.wallet.Balance(ctx, a.Addr)
+		cancel()
+		if err != nil {
+			log.Warnf("getting balance of %s: %s", a.Addr, err)
+		} else {
+			balance = bal.String()
+		}
+		walletRows[j] = []interface{}{a.Name, a.Addr, a.Type, balance}
+	}
+
+	g.renderDashboard(c, "/public/html/dashboard_storage.gohtml", 0, gin.H{
+		"Storage": gin.H{
+			"Title":    "Stored Data",
+			"Subtitle": fmt.Sprintf("%d cids", len(infos)),
+			"Headers":  []string{"Cid", "Created", "Hot", "Cold", "Last Job"},
+			"Rows":     storageRows,
+		},
+		"Wallet": gin.H{
+			"Title":    "Wallet Addresses",
+			"Subtitle": "Balances in attoFIL",
+			"Headers":  []string{"Name", "Address", "Type", "Balance"},
+			"Rows":     walletRows,
+		},
+	})
+}
+
+func (g *Gateway) dashboardDealsHandler(c *gin.Context) {
+	i := userAPI(c)
+
+	records, err := i.StorageDealRecords(
+		deals.WithIncludePending(true),
+		deals.WithIncludeFinal(true),
+		deals.WithIncludeFailed(true),
+	)
+	if err != nil {
+		g.renderError(c, http.StatusInternalServerError, fmt.Errorf("listing storage deal records: %s", err))
+		return
+	}
+	rows := make([][]interface{}, len(records))
+	for j, r := range records {
+		status := "active"
+		if r.Pending {
+			status = "pending"
+		}
+		if r.ErrMsg != "" {
+			status = "failed: " + r.ErrMsg
+		}
+		expiration := "-"
+		if r.DealInfo.StartEpoch > 0 {
+			end := int64(r.DealInfo.StartEpoch + r.DealInfo.Duration)
+			expiration = fmt.Sprintf("%d (%s)", end, timeToString(epochToTime(end)))
+		}
+		rows[j] = []interface{}{
+			r.RootCid.String(),
+			r.DealInfo.Miner,
+			r.DealInfo.DealID,
+			status,
+			r.DealInfo.PricePerEpoch,
+			r.DealInfo.Size,
+			expiration,
+		}
+	}
+
+	g.renderDashboard(c, "/public/html/dashboard_deals.gohtml", 1, gin.H{
+		"Title":    "Storage Deals",
+		"Subtitle": fmt.Sprintf("%d deals", len(records)),
+		"Headers":  []string{"Cid", "Miner", "Deal ID", "Status", "Price per epoch", "Piece size", "Expiration"},
+		"Rows":     rows,
+	})
+}
+
+func (g *Gateway) dashboardJobsHandler(c *gin.Context) {
+	i := userAPI(c)
+
+	jobs, _, _, err := i.ListStorageJobs(api.ListStorageJobsConfig{Limit: numDashboardJobs})
+	if err != nil {
+		g.renderError(c, http.StatusInternalServerError, fmt.Errorf("listing storage jobs: %s", err))
+		return
+	}
+	views := make([]jobView, len(jobs))
+	for j, job := range jobs {
+		views[j] = newJobView(job)
+	}
+
+	g.renderDashboard(c, "/public/html/dashboard_jobs.gohtml", 2, gin.H{
+		"Title":    "Storage Jobs",
+		"Subtitle": fmt.Sprintf("Last %d jobs, updated live", numDashboardJobs),
+		"Headers":  []string{"Job ID", "Cid", "Status", "Created", "Deals", "Error"},
+		"Jobs":     views,
+	})
+}
+
+func (g *Gateway) dashboardJobsEventsHandler(c *gin.Context) {
+	i := userAPI(c)
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	ch := make(chan ffs.StorageJob, 10)
+	go func() {
+		if err := i.WatchJobs(ctx, ch); err != nil {
+			log.Errorf("watching jobs of %s: %s", i.ID(), err)
+		}
+		close(ch)
+	}()
+	defer func() {
+		cancel()
+		for range ch {
+		}
+	}()
+
+	setEventStreamHeaders(c)
+	c.Stream(func(w io.Writer) bool {
+		j, ok := <-ch
+		if !ok {
+			return false
+		}
+		c.SSEvent("job", newJobView(j))
+		return true
+	})
+}
+
+func (g *Gateway) dashboardLogsHandler(c *gin.Context) {
+	g.renderDashboard(c, "/public/html/dashboard_logs.gohtml", 3, gin.H{
+		"Title": "Logs",
+		"Cid":   c.Query("cid"),
+	})
+}
+
+func (g *Gateway) dashboardLogsEventsHandler(c *gin.Context) {
+	i := userAPI(c)
+
+	cid, err := cid.Decode(c.Query("cid"))
+	if err != nil {
+		c.String(http.StatusBadRequest, "invalid cid: %s", err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	ch := make(chan ffs.LogEntry, 100)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- i.WatchLogs(ctx, ch, cid, api.WithHistory(true))
+		close(ch)
+	}()
+	defer func() {
+		cancel()
+		for range ch {
+		}
+	}()
+
+	setEventStreamHeaders(c)
+	c.Stream(func(w io.Writer) bool {
+		le, ok := <-ch
+		if !ok {
+			if err := <-errCh; err != nil {
+				c.SSEvent("failure", err.Error())
+			}
+			return false
+		}
+		c.SSEvent("log", logView{
+			Time:  le.Timestamp.Format(time.RFC3339),
+			JobID: string(le.Jid),
+			Msg:   le.Msg,
+		})
+		return true
+	})
+}
+
+func (g *Gateway) renderLogin(c *gin.Context, code int, errMsg string) {
+	c.HTML(code, "/public/html/dashboard_login.gohtml", gin.H{
+		"BasePath": g.basePath + "/",
+		"Error":    errMsg,
+	})
+	c.Abort()
+}
+
+func (g *Gateway) renderDashboard(c *gin.Context, name string, selectedIndex int, data gin.H) {
+	data["BasePath"] = g.basePath + "/"
+	data["MenuItems"] = makeDashboardMenuItems(selectedIndex)
+	data["UserID"] = userAPI(c).ID().String()
+	c.HTML(http.StatusOK, name, data)
+}
+
+func (g *Gateway) dashboardPath() string {
+	return g.basePath + "/dashboard"
+}
+
+func makeDashboardMenuItems(selectedIndex int) []menuItem {
+	menuItems := []menuItem{
+		{
+			Name: "Storage",
+			Path: "dashboard",
+		},
+		{
+			Name: "Deals",
+			Path: "dashboard/deals",
+		},
+		{
+			Name: "Jobs",
+			Path: "dashboard/jobs",
+		},
+		{
+			Name: "Logs",
+			Path: "dashboard/logs",
+		},
+	}
+	menuItems[selectedIndex].Selected = true
+	return menuItems
+}
+
+func newJobView(j ffs.StorageJob) jobView {
+	states := make([]string, len(j.DealInfo))
+	for k, di := range j.DealInfo {
+		states[k] = fmt.Sprintf("%s: %s", di.Miner, di.StateName)
+	}
+	for _, de := range j.DealErrors {
+		states = append(states, fmt.Sprintf("%s: %s", de.Miner, de.Message))
+	}
+	return jobView{
+		ID:        string(j.ID),
+		Cid:       j.Cid.String(),
+		Status:    ffs.JobStatusStr[j.Status],
+		CreatedAt: timeToString(time.Unix(j.CreatedAt, 0)),
+		Deals:     strings.Join(states, ", "),
+		Error:     j.ErrCause,
+	}
+}
+
+func userAPI(c *gin.Context) *api.API {
+	return c.MustGet(dashboardAPIKey).(*api.API)
+}
+
+func setEventStreamHeaders(c *gin.Context) {
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+}
@@ -0,0 +1,83 @@
+package gateway
+
+import (
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/ffs/api"
+	"github.com/textileio/powergate/v2/ffs/manager"
+)
+
+func TestDashboardRequiresToken(t *testing.T) {
+	t.Parallel()
+	router := setupDashboard(t)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/dashboard/deals", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `name="token"`)
+
+	req := httptest.NewRequest("GET", "/dashboard/jobs", nil)
+	req.AddCookie(&http.Cookie{Name: tokenCookie, Value: "invalid"})
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.Contains(t, w.Body.String(), "Invalid auth token")
+
+	req = httptest.NewRequest("GET", "/dashboard/jobs/events", nil)
+	req.Header.Set("Authorization", "Bearer invalid")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestDashboardLogin(t *testing.T) {
+	t.Parallel()
+	router := setupDashboard(t)
+
+	form := url.Values{"token": []string{"invalid"}}
+	req := httptest.NewRequest("POST", "/dashboard/login", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.Empty(t, w.Header().Get("Set-Cookie"))
+
+	req = httptest.NewRequest("POST", "/dashboard/logout", nil)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusSeeOther, w.Code)
+	require.Contains(t, w.Header().Get("Set-Cookie"), tokenCookie+"=;")
+}
+
+var (
+	templateOnce sync.Once
+	temp         *template.Template
+	tempErr      error
+)
+
+func setupDashboard(t *testing.T) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	// Binary assets can be read only once.
+	templateOnce.Do(func() { temp, tempErr = loadTemplate() })
+	require.NoError(t, tempErr)
+
+	g := NewGateway("", nil, nil, nil, nil, &userAPIs{}, nil)
+	router := gin.New()
+	router.SetHTMLTemplate(temp)
+	g.registerDashboard(router.Group(""))
+	return router
+}
+
+type userAPIs struct{}
+
+func (u *userAPIs) GetByAuthToken(token string) (*api.API, error) {
+	return nil, manager.ErrAuthTokenNotFound
+}
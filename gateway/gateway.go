@@ -18,10 +18,11 @@ import (
 	assets "github.com/jessevdk/go-assets"
 	"github.com/rs/cors"
 	gincors "github.com/rs/cors/wrapper/gin"
-	askRunner "github.com/textileio/powergate/v2/index/ask/runner"
-	faultsModule "github.com/textileio/powergate/v2/index/faults/module"
-	minerModule "github.com/textileio/powergate/v2/index/miner/lotusidx"
+	"github.com/textileio/powergate/v2/index/ask"
+	"github.com/textileio/powergate/v2/index/faults"
+	"github.com/textileio/powergate/v2/index/miner"
 	"github.com/textileio/powergate/v2/reputation"
+	"github.com/textileio/powergate/v2/wallet"
 )
 
 const numTopMiners = 100
@@ -44,23 +45,33 @@ func (f *fileSystem) Exists(prefix, path string) bool {
 	return ok
 }
 
+// ReputationModule provides miners reputation scores.
+type ReputationModule interface {
+	GetTopMiners(n int) ([]reputation.MinerScore, error)
+}
+
 // Gateway provides HTTP-based access to Textile.
 type Gateway struct {
 	addr             string
 	server           *http.Server
-	askIndex         *askRunner.Runner
-	minerIndex       *minerModule.Index
-	faultsIndex      *faultsModule.Index
-	reputationModule *reputation.Module
+	askIndex         ask.Module
+	minerIndex       miner.Module
+	faultsIndex      faults.Module
+	reputationModule ReputationModule
+	users            UserAPIs
+	wallet           wallet.Module
+	basePath         string
 }
 
 // NewGateway returns a new gateway.
 func NewGateway(
 	addr string,
-	askIndex *askRunner.Runner,
-	minerIndex *minerModule.Index,
-	faultsIndex *faultsModule.Index,
-	reputationModule *reputation.Module,
+	askIndex ask.Module,
+	minerIndex miner.Module,
+	faultsIndex faults.Module,
+	reputationModule ReputationModule,
+	users UserAPIs,
+	wallet wallet.Module,
 ) *Gateway {
 	return &Gateway{
 		addr:             addr,
@@ -68,6 +79,8 @@ func NewGateway(
 		minerIndex:       minerIndex,
 		faultsIndex:      faultsIndex,
 		reputationModule: reputationModule,
+		users:            users,
+		wallet:           wallet,
 	}
 }
 
@@ -90,12 +103,15 @@ func (g *Gateway) Start(basePath string) {
 	if basePath == "/" {
 		basePath = ""
 	}
+	g.basePath = basePath
 	router.Use(static.Serve(basePath, &fileSystem{Assets}))
 	rg := router.Group(basePath)
 	rg.GET("/asks", g.asksHandler)
 	rg.GET("/miners", g.minersHandler)
 	rg.GET("/faults", g.faultsHandler)
 	rg.GET("/reputation", g.reputationHandler)
+	g.registerAPI(rg, basePath)
+	g.registerDashboard(rg)
 
 	rg.GET("/", func(c *gin.Context) {
 		c.Request.URL.Path = basePath + "/asks"
@@ -343,6 +359,11 @@ func makeMenuItems(selectedIndex int) []menuItem {
 			Path:     "reputation",
 			Selected: false,
 		},
+		{
+			Name:     "Dashboard",
+			Path:     "dashboard",
+			Selected: false,
+		},
 	}
 	menuItems[selectedIndex].Selected = true
 	return menuItems
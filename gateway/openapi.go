@@ -0,0 +1,148 @@
+package gateway
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// openAPISpec generates an OpenAPI 3 specification of the REST API
+// from the endpoints descriptions.
+func openAPISpec(serverURL string, endpoints []apiEndpoint) gin.H {
+	schemas := gin.H{
+		"Error": schemaOf(reflect.TypeOf(apiError{})),
+	}
+	paths := gin.H{}
+	for _, ep := range endpoints {
+		itemName := schemaName(ep.Item)
+		listName := itemName + "List"
+		schemas[itemName] = schemaOf(reflect.TypeOf(ep.Item))
+		list := schemaOf(reflect.TypeOf(apiList{}))
+		list["properties"].(gin.H)["items"] = gin.H{
+			"type":  "array",
+			"items": gin.H{"$ref": "#/components/schemas/" + itemName},
+		}
+		schemas[listName] = list
+
+		params := make([]gin.H, 0, len(ep.Params)+4)
+		for _, p := range ep.Params {
+			params = append(params, queryParam(p.Name, p.Description, gin.H{"type": string(p.Type)}))
+		}
+		params = append(params,
+			queryParam("sort", "Field to sort results by", gin.H{"type": "string", "enum": ep.SortFields, "default": ep.SortFields[0]}),
+			queryParam("order", "Sort order", gin.H{"type": "string", "enum": []string{"asc", "desc"}, "default": "asc"}),
+			queryParam("limit", "Maximum number of items to return", gin.H{"type": "integer", "minimum": 1, "maximum": maxLimit, "default": defaultLimit}),
+			queryParam("offset", "Number of items to skip", gin.H{"type": "integer", "minimum": 0, "default": 0}),
+		)
+
+		paths[ep.Path] = gin.H{
+			"get": gin.H{
+				"summary":     ep.Summary,
+				"operationId": "list" + strings.Title(strings.TrimPrefix(ep.Path, "/")),
+				"parameters":  params,
+				"responses": gin.H{
+					"200": gin.H{
+						"description": "A page of results",
+						"headers": gin.H{
+							"ETag": gin.H{
+								"description": "Version of the results, changes when the underlying index is updated",
+								"schema":      gin.H{"type": "string"},
+							},
+						},
+						"content": jsonContent(listName),
+					},
+					"304": gin.H{"description": "The results didn't change since the version in If-None-Match"},
+					"400": gin.H{"description": "Invalid query parameters", "content": jsonContent("Error")},
+					"500": gin.H{"description": "Internal error", "content": jsonContent("Error")},
+				},
+			},
+		}
+	}
+
+	return gin.H{
+		"openapi": "3.0.3",
+		"info": gin.H{
+			"title":   "Powergate Gateway API",
+			"version": "v1",
+		},
+		"servers":    []gin.H{{"url": serverURL}},
+		"paths":      paths,
+		"components": gin.H{"schemas": schemas},
+	}
+}
+
+func queryParam(name, description string, schema gin.H) gin.H {
+	return gin.H{
+		"name":        name,
+		"in":          "query",
+		"description": description,
+		"required":    false,
+		"schema":      schema,
+	}
+}
+
+func jsonContent(schema string) gin.H {
+	return gin.H{
+		"application/json": gin.H{
+			"schema": gin.H{"$ref": "#/components/schemas/" + schema},
+		},
+	}
+}
+
+// schemaName returns the schema name of an API type, e.g: apiMiner -> Miner.
+func schemaName(v interface{}) string {
+	return strings.TrimPrefix(reflect.TypeOf(v).Name(), "api")
+}
+
+// schemaOf returns the JSON schema of a type using its JSON field names.
+func schemaOf(t reflect.Type) gin.H {
+	if t == timeType {
+		return gin.H{"type": "string", "format": "date-time"}
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		s := schemaOf(t.Elem())
+		s["nullable"] = true
+		return s
+	case reflect.Struct:
+		props := gin.H{}
+		var required []string
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tag := f.Tag.Get("json")
+			name := strings.Split(tag, ",")[0]
+			if name == "" || name == "-" {
+				continue
+			}
+			props[name] = schemaOf(f.Type)
+			if !strings.Contains(tag, "omitempty") {
+				required = append(required, name)
+			}
+		}
+		s := gin.H{"type": "object", "properties": props}
+		if len(required) > 0 {
+			s["required"] = required
+		}
+		return s
+	case reflect.Slice:
+		return gin.H{"type": "array", "items": schemaOf(t.Elem())}
+	case reflect.Interface:
+		return gin.H{}
+	case reflect.String:
+		return gin.H{"type": "string"}
+	case reflect.Bool:
+		return gin.H{"type": "boolean"}
+	case reflect.Float32, reflect.Float64:
+		return gin.H{"type": "number", "format": "double"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return gin.H{"type": "integer", "format": "int64"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return gin.H{"type": "integer", "format": "int64", "minimum": 0}
+	default:
+		return gin.H{}
+	}
+}
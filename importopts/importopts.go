@@ -0,0 +1,70 @@
+package importopts
+
+import (
+	"fmt"
+
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	mh "github.com/multiformats/go-multihash"
+)
+
+const (
+	// LayoutBalanced is the default DAG layout.
+	LayoutBalanced = "balanced"
+	// LayoutTrickle is a DAG layout optimized for reading data in order.
+	LayoutTrickle = "trickle"
+)
+
+// Options are UnixFS import options, which determine the Cid of imported
+// data. The zero value uses go-ipfs defaults, so importing data with equal
+// Options results in the same Cid in Hot Storage and in prepared data.
+type Options struct {
+	// Chunker is the chunking algorithm, "size-<bytes>" or
+	// "rabin-<min>-<avg>-<max>". Empty means "size-262144".
+	Chunker string
+	// CidVersion is the Cid version. Zero means CIDv0, unless the
+	// hash function isn't sha2-256 which requires CIDv1.
+	CidVersion int
+	// RawLeaves indicates if leaves are raw blocks instead of UnixFS
+	// nodes. Unless RawLeavesSet is true, it's enabled only for CIDv1.
+	RawLeaves    bool
+	RawLeavesSet bool
+	// Layout is the DAG layout, LayoutBalanced or LayoutTrickle.
+	// Empty means LayoutBalanced.
+	Layout string
+	// HashFunction is the multihash function name. Empty
+	// means "sha2-256".
+	HashFunction string
+}
+
+// UnixfsAddOptions returns the go-ipfs add options for o.
+func (o Options) UnixfsAddOptions() ([]options.UnixfsAddOption, error) {
+	var opts []options.UnixfsAddOption
+	if o.Chunker != "" {
+		opts = append(opts, options.Unixfs.Chunker(o.Chunker))
+	}
+	switch o.CidVersion {
+	case 0:
+	case 1:
+		opts = append(opts, options.Unixfs.CidVersion(1))
+	default:
+		return nil, fmt.Errorf("unknown cid version %d", o.CidVersion)
+	}
+	if o.RawLeavesSet {
+		opts = append(opts, options.Unixfs.RawLeaves(o.RawLeaves))
+	}
+	switch o.Layout {
+	case "", LayoutBalanced:
+	case LayoutTrickle:
+		opts = append(opts, options.Unixfs.Layout(options.TrickleLayout))
+	default:
+		return nil, fmt.Errorf("unknown layout %s", o.Layout)
+	}
+	if o.HashFunction != "" {
+		code, ok := mh.Names[o.HashFunction]
+		if !ok {
+			return nil, fmt.Errorf("unknown hash function %s", o.HashFunction)
+		}
+		opts = append(opts, options.Unixfs.Hash(code))
+	}
+	return opts, nil
+}
@@ -0,0 +1,53 @@
+package importopts
+
+import (
+	"testing"
+
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnixfsAddOptions(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		opts      Options
+		chunker   string
+		version   uint64
+		rawLeaves bool
+		layout    options.Layout
+		hash      uint64
+	}{
+		{name: "default", chunker: "size-262144", version: 0, hash: mh.SHA2_256},
+		{name: "cidv1", opts: Options{CidVersion: 1}, chunker: "size-262144", version: 1, rawLeaves: true, hash: mh.SHA2_256},
+		{name: "cidv1 without raw leaves", opts: Options{CidVersion: 1, RawLeavesSet: true}, chunker: "size-262144", version: 1, hash: mh.SHA2_256},
+		{name: "rabin trickle", opts: Options{Chunker: "rabin-1024-2048-4096", Layout: LayoutTrickle}, chunker: "rabin-1024-2048-4096", layout: options.TrickleLayout, hash: mh.SHA2_256},
+		{name: "blake2b", opts: Options{HashFunction: "blake2b-256"}, chunker: "size-262144", version: 1, rawLeaves: true, hash: mh.BLAKE2B_MIN + 31},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			opts, err := test.opts.UnixfsAddOptions()
+			require.NoError(t, err)
+			settings, prefix, err := options.UnixfsAddOptions(opts...)
+			require.NoError(t, err)
+			require.Equal(t, test.chunker, settings.Chunker)
+			require.Equal(t, test.version, prefix.Version)
+			require.Equal(t, test.rawLeaves, settings.RawLeaves)
+			require.Equal(t, test.layout, settings.Layout)
+			require.Equal(t, test.hash, prefix.MhType)
+		})
+	}
+}
+
+func TestUnixfsAddOptionsInvalid(t *testing.T) {
+	t.Parallel()
+	_, err := Options{CidVersion: 2}.UnixfsAddOptions()
+	require.Error(t, err)
+	_, err = Options{Layout: "unknown"}.UnixfsAddOptions()
+	require.Error(t, err)
+	_, err = Options{HashFunction: "unknown"}.UnixfsAddOptions()
+	require.Error(t, err)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/filecoin-project/go-jsonrpc"
 	"github.com/filecoin-project/lotus/api"
+	marketevents "github.com/filecoin-project/lotus/markets/loggers"
 	logging "github.com/ipfs/go-log/v2"
 	ma "github.com/multiformats/go-multiaddr"
 
@@ -21,6 +22,31 @@ var (
 // ClientBuilder creates a new Lotus client.
 type ClientBuilder func(ctx context.Context) (*api.FullNodeStruct, func(), error)
 
+// RetrievalClientBuilder creates a new Lotus client for retrievals
+// restricted by a selector.
+type RetrievalClientBuilder func(ctx context.Context) (*RetrievalClient, func(), error)
+
+// RetrievalOrder is a retrieval order which only retrieves the blocks
+// matched by DataSelector, a dag-json encoded IPLD selector. Lotus nodes
+// without retrieval selector support ignore it, and retrieve the whole DAG.
+type RetrievalOrder struct {
+	api.RetrievalOrder
+	DataSelector *string `json:",omitempty"`
+}
+
+// RetrievalClient is a Lotus client which makes retrievals with
+// RetrievalOrders.
+type RetrievalClient struct {
+	Internal struct {
+		ClientRetrieveWithEvents func(ctx context.Context, order RetrievalOrder, ref *api.FileRef) (<-chan marketevents.RetrievalEvent, error)
+	}
+}
+
+// ClientRetrieveWithEvents retrieves the data of order, reporting its progress.
+func (rc *RetrievalClient) ClientRetrieveWithEvents(ctx context.Context, order RetrievalOrder, ref *api.FileRef) (<-chan marketevents.RetrievalEvent, error) {
+	return rc.Internal.ClientRetrieveWithEvents(ctx, order, ref)
+}
+
 // NewBuilder creates a new ClientBuilder.
 func NewBuilder(maddr ma.Multiaddr, authToken string, connRetries int) (ClientBuilder, error) {
 	addr, err := util.TCPAddrFromMultiAddr(maddr)
@@ -33,27 +59,50 @@ func NewBuilder(maddr ma.Multiaddr, authToken string, connRetries int) (ClientBu
 
 	return func(ctx context.Context) (*api.FullNodeStruct, func(), error) {
 		var api api.FullNodeStruct
-		var closer jsonrpc.ClientCloser
-		var err error
-		for i := 0; i < connRetries; i++ {
-			if ctx.Err() != nil {
-				return nil, nil, fmt.Errorf("canceled by context")
-			}
-			closer, err = jsonrpc.NewMergeClient(context.Background(), "ws://"+addr+"/rpc/v0", "Filecoin",
-				[]interface{}{
-					&api.Internal,
-					&api.CommonStruct.Internal,
-				}, headers)
-			if err == nil {
-				break
-			}
-			log.Warnf("failed to connect to Lotus client %s, retrying...", err)
-			time.Sleep(time.Second * 10)
-		}
+		closer, err := connect(ctx, addr, headers, connRetries, &api.Internal, &api.CommonStruct.Internal)
 		if err != nil {
-			return nil, nil, fmt.Errorf("couldn't connect to Lotus API: %s", err)
+			return nil, nil, err
 		}
-
 		return &api, closer, nil
 	}, nil
 }
+
+// NewRetrievalBuilder creates a new RetrievalClientBuilder.
+func NewRetrievalBuilder(maddr ma.Multiaddr, authToken string, connRetries int) (RetrievalClientBuilder, error) {
+	addr, err := util.TCPAddrFromMultiAddr(maddr)
+	if err != nil {
+		return nil, err
+	}
+	headers := http.Header{
+		"Authorization": []string{"Bearer " + authToken},
+	}
+
+	return func(ctx context.Context) (*RetrievalClient, func(), error) {
+		var rc RetrievalClient
+		closer, err := connect(ctx, addr, headers, connRetries, &rc.Internal)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &rc, closer, nil
+	}, nil
+}
+
+func connect(ctx context.Context, addr string, headers http.Header, connRetries int, outs ...interface{}) (jsonrpc.ClientCloser, error) {
+	var closer jsonrpc.ClientCloser
+	var err error
+	for i := 0; i < connRetries; i++ {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("canceled by context")
+		}
+		closer, err = jsonrpc.NewMergeClient(context.Background(), "ws://"+addr+"/rpc/v0", "Filecoin", outs, headers)
+		if err == nil {
+			break
+		}
+		log.Warnf("failed to connect to Lotus client %s, retrying...", err)
+		time.Sleep(time.Second * 10)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("couldn't connect to Lotus API: %s", err)
+	}
+	return closer, nil
+}
@@ -0,0 +1,187 @@
+package migration
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+)
+
+// DryRunReport describes the datastore changes of a migration
+// run in dry-run mode.
+type DryRunReport struct {
+	// Version is the datastore version after the migration.
+	Version int
+	// Down is true if the migration was reverted.
+	Down     bool
+	Added    int
+	Modified int
+	Deleted  int
+}
+
+// DryRun runs all the needed migrations to upgrade or downgrade the datastore
+// to the provided version in a single transaction that is always discarded,
+// and reports the changed keys by each migration. Since all migrations are
+// run in a transaction, the datastore must support transactions big enough
+// to contain all the changes.
+func (m *Migrator) DryRun(version int) ([]DryRunReport, error) {
+	currentVersion, emptyDS, err := m.getCurrentVersion()
+	if err != nil {
+		return nil, fmt.Errorf("getting current version: %s", err)
+	}
+	if emptyDS {
+		return nil, nil
+	}
+	if version < 0 || version > m.getTargetVersion() {
+		return nil, fmt.Errorf("unknown version %d", version)
+	}
+	if currentVersion > m.getTargetVersion() {
+		return nil, fmt.Errorf("current version %d is newer than known migrations", currentVersion)
+	}
+
+	txn, err := m.ds.NewTransaction(false)
+	if err != nil {
+		return nil, fmt.Errorf("creating txn for dry-run: %s", err)
+	}
+	defer txn.Discard()
+
+	var reports []DryRunReport
+	for i := currentVersion + 1; i <= version; i++ {
+		migration, ok := m.migrations[i]
+		if !ok {
+			return nil, fmt.Errorf("migration %d script not found", i)
+		}
+		r := newRecorder(txn)
+		if err := migration.Run(r); err != nil {
+			return nil, fmt.Errorf("running migration %d: %s", i, err)
+		}
+		if migration.Verify != nil {
+			if err := migration.Verify(r); err != nil {
+				return nil, fmt.Errorf("verifying migration %d: %s", i, err)
+			}
+		}
+		reports = append(reports, r.report(i, false))
+	}
+	for i := currentVersion; i > version; i-- {
+		migration, ok := m.migrations[i]
+		if !ok {
+			return nil, fmt.Errorf("migration %d script not found", i)
+		}
+		if migration.Down == nil {
+			return nil, fmt.Errorf("migration %d isn't reversible", i)
+		}
+		r := newRecorder(txn)
+		if err := migration.Down(r); err != nil {
+			return nil, fmt.Errorf("reverting migration %d: %s", i, err)
+		}
+		reports = append(reports, r.report(i-1, true))
+	}
+
+	return reports, nil
+}
+
+// recorder tracks the keys changed through a transaction. It also
+// serializes access to the transaction, since migrations can write
+// concurrently and transactions might not support it.
+type recorder struct {
+	lock    sync.Mutex
+	txn     datastore.Txn
+	changes map[datastore.Key]*keyChange
+}
+
+type keyChange struct {
+	existed bool
+	deleted bool
+}
+
+var _ datastoreReaderWriter = (*recorder)(nil)
+
+func newRecorder(txn datastore.Txn) *recorder {
+	return &recorder{
+		txn:     txn,
+		changes: make(map[datastore.Key]*keyChange),
+	}
+}
+
+func (r *recorder) Get(key datastore.Key) ([]byte, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.txn.Get(key)
+}
+
+func (r *recorder) Has(key datastore.Key) (bool, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.txn.Has(key)
+}
+
+func (r *recorder) GetSize(key datastore.Key) (int, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.txn.GetSize(key)
+}
+
+func (r *recorder) Query(q query.Query) (query.Results, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.txn.Query(q)
+}
+
+func (r *recorder) Put(key datastore.Key, value []byte) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	c, err := r.track(key)
+	if err != nil {
+		return err
+	}
+	if err := r.txn.Put(key, value); err != nil {
+		return err
+	}
+	c.deleted = false
+	return nil
+}
+
+func (r *recorder) Delete(key datastore.Key) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	c, err := r.track(key)
+	if err != nil {
+		return err
+	}
+	if err := r.txn.Delete(key); err != nil {
+		return err
+	}
+	c.deleted = true
+	return nil
+}
+
+func (r *recorder) track(key datastore.Key) (*keyChange, error) {
+	if c, ok := r.changes[key]; ok {
+		return c, nil
+	}
+	existed, err := r.txn.Has(key)
+	if err != nil {
+		return nil, fmt.Errorf("checking if key exists: %s", err)
+	}
+	c := &keyChange{existed: existed}
+	r.changes[key] = c
+	return c, nil
+}
+
+func (r *recorder) report(version int, down bool) DryRunReport {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	rep := DryRunReport{Version: version, Down: down}
+	for _, c := range r.changes {
+		switch {
+		case c.existed && c.deleted:
+			rep.Deleted++
+		case c.existed:
+			rep.Modified++
+		case !c.deleted:
+			rep.Added++
+		}
+	}
+	return rep
+}
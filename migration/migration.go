@@ -29,8 +29,13 @@ type datastoreReaderWriter interface {
 
 // Migration runs a vA->v(A+1) migration. UseTxn indicates
 // if this migration should be run in a transaction.
+// Down is optional, and reverts the migration from v(A+1) to vA.
+// Verify is optional, and checks the datastore state after running
+// the migration; if it fails, the datastore version isn't updated.
 type Migration struct {
 	Run    func(datastoreReaderWriter) error
+	Down   func(datastoreReaderWriter) error
+	Verify func(datastore.Read) error
 	UseTxn bool
 }
 
@@ -64,6 +69,28 @@ func (m *Migrator) Ensure() error {
 		return nil
 	}
 
+	return m.migrate(currentVersion, targetVersion)
+}
+
+// MigrateTo runs all the needed migrations to upgrade or downgrade the
+// datastore to the provided version. Downgrading requires all the
+// reverted migrations to define a Down step.
+func (m *Migrator) MigrateTo(version int) error {
+	currentVersion, emptyDS, err := m.getCurrentVersion()
+	if err != nil {
+		return fmt.Errorf("getting current version: %s", err)
+	}
+	if emptyDS {
+		return fmt.Errorf("datastore is empty")
+	}
+	if version < 0 || version > m.getTargetVersion() {
+		return fmt.Errorf("unknown version %d", version)
+	}
+
+	return m.migrate(currentVersion, version)
+}
+
+func (m *Migrator) migrate(currentVersion, targetVersion int) error {
 	log.Infof("Current datastore version is %d, target version %d", currentVersion, targetVersion)
 
 	if currentVersion == targetVersion {
@@ -71,7 +98,17 @@ func (m *Migrator) Ensure() error {
 	}
 
 	if currentVersion > targetVersion {
-		return fmt.Errorf("migrations are forward only, current version %d, target version %d", currentVersion, targetVersion)
+		if currentVersion > m.getTargetVersion() {
+			return fmt.Errorf("current version %d is newer than known migrations", currentVersion)
+		}
+		for i := currentVersion; i > targetVersion; i-- {
+			log.Infof("Reverting v%d migration...", i)
+			if err := m.runDown(i); err != nil {
+				return fmt.Errorf("reverting migration %d: %s", i, err)
+			}
+			log.Infof("Migration %d reverted successfully", i)
+		}
+		return nil
 	}
 
 	for i := currentVersion + 1; i <= targetVersion; i++ {
@@ -118,15 +155,46 @@ func (m *Migrator) getCurrentVersion() (int, bool, error) {
 }
 
 func (m *Migrator) run(version int) error {
-	var dsReaderWriter datastoreReaderWriter
+	migration, ok := m.migrations[version]
+	if !ok {
+		return fmt.Errorf("migration script not found")
+	}
+
+	return m.apply(migration.UseTxn, version, func(ds datastoreReaderWriter) error {
+		if err := migration.Run(ds); err != nil {
+			return fmt.Errorf("running migration script: %s", err)
+		}
+		if migration.Verify != nil {
+			if err := migration.Verify(ds); err != nil {
+				return fmt.Errorf("verifying migration: %s", err)
+			}
+		}
+		return nil
+	})
+}
 
+func (m *Migrator) runDown(version int) error {
 	migration, ok := m.migrations[version]
 	if !ok {
 		return fmt.Errorf("migration script not found")
 	}
+	if migration.Down == nil {
+		return fmt.Errorf("migration isn't reversible")
+	}
+
+	return m.apply(migration.UseTxn, version-1, func(ds datastoreReaderWriter) error {
+		if err := migration.Down(ds); err != nil {
+			return fmt.Errorf("running down migration script: %s", err)
+		}
+		return nil
+	})
+}
 
-	dsReaderWriter = m.ds
-	if migration.UseTxn {
+// apply runs f, and saves newVersion as the datastore version if
+// it succeeds. If useTxn is true, both are done in a transaction.
+func (m *Migrator) apply(useTxn bool, newVersion int, f func(datastoreReaderWriter) error) error {
+	var dsReaderWriter datastoreReaderWriter = m.ds
+	if useTxn {
 		txn, err := m.ds.NewTransaction(false)
 		if err != nil {
 			return fmt.Errorf("creating txn for migration: %s", err)
@@ -135,20 +203,15 @@ func (m *Migrator) run(version int) error {
 		dsReaderWriter = txn
 	}
 
-	if err := migration.Run(dsReaderWriter); err != nil {
-		return fmt.Errorf("running migration script: %s", err)
+	if err := f(dsReaderWriter); err != nil {
+		return err
 	}
 
-	newVer := currentVersion{Version: version}
-	newVerBuf, err := json.Marshal(newVer)
-	if err != nil {
-		return fmt.Errorf("marshaling new version: %s", err)
-	}
-	if err := dsReaderWriter.Put(keyCurrentVersion, newVerBuf); err != nil {
-		return fmt.Errorf("saving new version: %s", err)
+	if err := putVersion(dsReaderWriter, newVersion); err != nil {
+		return err
 	}
 
-	if migration.UseTxn {
+	if useTxn {
 		txn := dsReaderWriter.(datastore.Txn)
 		if err := txn.Commit(); err != nil {
 			return fmt.Errorf("committing transaction: %s", err)
@@ -158,6 +221,18 @@ func (m *Migrator) run(version int) error {
 	return nil
 }
 
+func putVersion(ds datastore.Write, version int) error {
+	newVer := currentVersion{Version: version}
+	newVerBuf, err := json.Marshal(newVer)
+	if err != nil {
+		return fmt.Errorf("marshaling new version: %s", err)
+	}
+	if err := ds.Put(keyCurrentVersion, newVerBuf); err != nil {
+		return fmt.Errorf("saving new version: %s", err)
+	}
+	return nil
+}
+
 func (m *Migrator) isDSEmpty() (bool, error) {
 	q := query.Query{Limit: 1}
 	res, err := m.ds.Query(q)
@@ -185,14 +260,25 @@ func (m *Migrator) getTargetVersion() int {
 }
 
 func (m *Migrator) bootstrapEmptyDatastore(version int) error {
-	newVer := currentVersion{Version: version}
-	newVerBuf, err := json.Marshal(newVer)
+	return putVersion(m.ds, version)
+}
+
+// deletePrefix deletes all the keys with the provided prefix.
+func deletePrefix(ds datastoreReaderWriter, prefix string) error {
+	q := query.Query{Prefix: prefix, KeysOnly: true}
+	res, err := ds.Query(q)
 	if err != nil {
-		return fmt.Errorf("marshaling new version: %s", err)
+		return fmt.Errorf("executing query: %s", err)
 	}
-	if err := m.ds.Put(keyCurrentVersion, newVerBuf); err != nil {
-		return fmt.Errorf("saving new version: %s", err)
+	defer func() { _ = res.Close() }()
+	all, err := res.Rest()
+	if err != nil {
+		return fmt.Errorf("getting query results: %s", err)
+	}
+	for _, e := range all {
+		if err := ds.Delete(datastore.NewKey(e.Key)); err != nil {
+			return fmt.Errorf("deleting key %s: %s", e.Key, err)
+		}
 	}
-
 	return nil
 }
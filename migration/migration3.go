@@ -89,4 +89,45 @@ var V3StorageJobsIndexMigration = Migration{
 
 		return nil
 	},
+	Down: func(ds datastoreReaderWriter) error {
+		for _, prefix := range []string{"/ffs/scheduler/sjstore/apiid", "/ffs/scheduler/sjstore/cid"} {
+			if err := deletePrefix(ds, prefix); err != nil {
+				return fmt.Errorf("deleting %s index: %s", prefix, err)
+			}
+		}
+		return nil
+	},
+	Verify: func(ds datastore.Read) error {
+		q := query.Query{Prefix: "/ffs/scheduler/sjstore/job"}
+		res, err := ds.Query(q)
+		if err != nil {
+			return fmt.Errorf("querying sjstore jobs: %s", err)
+		}
+		defer func() { _ = res.Close() }()
+
+		for r := range res.Next() {
+			if r.Error != nil {
+				return fmt.Errorf("iterating results: %s", r.Error)
+			}
+			var job storageJob
+			if err := json.Unmarshal(r.Value, &job); err != nil {
+				return fmt.Errorf("unmarshaling job: %s", err)
+			}
+			createdAt := fmt.Sprintf("%d", job.CreatedAt)
+			keys := []datastore.Key{
+				datastore.NewKey("/ffs/scheduler/sjstore/apiid").ChildString(job.APIID).ChildString(job.Cid.String()).ChildString(createdAt),
+				datastore.NewKey("/ffs/scheduler/sjstore/cid").ChildString(job.Cid.String()).ChildString(job.APIID).ChildString(createdAt),
+			}
+			for _, k := range keys {
+				ok, err := ds.Has(k)
+				if err != nil {
+					return fmt.Errorf("checking index key: %s", err)
+				}
+				if !ok {
+					return fmt.Errorf("job %s isn't indexed in %s", job.ID, k)
+				}
+			}
+		}
+		return nil
+	},
 }
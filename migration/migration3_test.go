@@ -21,3 +21,23 @@ func TestV3(t *testing.T) {
 
 	post(t, ds, "testdata/v3_StorageJobs.post")
 }
+
+func TestV3Down(t *testing.T) {
+	t.Parallel()
+
+	ds := tests.NewTxMapDatastore()
+
+	pre(t, ds, "testdata/v3_StorageJobs.pre")
+
+	err := V3StorageJobsIndexMigration.Run(ds)
+	require.NoError(t, err)
+	err = V3StorageJobsIndexMigration.Verify(ds)
+	require.NoError(t, err)
+
+	err = V3StorageJobsIndexMigration.Down(ds)
+	require.NoError(t, err)
+	err = V3StorageJobsIndexMigration.Verify(ds)
+	require.Error(t, err)
+
+	post(t, ds, "testdata/v3_StorageJobs.pre")
+}
@@ -30,4 +30,25 @@ var V5DeleteOldMinerIndex = Migration{
 
 		return nil
 	},
+	// The old miner index is rebuilt from the chain when missing,
+	// so reverting doesn't need to restore deleted keys.
+	Down: func(_ datastoreReaderWriter) error {
+		return nil
+	},
+	Verify: func(ds datastore.Read) error {
+		q := query.Query{Prefix: "/index/miner/chainstore", KeysOnly: true, Limit: 1}
+		res, err := ds.Query(q)
+		if err != nil {
+			return fmt.Errorf("querying records: %s", err)
+		}
+		defer func() { _ = res.Close() }()
+		all, err := res.Rest()
+		if err != nil {
+			return fmt.Errorf("getting query results: %s", err)
+		}
+		if len(all) > 0 {
+			return fmt.Errorf("chainstore key %s wasn't deleted", all[0].Key)
+		}
+		return nil
+	},
 }
@@ -0,0 +1,131 @@
+package migration
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/textileio/powergate/v2/util"
+)
+
+// V6StorageDealRecordsIndexes contains the logic to upgrade a datastore from
+// version 5 to version 6. It builds the storage deal records secondary
+// indexes for existing records.
+var V6StorageDealRecordsIndexes = Migration{
+	UseTxn: false,
+	Run: func(ds datastoreReaderWriter) error {
+		var count int
+		for _, prefix := range []string{"/deals/storage-pending", "/deals/storage-final"} {
+			q := query.Query{Prefix: prefix}
+			res, err := ds.Query(q)
+			if err != nil {
+				return fmt.Errorf("querying records: %s", err)
+			}
+			all, err := res.Rest()
+			_ = res.Close()
+			if err != nil {
+				return fmt.Errorf("getting query results: %s", err)
+			}
+
+			for _, r := range all {
+				var sdr v5StorageDealRecord
+				if err := json.Unmarshal(r.Value, &sdr); err != nil {
+					return fmt.Errorf("unmarshaling storage deal record %s: %s", r.Key, err)
+				}
+				// With TrimPrefix since the value should be relative
+				// to the namespace of the store, and not the full-key.
+				recordKey := []byte(strings.TrimPrefix(r.Key, "/deals"))
+				for _, k := range v6IndexKeys(sdr) {
+					if err := ds.Put(k, recordKey); err != nil {
+						return fmt.Errorf("saving index entry %s: %s", k, err)
+					}
+				}
+				count++
+			}
+		}
+		log.Infof("indexed %d storage deal records", count)
+
+		return nil
+	},
+	Down: func(ds datastoreReaderWriter) error {
+		return deletePrefix(ds, "/deals/storageidx")
+	},
+	Verify: func(ds datastore.Read) error {
+		for _, prefix := range []string{"/deals/storage-pending", "/deals/storage-final"} {
+			q := query.Query{Prefix: prefix}
+			res, err := ds.Query(q)
+			if err != nil {
+				return fmt.Errorf("querying records: %s", err)
+			}
+			all, err := res.Rest()
+			_ = res.Close()
+			if err != nil {
+				return fmt.Errorf("getting query results: %s", err)
+			}
+			for _, r := range all {
+				var sdr v5StorageDealRecord
+				if err := json.Unmarshal(r.Value, &sdr); err != nil {
+					return fmt.Errorf("unmarshaling storage deal record %s: %s", r.Key, err)
+				}
+				for _, k := range v6IndexKeys(sdr) {
+					exists, err := ds.Has(k)
+					if err != nil {
+						return fmt.Errorf("checking index entry %s: %s", k, err)
+					}
+					if !exists {
+						return fmt.Errorf("index entry %s for record %s is missing", k, r.Key)
+					}
+				}
+			}
+		}
+		return nil
+	},
+}
+
+// v5StorageDealRecord contains the fields of storage deal records
+// in version 5 which are indexed.
+type v5StorageDealRecord struct {
+	RootCid  cid.Cid
+	Addr     string
+	DealInfo v5StorageDealInfo
+	Time     int64
+}
+
+type v5StorageDealInfo struct {
+	ProposalCid cid.Cid
+	StateID     uint64
+	Miner       string
+	StartEpoch  uint64
+	Duration    uint64
+}
+
+// v6IndexKeys returns the index entry keys of a record, using the key
+// layout of version 6.
+func v6IndexKeys(sdr v5StorageDealRecord) []datastore.Key {
+	base := datastore.NewKey("/deals/storageidx")
+	pc := util.CidToString(sdr.DealInfo.ProposalCid)
+	keys := []datastore.Key{
+		base.ChildString("state").ChildString(strconv.FormatUint(sdr.DealInfo.StateID, 10)).ChildString(pc),
+	}
+	if sdr.DealInfo.Miner != "" {
+		keys = append(keys, base.ChildString("miner").ChildString(sdr.DealInfo.Miner).ChildString(pc))
+	}
+	if sdr.RootCid.Defined() {
+		keys = append(keys, base.ChildString("payload").ChildString(util.CidToString(sdr.RootCid)).ChildString(pc))
+	}
+	if sdr.Addr != "" {
+		keys = append(keys, base.ChildString("addr").ChildString(sdr.Addr).ChildString(pc))
+	}
+	if sdr.Time >= 0 {
+		keys = append(keys, base.ChildString("time").ChildString(fmt.Sprintf("%020d", sdr.Time)).ChildString(pc))
+	}
+	if sdr.DealInfo.StartEpoch > 0 {
+		exp := sdr.DealInfo.StartEpoch + sdr.DealInfo.Duration
+		keys = append(keys, base.ChildString("expiration").ChildString(fmt.Sprintf("%020d", exp)).ChildString(pc))
+	}
+	return keys
+}
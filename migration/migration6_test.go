@@ -0,0 +1,41 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func TestV6(t *testing.T) {
+	t.Parallel()
+
+	ds := tests.NewTxMapDatastore()
+
+	pre(t, ds, "testdata/v6_StorageDealRecordsIndexes.pre")
+
+	err := V6StorageDealRecordsIndexes.Run(ds)
+	require.NoError(t, err)
+	err = V6StorageDealRecordsIndexes.Verify(ds)
+	require.NoError(t, err)
+
+	post(t, ds, "testdata/v6_StorageDealRecordsIndexes.post")
+}
+
+func TestV6Down(t *testing.T) {
+	t.Parallel()
+
+	ds := tests.NewTxMapDatastore()
+
+	pre(t, ds, "testdata/v6_StorageDealRecordsIndexes.pre")
+
+	err := V6StorageDealRecordsIndexes.Run(ds)
+	require.NoError(t, err)
+
+	err = V6StorageDealRecordsIndexes.Down(ds)
+	require.NoError(t, err)
+	err = V6StorageDealRecordsIndexes.Verify(ds)
+	require.Error(t, err)
+
+	post(t, ds, "testdata/v6_StorageDealRecordsIndexes.pre")
+}
@@ -142,6 +142,156 @@ func TestFailingMigration(t *testing.T) {
 	require.Equal(t, 0, v)
 }
 
+func TestMigrateToDown(t *testing.T) {
+	t.Parallel()
+
+	ms := map[int]Migration{
+		1: {
+			UseTxn: true,
+			Run: func(ds datastoreReaderWriter) error {
+				return ds.Put(datastore.NewKey("v1"), []byte("1"))
+			},
+			Down: func(ds datastoreReaderWriter) error {
+				return ds.Delete(datastore.NewKey("v1"))
+			},
+		},
+		2: {
+			UseTxn: false,
+			Run: func(ds datastoreReaderWriter) error {
+				return ds.Put(datastore.NewKey("v2"), []byte("2"))
+			},
+			Down: func(ds datastoreReaderWriter) error {
+				return ds.Delete(datastore.NewKey("v2"))
+			},
+		},
+	}
+	m := newMigrator(ms, false)
+
+	err := m.Ensure()
+	require.NoError(t, err)
+	v, _, err := m.getCurrentVersion()
+	require.NoError(t, err)
+	require.Equal(t, 2, v)
+
+	err = m.MigrateTo(0)
+	require.NoError(t, err)
+	v, _, err = m.getCurrentVersion()
+	require.NoError(t, err)
+	require.Equal(t, 0, v)
+	for _, k := range []string{"v1", "v2"} {
+		ok, err := m.ds.Has(datastore.NewKey(k))
+		require.NoError(t, err)
+		require.False(t, ok)
+	}
+
+	err = m.MigrateTo(3)
+	require.Error(t, err)
+}
+
+func TestMigrateToNotReversible(t *testing.T) {
+	t.Parallel()
+
+	ms := map[int]Migration{
+		1: {
+			UseTxn: true,
+			Run: func(_ datastoreReaderWriter) error {
+				return nil
+			},
+		},
+	}
+	m := newMigrator(ms, false)
+
+	err := m.Ensure()
+	require.NoError(t, err)
+
+	err = m.MigrateTo(0)
+	require.Error(t, err)
+
+	v, _, err := m.getCurrentVersion()
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+}
+
+func TestFailingVerification(t *testing.T) {
+	t.Parallel()
+
+	ms := map[int]Migration{
+		1: {
+			UseTxn: false,
+			Run: func(_ datastoreReaderWriter) error {
+				return nil
+			},
+			Verify: func(_ datastore.Read) error {
+				return fmt.Errorf("I failed")
+			},
+		},
+	}
+	m := newMigrator(ms, false)
+
+	err := m.Ensure()
+	require.Error(t, err)
+
+	v, _, err := m.getCurrentVersion()
+	require.NoError(t, err)
+	require.Equal(t, 0, v)
+}
+
+func TestDryRun(t *testing.T) {
+	t.Parallel()
+
+	ds, err := badger.NewDatastore(t.TempDir(), &badger.DefaultOptions)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, ds.Close()) }()
+	require.NoError(t, ds.Put(datastore.NewKey("foo"), []byte("bar")))
+	require.NoError(t, ds.Put(datastore.NewKey("baz"), []byte("qux")))
+
+	ms := map[int]Migration{
+		1: {
+			UseTxn: false,
+			Run: func(ds datastoreReaderWriter) error {
+				if err := ds.Put(datastore.NewKey("foo"), []byte("bar2")); err != nil {
+					return err
+				}
+				if err := ds.Delete(datastore.NewKey("baz")); err != nil {
+					return err
+				}
+				return ds.Put(datastore.NewKey("new"), []byte("1"))
+			},
+			Verify: func(ds datastore.Read) error {
+				_, err := ds.Get(datastore.NewKey("new"))
+				return err
+			},
+			Down: func(ds datastoreReaderWriter) error {
+				return ds.Delete(datastore.NewKey("new"))
+			},
+		},
+	}
+	m := New(ds, ms)
+
+	reports, err := m.DryRun(1)
+	require.NoError(t, err)
+	require.Equal(t, []DryRunReport{{Version: 1, Added: 1, Modified: 1, Deleted: 1}}, reports)
+
+	// Nothing was changed.
+	v, _, err := m.getCurrentVersion()
+	require.NoError(t, err)
+	require.Equal(t, 0, v)
+	val, err := ds.Get(datastore.NewKey("foo"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("bar"), val)
+	ok, err := ds.Has(datastore.NewKey("new"))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	require.NoError(t, m.Ensure())
+	reports, err = m.DryRun(0)
+	require.NoError(t, err)
+	require.Equal(t, []DryRunReport{{Version: 0, Down: true, Deleted: 1}}, reports)
+	ok, err = ds.Has(datastore.NewKey("new"))
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
 func TestRealDataBadgerFromV0(t *testing.T) {
 	logger.SetDebugLogging()
 	_ = logger.SetLogLevel("badger", "error")
@@ -223,6 +373,7 @@ func TestRealDataBadgerFromV3(t *testing.T) {
 		3: V3StorageJobsIndexMigration,
 		4: V4RecordsMigration,
 		5: V5DeleteOldMinerIndex,
+		6: V6StorageDealRecordsIndexes,
 	}
 	m := New(ds, migrations)
 	err = m.Ensure()
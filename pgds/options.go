@@ -0,0 +1,49 @@
+package pgds
+
+import "time"
+
+var defaultConfig = config{
+	opTimeout:  30 * time.Second,
+	txnTimeout: 30 * time.Second,
+	txnRetries: 5,
+	tableName:  "kvstore",
+}
+
+type config struct {
+	opTimeout  time.Duration
+	txnTimeout time.Duration
+	txnRetries int
+	tableName  string
+}
+
+// Option configures the datastore.
+type Option func(*config)
+
+// WithOpTimeout sets the timeout of single operations.
+func WithOpTimeout(d time.Duration) Option {
+	return func(c *config) {
+		c.opTimeout = d
+	}
+}
+
+// WithTxnTimeout sets the maximum duration of transactions.
+func WithTxnTimeout(d time.Duration) Option {
+	return func(c *config) {
+		c.txnTimeout = d
+	}
+}
+
+// WithTxnRetries sets the maximum number of times a transaction is retried
+// after a serialization failure.
+func WithTxnRetries(n int) Option {
+	return func(c *config) {
+		c.txnRetries = n
+	}
+}
+
+// WithTableName sets the name of the table that stores all keys.
+func WithTableName(name string) Option {
+	return func(c *config) {
+		c.tableName = name
+	}
+}
@@ -0,0 +1,326 @@
+// Package pgds implements a transactional go-datastore backed by PostgreSQL.
+//
+// All keys are stored in a single table, with keys ordered bytewise so prefix
+// queries and key orders are resolved by the database.
+package pgds
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	logger "github.com/ipfs/go-log/v2"
+	"github.com/lib/pq"
+)
+
+var (
+	log = logger.Logger("pgds")
+
+	sqlCompareOps = map[query.Op]string{
+		query.Equal:              "=",
+		query.NotEqual:           "<>",
+		query.GreaterThan:        ">",
+		query.GreaterThanOrEqual: ">=",
+		query.LessThan:           "<",
+		query.LessThanOrEqual:    "<=",
+	}
+
+	validTableName = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// Datastore is a go-datastore backed by PostgreSQL.
+type Datastore struct {
+	db  *sql.DB
+	cfg config
+	sqlOps
+}
+
+var _ datastore.TxnDatastore = (*Datastore)(nil)
+var _ datastore.Batching = (*Datastore)(nil)
+
+// New returns a new Datastore connected to the database in uri, creating
+// the table that stores all keys if it doesn't exist.
+func New(ctx context.Context, uri string, opts ...Option) (*Datastore, error) {
+	cfg := defaultConfig
+	for _, o := range opts {
+		o(&cfg)
+	}
+	if !validTableName.MatchString(cfg.tableName) {
+		return nil, fmt.Errorf("invalid table name %s", cfg.tableName)
+	}
+
+	db, err := sql.Open("postgres", uri)
+	if err != nil {
+		return nil, fmt.Errorf("opening database: %s", err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("connecting to database: %s", err)
+	}
+	// The C collation orders keys bytewise, which is the
+	// ordering expected by go-datastore key orders.
+	stmt := fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+		key TEXT COLLATE "C" PRIMARY KEY,
+		value BYTEA NOT NULL
+	)`, cfg.tableName)
+	if _, err := db.ExecContext(ctx, stmt); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("creating table: %s", err)
+	}
+
+	return &Datastore{
+		db:     db,
+		cfg:    cfg,
+		sqlOps: sqlOps{q: db, table: cfg.tableName, timeout: cfg.opTimeout},
+	}, nil
+}
+
+// Batch returns a batch that is applied atomically on commit.
+func (d *Datastore) Batch() (datastore.Batch, error) {
+	return d.NewTransaction(false)
+}
+
+// Sync is a noop, since writes are durable when committed.
+func (d *Datastore) Sync(datastore.Key) error {
+	return nil
+}
+
+// Close closes the database connections.
+func (d *Datastore) Close() error {
+	return d.db.Close()
+}
+
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// sqlOps implements datastore reads and writes on a database or transaction.
+// Operations use ctx if set, or a new context with timeout otherwise.
+type sqlOps struct {
+	q        querier
+	table    string
+	ctx      context.Context
+	timeout  time.Duration
+	buffered bool
+}
+
+func (o sqlOps) context() (context.Context, context.CancelFunc) {
+	if o.ctx != nil {
+		return o.ctx, func() {}
+	}
+	return context.WithTimeout(context.Background(), o.timeout)
+}
+
+// Get returns the value of key.
+func (o sqlOps) Get(key datastore.Key) ([]byte, error) {
+	ctx, cancel := o.context()
+	defer cancel()
+	var value []byte
+	err := o.q.QueryRowContext(ctx, fmt.Sprintf("SELECT value FROM %s WHERE key = $1", o.table), key.String()).Scan(&value)
+	if err == sql.ErrNoRows {
+		return nil, datastore.ErrNotFound
+	}
+	if err != nil {
+		return nil, opError("getting key", err)
+	}
+	return value, nil
+}
+
+// Has returns true if key exists.
+func (o sqlOps) Has(key datastore.Key) (bool, error) {
+	ctx, cancel := o.context()
+	defer cancel()
+	var exists bool
+	err := o.q.QueryRowContext(ctx, fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s WHERE key = $1)", o.table), key.String()).Scan(&exists)
+	if err != nil {
+		return false, opError("checking key", err)
+	}
+	return exists, nil
+}
+
+// GetSize returns the size of the value of key.
+func (o sqlOps) GetSize(key datastore.Key) (int, error) {
+	ctx, cancel := o.context()
+	defer cancel()
+	var size int
+	err := o.q.QueryRowContext(ctx, fmt.Sprintf("SELECT octet_length(value) FROM %s WHERE key = $1", o.table), key.String()).Scan(&size)
+	if err == sql.ErrNoRows {
+		return -1, datastore.ErrNotFound
+	}
+	if err != nil {
+		return -1, opError("getting key size", err)
+	}
+	return size, nil
+}
+
+// Put sets the value of key.
+func (o sqlOps) Put(key datastore.Key, value []byte) error {
+	ctx, cancel := o.context()
+	defer cancel()
+	if value == nil {
+		value = []byte{}
+	}
+	stmt := fmt.Sprintf("INSERT INTO %s (key, value) VALUES ($1, $2) ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value", o.table)
+	if _, err := o.q.ExecContext(ctx, stmt, key.String(), value); err != nil {
+		return opError("putting key", err)
+	}
+	return nil
+}
+
+// Delete deletes key. Deleting a missing key isn't an error.
+func (o sqlOps) Delete(key datastore.Key) error {
+	ctx, cancel := o.context()
+	defer cancel()
+	if _, err := o.q.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s WHERE key = $1", o.table), key.String()); err != nil {
+		return opError("deleting key", err)
+	}
+	return nil
+}
+
+// Query executes q. Prefixes, key orders, limits and offsets are resolved by the
+// database, and other filters and orders are naively applied to the results.
+func (o sqlOps) Query(q query.Query) (query.Results, error) {
+	var (
+		where []string
+		args  []interface{}
+	)
+	if prefix := cleanPrefix(q.Prefix); prefix != "/" {
+		args = append(args, escapeLike(prefix+"/")+"%")
+		where = append(where, fmt.Sprintf("key LIKE $%d", len(args)))
+	}
+
+	// Key comparisons are resolved by the database, so range
+	// scans seek to their bound using the primary key index.
+	var filters []query.Filter
+	for _, f := range q.Filters {
+		fkc, ok := f.(query.FilterKeyCompare)
+		if pf, isPtr := f.(*query.FilterKeyCompare); isPtr {
+			fkc, ok = *pf, true
+		}
+		op, supported := sqlCompareOps[fkc.Op]
+		if !ok || !supported {
+			filters = append(filters, f)
+			continue
+		}
+		args = append(args, fkc.Key)
+		where = append(where, fmt.Sprintf("key %s $%d", op, len(args)))
+	}
+
+	naive := query.Query{Filters: filters, Orders: q.Orders, Limit: q.Limit, Offset: q.Offset}
+	order := ""
+	if len(q.Orders) == 1 {
+		switch q.Orders[0].(type) {
+		case query.OrderByKey, *query.OrderByKey:
+			order = " ORDER BY key ASC"
+			naive.Orders = nil
+		case query.OrderByKeyDescending, *query.OrderByKeyDescending:
+			order = " ORDER BY key DESC"
+			naive.Orders = nil
+		}
+	}
+	limit := ""
+	if len(naive.Filters) == 0 && len(naive.Orders) == 0 {
+		if q.Limit > 0 {
+			limit += fmt.Sprintf(" LIMIT %d", q.Limit)
+		}
+		if q.Offset > 0 {
+			limit += fmt.Sprintf(" OFFSET %d", q.Offset)
+		}
+		naive.Limit, naive.Offset = 0, 0
+	}
+
+	columns := "key, value, octet_length(value)"
+	if q.KeysOnly {
+		columns = "key, NULL::bytea, octet_length(value)"
+	}
+	stmt := fmt.Sprintf("SELECT %s FROM %s", columns, o.table)
+	if len(where) > 0 {
+		stmt += " WHERE " + strings.Join(where, " AND ")
+	}
+	stmt += order + limit
+
+	// Rows outside transactions are streamed until results are closed,
+	// so they aren't bounded by the op timeout.
+	ctx, cancel := o.ctx, func() {}
+	if ctx == nil {
+		ctx, cancel = context.WithCancel(context.Background())
+	}
+	rows, err := o.q.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		cancel()
+		return nil, opError("querying keys", err)
+	}
+
+	next := func() (query.Result, bool) {
+		if !rows.Next() {
+			if err := rows.Err(); err != nil {
+				return query.Result{Error: opError("iterating rows", err)}, true
+			}
+			return query.Result{}, false
+		}
+		var e query.Entry
+		if err := rows.Scan(&e.Key, &e.Value, &e.Size); err != nil {
+			return query.Result{Error: opError("scanning row", err)}, true
+		}
+		return query.Result{Entry: e}, true
+	}
+	closeRows := func() error {
+		defer cancel()
+		return rows.Close()
+	}
+
+	var res query.Results
+	if o.buffered {
+		var entries []query.Entry
+		for {
+			r, ok := next()
+			if !ok {
+				break
+			}
+			if r.Error != nil {
+				_ = closeRows()
+				return nil, r.Error
+			}
+			entries = append(entries, r.Entry)
+		}
+		if err := closeRows(); err != nil {
+			return nil, fmt.Errorf("closing rows: %s", err)
+		}
+		res = query.ResultsWithEntries(q, entries)
+	} else {
+		res = query.ResultsFromIterator(q, query.Iterator{Next: next, Close: closeRows})
+	}
+	return query.ResultsReplaceQuery(query.NaiveQueryApply(naive, res), q), nil
+}
+
+// opError returns errSerialization if err is a serialization failure of
+// a transaction, which can be retried, or err with context otherwise.
+func opError(msg string, err error) error {
+	if isSerializationFailure(err) {
+		return errSerialization
+	}
+	return fmt.Errorf("%s: %s", msg, err)
+}
+
+// isSerializationFailure returns true if err is a serialization failure
+// or a deadlock detected by the database.
+func isSerializationFailure(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && (pqErr.Code == "40001" || pqErr.Code == "40P01")
+}
+
+func cleanPrefix(prefix string) string {
+	return datastore.NewKey(prefix).String()
+}
+
+// escapeLike escapes LIKE pattern wildcards in s.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,161 @@
+package pgds_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	dstest "github.com/ipfs/go-datastore/test"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/pgds"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func TestSuite(t *testing.T) {
+	ds := newDatastore(t)
+	dstest.SubtestAll(t, ds)
+}
+
+func TestTxn(t *testing.T) {
+	ds := newDatastore(t)
+	k := datastore.NewKey("/a/b")
+
+	txn, err := ds.NewTransaction(false)
+	require.NoError(t, err)
+	require.NoError(t, txn.Put(k, []byte("1")))
+	v, err := txn.Get(k)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), v)
+	_, err = ds.Get(k)
+	require.Equal(t, datastore.ErrNotFound, err)
+	txn.Discard()
+
+	_, err = ds.Get(k)
+	require.Equal(t, datastore.ErrNotFound, err)
+
+	txn, err = ds.NewTransaction(false)
+	require.NoError(t, err)
+	require.NoError(t, txn.Put(k, []byte("2")))
+	require.NoError(t, txn.Commit())
+	v, err = ds.Get(k)
+	require.NoError(t, err)
+	require.Equal(t, []byte("2"), v)
+}
+
+func TestTxnRetry(t *testing.T) {
+	ds := newDatastore(t)
+	k := datastore.NewKey("/counter")
+	require.NoError(t, ds.Put(k, []byte("0")))
+
+	other := datastore.NewKey("/other")
+	require.NoError(t, ds.Put(other, []byte("v")))
+
+	// txn1 and txn2 read keys written by each other, so one of them
+	// fails to commit. The retry succeeds if the reads didn't change.
+	txn1, err := ds.NewTransaction(false)
+	require.NoError(t, err)
+	_, err = txn1.Get(other)
+	require.NoError(t, err)
+	require.NoError(t, txn1.Put(k, []byte("1")))
+	txn2, err := ds.NewTransaction(false)
+	require.NoError(t, err)
+	_, err = txn2.Get(k)
+	require.NoError(t, err)
+	require.NoError(t, txn2.Put(other, []byte("v")))
+	require.NoError(t, txn2.Commit())
+	require.NoError(t, txn1.Commit())
+
+	v, err := ds.Get(k)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), v)
+
+	// If a read changed, the transaction conflicts.
+	txn1, err = ds.NewTransaction(false)
+	require.NoError(t, err)
+	_, err = txn1.Get(other)
+	require.NoError(t, err)
+	require.NoError(t, txn1.Put(k, []byte("2")))
+	txn2, err = ds.NewTransaction(false)
+	require.NoError(t, err)
+	_, err = txn2.Get(k)
+	require.NoError(t, err)
+	require.NoError(t, txn2.Put(other, []byte("changed")))
+	require.NoError(t, txn2.Commit())
+	require.Equal(t, pgds.ErrConflict, txn1.Commit())
+
+	v, err = ds.Get(k)
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), v)
+}
+
+func TestTxnQueryWhileWriting(t *testing.T) {
+	ds := newDatastore(t)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, ds.Put(datastore.NewKey(fmt.Sprintf("/old/%d", i)), []byte("v")))
+	}
+
+	txn, err := ds.NewTransaction(false)
+	require.NoError(t, err)
+	defer txn.Discard()
+	res, err := txn.Query(query.Query{Prefix: "/old"})
+	require.NoError(t, err)
+	for r := range res.Next() {
+		require.NoError(t, r.Error)
+		require.NoError(t, txn.Delete(datastore.NewKey(r.Key)))
+		require.NoError(t, txn.Put(datastore.NewKey("/new").Child(datastore.NewKey(r.Key)), r.Value))
+	}
+	require.NoError(t, txn.Commit())
+
+	res, err = ds.Query(query.Query{Prefix: "/new", KeysOnly: true})
+	require.NoError(t, err)
+	all, err := res.Rest()
+	require.NoError(t, err)
+	require.Len(t, all, 10)
+	res, err = ds.Query(query.Query{Prefix: "/old", KeysOnly: true})
+	require.NoError(t, err)
+	all, err = res.Rest()
+	require.NoError(t, err)
+	require.Empty(t, all)
+}
+
+func TestPrefixBoundaries(t *testing.T) {
+	ds := newDatastore(t)
+	for _, k := range []string{"/a", "/a/1", "/a/2", "/ab/1", "/a_/1", "/a%/1"} {
+		require.NoError(t, ds.Put(datastore.NewKey(k), []byte(k)))
+	}
+	res, err := ds.Query(query.Query{Prefix: "/a", Orders: []query.Order{query.OrderByKeyDescending{}}})
+	require.NoError(t, err)
+	all, err := res.Rest()
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	require.Equal(t, "/a/2", all[0].Key)
+	require.Equal(t, "/a/1", all[1].Key)
+}
+
+func TestQueryKeyCompare(t *testing.T) {
+	ds := newDatastore(t)
+	for _, k := range []string{"/a/1", "/a/2", "/a/3", "/b/1"} {
+		require.NoError(t, ds.Put(datastore.NewKey(k), []byte(k)))
+	}
+	res, err := ds.Query(query.Query{
+		Prefix:  "/a",
+		Filters: []query.Filter{query.FilterKeyCompare{Op: query.GreaterThanOrEqual, Key: "/a/2"}},
+		Orders:  []query.Order{query.OrderByKey{}},
+		Limit:   1,
+	})
+	require.NoError(t, err)
+	all, err := res.Rest()
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Equal(t, "/a/2", all[0].Key)
+}
+
+func newDatastore(t *testing.T) *pgds.Datastore {
+	uri := os.Getenv(tests.PostgresURIEnv)
+	if uri == "" {
+		t.Skipf("%s isn't set", tests.PostgresURIEnv)
+	}
+	return tests.NewPostgresDatastore(t, uri)
+}
@@ -0,0 +1,295 @@
+package pgds
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+)
+
+var (
+	// ErrConflict is returned when a transaction can't be committed because
+	// data it read was concurrently modified. The transaction should be
+	// discarded, and retried by the caller.
+	ErrConflict = errors.New("transaction conflicts with a concurrent transaction")
+
+	// errSerialization is returned by operations that failed because of a
+	// serialization failure, so the transaction can be retried.
+	errSerialization = errors.New("serialization failure")
+)
+
+// NewTransaction starts a new transaction. Transactions are serializable,
+// and read-only transactions read a consistent snapshot of the datastore.
+//
+// If a write transaction fails because of a concurrent transaction, its
+// operations are replayed in a new transaction. The retry succeeds only if
+// every read returns the same result, so writes based on them are still
+// valid; otherwise, ErrConflict is returned.
+func (d *Datastore) NewTransaction(readOnly bool) (datastore.Txn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), d.cfg.txnTimeout)
+	opts := &sql.TxOptions{Isolation: sql.LevelSerializable}
+	if readOnly {
+		opts = &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}
+	}
+	t := &txn{db: d.db, opts: opts, ctx: ctx, cancel: cancel, table: d.cfg.tableName, retries: d.cfg.txnRetries}
+	if err := t.begin(); err != nil {
+		cancel()
+		return nil, err
+	}
+	return t, nil
+}
+
+type txn struct {
+	db      *sql.DB
+	opts    *sql.TxOptions
+	ctx     context.Context
+	cancel  context.CancelFunc
+	table   string
+	retries int
+
+	tx *sql.Tx
+	// replays contains the executed operations, which are replayed
+	// if the transaction is retried.
+	replays []func(sqlOps) error
+	retried int
+}
+
+var errReadChanged = errors.New("read result changed")
+
+func (t *txn) begin() error {
+	tx, err := t.db.BeginTx(t.ctx, t.opts)
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %s", err)
+	}
+	t.tx = tx
+	return nil
+}
+
+func (t *txn) ops() sqlOps {
+	// Transactions use a single connection, which can't run other
+	// statements while query rows are being read, so query results
+	// are read completely.
+	return sqlOps{q: t.tx, table: t.table, ctx: t.ctx, buffered: true}
+}
+
+// do executes op, retrying the transaction on serialization failures.
+func (t *txn) do(op func(sqlOps) error) error {
+	for {
+		err := op(t.ops())
+		if err != errSerialization {
+			return err
+		}
+		if err := t.retry(); err != nil {
+			return err
+		}
+	}
+}
+
+// retry rolls back the current transaction, and replays the executed
+// operations in a new one.
+func (t *txn) retry() error {
+	for {
+		if t.retried == t.retries {
+			return ErrConflict
+		}
+		t.retried++
+		_ = t.tx.Rollback()
+		if err := t.begin(); err != nil {
+			return err
+		}
+		err := t.replay()
+		if err == errReadChanged {
+			return ErrConflict
+		}
+		if err != errSerialization {
+			return err
+		}
+	}
+}
+
+func (t *txn) replay() error {
+	for _, r := range t.replays {
+		if err := r(t.ops()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get returns the value of key.
+func (t *txn) Get(key datastore.Key) ([]byte, error) {
+	var (
+		value  []byte
+		getErr error
+	)
+	err := t.do(func(o sqlOps) error {
+		value, getErr = o.Get(key)
+		return serializationError(getErr)
+	})
+	if err != nil {
+		return nil, err
+	}
+	t.replays = append(t.replays, func(o sqlOps) error {
+		v, err := o.Get(key)
+		if err == errSerialization {
+			return err
+		}
+		if !sameError(err, getErr) || !bytes.Equal(v, value) {
+			return errReadChanged
+		}
+		return nil
+	})
+	return value, getErr
+}
+
+// Has returns true if key exists.
+func (t *txn) Has(key datastore.Key) (bool, error) {
+	var (
+		exists bool
+		hasErr error
+	)
+	err := t.do(func(o sqlOps) error {
+		exists, hasErr = o.Has(key)
+		return serializationError(hasErr)
+	})
+	if err != nil {
+		return false, err
+	}
+	t.replays = append(t.replays, func(o sqlOps) error {
+		e, err := o.Has(key)
+		if err == errSerialization {
+			return err
+		}
+		if !sameError(err, hasErr) || e != exists {
+			return errReadChanged
+		}
+		return nil
+	})
+	return exists, hasErr
+}
+
+// GetSize returns the size of the value of key.
+func (t *txn) GetSize(key datastore.Key) (int, error) {
+	var (
+		size    int
+		sizeErr error
+	)
+	err := t.do(func(o sqlOps) error {
+		size, sizeErr = o.GetSize(key)
+		return serializationError(sizeErr)
+	})
+	if err != nil {
+		return -1, err
+	}
+	t.replays = append(t.replays, func(o sqlOps) error {
+		s, err := o.GetSize(key)
+		if err == errSerialization {
+			return err
+		}
+		if !sameError(err, sizeErr) || s != size {
+			return errReadChanged
+		}
+		return nil
+	})
+	return size, sizeErr
+}
+
+// Query executes q.
+func (t *txn) Query(q query.Query) (query.Results, error) {
+	var entries []query.Entry
+	err := t.do(func(o sqlOps) error {
+		var err error
+		entries, err = queryEntries(o, q)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	t.replays = append(t.replays, func(o sqlOps) error {
+		es, err := queryEntries(o, q)
+		if err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(es, entries) {
+			return errReadChanged
+		}
+		return nil
+	})
+	return query.ResultsWithEntries(q, entries), nil
+}
+
+// Put sets the value of key.
+func (t *txn) Put(key datastore.Key, value []byte) error {
+	op := func(o sqlOps) error { return o.Put(key, value) }
+	if err := t.do(op); err != nil {
+		return err
+	}
+	t.replays = append(t.replays, op)
+	return nil
+}
+
+// Delete deletes key. Deleting a missing key isn't an error.
+func (t *txn) Delete(key datastore.Key) error {
+	op := func(o sqlOps) error { return o.Delete(key) }
+	if err := t.do(op); err != nil {
+		return err
+	}
+	t.replays = append(t.replays, op)
+	return nil
+}
+
+// Commit commits the transaction.
+func (t *txn) Commit() error {
+	defer t.cancel()
+	for {
+		err := t.tx.Commit()
+		if err == nil {
+			return nil
+		}
+		if !isSerializationFailure(err) {
+			return fmt.Errorf("committing transaction: %s", err)
+		}
+		if err := t.retry(); err != nil {
+			_ = t.tx.Rollback()
+			return err
+		}
+	}
+}
+
+// Discard discards the transaction.
+func (t *txn) Discard() {
+	defer t.cancel()
+	if err := t.tx.Rollback(); err != nil && err != sql.ErrTxDone {
+		log.Errorf("rolling back transaction: %s", err)
+	}
+}
+
+func queryEntries(o sqlOps, q query.Query) ([]query.Entry, error) {
+	res, err := o.Query(q)
+	if err != nil {
+		return nil, err
+	}
+	return res.Rest()
+}
+
+// serializationError returns err if it's a serialization failure, and nil
+// otherwise, since other errors are results of the operation.
+func serializationError(err error) error {
+	if err == errSerialization {
+		return err
+	}
+	return nil
+}
+
+// sameError returns true if two read results have the same error.
+func sameError(a, b error) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Error() == b.Error()
+}
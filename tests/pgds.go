@@ -0,0 +1,54 @@
+package tests
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/pgds"
+)
+
+// PostgresURIEnv is the environment variable with the URI of a PostgreSQL
+// database used by tests that support running with the pgds datastore.
+const PostgresURIEnv = "POWERGATE_TEST_POSTGRES_URI"
+
+// NewTxnDatastore returns a new empty datastore for tests. If PostgresURIEnv
+// is set, it returns a pgds datastore in a new table that is dropped when the
+// test finishes. Otherwise, it returns a TxMapDatastore.
+func NewTxnDatastore(t *testing.T) datastore.TxnDatastore {
+	uri := os.Getenv(PostgresURIEnv)
+	if uri == "" {
+		return NewTxMapDatastore()
+	}
+	return NewPostgresDatastore(t, uri)
+}
+
+// NewPostgresDatastore returns a pgds datastore in a new table of the
+// database in uri. The table is dropped when the test finishes.
+func NewPostgresDatastore(t *testing.T, uri string) *pgds.Datastore {
+	buf := make([]byte, 8)
+	_, err := rand.Read(buf)
+	require.NoError(t, err)
+	table := "test_" + hex.EncodeToString(buf)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	ds, err := pgds.New(ctx, uri, pgds.WithTableName(table))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, ds.Close())
+		db, err := sql.Open("postgres", uri)
+		require.NoError(t, err)
+		defer func() { require.NoError(t, db.Close()) }()
+		_, err = db.Exec(fmt.Sprintf("DROP TABLE %s", table))
+		require.NoError(t, err)
+	})
+	return ds
+}
@@ -10,12 +10,7 @@ import (
 
 // Wrap wraps a TxDatastore with a namespace prefix.
 func Wrap(child ds.TxnDatastore, prefix string) *Datastore {
-	parts := strings.Split(prefix, "/")
-	prefixKey := ds.NewKey("/")
-	for _, part := range parts {
-		prefixKey = prefixKey.ChildString(part)
-	}
-	t := kt.PrefixTransform{Prefix: prefixKey}
+	t := prefixTransform(prefix)
 	nds := &Datastore{
 		child:        child,
 		Datastore:    kt.Wrap(child, t),
@@ -24,6 +19,21 @@ func Wrap(child ds.TxnDatastore, prefix string) *Datastore {
 	return nds
 }
 
+// WrapTxn wraps a transaction with a namespace prefix, so it can be shared
+// by stores using different namespaces of the same datastore.
+func WrapTxn(t ds.Txn, prefix string) ds.Txn {
+	return &txn{Txn: t, ds: &Datastore{KeyTransform: prefixTransform(prefix)}}
+}
+
+func prefixTransform(prefix string) kt.PrefixTransform {
+	parts := strings.Split(prefix, "/")
+	prefixKey := ds.NewKey("/")
+	for _, part := range parts {
+		prefixKey = prefixKey.ChildString(part)
+	}
+	return kt.PrefixTransform{Prefix: prefixKey}
+}
+
 // Datastore keeps a KeyTransform function.
 type Datastore struct {
 	child ds.TxnDatastore
@@ -36,6 +46,25 @@ type txn struct {
 	ds *Datastore
 }
 
+// NewRootTransaction returns a transaction of the root datastore, which is the
+// innermost datastore wrapped by d. It can be shared by stores in different
+// namespaces of the root datastore wrapping it with WrapRootTxn.
+func (d *Datastore) NewRootTransaction(readOnly bool) (ds.Txn, error) {
+	if c, ok := d.child.(*Datastore); ok {
+		return c.NewRootTransaction(readOnly)
+	}
+	return d.child.NewTransaction(readOnly)
+}
+
+// WrapRootTxn wraps a transaction of the root datastore of d with the
+// namespace prefixes of d.
+func (d *Datastore) WrapRootTxn(t ds.Txn) ds.Txn {
+	if c, ok := d.child.(*Datastore); ok {
+		t = c.WrapRootTxn(t)
+	}
+	return &txn{Txn: t, ds: d}
+}
+
 // NewTransaction returns a transaction wrapped by the selected namespace prefix.
 func (d *Datastore) NewTransaction(readOnly bool) (ds.Txn, error) {
 	t, err := d.child.NewTransaction(readOnly)
@@ -0,0 +1,37 @@
+package txndstransform
+
+import (
+	"testing"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/powergate/v2/tests"
+)
+
+func TestRootTransaction(t *testing.T) {
+	t.Parallel()
+	root := tests.NewTxMapDatastore()
+	a := Wrap(Wrap(root, "ffs/manager"), "api")
+	b := Wrap(Wrap(root, "ffs/scheduler"), "tstore")
+
+	txn, err := a.NewRootTransaction(false)
+	require.NoError(t, err)
+	defer txn.Discard()
+	err = a.WrapRootTxn(txn).Put(ds.NewKey("k1"), []byte("v1"))
+	require.NoError(t, err)
+	err = b.WrapRootTxn(txn).Put(ds.NewKey("k2"), []byte("v2"))
+	require.NoError(t, err)
+
+	// Nothing is written until the transaction is committed.
+	_, err = root.Get(ds.NewKey("/ffs/manager/api/k1"))
+	require.Equal(t, ds.ErrNotFound, err)
+
+	err = txn.Commit()
+	require.NoError(t, err)
+	v, err := root.Get(ds.NewKey("/ffs/manager/api/k1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("v1"), v)
+	v, err = b.Get(ds.NewKey("k2"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("v2"), v)
+}